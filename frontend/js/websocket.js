@@ -59,6 +59,8 @@ export function initWebsocket () {
     window.addEventListener('EventOutputChunk', onOutputChunk)
     window.addEventListener('EventExecutionStarted', onExecutionStarted)
     window.addEventListener('EventExecutionFinished', onExecutionFinished)
+    window.addEventListener('EventApprovalRequested', onApprovalRequested)
+    window.addEventListener('EventApprovalResolved', onApprovalResolved)
     window.addEventListener('pagehide', stopEventStream)
     listenersInitialized = true
   }
@@ -205,7 +207,9 @@ const eventCaseToTypeName = {
   executionFinished: 'EventExecutionFinished',
   executionStarted: 'EventExecutionStarted',
   outputChunk: 'EventOutputChunk',
-  heartbeat: 'EventHeartbeat'
+  heartbeat: 'EventHeartbeat',
+  approvalRequested: 'EventApprovalRequested',
+  approvalResolved: 'EventApprovalResolved'
 }
 
 function handleEvent (msg) {
@@ -235,6 +239,8 @@ function handleEvent (msg) {
       break
     case 'EventExecutionFinished':
     case 'EventExecutionStarted':
+    case 'EventApprovalRequested':
+    case 'EventApprovalResolved':
       window.dispatchEvent(j)
       break
     default:
@@ -279,3 +285,11 @@ function onExecutionFinished (evt) {
   applyExecutionLogEntry(evt.payload.logEntry)
   applyExecutionFinishedBindingState(evt.payload.logEntry)
 }
+
+function onApprovalRequested (evt) {
+  applyExecutionLogEntry(evt.payload.approval?.logEntry)
+}
+
+function onApprovalResolved (evt) {
+  applyExecutionLogEntry(evt.payload.logEntry)
+}
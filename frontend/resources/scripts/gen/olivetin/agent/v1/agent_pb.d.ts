@@ -0,0 +1,209 @@
+// @generated by protoc-gen-es v2.12.1
+// @generated from file olivetin/agent/v1/agent.proto (package olivetin.agent.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file olivetin/agent/v1/agent.proto.
+ */
+export declare const file_olivetin_agent_v1_agent: GenFile;
+
+/**
+ * @generated from message olivetin.agent.v1.ConnectRequest
+ */
+export declare type ConnectRequest = Message<"olivetin.agent.v1.ConnectRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string hostname = 2;
+   */
+  hostname: string;
+
+  /**
+   * @generated from field: string version = 3;
+   */
+  version: string;
+};
+
+/**
+ * Describes the message olivetin.agent.v1.ConnectRequest.
+ * Use `create(ConnectRequestSchema)` to create a new message.
+ */
+export declare const ConnectRequestSchema: GenMessage<ConnectRequest>;
+
+/**
+ * @generated from message olivetin.agent.v1.AgentJob
+ */
+export declare type AgentJob = Message<"olivetin.agent.v1.AgentJob"> & {
+  /**
+   * @generated from field: string execution_tracking_id = 1;
+   */
+  executionTrackingId: string;
+
+  /**
+   * @generated from field: bool kill = 2;
+   */
+  kill: boolean;
+
+  /**
+   * @generated from field: string shell = 3;
+   */
+  shell: string;
+
+  /**
+   * @generated from field: repeated string exec = 4;
+   */
+  exec: string[];
+
+  /**
+   * KEY=value pairs, added to the agent's own environment.
+   *
+   * @generated from field: repeated string env = 5;
+   */
+  env: string[];
+
+  /**
+   * Seconds
+   *
+   * @generated from field: int32 timeout = 6;
+   */
+  timeout: number;
+};
+
+/**
+ * Describes the message olivetin.agent.v1.AgentJob.
+ * Use `create(AgentJobSchema)` to create a new message.
+ */
+export declare const AgentJobSchema: GenMessage<AgentJob>;
+
+/**
+ * @generated from message olivetin.agent.v1.SendOutputRequest
+ */
+export declare type SendOutputRequest = Message<"olivetin.agent.v1.SendOutputRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string execution_tracking_id = 2;
+   */
+  executionTrackingId: string;
+
+  /**
+   * @generated from field: string output = 3;
+   */
+  output: string;
+
+  /**
+   * "stdout" or "stderr"
+   *
+   * @generated from field: string stream = 4;
+   */
+  stream: string;
+};
+
+/**
+ * Describes the message olivetin.agent.v1.SendOutputRequest.
+ * Use `create(SendOutputRequestSchema)` to create a new message.
+ */
+export declare const SendOutputRequestSchema: GenMessage<SendOutputRequest>;
+
+/**
+ * @generated from message olivetin.agent.v1.SendOutputResponse
+ */
+export declare type SendOutputResponse = Message<"olivetin.agent.v1.SendOutputResponse"> & {
+};
+
+/**
+ * Describes the message olivetin.agent.v1.SendOutputResponse.
+ * Use `create(SendOutputResponseSchema)` to create a new message.
+ */
+export declare const SendOutputResponseSchema: GenMessage<SendOutputResponse>;
+
+/**
+ * @generated from message olivetin.agent.v1.FinishJobRequest
+ */
+export declare type FinishJobRequest = Message<"olivetin.agent.v1.FinishJobRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string execution_tracking_id = 2;
+   */
+  executionTrackingId: string;
+
+  /**
+   * @generated from field: int32 exit_code = 3;
+   */
+  exitCode: number;
+
+  /**
+   * @generated from field: bool timed_out = 4;
+   */
+  timedOut: boolean;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message olivetin.agent.v1.FinishJobRequest.
+ * Use `create(FinishJobRequestSchema)` to create a new message.
+ */
+export declare const FinishJobRequestSchema: GenMessage<FinishJobRequest>;
+
+/**
+ * @generated from message olivetin.agent.v1.FinishJobResponse
+ */
+export declare type FinishJobResponse = Message<"olivetin.agent.v1.FinishJobResponse"> & {
+};
+
+/**
+ * Describes the message olivetin.agent.v1.FinishJobResponse.
+ * Use `create(FinishJobResponseSchema)` to create a new message.
+ */
+export declare const FinishJobResponseSchema: GenMessage<FinishJobResponse>;
+
+/**
+ * AgentService is called by olivetin-agent processes running on other hosts.
+ * Agents hold Connect open to receive jobs, and report back with unary calls,
+ * as the OliveTin HTTP server does not support bidirectional streams.
+ *
+ * @generated from service olivetin.agent.v1.AgentService
+ */
+export declare const AgentService: GenService<{
+  /**
+   * @generated from rpc olivetin.agent.v1.AgentService.Connect
+   */
+  connect: {
+    methodKind: "server_streaming";
+    input: typeof ConnectRequestSchema;
+    output: typeof AgentJobSchema;
+  },
+  /**
+   * @generated from rpc olivetin.agent.v1.AgentService.SendOutput
+   */
+  sendOutput: {
+    methodKind: "unary";
+    input: typeof SendOutputRequestSchema;
+    output: typeof SendOutputResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.agent.v1.AgentService.FinishJob
+   */
+  finishJob: {
+    methodKind: "unary";
+    input: typeof FinishJobRequestSchema;
+    output: typeof FinishJobResponseSchema;
+  },
+}>;
@@ -0,0 +1,63 @@
+// @generated by protoc-gen-es v2.12.1
+// @generated from file olivetin/agent/v1/agent.proto (package olivetin.agent.v1, syntax proto3)
+/* eslint-disable */
+
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+
+/**
+ * Describes the file olivetin/agent/v1/agent.proto.
+ */
+export const file_olivetin_agent_v1_agent = /*@__PURE__*/
+  fileDesc("Ch1vbGl2ZXRpbi9hZ2VudC92MS9hZ2VudC5wcm90bxIRb2xpdmV0aW4uYWdlbnQudjEiQQoOQ29ubmVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIQCghob3N0bmFtZRgCIAEoCRIPCgd2ZXJzaW9uGAMgASgJInIKCEFnZW50Sm9iEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgBIAEoCRIMCgRraWxsGAIgASgIEg0KBXNoZWxsGAMgASgJEgwKBGV4ZWMYBCADKAkSCwoDZW52GAUgAygJEg8KB3RpbWVvdXQYBiABKAUiYAoRU2VuZE91dHB1dFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYAiABKAkSDgoGb3V0cHV0GAMgASgJEg4KBnN0cmVhbRgEIAEoCSIUChJTZW5kT3V0cHV0UmVzcG9uc2UidAoQRmluaXNoSm9iUmVxdWVzdBIMCgRuYW1lGAEgASgJEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgCIAEoCRIRCglleGl0X2NvZGUYAyABKAUSEQoJdGltZWRfb3V0GAQgASgIEg0KBWVycm9yGAUgASgJIhMKEUZpbmlzaEpvYlJlc3BvbnNlMpQCCgxBZ2VudFNlcnZpY2USTQoHQ29ubmVjdBIhLm9saXZldGluLmFnZW50LnYxLkNvbm5lY3RSZXF1ZXN0Ghsub2xpdmV0aW4uYWdlbnQudjEuQWdlbnRKb2IiADABElsKClNlbmRPdXRwdXQSJC5vbGl2ZXRpbi5hZ2VudC52MS5TZW5kT3V0cHV0UmVxdWVzdBolLm9saXZldGluLmFnZW50LnYxLlNlbmRPdXRwdXRSZXNwb25zZSIAElgKCUZpbmlzaEpvYhIjLm9saXZldGluLmFnZW50LnYxLkZpbmlzaEpvYlJlcXVlc3QaJC5vbGl2ZXRpbi5hZ2VudC52MS5GaW5pc2hKb2JSZXNwb25zZSIAQjxaOmdpdGh1Yi5jb20vT2xpdmVUaW4vT2xpdmVUaW4vZ2VuL29saXZldGluL2FnZW50L3YxO2FnZW50djFiBnByb3RvMw");
+
+/**
+ * Describes the message olivetin.agent.v1.ConnectRequest.
+ * Use `create(ConnectRequestSchema)` to create a new message.
+ */
+export const ConnectRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_agent_v1_agent, 0);
+
+/**
+ * Describes the message olivetin.agent.v1.AgentJob.
+ * Use `create(AgentJobSchema)` to create a new message.
+ */
+export const AgentJobSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_agent_v1_agent, 1);
+
+/**
+ * Describes the message olivetin.agent.v1.SendOutputRequest.
+ * Use `create(SendOutputRequestSchema)` to create a new message.
+ */
+export const SendOutputRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_agent_v1_agent, 2);
+
+/**
+ * Describes the message olivetin.agent.v1.SendOutputResponse.
+ * Use `create(SendOutputResponseSchema)` to create a new message.
+ */
+export const SendOutputResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_agent_v1_agent, 3);
+
+/**
+ * Describes the message olivetin.agent.v1.FinishJobRequest.
+ * Use `create(FinishJobRequestSchema)` to create a new message.
+ */
+export const FinishJobRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_agent_v1_agent, 4);
+
+/**
+ * Describes the message olivetin.agent.v1.FinishJobResponse.
+ * Use `create(FinishJobResponseSchema)` to create a new message.
+ */
+export const FinishJobResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_agent_v1_agent, 5);
+
+/**
+ * AgentService is called by olivetin-agent processes running on other hosts.
+ * Agents hold Connect open to receive jobs, and report back with unary calls,
+ * as the OliveTin HTTP server does not support bidirectional streams.
+ *
+ * @generated from service olivetin.agent.v1.AgentService
+ */
+export const AgentService = /*@__PURE__*/
+  serviceDesc(file_olivetin_agent_v1_agent, 0);
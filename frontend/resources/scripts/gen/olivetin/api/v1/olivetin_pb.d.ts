@@ -110,6 +110,11 @@ export declare type Action = Message<"olivetin.api.v1.Action"> & {
    * @generated from field: repeated olivetin.api.v1.ActionGroupMembership groups = 19;
    */
   groups: ActionGroupMembership[];
+
+  /**
+   * @generated from field: repeated olivetin.api.v1.ActionMqttExecHint exec_on_mqtt = 21;
+   */
+  execOnMqtt: ActionMqttExecHint[];
 };
 
 /**
@@ -175,6 +180,32 @@ export declare type ActionWebhookExecHint = Message<"olivetin.api.v1.ActionWebho
  */
 export declare const ActionWebhookExecHintSchema: GenMessage<ActionWebhookExecHint>;
 
+/**
+ * @generated from message olivetin.api.v1.ActionMqttExecHint
+ */
+export declare type ActionMqttExecHint = Message<"olivetin.api.v1.ActionMqttExecHint"> & {
+  /**
+   * @generated from field: string broker = 1;
+   */
+  broker: string;
+
+  /**
+   * @generated from field: string topic = 2;
+   */
+  topic: string;
+
+  /**
+   * @generated from field: string match_path = 3;
+   */
+  matchPath: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ActionMqttExecHint.
+ * Use `create(ActionMqttExecHintSchema)` to create a new message.
+ */
+export declare const ActionMqttExecHintSchema: GenMessage<ActionMqttExecHint>;
+
 /**
  * @generated from message olivetin.api.v1.ActionArgument
  */
@@ -353,6 +384,11 @@ export declare type EffectivePolicy = Message<"olivetin.api.v1.EffectivePolicy">
    * @generated from field: bool show_version_number = 3;
    */
   showVersionNumber: boolean;
+
+  /**
+   * @generated from field: bool show_audit_log = 4;
+   */
+  showAuditLog: boolean;
 };
 
 /**
@@ -662,6 +698,13 @@ export declare type GetLogsRequest = Message<"olivetin.api.v1.GetLogsRequest"> &
    * @generated from field: string filter = 4;
    */
   filter: string;
+
+  /**
+   * Optional; returns all the executions of a workflow run, and the run.
+   *
+   * @generated from field: string workflow_tracking_id = 5;
+   */
+  workflowTrackingId: string;
 };
 
 /**
@@ -787,6 +830,59 @@ export declare type LogEntry = Message<"olivetin.api.v1.LogEntry"> & {
    * @generated from field: repeated olivetin.api.v1.StartActionArgument arguments = 24;
    */
   arguments: StartActionArgument[];
+
+  /**
+   * @generated from field: bool awaiting_approval = 25;
+   */
+  awaitingApproval: boolean;
+
+  /**
+   * @generated from field: repeated string approved_by = 26;
+   */
+  approvedBy: string[];
+
+  /**
+   * @generated from field: string stdout = 27;
+   */
+  stdout: string;
+
+  /**
+   * @generated from field: string stderr = 28;
+   */
+  stderr: string;
+
+  /**
+   * The parsed output of actions with an outputFormat, as a JSON object. Empty if there is no result.
+   *
+   * @generated from field: string result_json = 29;
+   */
+  resultJson: string;
+
+  /**
+   * Empty unless the execution is a step of a workflow run.
+   *
+   * @generated from field: string workflow_tracking_id = 30;
+   */
+  workflowTrackingId: string;
+
+  /**
+   * @generated from field: string workflow_step = 31;
+   */
+  workflowStep: string;
+
+  /**
+   * The current attempt, for actions with a retry policy. 0 before the action is executed.
+   *
+   * @generated from field: int32 attempt = 32;
+   */
+  attempt: number;
+
+  /**
+   * The attempts that failed before the current one.
+   *
+   * @generated from field: repeated olivetin.api.v1.ExecutionAttempt attempts = 33;
+   */
+  attempts: ExecutionAttempt[];
 };
 
 /**
@@ -795,6 +891,47 @@ export declare type LogEntry = Message<"olivetin.api.v1.LogEntry"> & {
  */
 export declare const LogEntrySchema: GenMessage<LogEntry>;
 
+/**
+ * @generated from message olivetin.api.v1.ExecutionAttempt
+ */
+export declare type ExecutionAttempt = Message<"olivetin.api.v1.ExecutionAttempt"> & {
+  /**
+   * @generated from field: int32 attempt = 1;
+   */
+  attempt: number;
+
+  /**
+   * @generated from field: string datetime_started = 2;
+   */
+  datetimeStarted: string;
+
+  /**
+   * @generated from field: string datetime_finished = 3;
+   */
+  datetimeFinished: string;
+
+  /**
+   * @generated from field: int32 exit_code = 4;
+   */
+  exitCode: number;
+
+  /**
+   * @generated from field: bool timed_out = 5;
+   */
+  timedOut: boolean;
+
+  /**
+   * @generated from field: string output = 6;
+   */
+  output: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ExecutionAttempt.
+ * Use `create(ExecutionAttemptSchema)` to create a new message.
+ */
+export declare const ExecutionAttemptSchema: GenMessage<ExecutionAttempt>;
+
 /**
  * @generated from message olivetin.api.v1.GetLogsResponse
  */
@@ -823,6 +960,13 @@ export declare type GetLogsResponse = Message<"olivetin.api.v1.GetLogsResponse">
    * @generated from field: int64 start_offset = 5;
    */
   startOffset: bigint;
+
+  /**
+   * Set when workflow_tracking_id was requested and the run is still known.
+   *
+   * @generated from field: olivetin.api.v1.WorkflowRun workflow_run = 6;
+   */
+  workflowRun?: WorkflowRun | undefined;
 };
 
 /**
@@ -831,6 +975,135 @@ export declare type GetLogsResponse = Message<"olivetin.api.v1.GetLogsResponse">
  */
 export declare const GetLogsResponseSchema: GenMessage<GetLogsResponse>;
 
+/**
+ * @generated from message olivetin.api.v1.WorkflowRun
+ */
+export declare type WorkflowRun = Message<"olivetin.api.v1.WorkflowRun"> & {
+  /**
+   * @generated from field: string workflow_tracking_id = 1;
+   */
+  workflowTrackingId: string;
+
+  /**
+   * @generated from field: string workflow_id = 2;
+   */
+  workflowId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string user = 4;
+   */
+  user: string;
+
+  /**
+   * @generated from field: string status = 5;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string datetime_started = 6;
+   */
+  datetimeStarted: string;
+
+  /**
+   * @generated from field: string datetime_finished = 7;
+   */
+  datetimeFinished: string;
+
+  /**
+   * @generated from field: repeated olivetin.api.v1.WorkflowStepRun steps = 8;
+   */
+  steps: WorkflowStepRun[];
+};
+
+/**
+ * Describes the message olivetin.api.v1.WorkflowRun.
+ * Use `create(WorkflowRunSchema)` to create a new message.
+ */
+export declare const WorkflowRunSchema: GenMessage<WorkflowRun>;
+
+/**
+ * @generated from message olivetin.api.v1.WorkflowStepRun
+ */
+export declare type WorkflowStepRun = Message<"olivetin.api.v1.WorkflowStepRun"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string action_title = 2;
+   */
+  actionTitle: string;
+
+  /**
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string execution_tracking_id = 4;
+   */
+  executionTrackingId: string;
+
+  /**
+   * @generated from field: int32 exit_code = 5;
+   */
+  exitCode: number;
+
+  /**
+   * @generated from field: string error = 6;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.WorkflowStepRun.
+ * Use `create(WorkflowStepRunSchema)` to create a new message.
+ */
+export declare const WorkflowStepRunSchema: GenMessage<WorkflowStepRun>;
+
+/**
+ * @generated from message olivetin.api.v1.StartWorkflowRequest
+ */
+export declare type StartWorkflowRequest = Message<"olivetin.api.v1.StartWorkflowRequest"> & {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId: string;
+
+  /**
+   * @generated from field: repeated olivetin.api.v1.StartActionArgument arguments = 2;
+   */
+  arguments: StartActionArgument[];
+};
+
+/**
+ * Describes the message olivetin.api.v1.StartWorkflowRequest.
+ * Use `create(StartWorkflowRequestSchema)` to create a new message.
+ */
+export declare const StartWorkflowRequestSchema: GenMessage<StartWorkflowRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.StartWorkflowResponse
+ */
+export declare type StartWorkflowResponse = Message<"olivetin.api.v1.StartWorkflowResponse"> & {
+  /**
+   * @generated from field: string workflow_tracking_id = 1;
+   */
+  workflowTrackingId: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.StartWorkflowResponse.
+ * Use `create(StartWorkflowResponseSchema)` to create a new message.
+ */
+export declare const StartWorkflowResponseSchema: GenMessage<StartWorkflowResponse>;
+
 /**
  * @generated from message olivetin.api.v1.GetActionLogsRequest
  */
@@ -1005,6 +1278,13 @@ export declare type GetExecutionQueueResponse = Message<"olivetin.api.v1.GetExec
    * @generated from field: int32 total_active = 2;
    */
   totalActive: number;
+
+  /**
+   * Executions that are scheduled to run later, soonest first.
+   *
+   * @generated from field: repeated olivetin.api.v1.ScheduledExecution scheduled = 3;
+   */
+  scheduled: ScheduledExecution[];
 };
 
 /**
@@ -1014,81 +1294,417 @@ export declare type GetExecutionQueueResponse = Message<"olivetin.api.v1.GetExec
 export declare const GetExecutionQueueResponseSchema: GenMessage<GetExecutionQueueResponse>;
 
 /**
- * @generated from message olivetin.api.v1.ValidateArgumentTypeRequest
+ * @generated from message olivetin.api.v1.ScheduledExecution
  */
-export declare type ValidateArgumentTypeRequest = Message<"olivetin.api.v1.ValidateArgumentTypeRequest"> & {
+export declare type ScheduledExecution = Message<"olivetin.api.v1.ScheduledExecution"> & {
   /**
-   * @generated from field: string value = 1;
+   * Also the execution tracking ID, once it runs.
+   *
+   * @generated from field: string id = 1;
    */
-  value: string;
+  id: string;
 
   /**
-   * @generated from field: string type = 2;
+   * @generated from field: string binding_id = 2;
    */
-  type: string;
+  bindingId: string;
 
   /**
-   * @generated from field: string binding_id = 3;
+   * @generated from field: string action_title = 3;
    */
-  bindingId: string;
+  actionTitle: string;
 
   /**
-   * @generated from field: string argument_name = 4;
+   * @generated from field: string action_icon = 4;
    */
-  argumentName: string;
+  actionIcon: string;
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string datetime = 5;
+   */
+  datetime: string;
+
+  /**
+   * RFC3339
+   *
+   * @generated from field: string datetime_scheduled = 6;
+   */
+  datetimeScheduled: string;
+
+  /**
+   * @generated from field: repeated olivetin.api.v1.StartActionArgument arguments = 7;
+   */
+  arguments: StartActionArgument[];
+
+  /**
+   * @generated from field: string justification = 8;
+   */
+  justification: string;
+
+  /**
+   * @generated from field: string user = 9;
+   */
+  user: string;
+
+  /**
+   * @generated from field: bool can_cancel = 10;
+   */
+  canCancel: boolean;
 };
 
 /**
- * Describes the message olivetin.api.v1.ValidateArgumentTypeRequest.
- * Use `create(ValidateArgumentTypeRequestSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.ScheduledExecution.
+ * Use `create(ScheduledExecutionSchema)` to create a new message.
  */
-export declare const ValidateArgumentTypeRequestSchema: GenMessage<ValidateArgumentTypeRequest>;
+export declare const ScheduledExecutionSchema: GenMessage<ScheduledExecution>;
 
 /**
- * @generated from message olivetin.api.v1.ValidateArgumentTypeResponse
+ * @generated from message olivetin.api.v1.ScheduleActionRequest
  */
-export declare type ValidateArgumentTypeResponse = Message<"olivetin.api.v1.ValidateArgumentTypeResponse"> & {
+export declare type ScheduleActionRequest = Message<"olivetin.api.v1.ScheduleActionRequest"> & {
   /**
-   * @generated from field: bool valid = 1;
+   * @generated from field: string binding_id = 1;
    */
-  valid: boolean;
+  bindingId: string;
 
   /**
-   * @generated from field: string description = 2;
+   * RFC3339, for example 2026-01-02T02:00:00+01:00
+   *
+   * @generated from field: string datetime = 2;
    */
-  description: string;
+  datetime: string;
+
+  /**
+   * @generated from field: repeated olivetin.api.v1.StartActionArgument arguments = 3;
+   */
+  arguments: StartActionArgument[];
+
+  /**
+   * @generated from field: string justification = 4;
+   */
+  justification: string;
 };
 
 /**
- * Describes the message olivetin.api.v1.ValidateArgumentTypeResponse.
- * Use `create(ValidateArgumentTypeResponseSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.ScheduleActionRequest.
+ * Use `create(ScheduleActionRequestSchema)` to create a new message.
  */
-export declare const ValidateArgumentTypeResponseSchema: GenMessage<ValidateArgumentTypeResponse>;
+export declare const ScheduleActionRequestSchema: GenMessage<ScheduleActionRequest>;
 
 /**
- * @generated from message olivetin.api.v1.WatchExecutionRequest
+ * @generated from message olivetin.api.v1.ScheduleActionResponse
  */
-export declare type WatchExecutionRequest = Message<"olivetin.api.v1.WatchExecutionRequest"> & {
+export declare type ScheduleActionResponse = Message<"olivetin.api.v1.ScheduleActionResponse"> & {
   /**
-   * @generated from field: string execution_tracking_id = 1;
+   * @generated from field: olivetin.api.v1.ScheduledExecution scheduled_execution = 1;
    */
-  executionTrackingId: string;
+  scheduledExecution?: ScheduledExecution | undefined;
 };
 
 /**
- * Describes the message olivetin.api.v1.WatchExecutionRequest.
- * Use `create(WatchExecutionRequestSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.ScheduleActionResponse.
+ * Use `create(ScheduleActionResponseSchema)` to create a new message.
  */
-export declare const WatchExecutionRequestSchema: GenMessage<WatchExecutionRequest>;
+export declare const ScheduleActionResponseSchema: GenMessage<ScheduleActionResponse>;
 
 /**
- * @generated from message olivetin.api.v1.WatchExecutionUpdate
+ * @generated from message olivetin.api.v1.ListScheduledExecutionsRequest
  */
-export declare type WatchExecutionUpdate = Message<"olivetin.api.v1.WatchExecutionUpdate"> & {
-  /**
-   * @generated from field: string update = 1;
-   */
-  update: string;
+export declare type ListScheduledExecutionsRequest = Message<"olivetin.api.v1.ListScheduledExecutionsRequest"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.ListScheduledExecutionsRequest.
+ * Use `create(ListScheduledExecutionsRequestSchema)` to create a new message.
+ */
+export declare const ListScheduledExecutionsRequestSchema: GenMessage<ListScheduledExecutionsRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ListScheduledExecutionsResponse
+ */
+export declare type ListScheduledExecutionsResponse = Message<"olivetin.api.v1.ListScheduledExecutionsResponse"> & {
+  /**
+   * @generated from field: repeated olivetin.api.v1.ScheduledExecution scheduled_executions = 1;
+   */
+  scheduledExecutions: ScheduledExecution[];
+};
+
+/**
+ * Describes the message olivetin.api.v1.ListScheduledExecutionsResponse.
+ * Use `create(ListScheduledExecutionsResponseSchema)` to create a new message.
+ */
+export declare const ListScheduledExecutionsResponseSchema: GenMessage<ListScheduledExecutionsResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.CancelScheduledExecutionRequest
+ */
+export declare type CancelScheduledExecutionRequest = Message<"olivetin.api.v1.CancelScheduledExecutionRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.CancelScheduledExecutionRequest.
+ * Use `create(CancelScheduledExecutionRequestSchema)` to create a new message.
+ */
+export declare const CancelScheduledExecutionRequestSchema: GenMessage<CancelScheduledExecutionRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.CancelScheduledExecutionResponse
+ */
+export declare type CancelScheduledExecutionResponse = Message<"olivetin.api.v1.CancelScheduledExecutionResponse"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.CancelScheduledExecutionResponse.
+ * Use `create(CancelScheduledExecutionResponseSchema)` to create a new message.
+ */
+export declare const CancelScheduledExecutionResponseSchema: GenMessage<CancelScheduledExecutionResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.CronSchedule
+ */
+export declare type CronSchedule = Message<"olivetin.api.v1.CronSchedule"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string binding_id = 2;
+   */
+  bindingId: string;
+
+  /**
+   * @generated from field: string action_title = 3;
+   */
+  actionTitle: string;
+
+  /**
+   * @generated from field: string action_icon = 4;
+   */
+  actionIcon: string;
+
+  /**
+   * @generated from field: string cron_line = 5;
+   */
+  cronLine: string;
+
+  /**
+   * RFC3339, empty when paused or invalid
+   *
+   * @generated from field: string next_run = 6;
+   */
+  nextRun: string;
+
+  /**
+   * RFC3339, empty when it has not run since startup
+   *
+   * @generated from field: string previous_run = 7;
+   */
+  previousRun: string;
+
+  /**
+   * @generated from field: bool paused = 8;
+   */
+  paused: boolean;
+
+  /**
+   * Set when the cron line is invalid
+   *
+   * @generated from field: string error = 9;
+   */
+  error: string;
+
+  /**
+   * @generated from field: olivetin.api.v1.LogEntry last_execution = 10;
+   */
+  lastExecution?: LogEntry | undefined;
+
+  /**
+   * @generated from field: bool can_pause = 11;
+   */
+  canPause: boolean;
+};
+
+/**
+ * Describes the message olivetin.api.v1.CronSchedule.
+ * Use `create(CronScheduleSchema)` to create a new message.
+ */
+export declare const CronScheduleSchema: GenMessage<CronSchedule>;
+
+/**
+ * @generated from message olivetin.api.v1.ListCronSchedulesRequest
+ */
+export declare type ListCronSchedulesRequest = Message<"olivetin.api.v1.ListCronSchedulesRequest"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.ListCronSchedulesRequest.
+ * Use `create(ListCronSchedulesRequestSchema)` to create a new message.
+ */
+export declare const ListCronSchedulesRequestSchema: GenMessage<ListCronSchedulesRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ListCronSchedulesResponse
+ */
+export declare type ListCronSchedulesResponse = Message<"olivetin.api.v1.ListCronSchedulesResponse"> & {
+  /**
+   * @generated from field: repeated olivetin.api.v1.CronSchedule schedules = 1;
+   */
+  schedules: CronSchedule[];
+};
+
+/**
+ * Describes the message olivetin.api.v1.ListCronSchedulesResponse.
+ * Use `create(ListCronSchedulesResponseSchema)` to create a new message.
+ */
+export declare const ListCronSchedulesResponseSchema: GenMessage<ListCronSchedulesResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.PauseCronScheduleRequest
+ */
+export declare type PauseCronScheduleRequest = Message<"olivetin.api.v1.PauseCronScheduleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.PauseCronScheduleRequest.
+ * Use `create(PauseCronScheduleRequestSchema)` to create a new message.
+ */
+export declare const PauseCronScheduleRequestSchema: GenMessage<PauseCronScheduleRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.PauseCronScheduleResponse
+ */
+export declare type PauseCronScheduleResponse = Message<"olivetin.api.v1.PauseCronScheduleResponse"> & {
+  /**
+   * @generated from field: olivetin.api.v1.CronSchedule schedule = 1;
+   */
+  schedule?: CronSchedule | undefined;
+};
+
+/**
+ * Describes the message olivetin.api.v1.PauseCronScheduleResponse.
+ * Use `create(PauseCronScheduleResponseSchema)` to create a new message.
+ */
+export declare const PauseCronScheduleResponseSchema: GenMessage<PauseCronScheduleResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.ResumeCronScheduleRequest
+ */
+export declare type ResumeCronScheduleRequest = Message<"olivetin.api.v1.ResumeCronScheduleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ResumeCronScheduleRequest.
+ * Use `create(ResumeCronScheduleRequestSchema)` to create a new message.
+ */
+export declare const ResumeCronScheduleRequestSchema: GenMessage<ResumeCronScheduleRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ResumeCronScheduleResponse
+ */
+export declare type ResumeCronScheduleResponse = Message<"olivetin.api.v1.ResumeCronScheduleResponse"> & {
+  /**
+   * @generated from field: olivetin.api.v1.CronSchedule schedule = 1;
+   */
+  schedule?: CronSchedule | undefined;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ResumeCronScheduleResponse.
+ * Use `create(ResumeCronScheduleResponseSchema)` to create a new message.
+ */
+export declare const ResumeCronScheduleResponseSchema: GenMessage<ResumeCronScheduleResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.ValidateArgumentTypeRequest
+ */
+export declare type ValidateArgumentTypeRequest = Message<"olivetin.api.v1.ValidateArgumentTypeRequest"> & {
+  /**
+   * @generated from field: string value = 1;
+   */
+  value: string;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string binding_id = 3;
+   */
+  bindingId: string;
+
+  /**
+   * @generated from field: string argument_name = 4;
+   */
+  argumentName: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ValidateArgumentTypeRequest.
+ * Use `create(ValidateArgumentTypeRequestSchema)` to create a new message.
+ */
+export declare const ValidateArgumentTypeRequestSchema: GenMessage<ValidateArgumentTypeRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ValidateArgumentTypeResponse
+ */
+export declare type ValidateArgumentTypeResponse = Message<"olivetin.api.v1.ValidateArgumentTypeResponse"> & {
+  /**
+   * @generated from field: bool valid = 1;
+   */
+  valid: boolean;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ValidateArgumentTypeResponse.
+ * Use `create(ValidateArgumentTypeResponseSchema)` to create a new message.
+ */
+export declare const ValidateArgumentTypeResponseSchema: GenMessage<ValidateArgumentTypeResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.WatchExecutionRequest
+ */
+export declare type WatchExecutionRequest = Message<"olivetin.api.v1.WatchExecutionRequest"> & {
+  /**
+   * @generated from field: string execution_tracking_id = 1;
+   */
+  executionTrackingId: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.WatchExecutionRequest.
+ * Use `create(WatchExecutionRequestSchema)` to create a new message.
+ */
+export declare const WatchExecutionRequestSchema: GenMessage<WatchExecutionRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.WatchExecutionUpdate
+ */
+export declare type WatchExecutionUpdate = Message<"olivetin.api.v1.WatchExecutionUpdate"> & {
+  /**
+   * @generated from field: string update = 1;
+   */
+  update: string;
 };
 
 /**
@@ -1210,6 +1826,16 @@ export declare type WhoAmIResponse = Message<"olivetin.api.v1.WhoAmIResponse"> &
    * @generated from field: string sid = 5;
    */
   sid: string;
+
+  /**
+   * @generated from field: bool totp_available = 6;
+   */
+  totpAvailable: boolean;
+
+  /**
+   * @generated from field: bool totp_enrolled = 7;
+   */
+  totpEnrolled: boolean;
 };
 
 /**
@@ -1416,6 +2042,18 @@ export declare type EventStreamResponse = Message<"olivetin.api.v1.EventStreamRe
      */
     value: EventHeartbeat;
     case: "heartbeat";
+  } | {
+    /**
+     * @generated from field: olivetin.api.v1.EventApprovalRequested approval_requested = 8;
+     */
+    value: EventApprovalRequested;
+    case: "approvalRequested";
+  } | {
+    /**
+     * @generated from field: olivetin.api.v1.EventApprovalResolved approval_resolved = 9;
+     */
+    value: EventApprovalResolved;
+    case: "approvalResolved";
   } | { case: undefined; value?: undefined };
 };
 
@@ -1438,6 +2076,20 @@ export declare type EventOutputChunk = Message<"olivetin.api.v1.EventOutputChunk
    * @generated from field: string output = 2;
    */
   output: string;
+
+  /**
+   * "stdout" or "stderr"
+   *
+   * @generated from field: string stream = 3;
+   */
+  stream: string;
+
+  /**
+   * When the chunk was written, format: "2006-01-02 15:04:05.000"
+   *
+   * @generated from field: string datetime = 4;
+   */
+  datetime: string;
 };
 
 /**
@@ -1450,6 +2102,10 @@ export declare const EventOutputChunkSchema: GenMessage<EventOutputChunk>;
  * @generated from message olivetin.api.v1.EventEntityChanged
  */
 export declare type EventEntityChanged = Message<"olivetin.api.v1.EventEntityChanged"> & {
+  /**
+   * @generated from field: string entity_name = 1;
+   */
+  entityName: string;
 };
 
 /**
@@ -1574,6 +2230,16 @@ export declare type LocalUserLoginRequest = Message<"olivetin.api.v1.LocalUserLo
    * @generated from field: string password = 2;
    */
   password: string;
+
+  /**
+   * @generated from field: string totp_challenge = 3;
+   */
+  totpChallenge: string;
+
+  /**
+   * @generated from field: string totp_code = 4;
+   */
+  totpCode: string;
 };
 
 /**
@@ -1590,6 +2256,16 @@ export declare type LocalUserLoginResponse = Message<"olivetin.api.v1.LocalUserL
    * @generated from field: bool success = 1;
    */
   success: boolean;
+
+  /**
+   * @generated from field: bool totp_required = 2;
+   */
+  totpRequired: boolean;
+
+  /**
+   * @generated from field: string totp_challenge = 3;
+   */
+  totpChallenge: string;
 };
 
 /**
@@ -1598,6 +2274,104 @@ export declare type LocalUserLoginResponse = Message<"olivetin.api.v1.LocalUserL
  */
 export declare const LocalUserLoginResponseSchema: GenMessage<LocalUserLoginResponse>;
 
+/**
+ * @generated from message olivetin.api.v1.BeginTotpEnrolmentRequest
+ */
+export declare type BeginTotpEnrolmentRequest = Message<"olivetin.api.v1.BeginTotpEnrolmentRequest"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.BeginTotpEnrolmentRequest.
+ * Use `create(BeginTotpEnrolmentRequestSchema)` to create a new message.
+ */
+export declare const BeginTotpEnrolmentRequestSchema: GenMessage<BeginTotpEnrolmentRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.BeginTotpEnrolmentResponse
+ */
+export declare type BeginTotpEnrolmentResponse = Message<"olivetin.api.v1.BeginTotpEnrolmentResponse"> & {
+  /**
+   * @generated from field: string secret = 1;
+   */
+  secret: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: bytes qr_code_png = 3;
+   */
+  qrCodePng: Uint8Array;
+};
+
+/**
+ * Describes the message olivetin.api.v1.BeginTotpEnrolmentResponse.
+ * Use `create(BeginTotpEnrolmentResponseSchema)` to create a new message.
+ */
+export declare const BeginTotpEnrolmentResponseSchema: GenMessage<BeginTotpEnrolmentResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.ConfirmTotpEnrolmentRequest
+ */
+export declare type ConfirmTotpEnrolmentRequest = Message<"olivetin.api.v1.ConfirmTotpEnrolmentRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ConfirmTotpEnrolmentRequest.
+ * Use `create(ConfirmTotpEnrolmentRequestSchema)` to create a new message.
+ */
+export declare const ConfirmTotpEnrolmentRequestSchema: GenMessage<ConfirmTotpEnrolmentRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ConfirmTotpEnrolmentResponse
+ */
+export declare type ConfirmTotpEnrolmentResponse = Message<"olivetin.api.v1.ConfirmTotpEnrolmentResponse"> & {
+  /**
+   * @generated from field: repeated string recovery_codes = 1;
+   */
+  recoveryCodes: string[];
+};
+
+/**
+ * Describes the message olivetin.api.v1.ConfirmTotpEnrolmentResponse.
+ * Use `create(ConfirmTotpEnrolmentResponseSchema)` to create a new message.
+ */
+export declare const ConfirmTotpEnrolmentResponseSchema: GenMessage<ConfirmTotpEnrolmentResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.DisableTotpRequest
+ */
+export declare type DisableTotpRequest = Message<"olivetin.api.v1.DisableTotpRequest"> & {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.DisableTotpRequest.
+ * Use `create(DisableTotpRequestSchema)` to create a new message.
+ */
+export declare const DisableTotpRequestSchema: GenMessage<DisableTotpRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.DisableTotpResponse
+ */
+export declare type DisableTotpResponse = Message<"olivetin.api.v1.DisableTotpResponse"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.DisableTotpResponse.
+ * Use `create(DisableTotpResponseSchema)` to create a new message.
+ */
+export declare const DisableTotpResponseSchema: GenMessage<DisableTotpResponse>;
+
 /**
  * @generated from message olivetin.api.v1.PasswordHashRequest
  */
@@ -1619,73 +2393,279 @@ export declare const PasswordHashRequestSchema: GenMessage<PasswordHashRequest>;
  */
 export declare type PasswordHashResponse = Message<"olivetin.api.v1.PasswordHashResponse"> & {
   /**
-   * @generated from field: string hash = 1;
+   * @generated from field: string hash = 1;
+   */
+  hash: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.PasswordHashResponse.
+ * Use `create(PasswordHashResponseSchema)` to create a new message.
+ */
+export declare const PasswordHashResponseSchema: GenMessage<PasswordHashResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.LogoutRequest
+ */
+export declare type LogoutRequest = Message<"olivetin.api.v1.LogoutRequest"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.LogoutRequest.
+ * Use `create(LogoutRequestSchema)` to create a new message.
+ */
+export declare const LogoutRequestSchema: GenMessage<LogoutRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.LogoutResponse
+ */
+export declare type LogoutResponse = Message<"olivetin.api.v1.LogoutResponse"> & {
+  /**
+   * Set when the user should also be logged out of an OpenID Connect provider.
+   *
+   * @generated from field: string redirect_url = 1;
+   */
+  redirectUrl: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.LogoutResponse.
+ * Use `create(LogoutResponseSchema)` to create a new message.
+ */
+export declare const LogoutResponseSchema: GenMessage<LogoutResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.GetDiagnosticsRequest
+ */
+export declare type GetDiagnosticsRequest = Message<"olivetin.api.v1.GetDiagnosticsRequest"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.GetDiagnosticsRequest.
+ * Use `create(GetDiagnosticsRequestSchema)` to create a new message.
+ */
+export declare const GetDiagnosticsRequestSchema: GenMessage<GetDiagnosticsRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.GetDiagnosticsResponse
+ */
+export declare type GetDiagnosticsResponse = Message<"olivetin.api.v1.GetDiagnosticsResponse"> & {
+  /**
+   * @generated from field: string SshFoundKey = 1;
+   */
+  SshFoundKey: string;
+
+  /**
+   * @generated from field: string SshFoundConfig = 2;
+   */
+  SshFoundConfig: string;
+
+  /**
+   * Newest first
+   *
+   * @generated from field: repeated olivetin.api.v1.WebhookDelivery webhook_deliveries = 3;
+   */
+  webhookDeliveries: WebhookDelivery[];
+
+  /**
+   * @generated from field: int32 webhook_deliveries_pending = 4;
+   */
+  webhookDeliveriesPending: number;
+};
+
+/**
+ * Describes the message olivetin.api.v1.GetDiagnosticsResponse.
+ * Use `create(GetDiagnosticsResponseSchema)` to create a new message.
+ */
+export declare const GetDiagnosticsResponseSchema: GenMessage<GetDiagnosticsResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.WebhookDelivery
+ */
+export declare type WebhookDelivery = Message<"olivetin.api.v1.WebhookDelivery"> & {
+  /**
+   * @generated from field: string datetime = 1;
+   */
+  datetime: string;
+
+  /**
+   * @generated from field: string webhook = 2;
+   */
+  webhook: string;
+
+  /**
+   * @generated from field: string event = 3;
+   */
+  event: string;
+
+  /**
+   * @generated from field: string execution_tracking_id = 4;
+   */
+  executionTrackingId: string;
+
+  /**
+   * @generated from field: int32 attempt = 5;
+   */
+  attempt: number;
+
+  /**
+   * @generated from field: string status = 6;
+   */
+  status: string;
+
+  /**
+   * @generated from field: bool delivered = 7;
+   */
+  delivered: boolean;
+
+  /**
+   * @generated from field: bool will_retry = 8;
    */
-  hash: string;
+  willRetry: boolean;
 };
 
 /**
- * Describes the message olivetin.api.v1.PasswordHashResponse.
- * Use `create(PasswordHashResponseSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
  */
-export declare const PasswordHashResponseSchema: GenMessage<PasswordHashResponse>;
+export declare const WebhookDeliverySchema: GenMessage<WebhookDelivery>;
 
 /**
- * @generated from message olivetin.api.v1.LogoutRequest
+ * @generated from message olivetin.api.v1.GetAuditLogRequest
  */
-export declare type LogoutRequest = Message<"olivetin.api.v1.LogoutRequest"> & {
-};
+export declare type GetAuditLogRequest = Message<"olivetin.api.v1.GetAuditLogRequest"> & {
+  /**
+   * @generated from field: string type = 1;
+   */
+  type: string;
 
-/**
- * Describes the message olivetin.api.v1.LogoutRequest.
- * Use `create(LogoutRequestSchema)` to create a new message.
- */
-export declare const LogoutRequestSchema: GenMessage<LogoutRequest>;
+  /**
+   * @generated from field: string username = 2;
+   */
+  username: string;
 
-/**
- * @generated from message olivetin.api.v1.LogoutResponse
- */
-export declare type LogoutResponse = Message<"olivetin.api.v1.LogoutResponse"> & {
+  /**
+   * RFC3339
+   *
+   * @generated from field: string since = 3;
+   */
+  since: string;
+
+  /**
+   * @generated from field: int32 limit = 4;
+   */
+  limit: number;
 };
 
 /**
- * Describes the message olivetin.api.v1.LogoutResponse.
- * Use `create(LogoutResponseSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.GetAuditLogRequest.
+ * Use `create(GetAuditLogRequestSchema)` to create a new message.
  */
-export declare const LogoutResponseSchema: GenMessage<LogoutResponse>;
+export declare const GetAuditLogRequestSchema: GenMessage<GetAuditLogRequest>;
 
 /**
- * @generated from message olivetin.api.v1.GetDiagnosticsRequest
+ * @generated from message olivetin.api.v1.GetAuditLogResponse
  */
-export declare type GetDiagnosticsRequest = Message<"olivetin.api.v1.GetDiagnosticsRequest"> & {
+export declare type GetAuditLogResponse = Message<"olivetin.api.v1.GetAuditLogResponse"> & {
+  /**
+   * Newest first
+   *
+   * @generated from field: repeated olivetin.api.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+
+  /**
+   * @generated from field: bool chain_valid = 2;
+   */
+  chainValid: boolean;
+
+  /**
+   * @generated from field: string chain_error = 3;
+   */
+  chainError: string;
 };
 
 /**
- * Describes the message olivetin.api.v1.GetDiagnosticsRequest.
- * Use `create(GetDiagnosticsRequestSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.GetAuditLogResponse.
+ * Use `create(GetAuditLogResponseSchema)` to create a new message.
  */
-export declare const GetDiagnosticsRequestSchema: GenMessage<GetDiagnosticsRequest>;
+export declare const GetAuditLogResponseSchema: GenMessage<GetAuditLogResponse>;
 
 /**
- * @generated from message olivetin.api.v1.GetDiagnosticsResponse
+ * @generated from message olivetin.api.v1.AuditEvent
  */
-export declare type GetDiagnosticsResponse = Message<"olivetin.api.v1.GetDiagnosticsResponse"> & {
+export declare type AuditEvent = Message<"olivetin.api.v1.AuditEvent"> & {
   /**
-   * @generated from field: string SshFoundKey = 1;
+   * @generated from field: int32 schema = 1;
    */
-  SshFoundKey: string;
+  schema: number;
 
   /**
-   * @generated from field: string SshFoundConfig = 2;
+   * @generated from field: uint64 seq = 2;
    */
-  SshFoundConfig: string;
+  seq: bigint;
+
+  /**
+   * @generated from field: string time = 3;
+   */
+  time: string;
+
+  /**
+   * @generated from field: string type = 4;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string outcome = 5;
+   */
+  outcome: string;
+
+  /**
+   * @generated from field: string username = 6;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string provider = 7;
+   */
+  provider: string;
+
+  /**
+   * @generated from field: string action = 8;
+   */
+  action: string;
+
+  /**
+   * @generated from field: string tracking_id = 9;
+   */
+  trackingId: string;
+
+  /**
+   * @generated from field: string permission = 10;
+   */
+  permission: string;
+
+  /**
+   * @generated from field: string detail = 11;
+   */
+  detail: string;
+
+  /**
+   * @generated from field: string prev_hash = 12;
+   */
+  prevHash: string;
+
+  /**
+   * @generated from field: string hash = 13;
+   */
+  hash: string;
 };
 
 /**
- * Describes the message olivetin.api.v1.GetDiagnosticsResponse.
- * Use `create(GetDiagnosticsResponseSchema)` to create a new message.
+ * Describes the message olivetin.api.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
  */
-export declare const GetDiagnosticsResponseSchema: GenMessage<GetDiagnosticsResponse>;
+export declare const AuditEventSchema: GenMessage<AuditEvent>;
 
 /**
  * @generated from message olivetin.api.v1.InitRequest
@@ -2067,6 +3047,171 @@ export declare type RestartActionRequest = Message<"olivetin.api.v1.RestartActio
  */
 export declare const RestartActionRequestSchema: GenMessage<RestartActionRequest>;
 
+/**
+ * @generated from message olivetin.api.v1.PendingApproval
+ */
+export declare type PendingApproval = Message<"olivetin.api.v1.PendingApproval"> & {
+  /**
+   * @generated from field: olivetin.api.v1.LogEntry log_entry = 1;
+   */
+  logEntry?: LogEntry | undefined;
+
+  /**
+   * @generated from field: int32 approvals_required = 2;
+   */
+  approvalsRequired: number;
+
+  /**
+   * @generated from field: string datetime_expires = 3;
+   */
+  datetimeExpires: string;
+
+  /**
+   * @generated from field: bool can_approve = 4;
+   */
+  canApprove: boolean;
+};
+
+/**
+ * Describes the message olivetin.api.v1.PendingApproval.
+ * Use `create(PendingApprovalSchema)` to create a new message.
+ */
+export declare const PendingApprovalSchema: GenMessage<PendingApproval>;
+
+/**
+ * @generated from message olivetin.api.v1.ListPendingApprovalsRequest
+ */
+export declare type ListPendingApprovalsRequest = Message<"olivetin.api.v1.ListPendingApprovalsRequest"> & {
+};
+
+/**
+ * Describes the message olivetin.api.v1.ListPendingApprovalsRequest.
+ * Use `create(ListPendingApprovalsRequestSchema)` to create a new message.
+ */
+export declare const ListPendingApprovalsRequestSchema: GenMessage<ListPendingApprovalsRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ListPendingApprovalsResponse
+ */
+export declare type ListPendingApprovalsResponse = Message<"olivetin.api.v1.ListPendingApprovalsResponse"> & {
+  /**
+   * @generated from field: repeated olivetin.api.v1.PendingApproval approvals = 1;
+   */
+  approvals: PendingApproval[];
+};
+
+/**
+ * Describes the message olivetin.api.v1.ListPendingApprovalsResponse.
+ * Use `create(ListPendingApprovalsResponseSchema)` to create a new message.
+ */
+export declare const ListPendingApprovalsResponseSchema: GenMessage<ListPendingApprovalsResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.ApproveExecutionRequest
+ */
+export declare type ApproveExecutionRequest = Message<"olivetin.api.v1.ApproveExecutionRequest"> & {
+  /**
+   * @generated from field: string execution_tracking_id = 1;
+   */
+  executionTrackingId: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ApproveExecutionRequest.
+ * Use `create(ApproveExecutionRequestSchema)` to create a new message.
+ */
+export declare const ApproveExecutionRequestSchema: GenMessage<ApproveExecutionRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.ApproveExecutionResponse
+ */
+export declare type ApproveExecutionResponse = Message<"olivetin.api.v1.ApproveExecutionResponse"> & {
+  /**
+   * @generated from field: string execution_tracking_id = 1;
+   */
+  executionTrackingId: string;
+
+  /**
+   * @generated from field: int32 approvals_remaining = 2;
+   */
+  approvalsRemaining: number;
+};
+
+/**
+ * Describes the message olivetin.api.v1.ApproveExecutionResponse.
+ * Use `create(ApproveExecutionResponseSchema)` to create a new message.
+ */
+export declare const ApproveExecutionResponseSchema: GenMessage<ApproveExecutionResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.RejectExecutionRequest
+ */
+export declare type RejectExecutionRequest = Message<"olivetin.api.v1.RejectExecutionRequest"> & {
+  /**
+   * @generated from field: string execution_tracking_id = 1;
+   */
+  executionTrackingId: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.RejectExecutionRequest.
+ * Use `create(RejectExecutionRequestSchema)` to create a new message.
+ */
+export declare const RejectExecutionRequestSchema: GenMessage<RejectExecutionRequest>;
+
+/**
+ * @generated from message olivetin.api.v1.RejectExecutionResponse
+ */
+export declare type RejectExecutionResponse = Message<"olivetin.api.v1.RejectExecutionResponse"> & {
+  /**
+   * @generated from field: string execution_tracking_id = 1;
+   */
+  executionTrackingId: string;
+};
+
+/**
+ * Describes the message olivetin.api.v1.RejectExecutionResponse.
+ * Use `create(RejectExecutionResponseSchema)` to create a new message.
+ */
+export declare const RejectExecutionResponseSchema: GenMessage<RejectExecutionResponse>;
+
+/**
+ * @generated from message olivetin.api.v1.EventApprovalRequested
+ */
+export declare type EventApprovalRequested = Message<"olivetin.api.v1.EventApprovalRequested"> & {
+  /**
+   * @generated from field: olivetin.api.v1.PendingApproval approval = 1;
+   */
+  approval?: PendingApproval | undefined;
+};
+
+/**
+ * Describes the message olivetin.api.v1.EventApprovalRequested.
+ * Use `create(EventApprovalRequestedSchema)` to create a new message.
+ */
+export declare const EventApprovalRequestedSchema: GenMessage<EventApprovalRequested>;
+
+/**
+ * @generated from message olivetin.api.v1.EventApprovalResolved
+ */
+export declare type EventApprovalResolved = Message<"olivetin.api.v1.EventApprovalResolved"> & {
+  /**
+   * @generated from field: olivetin.api.v1.LogEntry log_entry = 1;
+   */
+  logEntry?: LogEntry | undefined;
+
+  /**
+   * @generated from field: bool approved = 2;
+   */
+  approved: boolean;
+};
+
+/**
+ * Describes the message olivetin.api.v1.EventApprovalResolved.
+ * Use `create(EventApprovalResolvedSchema)` to create a new message.
+ */
+export declare const EventApprovalResolvedSchema: GenMessage<EventApprovalResolved>;
+
 /**
  * @generated from service olivetin.api.v1.OliveTinApiService
  */
@@ -2143,6 +3288,14 @@ export declare const OliveTinApiService: GenService<{
     input: typeof GetLogsRequestSchema;
     output: typeof GetLogsResponseSchema;
   },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.StartWorkflow
+   */
+  startWorkflow: {
+    methodKind: "unary";
+    input: typeof StartWorkflowRequestSchema;
+    output: typeof StartWorkflowResponseSchema;
+  },
   /**
    * @generated from rpc olivetin.api.v1.OliveTinApiService.GetActionLogs
    */
@@ -2159,6 +3312,78 @@ export declare const OliveTinApiService: GenService<{
     input: typeof GetExecutionQueueRequestSchema;
     output: typeof GetExecutionQueueResponseSchema;
   },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ScheduleAction
+   */
+  scheduleAction: {
+    methodKind: "unary";
+    input: typeof ScheduleActionRequestSchema;
+    output: typeof ScheduleActionResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ListScheduledExecutions
+   */
+  listScheduledExecutions: {
+    methodKind: "unary";
+    input: typeof ListScheduledExecutionsRequestSchema;
+    output: typeof ListScheduledExecutionsResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.CancelScheduledExecution
+   */
+  cancelScheduledExecution: {
+    methodKind: "unary";
+    input: typeof CancelScheduledExecutionRequestSchema;
+    output: typeof CancelScheduledExecutionResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ListCronSchedules
+   */
+  listCronSchedules: {
+    methodKind: "unary";
+    input: typeof ListCronSchedulesRequestSchema;
+    output: typeof ListCronSchedulesResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.PauseCronSchedule
+   */
+  pauseCronSchedule: {
+    methodKind: "unary";
+    input: typeof PauseCronScheduleRequestSchema;
+    output: typeof PauseCronScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ResumeCronSchedule
+   */
+  resumeCronSchedule: {
+    methodKind: "unary";
+    input: typeof ResumeCronScheduleRequestSchema;
+    output: typeof ResumeCronScheduleResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ListPendingApprovals
+   */
+  listPendingApprovals: {
+    methodKind: "unary";
+    input: typeof ListPendingApprovalsRequestSchema;
+    output: typeof ListPendingApprovalsResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ApproveExecution
+   */
+  approveExecution: {
+    methodKind: "unary";
+    input: typeof ApproveExecutionRequestSchema;
+    output: typeof ApproveExecutionResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.RejectExecution
+   */
+  rejectExecution: {
+    methodKind: "unary";
+    input: typeof RejectExecutionRequestSchema;
+    output: typeof RejectExecutionResponseSchema;
+  },
   /**
    * @generated from rpc olivetin.api.v1.OliveTinApiService.ValidateArgumentType
    */
@@ -2215,6 +3440,30 @@ export declare const OliveTinApiService: GenService<{
     input: typeof LocalUserLoginRequestSchema;
     output: typeof LocalUserLoginResponseSchema;
   },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.BeginTotpEnrolment
+   */
+  beginTotpEnrolment: {
+    methodKind: "unary";
+    input: typeof BeginTotpEnrolmentRequestSchema;
+    output: typeof BeginTotpEnrolmentResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.ConfirmTotpEnrolment
+   */
+  confirmTotpEnrolment: {
+    methodKind: "unary";
+    input: typeof ConfirmTotpEnrolmentRequestSchema;
+    output: typeof ConfirmTotpEnrolmentResponseSchema;
+  },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.DisableTotp
+   */
+  disableTotp: {
+    methodKind: "unary";
+    input: typeof DisableTotpRequestSchema;
+    output: typeof DisableTotpResponseSchema;
+  },
   /**
    * @generated from rpc olivetin.api.v1.OliveTinApiService.PasswordHash
    */
@@ -2247,6 +3496,14 @@ export declare const OliveTinApiService: GenService<{
     input: typeof GetDiagnosticsRequestSchema;
     output: typeof GetDiagnosticsResponseSchema;
   },
+  /**
+   * @generated from rpc olivetin.api.v1.OliveTinApiService.GetAuditLog
+   */
+  getAuditLog: {
+    methodKind: "unary";
+    input: typeof GetAuditLogRequestSchema;
+    output: typeof GetAuditLogResponseSchema;
+  },
   /**
    * @generated from rpc olivetin.api.v1.OliveTinApiService.Init
    */
@@ -8,7 +8,7 @@ import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2
  * Describes the file olivetin/api/v1/olivetin.proto.
  */
 export const file_olivetin_api_v1_olivetin = /*@__PURE__*/
-  fileDesc("Ch5vbGl2ZXRpbi9hcGkvdjEvb2xpdmV0aW4ucHJvdG8SD29saXZldGluLmFwaS52MSLGBAoGQWN0aW9uEhIKCmJpbmRpbmdfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDAoEaWNvbhgDIAEoCRIQCghjYW5fZXhlYxgEIAEoCBIyCglhcmd1bWVudHMYBSADKAsyHy5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uQXJndW1lbnQSFgoOcG9wdXBfb25fc3RhcnQYBiABKAkSDQoFb3JkZXIYByABKAUSDwoHdGltZW91dBgIIAEoBRIjChtkYXRldGltZV9yYXRlX2xpbWl0X2V4cGlyZXMYCSABKAkSFwoPZXhlY19vbl9zdGFydHVwGAogASgIEhQKDGV4ZWNfb25fY3JvbhgLIAMoCRIjChtleGVjX29uX2ZpbGVfY3JlYXRlZF9pbl9kaXIYDCADKAkSIwobZXhlY19vbl9maWxlX2NoYW5nZWRfaW5fZGlyGA0gAygJEh0KFWV4ZWNfb25fY2FsZW5kYXJfZmlsZRgOIAEoCRJAChBleGVjX29uX3dlYmhvb2tzGA8gAygLMiYub2xpdmV0aW4uYXBpLnYxLkFjdGlvbldlYmhvb2tFeGVjSGludBIVCg1qdXN0aWZpY2F0aW9uGBQgASgJEhwKFGhhc19ydW5uaW5nX2luc3RhbmNlGBEgASgIEhsKE2hhc19xdWV1ZWRfaW5zdGFuY2UYEiABKAgSNgoGZ3JvdXBzGBMgAygLMiYub2xpdmV0aW4uYXBpLnYxLkFjdGlvbkdyb3VwTWVtYmVyc2hpcEoECBAQESJRChVBY3Rpb25Hcm91cE1lbWJlcnNoaXASDAoEbmFtZRgBIAEoCRIWCg5tYXhfY29uY3VycmVudBgCIAEoBRISCgpxdWV1ZV9zaXplGAMgASgFIsMCChVBY3Rpb25XZWJob29rRXhlY0hpbnQSEAoIdGVtcGxhdGUYASABKAkSEgoKbWF0Y2hfcGF0aBgCIAEoCRJPCg1tYXRjaF9oZWFkZXJzGAMgAygLMjgub2xpdmV0aW4uYXBpLnYxLkFjdGlvbldlYmhvb2tFeGVjSGludC5NYXRjaEhlYWRlcnNFbnRyeRJLCgttYXRjaF9xdWVyeRgEIAMoCzI2Lm9saXZldGluLmFwaS52MS5BY3Rpb25XZWJob29rRXhlY0hpbnQuTWF0Y2hRdWVyeUVudHJ5GjMKEU1hdGNoSGVhZGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaMQoPTWF0Y2hRdWVyeUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiuwIKDkFjdGlvbkFyZ3VtZW50EgwKBG5hbWUYASABKAkSDQoFdGl0bGUYAiABKAkSDAoEdHlwZRgDIAEoCRIVCg1kZWZhdWx0X3ZhbHVlGAQgASgJEjYKB2Nob2ljZXMYBSADKAsyJS5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uQXJndW1lbnRDaG9pY2USEwoLZGVzY3JpcHRpb24YBiABKAkSRQoLc3VnZ2VzdGlvbnMYByADKAsyMC5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uQXJndW1lbnQuU3VnZ2VzdGlvbnNFbnRyeRIfChdzdWdnZXN0aW9uc19icm93c2VyX2tleRgIIAEoCRoyChBTdWdnZXN0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiNAoUQWN0aW9uQXJndW1lbnRDaG9pY2USDQoFdmFsdWUYASABKAkSDQoFdGl0bGUYAiABKAki1AEKE0VudGl0eVJlbGF0ZWRBY3Rpb24SJwoGYWN0aW9uGAEgASgLMhcub2xpdmV0aW4uYXBpLnYxLkFjdGlvbhJZChNwcmVmaWxsZWRfYXJndW1lbnRzGAIgAygLMjwub2xpdmV0aW4uYXBpLnYxLkVudGl0eVJlbGF0ZWRBY3Rpb24uUHJlZmlsbGVkQXJndW1lbnRzRW50cnkaOQoXUHJlZmlsbGVkQXJndW1lbnRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASL/AQoGRW50aXR5Eg0KBXRpdGxlGAEgASgJEhIKCnVuaXF1ZV9rZXkYAiABKAkSDAoEdHlwZRgDIAEoCRITCgtkaXJlY3RvcmllcxgEIAMoCRIzCgZmaWVsZHMYBSADKAsyIy5vbGl2ZXRpbi5hcGkudjEuRW50aXR5LkZpZWxkc0VudHJ5Ej0KD3JlbGF0ZWRfYWN0aW9ucxgGIAMoCzIkLm9saXZldGluLmFwaS52MS5FbnRpdHlSZWxhdGVkQWN0aW9uEgwKBGljb24YByABKAkaLQoLRmllbGRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJUChRHZXREYXNoYm9hcmRSZXNwb25zZRINCgV0aXRsZRgBIAEoCRItCglkYXNoYm9hcmQYBCABKAsyGi5vbGl2ZXRpbi5hcGkudjEuRGFzaGJvYXJkIl8KD0VmZmVjdGl2ZVBvbGljeRIYChBzaG93X2RpYWdub3N0aWNzGAEgASgIEhUKDXNob3dfbG9nX2xpc3QYAiABKAgSGwoTc2hvd192ZXJzaW9uX251bWJlchgDIAEoCCJNChNHZXREYXNoYm9hcmRSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEhMKC2VudGl0eV90eXBlGAIgASgJEhIKCmVudGl0eV9rZXkYAyABKAkiUQoJRGFzaGJvYXJkEg0KBXRpdGxlGAEgASgJEjUKCGNvbnRlbnRzGAIgAygLMiMub2xpdmV0aW4uYXBpLnYxLkRhc2hib2FyZENvbXBvbmVudCLbAQoSRGFzaGJvYXJkQ29tcG9uZW50Eg0KBXRpdGxlGAEgASgJEgwKBHR5cGUYAiABKAkSNQoIY29udGVudHMYAyADKAsyIy5vbGl2ZXRpbi5hcGkudjEuRGFzaGJvYXJkQ29tcG9uZW50EgwKBGljb24YBCABKAkSEQoJY3NzX2NsYXNzGAUgASgJEicKBmFjdGlvbhgGIAEoCzIXLm9saXZldGluLmFwaS52MS5BY3Rpb24SEwoLZW50aXR5X3R5cGUYByABKAkSEgoKZW50aXR5X2tleRgIIAEoCSKUAQoSU3RhcnRBY3Rpb25SZXF1ZXN0EhIKCmJpbmRpbmdfaWQYASABKAkSNwoJYXJndW1lbnRzGAIgAygLMiQub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQXJndW1lbnQSGgoSdW5pcXVlX3RyYWNraW5nX2lkGAMgASgJEhUKDWp1c3RpZmljYXRpb24YBCABKAkiMgoTU3RhcnRBY3Rpb25Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIjQKE1N0YXJ0QWN0aW9uUmVzcG9uc2USHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAIgASgJIn4KGVN0YXJ0QWN0aW9uQW5kV2FpdFJlcXVlc3QSEQoJYWN0aW9uX2lkGAEgASgJEjcKCWFyZ3VtZW50cxgCIAMoCzIkLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFyZ3VtZW50EhUKDWp1c3RpZmljYXRpb24YAyABKAkiSgoaU3RhcnRBY3Rpb25BbmRXYWl0UmVzcG9uc2USLAoJbG9nX2VudHJ5GAEgASgLMhkub2xpdmV0aW4uYXBpLnYxLkxvZ0VudHJ5IiwKF1N0YXJ0QWN0aW9uQnlHZXRSZXF1ZXN0EhEKCWFjdGlvbl9pZBgBIAEoCSI5ChhTdGFydEFjdGlvbkJ5R2V0UmVzcG9uc2USHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAIgASgJIjMKHlN0YXJ0QWN0aW9uQnlHZXRBbmRXYWl0UmVxdWVzdBIRCglhY3Rpb25faWQYASABKAkiTwofU3RhcnRBY3Rpb25CeUdldEFuZFdhaXRSZXNwb25zZRIsCglsb2dfZW50cnkYASABKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkiXgoOR2V0TG9nc1JlcXVlc3QSFAoMc3RhcnRfb2Zmc2V0GAEgASgDEhMKC2RhdGVfZmlsdGVyGAIgASgJEhEKCXBhZ2Vfc2l6ZRgDIAEoAxIOCgZmaWx0ZXIYBCABKAkilAQKCExvZ0VudHJ5EhgKEGRhdGV0aW1lX3N0YXJ0ZWQYASABKAkSFAoMYWN0aW9uX3RpdGxlGAIgASgJEg4KBm91dHB1dBgDIAEoCRIRCgl0aW1lZF9vdXQYBSABKAgSEQoJZXhpdF9jb2RlGAYgASgFEgwKBHVzZXIYByABKAkSEgoKdXNlcl9jbGFzcxgIIAEoCRITCgthY3Rpb25faWNvbhgJIAEoCRIMCgR0YWdzGAogAygJEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgLIAEoCRIZChFkYXRldGltZV9maW5pc2hlZBgMIAEoCRIZChFleGVjdXRpb25fc3RhcnRlZBgOIAEoCBIaChJleGVjdXRpb25fZmluaXNoZWQYDyABKAgSDwoHYmxvY2tlZBgQIAEoCBIWCg5kYXRldGltZV9pbmRleBgRIAEoAxIQCghjYW5fa2lsbBgSIAEoCBIjChtkYXRldGltZV9yYXRlX2xpbWl0X2V4cGlyZXMYEyABKAkSEgoKYmluZGluZ19pZBgUIAEoCRIOCgZxdWV1ZWQYFSABKAgSGAoQcXVldWVkX2Zvcl9ncm91cBgWIAEoCRIVCg1qdXN0aWZpY2F0aW9uGBcgASgJEjcKCWFyZ3VtZW50cxgYIAMoCzIkLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFyZ3VtZW50IpEBCg9HZXRMb2dzUmVzcG9uc2USJwoEbG9ncxgBIAMoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeRIXCg9jb3VudF9yZW1haW5pbmcYAiABKAMSEQoJcGFnZV9zaXplGAMgASgDEhMKC3RvdGFsX2NvdW50GAQgASgDEhQKDHN0YXJ0X29mZnNldBgFIAEoAyI/ChRHZXRBY3Rpb25Mb2dzUmVxdWVzdBIRCglhY3Rpb25faWQYASABKAkSFAoMc3RhcnRfb2Zmc2V0GAIgASgDIpcBChVHZXRBY3Rpb25Mb2dzUmVzcG9uc2USJwoEbG9ncxgBIAMoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeRIXCg9jb3VudF9yZW1haW5pbmcYAiABKAMSEQoJcGFnZV9zaXplGAMgASgDEhMKC3RvdGFsX2NvdW50GAQgASgDEhQKDHN0YXJ0X29mZnNldBgFIAEoAyIaChhHZXRFeGVjdXRpb25RdWV1ZVJlcXVlc3QixgEKFEV4ZWN1dGlvblF1ZXVlQWN0aW9uEhIKCmJpbmRpbmdfaWQYASABKAkSFAoMYWN0aW9uX3RpdGxlGAIgASgJEhMKC2FjdGlvbl9pY29uGAMgASgJEhYKDm1heF9jb25jdXJyZW50GAQgASgFEhQKDGFjdGl2ZV9jb3VudBgFIAEoBRIVCg1lbnRpdHlfcHJlZml4GAYgASgJEioKB2VudHJpZXMYByADKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkiwQEKE0V4ZWN1dGlvblF1ZXVlR3JvdXASDAoEbmFtZRgBIAEoCRIMCgRpY29uGAIgASgJEhYKDm1heF9jb25jdXJyZW50GAMgASgFEhQKDGFjdGl2ZV9jb3VudBgEIAEoBRI2CgdhY3Rpb25zGAUgAygLMiUub2xpdmV0aW4uYXBpLnYxLkV4ZWN1dGlvblF1ZXVlQWN0aW9uEhQKDHF1ZXVlZF9jb3VudBgGIAEoBRISCgpxdWV1ZV9zaXplGAcgASgFImcKGUdldEV4ZWN1dGlvblF1ZXVlUmVzcG9uc2USNAoGZ3JvdXBzGAEgAygLMiQub2xpdmV0aW4uYXBpLnYxLkV4ZWN1dGlvblF1ZXVlR3JvdXASFAoMdG90YWxfYWN0aXZlGAIgASgFImUKG1ZhbGlkYXRlQXJndW1lbnRUeXBlUmVxdWVzdBINCgV2YWx1ZRgBIAEoCRIMCgR0eXBlGAIgASgJEhIKCmJpbmRpbmdfaWQYAyABKAkSFQoNYXJndW1lbnRfbmFtZRgEIAEoCSJCChxWYWxpZGF0ZUFyZ3VtZW50VHlwZVJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEhMKC2Rlc2NyaXB0aW9uGAIgASgJIjYKFVdhdGNoRXhlY3V0aW9uUmVxdWVzdBIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAkiJgoUV2F0Y2hFeGVjdXRpb25VcGRhdGUSDgoGdXBkYXRlGAEgASgJIkoKFkV4ZWN1dGlvblN0YXR1c1JlcXVlc3QSHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAEgASgJEhEKCWFjdGlvbl9pZBgCIAEoCSJhChlEYXNoYm9hcmROYXZpZ2F0aW9uVGFyZ2V0Eg0KBXRpdGxlGAEgASgJEhMKC2VudGl0eV90eXBlGAIgASgJEhIKCmVudGl0eV9rZXkYAyABKAkSDAoEcGF0aBgEIAEoCSKPAQoXRXhlY3V0aW9uU3RhdHVzUmVzcG9uc2USLAoJbG9nX2VudHJ5GAEgASgLMhkub2xpdmV0aW4uYXBpLnYxLkxvZ0VudHJ5EkYKEmJhY2tfdG9fZGFzaGJvYXJkcxgCIAMoCzIqLm9saXZldGluLmFwaS52MS5EYXNoYm9hcmROYXZpZ2F0aW9uVGFyZ2V0Ig8KDVdob0FtSVJlcXVlc3QibAoOV2hvQW1JUmVzcG9uc2USGgoSYXV0aGVudGljYXRlZF91c2VyGAEgASgJEhEKCXVzZXJncm91cBgCIAEoCRIQCghwcm92aWRlchgDIAEoCRIMCgRhY2xzGAQgAygJEgsKA3NpZBgFIAEoCSIaChhTZXJ2ZXJEaWFnbm9zdGljc1JlcXVlc3QiKgoZU2VydmVyRGlhZ25vc3RpY3NSZXNwb25zZRINCgVhbGVydBgBIAEoCSIRCg9EdW1wVmFyc1JlcXVlc3QilQEKEER1bXBWYXJzUmVzcG9uc2USDQoFYWxlcnQYASABKAkSQQoIY29udGVudHMYAiADKAsyLy5vbGl2ZXRpbi5hcGkudjEuRHVtcFZhcnNSZXNwb25zZS5Db250ZW50c0VudHJ5Gi8KDUNvbnRlbnRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASI7CgxEZWJ1Z0JpbmRpbmcSFAoMYWN0aW9uX3RpdGxlGAEgASgJEhUKDWVudGl0eV9wcmVmaXgYAiABKAkiHgocRHVtcFB1YmxpY0lkQWN0aW9uTWFwUmVxdWVzdCLOAQodRHVtcFB1YmxpY0lkQWN0aW9uTWFwUmVzcG9uc2USDQoFYWxlcnQYASABKAkSTgoIY29udGVudHMYAiADKAsyPC5vbGl2ZXRpbi5hcGkudjEuRHVtcFB1YmxpY0lkQWN0aW9uTWFwUmVzcG9uc2UuQ29udGVudHNFbnRyeRpOCg1Db250ZW50c0VudHJ5EgsKA2tleRgBIAEoCRIsCgV2YWx1ZRgCIAEoCzIdLm9saXZldGluLmFwaS52MS5EZWJ1Z0JpbmRpbmc6AjgBIhIKEEdldFJlYWR5elJlcXVlc3QiIwoRR2V0UmVhZHl6UmVzcG9uc2USDgoGc3RhdHVzGAEgASgJIhQKEkV2ZW50U3RyZWFtUmVxdWVzdCKZAwoTRXZlbnRTdHJlYW1SZXNwb25zZRI9Cg5lbnRpdHlfY2hhbmdlZBgCIAEoCzIjLm9saXZldGluLmFwaS52MS5FdmVudEVudGl0eUNoYW5nZWRIABI9Cg5jb25maWdfY2hhbmdlZBgDIAEoCzIjLm9saXZldGluLmFwaS52MS5FdmVudENvbmZpZ0NoYW5nZWRIABJFChJleGVjdXRpb25fZmluaXNoZWQYBCABKAsyJy5vbGl2ZXRpbi5hcGkudjEuRXZlbnRFeGVjdXRpb25GaW5pc2hlZEgAEkMKEWV4ZWN1dGlvbl9zdGFydGVkGAUgASgLMiYub2xpdmV0aW4uYXBpLnYxLkV2ZW50RXhlY3V0aW9uU3RhcnRlZEgAEjkKDG91dHB1dF9jaHVuaxgGIAEoCzIhLm9saXZldGluLmFwaS52MS5FdmVudE91dHB1dENodW5rSAASNAoJaGVhcnRiZWF0GAcgASgLMh8ub2xpdmV0aW4uYXBpLnYxLkV2ZW50SGVhcnRiZWF0SABCBwoFZXZlbnQiQQoQRXZlbnRPdXRwdXRDaHVuaxIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAkSDgoGb3V0cHV0GAIgASgJIhQKEkV2ZW50RW50aXR5Q2hhbmdlZCIUChJFdmVudENvbmZpZ0NoYW5nZWQiEAoORXZlbnRIZWFydGJlYXQiRgoWRXZlbnRFeGVjdXRpb25GaW5pc2hlZBIsCglsb2dfZW50cnkYASABKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkiRQoVRXZlbnRFeGVjdXRpb25TdGFydGVkEiwKCWxvZ19lbnRyeRgBIAEoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeSIyChFLaWxsQWN0aW9uUmVxdWVzdBIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAkibQoSS2lsbEFjdGlvblJlc3BvbnNlEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgBIAEoCRIOCgZraWxsZWQYAiABKAgSGQoRYWxyZWFkeV9jb21wbGV0ZWQYAyABKAgSDQoFZm91bmQYBCABKAgiOwoVTG9jYWxVc2VyTG9naW5SZXF1ZXN0EhAKCHVzZXJuYW1lGAEgASgJEhAKCHBhc3N3b3JkGAIgASgJIikKFkxvY2FsVXNlckxvZ2luUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCCInChNQYXNzd29yZEhhc2hSZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIiQKFFBhc3N3b3JkSGFzaFJlc3BvbnNlEgwKBGhhc2gYASABKAkiDwoNTG9nb3V0UmVxdWVzdCIQCg5Mb2dvdXRSZXNwb25zZSIXChVHZXREaWFnbm9zdGljc1JlcXVlc3QiRQoWR2V0RGlhZ25vc3RpY3NSZXNwb25zZRITCgtTc2hGb3VuZEtleRgBIAEoCRIWCg5Tc2hGb3VuZENvbmZpZxgCIAEoCSINCgtJbml0UmVxdWVzdCLrBQoMSW5pdFJlc3BvbnNlEhIKCnNob3dGb290ZXIYASABKAgSFgoOc2hvd05hdmlnYXRpb24YAiABKAgSFwoPc2hvd05ld1ZlcnNpb25zGAMgASgIEhgKEGF2YWlsYWJsZVZlcnNpb24YBCABKAkSFgoOY3VycmVudFZlcnNpb24YBSABKAkSEQoJcGFnZVRpdGxlGAYgASgJEh4KFnNlY3Rpb25OYXZpZ2F0aW9uU3R5bGUYByABKAkSGgoSZGVmYXVsdEljb25Gb3JCYWNrGAggASgJEhYKDmVuYWJsZUN1c3RvbUpzGAkgASgIEhQKDGF1dGhMb2dpblVybBgKIAEoCRIWCg5hdXRoTG9jYWxMb2dpbhgLIAEoCBIRCglzdHlsZU1vZHMYDCADKAkSOAoPb0F1dGgyUHJvdmlkZXJzGA0gAygLMh8ub2xpdmV0aW4uYXBpLnYxLk9BdXRoMlByb3ZpZGVyEjgKD2FkZGl0aW9uYWxMaW5rcxgOIAMoCzIfLm9saXZldGluLmFwaS52MS5BZGRpdGlvbmFsTGluaxIWCg5yb290RGFzaGJvYXJkcxgPIAMoCRIaChJhdXRoZW50aWNhdGVkX3VzZXIYECABKAkSIwobYXV0aGVudGljYXRlZF91c2VyX3Byb3ZpZGVyGBEgASgJEjoKEGVmZmVjdGl2ZV9wb2xpY3kYEiABKAsyIC5vbGl2ZXRpbi5hcGkudjEuRWZmZWN0aXZlUG9saWN5EhYKDmJhbm5lcl9tZXNzYWdlGBMgASgJEhIKCmJhbm5lcl9jc3MYFCABKAkSGAoQc2hvd19kaWFnbm9zdGljcxgVIAEoCBIVCg1zaG93X2xvZ19saXN0GBYgASgIEhYKDmxvZ2luX3JlcXVpcmVkGBcgASgIEhgKEGF2YWlsYWJsZV90aGVtZXMYGCADKAkSJAocc2hvd19uYXZpZ2F0ZV9vbl9zdGFydF9pY29ucxgZIAEoCCIsCg5BZGRpdGlvbmFsTGluaxINCgV0aXRsZRgBIAEoCRILCgN1cmwYAiABKAkiOgoOT0F1dGgyUHJvdmlkZXISDQoFdGl0bGUYASABKAkSDAoEaWNvbhgDIAEoCRILCgNrZXkYBCABKAkiLQoXR2V0QWN0aW9uQmluZGluZ1JlcXVlc3QSEgoKYmluZGluZ19pZBgBIAEoCSKLAQoYR2V0QWN0aW9uQmluZGluZ1Jlc3BvbnNlEicKBmFjdGlvbhgBIAEoCzIXLm9saXZldGluLmFwaS52MS5BY3Rpb24SRgoSYmFja190b19kYXNoYm9hcmRzGAIgAygLMioub2xpdmV0aW4uYXBpLnYxLkRhc2hib2FyZE5hdmlnYXRpb25UYXJnZXQiWgoSR2V0RW50aXRpZXNSZXF1ZXN0EhMKC2VudGl0eV90eXBlGAEgASgJEg4KBmZpbHRlchgCIAEoCRIMCgRwYWdlGAMgASgFEhEKCXBhZ2Vfc2l6ZRgEIAEoBSJUChNHZXRFbnRpdGllc1Jlc3BvbnNlEj0KEmVudGl0eV9kZWZpbml0aW9ucxgBIAMoCzIhLm9saXZldGluLmFwaS52MS5FbnRpdHlEZWZpbml0aW9uIsUBChBFbnRpdHlEZWZpbml0aW9uEg0KBXRpdGxlGAEgASgJEioKCWluc3RhbmNlcxgCIAMoCzIXLm9saXZldGluLmFwaS52MS5FbnRpdHkSGgoSdXNlZF9vbl9kYXNoYm9hcmRzGAMgAygJEgwKBGljb24YBCABKAkSMwoKcHJvcGVydGllcxgFIAMoCzIfLm9saXZldGluLmFwaS52MS5FbnRpdHlQcm9wZXJ0eRIXCg90b3RhbF9pbnN0YW5jZXMYBiABKAUiLQoORW50aXR5UHJvcGVydHkSDAoEbmFtZRgBIAEoCRINCgV0aXRsZRgCIAEoCSI0ChBHZXRFbnRpdHlSZXF1ZXN0EhIKCnVuaXF1ZV9rZXkYASABKAkSDAoEdHlwZRgCIAEoCSI1ChRSZXN0YXJ0QWN0aW9uUmVxdWVzdBIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAky7hMKEk9saXZlVGluQXBpU2VydmljZRJdCgxHZXREYXNoYm9hcmQSJC5vbGl2ZXRpbi5hcGkudjEuR2V0RGFzaGJvYXJkUmVxdWVzdBolLm9saXZldGluLmFwaS52MS5HZXREYXNoYm9hcmRSZXNwb25zZSIAEloKC1N0YXJ0QWN0aW9uEiMub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uUmVxdWVzdBokLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvblJlc3BvbnNlIgASbwoSU3RhcnRBY3Rpb25BbmRXYWl0Eioub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQW5kV2FpdFJlcXVlc3QaKy5vbGl2ZXRpbi5hcGkudjEuU3RhcnRBY3Rpb25BbmRXYWl0UmVzcG9uc2UiABJpChBTdGFydEFjdGlvbkJ5R2V0Eigub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQnlHZXRSZXF1ZXN0Gikub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQnlHZXRSZXNwb25zZSIAEn4KF1N0YXJ0QWN0aW9uQnlHZXRBbmRXYWl0Ei8ub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQnlHZXRBbmRXYWl0UmVxdWVzdBowLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkJ5R2V0QW5kV2FpdFJlc3BvbnNlIgASXgoNUmVzdGFydEFjdGlvbhIlLm9saXZldGluLmFwaS52MS5SZXN0YXJ0QWN0aW9uUmVxdWVzdBokLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvblJlc3BvbnNlIgASVwoKS2lsbEFjdGlvbhIiLm9saXZldGluLmFwaS52MS5LaWxsQWN0aW9uUmVxdWVzdBojLm9saXZldGluLmFwaS52MS5LaWxsQWN0aW9uUmVzcG9uc2UiABJmCg9FeGVjdXRpb25TdGF0dXMSJy5vbGl2ZXRpbi5hcGkudjEuRXhlY3V0aW9uU3RhdHVzUmVxdWVzdBooLm9saXZldGluLmFwaS52MS5FeGVjdXRpb25TdGF0dXNSZXNwb25zZSIAEk4KB0dldExvZ3MSHy5vbGl2ZXRpbi5hcGkudjEuR2V0TG9nc1JlcXVlc3QaIC5vbGl2ZXRpbi5hcGkudjEuR2V0TG9nc1Jlc3BvbnNlIgASYAoNR2V0QWN0aW9uTG9ncxIlLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25Mb2dzUmVxdWVzdBomLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25Mb2dzUmVzcG9uc2UiABJsChFHZXRFeGVjdXRpb25RdWV1ZRIpLm9saXZldGluLmFwaS52MS5HZXRFeGVjdXRpb25RdWV1ZVJlcXVlc3QaKi5vbGl2ZXRpbi5hcGkudjEuR2V0RXhlY3V0aW9uUXVldWVSZXNwb25zZSIAEnUKFFZhbGlkYXRlQXJndW1lbnRUeXBlEiwub2xpdmV0aW4uYXBpLnYxLlZhbGlkYXRlQXJndW1lbnRUeXBlUmVxdWVzdBotLm9saXZldGluLmFwaS52MS5WYWxpZGF0ZUFyZ3VtZW50VHlwZVJlc3BvbnNlIgASSwoGV2hvQW1JEh4ub2xpdmV0aW4uYXBpLnYxLldob0FtSVJlcXVlc3QaHy5vbGl2ZXRpbi5hcGkudjEuV2hvQW1JUmVzcG9uc2UiABJsChFTZXJ2ZXJEaWFnbm9zdGljcxIpLm9saXZldGluLmFwaS52MS5TZXJ2ZXJEaWFnbm9zdGljc1JlcXVlc3QaKi5vbGl2ZXRpbi5hcGkudjEuU2VydmVyRGlhZ25vc3RpY3NSZXNwb25zZSIAElEKCER1bXBWYXJzEiAub2xpdmV0aW4uYXBpLnYxLkR1bXBWYXJzUmVxdWVzdBohLm9saXZldGluLmFwaS52MS5EdW1wVmFyc1Jlc3BvbnNlIgASeAoVRHVtcFB1YmxpY0lkQWN0aW9uTWFwEi0ub2xpdmV0aW4uYXBpLnYxLkR1bXBQdWJsaWNJZEFjdGlvbk1hcFJlcXVlc3QaLi5vbGl2ZXRpbi5hcGkudjEuRHVtcFB1YmxpY0lkQWN0aW9uTWFwUmVzcG9uc2UiABJUCglHZXRSZWFkeXoSIS5vbGl2ZXRpbi5hcGkudjEuR2V0UmVhZHl6UmVxdWVzdBoiLm9saXZldGluLmFwaS52MS5HZXRSZWFkeXpSZXNwb25zZSIAEmMKDkxvY2FsVXNlckxvZ2luEiYub2xpdmV0aW4uYXBpLnYxLkxvY2FsVXNlckxvZ2luUmVxdWVzdBonLm9saXZldGluLmFwaS52MS5Mb2NhbFVzZXJMb2dpblJlc3BvbnNlIgASXQoMUGFzc3dvcmRIYXNoEiQub2xpdmV0aW4uYXBpLnYxLlBhc3N3b3JkSGFzaFJlcXVlc3QaJS5vbGl2ZXRpbi5hcGkudjEuUGFzc3dvcmRIYXNoUmVzcG9uc2UiABJLCgZMb2dvdXQSHi5vbGl2ZXRpbi5hcGkudjEuTG9nb3V0UmVxdWVzdBofLm9saXZldGluLmFwaS52MS5Mb2dvdXRSZXNwb25zZSIAElwKC0V2ZW50U3RyZWFtEiMub2xpdmV0aW4uYXBpLnYxLkV2ZW50U3RyZWFtUmVxdWVzdBokLm9saXZldGluLmFwaS52MS5FdmVudFN0cmVhbVJlc3BvbnNlIgAwARJjCg5HZXREaWFnbm9zdGljcxImLm9saXZldGluLmFwaS52MS5HZXREaWFnbm9zdGljc1JlcXVlc3QaJy5vbGl2ZXRpbi5hcGkudjEuR2V0RGlhZ25vc3RpY3NSZXNwb25zZSIAEkUKBEluaXQSHC5vbGl2ZXRpbi5hcGkudjEuSW5pdFJlcXVlc3QaHS5vbGl2ZXRpbi5hcGkudjEuSW5pdFJlc3BvbnNlIgASaQoQR2V0QWN0aW9uQmluZGluZxIoLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25CaW5kaW5nUmVxdWVzdBopLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25CaW5kaW5nUmVzcG9uc2UiABJaCgtHZXRFbnRpdGllcxIjLm9saXZldGluLmFwaS52MS5HZXRFbnRpdGllc1JlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuR2V0RW50aXRpZXNSZXNwb25zZSIAEkkKCUdldEVudGl0eRIhLm9saXZldGluLmFwaS52MS5HZXRFbnRpdHlSZXF1ZXN0Ghcub2xpdmV0aW4uYXBpLnYxLkVudGl0eSIAQjhaNmdpdGh1Yi5jb20vT2xpdmVUaW4vT2xpdmVUaW4vZ2VuL29saXZldGluL2FwaS92MTthcGl2MWIGcHJvdG8z");
+  fileDesc("Ch5vbGl2ZXRpbi9hcGkvdjEvb2xpdmV0aW4ucHJvdG8SD29saXZldGluLmFwaS52MSKBBQoGQWN0aW9uEhIKCmJpbmRpbmdfaWQYASABKAkSDQoFdGl0bGUYAiABKAkSDAoEaWNvbhgDIAEoCRIQCghjYW5fZXhlYxgEIAEoCBIyCglhcmd1bWVudHMYBSADKAsyHy5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uQXJndW1lbnQSFgoOcG9wdXBfb25fc3RhcnQYBiABKAkSDQoFb3JkZXIYByABKAUSDwoHdGltZW91dBgIIAEoBRIjChtkYXRldGltZV9yYXRlX2xpbWl0X2V4cGlyZXMYCSABKAkSFwoPZXhlY19vbl9zdGFydHVwGAogASgIEhQKDGV4ZWNfb25fY3JvbhgLIAMoCRIjChtleGVjX29uX2ZpbGVfY3JlYXRlZF9pbl9kaXIYDCADKAkSIwobZXhlY19vbl9maWxlX2NoYW5nZWRfaW5fZGlyGA0gAygJEh0KFWV4ZWNfb25fY2FsZW5kYXJfZmlsZRgOIAEoCRJAChBleGVjX29uX3dlYmhvb2tzGA8gAygLMiYub2xpdmV0aW4uYXBpLnYxLkFjdGlvbldlYmhvb2tFeGVjSGludBIVCg1qdXN0aWZpY2F0aW9uGBQgASgJEhwKFGhhc19ydW5uaW5nX2luc3RhbmNlGBEgASgIEhsKE2hhc19xdWV1ZWRfaW5zdGFuY2UYEiABKAgSNgoGZ3JvdXBzGBMgAygLMiYub2xpdmV0aW4uYXBpLnYxLkFjdGlvbkdyb3VwTWVtYmVyc2hpcBI5CgxleGVjX29uX21xdHQYFSADKAsyIy5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uTXF0dEV4ZWNIaW50SgQIEBARIlEKFUFjdGlvbkdyb3VwTWVtYmVyc2hpcBIMCgRuYW1lGAEgASgJEhYKDm1heF9jb25jdXJyZW50GAIgASgFEhIKCnF1ZXVlX3NpemUYAyABKAUiwwIKFUFjdGlvbldlYmhvb2tFeGVjSGludBIQCgh0ZW1wbGF0ZRgBIAEoCRISCgptYXRjaF9wYXRoGAIgASgJEk8KDW1hdGNoX2hlYWRlcnMYAyADKAsyOC5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uV2ViaG9va0V4ZWNIaW50Lk1hdGNoSGVhZGVyc0VudHJ5EksKC21hdGNoX3F1ZXJ5GAQgAygLMjYub2xpdmV0aW4uYXBpLnYxLkFjdGlvbldlYmhvb2tFeGVjSGludC5NYXRjaFF1ZXJ5RW50cnkaMwoRTWF0Y2hIZWFkZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARoxCg9NYXRjaFF1ZXJ5RW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJHChJBY3Rpb25NcXR0RXhlY0hpbnQSDgoGYnJva2VyGAEgASgJEg0KBXRvcGljGAIgASgJEhIKCm1hdGNoX3BhdGgYAyABKAkiuwIKDkFjdGlvbkFyZ3VtZW50EgwKBG5hbWUYASABKAkSDQoFdGl0bGUYAiABKAkSDAoEdHlwZRgDIAEoCRIVCg1kZWZhdWx0X3ZhbHVlGAQgASgJEjYKB2Nob2ljZXMYBSADKAsyJS5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uQXJndW1lbnRDaG9pY2USEwoLZGVzY3JpcHRpb24YBiABKAkSRQoLc3VnZ2VzdGlvbnMYByADKAsyMC5vbGl2ZXRpbi5hcGkudjEuQWN0aW9uQXJndW1lbnQuU3VnZ2VzdGlvbnNFbnRyeRIfChdzdWdnZXN0aW9uc19icm93c2VyX2tleRgIIAEoCRoyChBTdWdnZXN0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiNAoUQWN0aW9uQXJndW1lbnRDaG9pY2USDQoFdmFsdWUYASABKAkSDQoFdGl0bGUYAiABKAki1AEKE0VudGl0eVJlbGF0ZWRBY3Rpb24SJwoGYWN0aW9uGAEgASgLMhcub2xpdmV0aW4uYXBpLnYxLkFjdGlvbhJZChNwcmVmaWxsZWRfYXJndW1lbnRzGAIgAygLMjwub2xpdmV0aW4uYXBpLnYxLkVudGl0eVJlbGF0ZWRBY3Rpb24uUHJlZmlsbGVkQXJndW1lbnRzRW50cnkaOQoXUHJlZmlsbGVkQXJndW1lbnRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASL/AQoGRW50aXR5Eg0KBXRpdGxlGAEgASgJEhIKCnVuaXF1ZV9rZXkYAiABKAkSDAoEdHlwZRgDIAEoCRITCgtkaXJlY3RvcmllcxgEIAMoCRIzCgZmaWVsZHMYBSADKAsyIy5vbGl2ZXRpbi5hcGkudjEuRW50aXR5LkZpZWxkc0VudHJ5Ej0KD3JlbGF0ZWRfYWN0aW9ucxgGIAMoCzIkLm9saXZldGluLmFwaS52MS5FbnRpdHlSZWxhdGVkQWN0aW9uEgwKBGljb24YByABKAkaLQoLRmllbGRzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJUChRHZXREYXNoYm9hcmRSZXNwb25zZRINCgV0aXRsZRgBIAEoCRItCglkYXNoYm9hcmQYBCABKAsyGi5vbGl2ZXRpbi5hcGkudjEuRGFzaGJvYXJkIncKD0VmZmVjdGl2ZVBvbGljeRIYChBzaG93X2RpYWdub3N0aWNzGAEgASgIEhUKDXNob3dfbG9nX2xpc3QYAiABKAgSGwoTc2hvd192ZXJzaW9uX251bWJlchgDIAEoCBIWCg5zaG93X2F1ZGl0X2xvZxgEIAEoCCJNChNHZXREYXNoYm9hcmRSZXF1ZXN0Eg0KBXRpdGxlGAEgASgJEhMKC2VudGl0eV90eXBlGAIgASgJEhIKCmVudGl0eV9rZXkYAyABKAkiUQoJRGFzaGJvYXJkEg0KBXRpdGxlGAEgASgJEjUKCGNvbnRlbnRzGAIgAygLMiMub2xpdmV0aW4uYXBpLnYxLkRhc2hib2FyZENvbXBvbmVudCLbAQoSRGFzaGJvYXJkQ29tcG9uZW50Eg0KBXRpdGxlGAEgASgJEgwKBHR5cGUYAiABKAkSNQoIY29udGVudHMYAyADKAsyIy5vbGl2ZXRpbi5hcGkudjEuRGFzaGJvYXJkQ29tcG9uZW50EgwKBGljb24YBCABKAkSEQoJY3NzX2NsYXNzGAUgASgJEicKBmFjdGlvbhgGIAEoCzIXLm9saXZldGluLmFwaS52MS5BY3Rpb24SEwoLZW50aXR5X3R5cGUYByABKAkSEgoKZW50aXR5X2tleRgIIAEoCSKUAQoSU3RhcnRBY3Rpb25SZXF1ZXN0EhIKCmJpbmRpbmdfaWQYASABKAkSNwoJYXJndW1lbnRzGAIgAygLMiQub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQXJndW1lbnQSGgoSdW5pcXVlX3RyYWNraW5nX2lkGAMgASgJEhUKDWp1c3RpZmljYXRpb24YBCABKAkiMgoTU3RhcnRBY3Rpb25Bcmd1bWVudBIMCgRuYW1lGAEgASgJEg0KBXZhbHVlGAIgASgJIjQKE1N0YXJ0QWN0aW9uUmVzcG9uc2USHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAIgASgJIn4KGVN0YXJ0QWN0aW9uQW5kV2FpdFJlcXVlc3QSEQoJYWN0aW9uX2lkGAEgASgJEjcKCWFyZ3VtZW50cxgCIAMoCzIkLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFyZ3VtZW50EhUKDWp1c3RpZmljYXRpb24YAyABKAkiSgoaU3RhcnRBY3Rpb25BbmRXYWl0UmVzcG9uc2USLAoJbG9nX2VudHJ5GAEgASgLMhkub2xpdmV0aW4uYXBpLnYxLkxvZ0VudHJ5IiwKF1N0YXJ0QWN0aW9uQnlHZXRSZXF1ZXN0EhEKCWFjdGlvbl9pZBgBIAEoCSI5ChhTdGFydEFjdGlvbkJ5R2V0UmVzcG9uc2USHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAIgASgJIjMKHlN0YXJ0QWN0aW9uQnlHZXRBbmRXYWl0UmVxdWVzdBIRCglhY3Rpb25faWQYASABKAkiTwofU3RhcnRBY3Rpb25CeUdldEFuZFdhaXRSZXNwb25zZRIsCglsb2dfZW50cnkYASABKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkifAoOR2V0TG9nc1JlcXVlc3QSFAoMc3RhcnRfb2Zmc2V0GAEgASgDEhMKC2RhdGVfZmlsdGVyGAIgASgJEhEKCXBhZ2Vfc2l6ZRgDIAEoAxIOCgZmaWx0ZXIYBCABKAkSHAoUd29ya2Zsb3dfdHJhY2tpbmdfaWQYBSABKAki9AUKCExvZ0VudHJ5EhgKEGRhdGV0aW1lX3N0YXJ0ZWQYASABKAkSFAoMYWN0aW9uX3RpdGxlGAIgASgJEg4KBm91dHB1dBgDIAEoCRIRCgl0aW1lZF9vdXQYBSABKAgSEQoJZXhpdF9jb2RlGAYgASgFEgwKBHVzZXIYByABKAkSEgoKdXNlcl9jbGFzcxgIIAEoCRITCgthY3Rpb25faWNvbhgJIAEoCRIMCgR0YWdzGAogAygJEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgLIAEoCRIZChFkYXRldGltZV9maW5pc2hlZBgMIAEoCRIZChFleGVjdXRpb25fc3RhcnRlZBgOIAEoCBIaChJleGVjdXRpb25fZmluaXNoZWQYDyABKAgSDwoHYmxvY2tlZBgQIAEoCBIWCg5kYXRldGltZV9pbmRleBgRIAEoAxIQCghjYW5fa2lsbBgSIAEoCBIjChtkYXRldGltZV9yYXRlX2xpbWl0X2V4cGlyZXMYEyABKAkSEgoKYmluZGluZ19pZBgUIAEoCRIOCgZxdWV1ZWQYFSABKAgSGAoQcXVldWVkX2Zvcl9ncm91cBgWIAEoCRIVCg1qdXN0aWZpY2F0aW9uGBcgASgJEjcKCWFyZ3VtZW50cxgYIAMoCzIkLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFyZ3VtZW50EhkKEWF3YWl0aW5nX2FwcHJvdmFsGBkgASgIEhMKC2FwcHJvdmVkX2J5GBogAygJEg4KBnN0ZG91dBgbIAEoCRIOCgZzdGRlcnIYHCABKAkSEwoLcmVzdWx0X2pzb24YHSABKAkSHAoUd29ya2Zsb3dfdHJhY2tpbmdfaWQYHiABKAkSFQoNd29ya2Zsb3dfc3RlcBgfIAEoCRIPCgdhdHRlbXB0GCAgASgFEjMKCGF0dGVtcHRzGCEgAygLMiEub2xpdmV0aW4uYXBpLnYxLkV4ZWN1dGlvbkF0dGVtcHQijgEKEEV4ZWN1dGlvbkF0dGVtcHQSDwoHYXR0ZW1wdBgBIAEoBRIYChBkYXRldGltZV9zdGFydGVkGAIgASgJEhkKEWRhdGV0aW1lX2ZpbmlzaGVkGAMgASgJEhEKCWV4aXRfY29kZRgEIAEoBRIRCgl0aW1lZF9vdXQYBSABKAgSDgoGb3V0cHV0GAYgASgJIsUBCg9HZXRMb2dzUmVzcG9uc2USJwoEbG9ncxgBIAMoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeRIXCg9jb3VudF9yZW1haW5pbmcYAiABKAMSEQoJcGFnZV9zaXplGAMgASgDEhMKC3RvdGFsX2NvdW50GAQgASgDEhQKDHN0YXJ0X29mZnNldBgFIAEoAxIyCgx3b3JrZmxvd19ydW4YBiABKAsyHC5vbGl2ZXRpbi5hcGkudjEuV29ya2Zsb3dSdW4i0wEKC1dvcmtmbG93UnVuEhwKFHdvcmtmbG93X3RyYWNraW5nX2lkGAEgASgJEhMKC3dvcmtmbG93X2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEgwKBHVzZXIYBCABKAkSDgoGc3RhdHVzGAUgASgJEhgKEGRhdGV0aW1lX3N0YXJ0ZWQYBiABKAkSGQoRZGF0ZXRpbWVfZmluaXNoZWQYByABKAkSLwoFc3RlcHMYCCADKAsyIC5vbGl2ZXRpbi5hcGkudjEuV29ya2Zsb3dTdGVwUnVuIoQBCg9Xb3JrZmxvd1N0ZXBSdW4SCgoCaWQYASABKAkSFAoMYWN0aW9uX3RpdGxlGAIgASgJEg4KBnN0YXR1cxgDIAEoCRIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYBCABKAkSEQoJZXhpdF9jb2RlGAUgASgFEg0KBWVycm9yGAYgASgJImQKFFN0YXJ0V29ya2Zsb3dSZXF1ZXN0EhMKC3dvcmtmbG93X2lkGAEgASgJEjcKCWFyZ3VtZW50cxgCIAMoCzIkLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFyZ3VtZW50IjUKFVN0YXJ0V29ya2Zsb3dSZXNwb25zZRIcChR3b3JrZmxvd190cmFja2luZ19pZBgBIAEoCSI/ChRHZXRBY3Rpb25Mb2dzUmVxdWVzdBIRCglhY3Rpb25faWQYASABKAkSFAoMc3RhcnRfb2Zmc2V0GAIgASgDIpcBChVHZXRBY3Rpb25Mb2dzUmVzcG9uc2USJwoEbG9ncxgBIAMoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeRIXCg9jb3VudF9yZW1haW5pbmcYAiABKAMSEQoJcGFnZV9zaXplGAMgASgDEhMKC3RvdGFsX2NvdW50GAQgASgDEhQKDHN0YXJ0X29mZnNldBgFIAEoAyIaChhHZXRFeGVjdXRpb25RdWV1ZVJlcXVlc3QixgEKFEV4ZWN1dGlvblF1ZXVlQWN0aW9uEhIKCmJpbmRpbmdfaWQYASABKAkSFAoMYWN0aW9uX3RpdGxlGAIgASgJEhMKC2FjdGlvbl9pY29uGAMgASgJEhYKDm1heF9jb25jdXJyZW50GAQgASgFEhQKDGFjdGl2ZV9jb3VudBgFIAEoBRIVCg1lbnRpdHlfcHJlZml4GAYgASgJEioKB2VudHJpZXMYByADKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkiwQEKE0V4ZWN1dGlvblF1ZXVlR3JvdXASDAoEbmFtZRgBIAEoCRIMCgRpY29uGAIgASgJEhYKDm1heF9jb25jdXJyZW50GAMgASgFEhQKDGFjdGl2ZV9jb3VudBgEIAEoBRI2CgdhY3Rpb25zGAUgAygLMiUub2xpdmV0aW4uYXBpLnYxLkV4ZWN1dGlvblF1ZXVlQWN0aW9uEhQKDHF1ZXVlZF9jb3VudBgGIAEoBRISCgpxdWV1ZV9zaXplGAcgASgFIp8BChlHZXRFeGVjdXRpb25RdWV1ZVJlc3BvbnNlEjQKBmdyb3VwcxgBIAMoCzIkLm9saXZldGluLmFwaS52MS5FeGVjdXRpb25RdWV1ZUdyb3VwEhQKDHRvdGFsX2FjdGl2ZRgCIAEoBRI2CglzY2hlZHVsZWQYAyADKAsyIy5vbGl2ZXRpbi5hcGkudjEuU2NoZWR1bGVkRXhlY3V0aW9uIv8BChJTY2hlZHVsZWRFeGVjdXRpb24SCgoCaWQYASABKAkSEgoKYmluZGluZ19pZBgCIAEoCRIUCgxhY3Rpb25fdGl0bGUYAyABKAkSEwoLYWN0aW9uX2ljb24YBCABKAkSEAoIZGF0ZXRpbWUYBSABKAkSGgoSZGF0ZXRpbWVfc2NoZWR1bGVkGAYgASgJEjcKCWFyZ3VtZW50cxgHIAMoCzIkLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFyZ3VtZW50EhUKDWp1c3RpZmljYXRpb24YCCABKAkSDAoEdXNlchgJIAEoCRISCgpjYW5fY2FuY2VsGAogASgIIo0BChVTY2hlZHVsZUFjdGlvblJlcXVlc3QSEgoKYmluZGluZ19pZBgBIAEoCRIQCghkYXRldGltZRgCIAEoCRI3Cglhcmd1bWVudHMYAyADKAsyJC5vbGl2ZXRpbi5hcGkudjEuU3RhcnRBY3Rpb25Bcmd1bWVudBIVCg1qdXN0aWZpY2F0aW9uGAQgASgJIloKFlNjaGVkdWxlQWN0aW9uUmVzcG9uc2USQAoTc2NoZWR1bGVkX2V4ZWN1dGlvbhgBIAEoCzIjLm9saXZldGluLmFwaS52MS5TY2hlZHVsZWRFeGVjdXRpb24iIAoeTGlzdFNjaGVkdWxlZEV4ZWN1dGlvbnNSZXF1ZXN0ImQKH0xpc3RTY2hlZHVsZWRFeGVjdXRpb25zUmVzcG9uc2USQQoUc2NoZWR1bGVkX2V4ZWN1dGlvbnMYASADKAsyIy5vbGl2ZXRpbi5hcGkudjEuU2NoZWR1bGVkRXhlY3V0aW9uIi0KH0NhbmNlbFNjaGVkdWxlZEV4ZWN1dGlvblJlcXVlc3QSCgoCaWQYASABKAkiIgogQ2FuY2VsU2NoZWR1bGVkRXhlY3V0aW9uUmVzcG9uc2Ui+QEKDENyb25TY2hlZHVsZRIKCgJpZBgBIAEoCRISCgpiaW5kaW5nX2lkGAIgASgJEhQKDGFjdGlvbl90aXRsZRgDIAEoCRITCgthY3Rpb25faWNvbhgEIAEoCRIRCgljcm9uX2xpbmUYBSABKAkSEAoIbmV4dF9ydW4YBiABKAkSFAoMcHJldmlvdXNfcnVuGAcgASgJEg4KBnBhdXNlZBgIIAEoCBINCgVlcnJvchgJIAEoCRIxCg5sYXN0X2V4ZWN1dGlvbhgKIAEoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeRIRCgljYW5fcGF1c2UYCyABKAgiGgoYTGlzdENyb25TY2hlZHVsZXNSZXF1ZXN0Ik0KGUxpc3RDcm9uU2NoZWR1bGVzUmVzcG9uc2USMAoJc2NoZWR1bGVzGAEgAygLMh0ub2xpdmV0aW4uYXBpLnYxLkNyb25TY2hlZHVsZSImChhQYXVzZUNyb25TY2hlZHVsZVJlcXVlc3QSCgoCaWQYASABKAkiTAoZUGF1c2VDcm9uU2NoZWR1bGVSZXNwb25zZRIvCghzY2hlZHVsZRgBIAEoCzIdLm9saXZldGluLmFwaS52MS5Dcm9uU2NoZWR1bGUiJwoZUmVzdW1lQ3JvblNjaGVkdWxlUmVxdWVzdBIKCgJpZBgBIAEoCSJNChpSZXN1bWVDcm9uU2NoZWR1bGVSZXNwb25zZRIvCghzY2hlZHVsZRgBIAEoCzIdLm9saXZldGluLmFwaS52MS5Dcm9uU2NoZWR1bGUiZQobVmFsaWRhdGVBcmd1bWVudFR5cGVSZXF1ZXN0Eg0KBXZhbHVlGAEgASgJEgwKBHR5cGUYAiABKAkSEgoKYmluZGluZ19pZBgDIAEoCRIVCg1hcmd1bWVudF9uYW1lGAQgASgJIkIKHFZhbGlkYXRlQXJndW1lbnRUeXBlUmVzcG9uc2USDQoFdmFsaWQYASABKAgSEwoLZGVzY3JpcHRpb24YAiABKAkiNgoVV2F0Y2hFeGVjdXRpb25SZXF1ZXN0Eh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgBIAEoCSImChRXYXRjaEV4ZWN1dGlvblVwZGF0ZRIOCgZ1cGRhdGUYASABKAkiSgoWRXhlY3V0aW9uU3RhdHVzUmVxdWVzdBIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAkSEQoJYWN0aW9uX2lkGAIgASgJImEKGURhc2hib2FyZE5hdmlnYXRpb25UYXJnZXQSDQoFdGl0bGUYASABKAkSEwoLZW50aXR5X3R5cGUYAiABKAkSEgoKZW50aXR5X2tleRgDIAEoCRIMCgRwYXRoGAQgASgJIo8BChdFeGVjdXRpb25TdGF0dXNSZXNwb25zZRIsCglsb2dfZW50cnkYASABKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkSRgoSYmFja190b19kYXNoYm9hcmRzGAIgAygLMioub2xpdmV0aW4uYXBpLnYxLkRhc2hib2FyZE5hdmlnYXRpb25UYXJnZXQiDwoNV2hvQW1JUmVxdWVzdCKbAQoOV2hvQW1JUmVzcG9uc2USGgoSYXV0aGVudGljYXRlZF91c2VyGAEgASgJEhEKCXVzZXJncm91cBgCIAEoCRIQCghwcm92aWRlchgDIAEoCRIMCgRhY2xzGAQgAygJEgsKA3NpZBgFIAEoCRIWCg50b3RwX2F2YWlsYWJsZRgGIAEoCBIVCg10b3RwX2Vucm9sbGVkGAcgASgIIhoKGFNlcnZlckRpYWdub3N0aWNzUmVxdWVzdCIqChlTZXJ2ZXJEaWFnbm9zdGljc1Jlc3BvbnNlEg0KBWFsZXJ0GAEgASgJIhEKD0R1bXBWYXJzUmVxdWVzdCKVAQoQRHVtcFZhcnNSZXNwb25zZRINCgVhbGVydBgBIAEoCRJBCghjb250ZW50cxgCIAMoCzIvLm9saXZldGluLmFwaS52MS5EdW1wVmFyc1Jlc3BvbnNlLkNvbnRlbnRzRW50cnkaLwoNQ29udGVudHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIjsKDERlYnVnQmluZGluZxIUCgxhY3Rpb25fdGl0bGUYASABKAkSFQoNZW50aXR5X3ByZWZpeBgCIAEoCSIeChxEdW1wUHVibGljSWRBY3Rpb25NYXBSZXF1ZXN0Is4BCh1EdW1wUHVibGljSWRBY3Rpb25NYXBSZXNwb25zZRINCgVhbGVydBgBIAEoCRJOCghjb250ZW50cxgCIAMoCzI8Lm9saXZldGluLmFwaS52MS5EdW1wUHVibGljSWRBY3Rpb25NYXBSZXNwb25zZS5Db250ZW50c0VudHJ5Gk4KDUNvbnRlbnRzRW50cnkSCwoDa2V5GAEgASgJEiwKBXZhbHVlGAIgASgLMh0ub2xpdmV0aW4uYXBpLnYxLkRlYnVnQmluZGluZzoCOAEiEgoQR2V0UmVhZHl6UmVxdWVzdCIjChFHZXRSZWFkeXpSZXNwb25zZRIOCgZzdGF0dXMYASABKAkiFAoSRXZlbnRTdHJlYW1SZXF1ZXN0IqUEChNFdmVudFN0cmVhbVJlc3BvbnNlEj0KDmVudGl0eV9jaGFuZ2VkGAIgASgLMiMub2xpdmV0aW4uYXBpLnYxLkV2ZW50RW50aXR5Q2hhbmdlZEgAEj0KDmNvbmZpZ19jaGFuZ2VkGAMgASgLMiMub2xpdmV0aW4uYXBpLnYxLkV2ZW50Q29uZmlnQ2hhbmdlZEgAEkUKEmV4ZWN1dGlvbl9maW5pc2hlZBgEIAEoCzInLm9saXZldGluLmFwaS52MS5FdmVudEV4ZWN1dGlvbkZpbmlzaGVkSAASQwoRZXhlY3V0aW9uX3N0YXJ0ZWQYBSABKAsyJi5vbGl2ZXRpbi5hcGkudjEuRXZlbnRFeGVjdXRpb25TdGFydGVkSAASOQoMb3V0cHV0X2NodW5rGAYgASgLMiEub2xpdmV0aW4uYXBpLnYxLkV2ZW50T3V0cHV0Q2h1bmtIABI0CgloZWFydGJlYXQYByABKAsyHy5vbGl2ZXRpbi5hcGkudjEuRXZlbnRIZWFydGJlYXRIABJFChJhcHByb3ZhbF9yZXF1ZXN0ZWQYCCABKAsyJy5vbGl2ZXRpbi5hcGkudjEuRXZlbnRBcHByb3ZhbFJlcXVlc3RlZEgAEkMKEWFwcHJvdmFsX3Jlc29sdmVkGAkgASgLMiYub2xpdmV0aW4uYXBpLnYxLkV2ZW50QXBwcm92YWxSZXNvbHZlZEgAQgcKBWV2ZW50ImMKEEV2ZW50T3V0cHV0Q2h1bmsSHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAEgASgJEg4KBm91dHB1dBgCIAEoCRIOCgZzdHJlYW0YAyABKAkSEAoIZGF0ZXRpbWUYBCABKAkiKQoSRXZlbnRFbnRpdHlDaGFuZ2VkEhMKC2VudGl0eV9uYW1lGAEgASgJIhQKEkV2ZW50Q29uZmlnQ2hhbmdlZCIQCg5FdmVudEhlYXJ0YmVhdCJGChZFdmVudEV4ZWN1dGlvbkZpbmlzaGVkEiwKCWxvZ19lbnRyeRgBIAEoCzIZLm9saXZldGluLmFwaS52MS5Mb2dFbnRyeSJFChVFdmVudEV4ZWN1dGlvblN0YXJ0ZWQSLAoJbG9nX2VudHJ5GAEgASgLMhkub2xpdmV0aW4uYXBpLnYxLkxvZ0VudHJ5IjIKEUtpbGxBY3Rpb25SZXF1ZXN0Eh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgBIAEoCSJtChJLaWxsQWN0aW9uUmVzcG9uc2USHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAEgASgJEg4KBmtpbGxlZBgCIAEoCBIZChFhbHJlYWR5X2NvbXBsZXRlZBgDIAEoCBINCgVmb3VuZBgEIAEoCCJmChVMb2NhbFVzZXJMb2dpblJlcXVlc3QSEAoIdXNlcm5hbWUYASABKAkSEAoIcGFzc3dvcmQYAiABKAkSFgoOdG90cF9jaGFsbGVuZ2UYAyABKAkSEQoJdG90cF9jb2RlGAQgASgJIlgKFkxvY2FsVXNlckxvZ2luUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIVCg10b3RwX3JlcXVpcmVkGAIgASgIEhYKDnRvdHBfY2hhbGxlbmdlGAMgASgJIhsKGUJlZ2luVG90cEVucm9sbWVudFJlcXVlc3QiTgoaQmVnaW5Ub3RwRW5yb2xtZW50UmVzcG9uc2USDgoGc2VjcmV0GAEgASgJEgsKA3VybBgCIAEoCRITCgtxcl9jb2RlX3BuZxgDIAEoDCIrChtDb25maXJtVG90cEVucm9sbWVudFJlcXVlc3QSDAoEY29kZRgBIAEoCSI2ChxDb25maXJtVG90cEVucm9sbWVudFJlc3BvbnNlEhYKDnJlY292ZXJ5X2NvZGVzGAEgAygJIiIKEkRpc2FibGVUb3RwUmVxdWVzdBIMCgRjb2RlGAEgASgJIhUKE0Rpc2FibGVUb3RwUmVzcG9uc2UiJwoTUGFzc3dvcmRIYXNoUmVxdWVzdBIQCghwYXNzd29yZBgBIAEoCSIkChRQYXNzd29yZEhhc2hSZXNwb25zZRIMCgRoYXNoGAEgASgJIg8KDUxvZ291dFJlcXVlc3QiJgoOTG9nb3V0UmVzcG9uc2USFAoMcmVkaXJlY3RfdXJsGAEgASgJIhcKFUdldERpYWdub3N0aWNzUmVxdWVzdCKnAQoWR2V0RGlhZ25vc3RpY3NSZXNwb25zZRITCgtTc2hGb3VuZEtleRgBIAEoCRIWCg5Tc2hGb3VuZENvbmZpZxgCIAEoCRI8ChJ3ZWJob29rX2RlbGl2ZXJpZXMYAyADKAsyIC5vbGl2ZXRpbi5hcGkudjEuV2ViaG9va0RlbGl2ZXJ5EiIKGndlYmhvb2tfZGVsaXZlcmllc19wZW5kaW5nGAQgASgFIqoBCg9XZWJob29rRGVsaXZlcnkSEAoIZGF0ZXRpbWUYASABKAkSDwoHd2ViaG9vaxgCIAEoCRINCgVldmVudBgDIAEoCRIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYBCABKAkSDwoHYXR0ZW1wdBgFIAEoBRIOCgZzdGF0dXMYBiABKAkSEQoJZGVsaXZlcmVkGAcgASgIEhIKCndpbGxfcmV0cnkYCCABKAgiUgoSR2V0QXVkaXRMb2dSZXF1ZXN0EgwKBHR5cGUYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDQoFc2luY2UYAyABKAkSDQoFbGltaXQYBCABKAUibAoTR2V0QXVkaXRMb2dSZXNwb25zZRIrCgZldmVudHMYASADKAsyGy5vbGl2ZXRpbi5hcGkudjEuQXVkaXRFdmVudBITCgtjaGFpbl92YWxpZBgCIAEoCBITCgtjaGFpbl9lcnJvchgDIAEoCSLkAQoKQXVkaXRFdmVudBIOCgZzY2hlbWEYASABKAUSCwoDc2VxGAIgASgEEgwKBHRpbWUYAyABKAkSDAoEdHlwZRgEIAEoCRIPCgdvdXRjb21lGAUgASgJEhAKCHVzZXJuYW1lGAYgASgJEhAKCHByb3ZpZGVyGAcgASgJEg4KBmFjdGlvbhgIIAEoCRITCgt0cmFja2luZ19pZBgJIAEoCRISCgpwZXJtaXNzaW9uGAogASgJEg4KBmRldGFpbBgLIAEoCRIRCglwcmV2X2hhc2gYDCABKAkSDAoEaGFzaBgNIAEoCSINCgtJbml0UmVxdWVzdCLrBQoMSW5pdFJlc3BvbnNlEhIKCnNob3dGb290ZXIYASABKAgSFgoOc2hvd05hdmlnYXRpb24YAiABKAgSFwoPc2hvd05ld1ZlcnNpb25zGAMgASgIEhgKEGF2YWlsYWJsZVZlcnNpb24YBCABKAkSFgoOY3VycmVudFZlcnNpb24YBSABKAkSEQoJcGFnZVRpdGxlGAYgASgJEh4KFnNlY3Rpb25OYXZpZ2F0aW9uU3R5bGUYByABKAkSGgoSZGVmYXVsdEljb25Gb3JCYWNrGAggASgJEhYKDmVuYWJsZUN1c3RvbUpzGAkgASgIEhQKDGF1dGhMb2dpblVybBgKIAEoCRIWCg5hdXRoTG9jYWxMb2dpbhgLIAEoCBIRCglzdHlsZU1vZHMYDCADKAkSOAoPb0F1dGgyUHJvdmlkZXJzGA0gAygLMh8ub2xpdmV0aW4uYXBpLnYxLk9BdXRoMlByb3ZpZGVyEjgKD2FkZGl0aW9uYWxMaW5rcxgOIAMoCzIfLm9saXZldGluLmFwaS52MS5BZGRpdGlvbmFsTGluaxIWCg5yb290RGFzaGJvYXJkcxgPIAMoCRIaChJhdXRoZW50aWNhdGVkX3VzZXIYECABKAkSIwobYXV0aGVudGljYXRlZF91c2VyX3Byb3ZpZGVyGBEgASgJEjoKEGVmZmVjdGl2ZV9wb2xpY3kYEiABKAsyIC5vbGl2ZXRpbi5hcGkudjEuRWZmZWN0aXZlUG9saWN5EhYKDmJhbm5lcl9tZXNzYWdlGBMgASgJEhIKCmJhbm5lcl9jc3MYFCABKAkSGAoQc2hvd19kaWFnbm9zdGljcxgVIAEoCBIVCg1zaG93X2xvZ19saXN0GBYgASgIEhYKDmxvZ2luX3JlcXVpcmVkGBcgASgIEhgKEGF2YWlsYWJsZV90aGVtZXMYGCADKAkSJAocc2hvd19uYXZpZ2F0ZV9vbl9zdGFydF9pY29ucxgZIAEoCCIsCg5BZGRpdGlvbmFsTGluaxINCgV0aXRsZRgBIAEoCRILCgN1cmwYAiABKAkiOgoOT0F1dGgyUHJvdmlkZXISDQoFdGl0bGUYASABKAkSDAoEaWNvbhgDIAEoCRILCgNrZXkYBCABKAkiLQoXR2V0QWN0aW9uQmluZGluZ1JlcXVlc3QSEgoKYmluZGluZ19pZBgBIAEoCSKLAQoYR2V0QWN0aW9uQmluZGluZ1Jlc3BvbnNlEicKBmFjdGlvbhgBIAEoCzIXLm9saXZldGluLmFwaS52MS5BY3Rpb24SRgoSYmFja190b19kYXNoYm9hcmRzGAIgAygLMioub2xpdmV0aW4uYXBpLnYxLkRhc2hib2FyZE5hdmlnYXRpb25UYXJnZXQiWgoSR2V0RW50aXRpZXNSZXF1ZXN0EhMKC2VudGl0eV90eXBlGAEgASgJEg4KBmZpbHRlchgCIAEoCRIMCgRwYWdlGAMgASgFEhEKCXBhZ2Vfc2l6ZRgEIAEoBSJUChNHZXRFbnRpdGllc1Jlc3BvbnNlEj0KEmVudGl0eV9kZWZpbml0aW9ucxgBIAMoCzIhLm9saXZldGluLmFwaS52MS5FbnRpdHlEZWZpbml0aW9uIsUBChBFbnRpdHlEZWZpbml0aW9uEg0KBXRpdGxlGAEgASgJEioKCWluc3RhbmNlcxgCIAMoCzIXLm9saXZldGluLmFwaS52MS5FbnRpdHkSGgoSdXNlZF9vbl9kYXNoYm9hcmRzGAMgAygJEgwKBGljb24YBCABKAkSMwoKcHJvcGVydGllcxgFIAMoCzIfLm9saXZldGluLmFwaS52MS5FbnRpdHlQcm9wZXJ0eRIXCg90b3RhbF9pbnN0YW5jZXMYBiABKAUiLQoORW50aXR5UHJvcGVydHkSDAoEbmFtZRgBIAEoCRINCgV0aXRsZRgCIAEoCSI0ChBHZXRFbnRpdHlSZXF1ZXN0EhIKCnVuaXF1ZV9rZXkYASABKAkSDAoEdHlwZRgCIAEoCSI1ChRSZXN0YXJ0QWN0aW9uUmVxdWVzdBIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAkiigEKD1BlbmRpbmdBcHByb3ZhbBIsCglsb2dfZW50cnkYASABKAsyGS5vbGl2ZXRpbi5hcGkudjEuTG9nRW50cnkSGgoSYXBwcm92YWxzX3JlcXVpcmVkGAIgASgFEhgKEGRhdGV0aW1lX2V4cGlyZXMYAyABKAkSEwoLY2FuX2FwcHJvdmUYBCABKAgiHQobTGlzdFBlbmRpbmdBcHByb3ZhbHNSZXF1ZXN0IlMKHExpc3RQZW5kaW5nQXBwcm92YWxzUmVzcG9uc2USMwoJYXBwcm92YWxzGAEgAygLMiAub2xpdmV0aW4uYXBpLnYxLlBlbmRpbmdBcHByb3ZhbCI4ChdBcHByb3ZlRXhlY3V0aW9uUmVxdWVzdBIdChVleGVjdXRpb25fdHJhY2tpbmdfaWQYASABKAkiVgoYQXBwcm92ZUV4ZWN1dGlvblJlc3BvbnNlEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgBIAEoCRIbChNhcHByb3ZhbHNfcmVtYWluaW5nGAIgASgFIjcKFlJlamVjdEV4ZWN1dGlvblJlcXVlc3QSHQoVZXhlY3V0aW9uX3RyYWNraW5nX2lkGAEgASgJIjgKF1JlamVjdEV4ZWN1dGlvblJlc3BvbnNlEh0KFWV4ZWN1dGlvbl90cmFja2luZ19pZBgBIAEoCSJMChZFdmVudEFwcHJvdmFsUmVxdWVzdGVkEjIKCGFwcHJvdmFsGAEgASgLMiAub2xpdmV0aW4uYXBpLnYxLlBlbmRpbmdBcHByb3ZhbCJXChVFdmVudEFwcHJvdmFsUmVzb2x2ZWQSLAoJbG9nX2VudHJ5GAEgASgLMhkub2xpdmV0aW4uYXBpLnYxLkxvZ0VudHJ5EhAKCGFwcHJvdmVkGAIgASgIMvAfChJPbGl2ZVRpbkFwaVNlcnZpY2USXQoMR2V0RGFzaGJvYXJkEiQub2xpdmV0aW4uYXBpLnYxLkdldERhc2hib2FyZFJlcXVlc3QaJS5vbGl2ZXRpbi5hcGkudjEuR2V0RGFzaGJvYXJkUmVzcG9uc2UiABJaCgtTdGFydEFjdGlvbhIjLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvblJlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuU3RhcnRBY3Rpb25SZXNwb25zZSIAEm8KElN0YXJ0QWN0aW9uQW5kV2FpdBIqLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkFuZFdhaXRSZXF1ZXN0Gisub2xpdmV0aW4uYXBpLnYxLlN0YXJ0QWN0aW9uQW5kV2FpdFJlc3BvbnNlIgASaQoQU3RhcnRBY3Rpb25CeUdldBIoLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkJ5R2V0UmVxdWVzdBopLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkJ5R2V0UmVzcG9uc2UiABJ+ChdTdGFydEFjdGlvbkJ5R2V0QW5kV2FpdBIvLm9saXZldGluLmFwaS52MS5TdGFydEFjdGlvbkJ5R2V0QW5kV2FpdFJlcXVlc3QaMC5vbGl2ZXRpbi5hcGkudjEuU3RhcnRBY3Rpb25CeUdldEFuZFdhaXRSZXNwb25zZSIAEl4KDVJlc3RhcnRBY3Rpb24SJS5vbGl2ZXRpbi5hcGkudjEuUmVzdGFydEFjdGlvblJlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuU3RhcnRBY3Rpb25SZXNwb25zZSIAElcKCktpbGxBY3Rpb24SIi5vbGl2ZXRpbi5hcGkudjEuS2lsbEFjdGlvblJlcXVlc3QaIy5vbGl2ZXRpbi5hcGkudjEuS2lsbEFjdGlvblJlc3BvbnNlIgASZgoPRXhlY3V0aW9uU3RhdHVzEicub2xpdmV0aW4uYXBpLnYxLkV4ZWN1dGlvblN0YXR1c1JlcXVlc3QaKC5vbGl2ZXRpbi5hcGkudjEuRXhlY3V0aW9uU3RhdHVzUmVzcG9uc2UiABJOCgdHZXRMb2dzEh8ub2xpdmV0aW4uYXBpLnYxLkdldExvZ3NSZXF1ZXN0GiAub2xpdmV0aW4uYXBpLnYxLkdldExvZ3NSZXNwb25zZSIAEmAKDVN0YXJ0V29ya2Zsb3cSJS5vbGl2ZXRpbi5hcGkudjEuU3RhcnRXb3JrZmxvd1JlcXVlc3QaJi5vbGl2ZXRpbi5hcGkudjEuU3RhcnRXb3JrZmxvd1Jlc3BvbnNlIgASYAoNR2V0QWN0aW9uTG9ncxIlLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25Mb2dzUmVxdWVzdBomLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25Mb2dzUmVzcG9uc2UiABJsChFHZXRFeGVjdXRpb25RdWV1ZRIpLm9saXZldGluLmFwaS52MS5HZXRFeGVjdXRpb25RdWV1ZVJlcXVlc3QaKi5vbGl2ZXRpbi5hcGkudjEuR2V0RXhlY3V0aW9uUXVldWVSZXNwb25zZSIAEmMKDlNjaGVkdWxlQWN0aW9uEiYub2xpdmV0aW4uYXBpLnYxLlNjaGVkdWxlQWN0aW9uUmVxdWVzdBonLm9saXZldGluLmFwaS52MS5TY2hlZHVsZUFjdGlvblJlc3BvbnNlIgASfgoXTGlzdFNjaGVkdWxlZEV4ZWN1dGlvbnMSLy5vbGl2ZXRpbi5hcGkudjEuTGlzdFNjaGVkdWxlZEV4ZWN1dGlvbnNSZXF1ZXN0GjAub2xpdmV0aW4uYXBpLnYxLkxpc3RTY2hlZHVsZWRFeGVjdXRpb25zUmVzcG9uc2UiABKBAQoYQ2FuY2VsU2NoZWR1bGVkRXhlY3V0aW9uEjAub2xpdmV0aW4uYXBpLnYxLkNhbmNlbFNjaGVkdWxlZEV4ZWN1dGlvblJlcXVlc3QaMS5vbGl2ZXRpbi5hcGkudjEuQ2FuY2VsU2NoZWR1bGVkRXhlY3V0aW9uUmVzcG9uc2UiABJsChFMaXN0Q3JvblNjaGVkdWxlcxIpLm9saXZldGluLmFwaS52MS5MaXN0Q3JvblNjaGVkdWxlc1JlcXVlc3QaKi5vbGl2ZXRpbi5hcGkudjEuTGlzdENyb25TY2hlZHVsZXNSZXNwb25zZSIAEmwKEVBhdXNlQ3JvblNjaGVkdWxlEikub2xpdmV0aW4uYXBpLnYxLlBhdXNlQ3JvblNjaGVkdWxlUmVxdWVzdBoqLm9saXZldGluLmFwaS52MS5QYXVzZUNyb25TY2hlZHVsZVJlc3BvbnNlIgASbwoSUmVzdW1lQ3JvblNjaGVkdWxlEioub2xpdmV0aW4uYXBpLnYxLlJlc3VtZUNyb25TY2hlZHVsZVJlcXVlc3QaKy5vbGl2ZXRpbi5hcGkudjEuUmVzdW1lQ3JvblNjaGVkdWxlUmVzcG9uc2UiABJ1ChRMaXN0UGVuZGluZ0FwcHJvdmFscxIsLm9saXZldGluLmFwaS52MS5MaXN0UGVuZGluZ0FwcHJvdmFsc1JlcXVlc3QaLS5vbGl2ZXRpbi5hcGkudjEuTGlzdFBlbmRpbmdBcHByb3ZhbHNSZXNwb25zZSIAEmkKEEFwcHJvdmVFeGVjdXRpb24SKC5vbGl2ZXRpbi5hcGkudjEuQXBwcm92ZUV4ZWN1dGlvblJlcXVlc3QaKS5vbGl2ZXRpbi5hcGkudjEuQXBwcm92ZUV4ZWN1dGlvblJlc3BvbnNlIgASZgoPUmVqZWN0RXhlY3V0aW9uEicub2xpdmV0aW4uYXBpLnYxLlJlamVjdEV4ZWN1dGlvblJlcXVlc3QaKC5vbGl2ZXRpbi5hcGkudjEuUmVqZWN0RXhlY3V0aW9uUmVzcG9uc2UiABJ1ChRWYWxpZGF0ZUFyZ3VtZW50VHlwZRIsLm9saXZldGluLmFwaS52MS5WYWxpZGF0ZUFyZ3VtZW50VHlwZVJlcXVlc3QaLS5vbGl2ZXRpbi5hcGkudjEuVmFsaWRhdGVBcmd1bWVudFR5cGVSZXNwb25zZSIAEksKBldob0FtSRIeLm9saXZldGluLmFwaS52MS5XaG9BbUlSZXF1ZXN0Gh8ub2xpdmV0aW4uYXBpLnYxLldob0FtSVJlc3BvbnNlIgASbAoRU2VydmVyRGlhZ25vc3RpY3MSKS5vbGl2ZXRpbi5hcGkudjEuU2VydmVyRGlhZ25vc3RpY3NSZXF1ZXN0Gioub2xpdmV0aW4uYXBpLnYxLlNlcnZlckRpYWdub3N0aWNzUmVzcG9uc2UiABJRCghEdW1wVmFycxIgLm9saXZldGluLmFwaS52MS5EdW1wVmFyc1JlcXVlc3QaIS5vbGl2ZXRpbi5hcGkudjEuRHVtcFZhcnNSZXNwb25zZSIAEngKFUR1bXBQdWJsaWNJZEFjdGlvbk1hcBItLm9saXZldGluLmFwaS52MS5EdW1wUHVibGljSWRBY3Rpb25NYXBSZXF1ZXN0Gi4ub2xpdmV0aW4uYXBpLnYxLkR1bXBQdWJsaWNJZEFjdGlvbk1hcFJlc3BvbnNlIgASVAoJR2V0UmVhZHl6EiEub2xpdmV0aW4uYXBpLnYxLkdldFJlYWR5elJlcXVlc3QaIi5vbGl2ZXRpbi5hcGkudjEuR2V0UmVhZHl6UmVzcG9uc2UiABJjCg5Mb2NhbFVzZXJMb2dpbhImLm9saXZldGluLmFwaS52MS5Mb2NhbFVzZXJMb2dpblJlcXVlc3QaJy5vbGl2ZXRpbi5hcGkudjEuTG9jYWxVc2VyTG9naW5SZXNwb25zZSIAEm8KEkJlZ2luVG90cEVucm9sbWVudBIqLm9saXZldGluLmFwaS52MS5CZWdpblRvdHBFbnJvbG1lbnRSZXF1ZXN0Gisub2xpdmV0aW4uYXBpLnYxLkJlZ2luVG90cEVucm9sbWVudFJlc3BvbnNlIgASdQoUQ29uZmlybVRvdHBFbnJvbG1lbnQSLC5vbGl2ZXRpbi5hcGkudjEuQ29uZmlybVRvdHBFbnJvbG1lbnRSZXF1ZXN0Gi0ub2xpdmV0aW4uYXBpLnYxLkNvbmZpcm1Ub3RwRW5yb2xtZW50UmVzcG9uc2UiABJaCgtEaXNhYmxlVG90cBIjLm9saXZldGluLmFwaS52MS5EaXNhYmxlVG90cFJlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuRGlzYWJsZVRvdHBSZXNwb25zZSIAEl0KDFBhc3N3b3JkSGFzaBIkLm9saXZldGluLmFwaS52MS5QYXNzd29yZEhhc2hSZXF1ZXN0GiUub2xpdmV0aW4uYXBpLnYxLlBhc3N3b3JkSGFzaFJlc3BvbnNlIgASSwoGTG9nb3V0Eh4ub2xpdmV0aW4uYXBpLnYxLkxvZ291dFJlcXVlc3QaHy5vbGl2ZXRpbi5hcGkudjEuTG9nb3V0UmVzcG9uc2UiABJcCgtFdmVudFN0cmVhbRIjLm9saXZldGluLmFwaS52MS5FdmVudFN0cmVhbVJlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuRXZlbnRTdHJlYW1SZXNwb25zZSIAMAESYwoOR2V0RGlhZ25vc3RpY3MSJi5vbGl2ZXRpbi5hcGkudjEuR2V0RGlhZ25vc3RpY3NSZXF1ZXN0Gicub2xpdmV0aW4uYXBpLnYxLkdldERpYWdub3N0aWNzUmVzcG9uc2UiABJaCgtHZXRBdWRpdExvZxIjLm9saXZldGluLmFwaS52MS5HZXRBdWRpdExvZ1JlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuR2V0QXVkaXRMb2dSZXNwb25zZSIAEkUKBEluaXQSHC5vbGl2ZXRpbi5hcGkudjEuSW5pdFJlcXVlc3QaHS5vbGl2ZXRpbi5hcGkudjEuSW5pdFJlc3BvbnNlIgASaQoQR2V0QWN0aW9uQmluZGluZxIoLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25CaW5kaW5nUmVxdWVzdBopLm9saXZldGluLmFwaS52MS5HZXRBY3Rpb25CaW5kaW5nUmVzcG9uc2UiABJaCgtHZXRFbnRpdGllcxIjLm9saXZldGluLmFwaS52MS5HZXRFbnRpdGllc1JlcXVlc3QaJC5vbGl2ZXRpbi5hcGkudjEuR2V0RW50aXRpZXNSZXNwb25zZSIAEkkKCUdldEVudGl0eRIhLm9saXZldGluLmFwaS52MS5HZXRFbnRpdHlSZXF1ZXN0Ghcub2xpdmV0aW4uYXBpLnYxLkVudGl0eSIAQjhaNmdpdGh1Yi5jb20vT2xpdmVUaW4vT2xpdmVUaW4vZ2VuL29saXZldGluL2FwaS92MTthcGl2MWIGcHJvdG8z");
 
 /**
  * Describes the message olivetin.api.v1.Action.
@@ -31,530 +31,803 @@ export const ActionGroupMembershipSchema = /*@__PURE__*/
 export const ActionWebhookExecHintSchema = /*@__PURE__*/
   messageDesc(file_olivetin_api_v1_olivetin, 2);
 
+/**
+ * Describes the message olivetin.api.v1.ActionMqttExecHint.
+ * Use `create(ActionMqttExecHintSchema)` to create a new message.
+ */
+export const ActionMqttExecHintSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 3);
+
 /**
  * Describes the message olivetin.api.v1.ActionArgument.
  * Use `create(ActionArgumentSchema)` to create a new message.
  */
 export const ActionArgumentSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 3);
+  messageDesc(file_olivetin_api_v1_olivetin, 4);
 
 /**
  * Describes the message olivetin.api.v1.ActionArgumentChoice.
  * Use `create(ActionArgumentChoiceSchema)` to create a new message.
  */
 export const ActionArgumentChoiceSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 4);
+  messageDesc(file_olivetin_api_v1_olivetin, 5);
 
 /**
  * Describes the message olivetin.api.v1.EntityRelatedAction.
  * Use `create(EntityRelatedActionSchema)` to create a new message.
  */
 export const EntityRelatedActionSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 5);
+  messageDesc(file_olivetin_api_v1_olivetin, 6);
 
 /**
  * Describes the message olivetin.api.v1.Entity.
  * Use `create(EntitySchema)` to create a new message.
  */
 export const EntitySchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 6);
+  messageDesc(file_olivetin_api_v1_olivetin, 7);
 
 /**
  * Describes the message olivetin.api.v1.GetDashboardResponse.
  * Use `create(GetDashboardResponseSchema)` to create a new message.
  */
 export const GetDashboardResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 7);
+  messageDesc(file_olivetin_api_v1_olivetin, 8);
 
 /**
  * Describes the message olivetin.api.v1.EffectivePolicy.
  * Use `create(EffectivePolicySchema)` to create a new message.
  */
 export const EffectivePolicySchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 8);
+  messageDesc(file_olivetin_api_v1_olivetin, 9);
 
 /**
  * Describes the message olivetin.api.v1.GetDashboardRequest.
  * Use `create(GetDashboardRequestSchema)` to create a new message.
  */
 export const GetDashboardRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 9);
+  messageDesc(file_olivetin_api_v1_olivetin, 10);
 
 /**
  * Describes the message olivetin.api.v1.Dashboard.
  * Use `create(DashboardSchema)` to create a new message.
  */
 export const DashboardSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 10);
+  messageDesc(file_olivetin_api_v1_olivetin, 11);
 
 /**
  * Describes the message olivetin.api.v1.DashboardComponent.
  * Use `create(DashboardComponentSchema)` to create a new message.
  */
 export const DashboardComponentSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 11);
+  messageDesc(file_olivetin_api_v1_olivetin, 12);
 
 /**
  * Describes the message olivetin.api.v1.StartActionRequest.
  * Use `create(StartActionRequestSchema)` to create a new message.
  */
 export const StartActionRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 12);
+  messageDesc(file_olivetin_api_v1_olivetin, 13);
 
 /**
  * Describes the message olivetin.api.v1.StartActionArgument.
  * Use `create(StartActionArgumentSchema)` to create a new message.
  */
 export const StartActionArgumentSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 13);
+  messageDesc(file_olivetin_api_v1_olivetin, 14);
 
 /**
  * Describes the message olivetin.api.v1.StartActionResponse.
  * Use `create(StartActionResponseSchema)` to create a new message.
  */
 export const StartActionResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 14);
+  messageDesc(file_olivetin_api_v1_olivetin, 15);
 
 /**
  * Describes the message olivetin.api.v1.StartActionAndWaitRequest.
  * Use `create(StartActionAndWaitRequestSchema)` to create a new message.
  */
 export const StartActionAndWaitRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 15);
+  messageDesc(file_olivetin_api_v1_olivetin, 16);
 
 /**
  * Describes the message olivetin.api.v1.StartActionAndWaitResponse.
  * Use `create(StartActionAndWaitResponseSchema)` to create a new message.
  */
 export const StartActionAndWaitResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 16);
+  messageDesc(file_olivetin_api_v1_olivetin, 17);
 
 /**
  * Describes the message olivetin.api.v1.StartActionByGetRequest.
  * Use `create(StartActionByGetRequestSchema)` to create a new message.
  */
 export const StartActionByGetRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 17);
+  messageDesc(file_olivetin_api_v1_olivetin, 18);
 
 /**
  * Describes the message olivetin.api.v1.StartActionByGetResponse.
  * Use `create(StartActionByGetResponseSchema)` to create a new message.
  */
 export const StartActionByGetResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 18);
+  messageDesc(file_olivetin_api_v1_olivetin, 19);
 
 /**
  * Describes the message olivetin.api.v1.StartActionByGetAndWaitRequest.
  * Use `create(StartActionByGetAndWaitRequestSchema)` to create a new message.
  */
 export const StartActionByGetAndWaitRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 19);
+  messageDesc(file_olivetin_api_v1_olivetin, 20);
 
 /**
  * Describes the message olivetin.api.v1.StartActionByGetAndWaitResponse.
  * Use `create(StartActionByGetAndWaitResponseSchema)` to create a new message.
  */
 export const StartActionByGetAndWaitResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 20);
+  messageDesc(file_olivetin_api_v1_olivetin, 21);
 
 /**
  * Describes the message olivetin.api.v1.GetLogsRequest.
  * Use `create(GetLogsRequestSchema)` to create a new message.
  */
 export const GetLogsRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 21);
+  messageDesc(file_olivetin_api_v1_olivetin, 22);
 
 /**
  * Describes the message olivetin.api.v1.LogEntry.
  * Use `create(LogEntrySchema)` to create a new message.
  */
 export const LogEntrySchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 22);
+  messageDesc(file_olivetin_api_v1_olivetin, 23);
+
+/**
+ * Describes the message olivetin.api.v1.ExecutionAttempt.
+ * Use `create(ExecutionAttemptSchema)` to create a new message.
+ */
+export const ExecutionAttemptSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 24);
 
 /**
  * Describes the message olivetin.api.v1.GetLogsResponse.
  * Use `create(GetLogsResponseSchema)` to create a new message.
  */
 export const GetLogsResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 23);
+  messageDesc(file_olivetin_api_v1_olivetin, 25);
+
+/**
+ * Describes the message olivetin.api.v1.WorkflowRun.
+ * Use `create(WorkflowRunSchema)` to create a new message.
+ */
+export const WorkflowRunSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 26);
+
+/**
+ * Describes the message olivetin.api.v1.WorkflowStepRun.
+ * Use `create(WorkflowStepRunSchema)` to create a new message.
+ */
+export const WorkflowStepRunSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 27);
+
+/**
+ * Describes the message olivetin.api.v1.StartWorkflowRequest.
+ * Use `create(StartWorkflowRequestSchema)` to create a new message.
+ */
+export const StartWorkflowRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 28);
+
+/**
+ * Describes the message olivetin.api.v1.StartWorkflowResponse.
+ * Use `create(StartWorkflowResponseSchema)` to create a new message.
+ */
+export const StartWorkflowResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 29);
 
 /**
  * Describes the message olivetin.api.v1.GetActionLogsRequest.
  * Use `create(GetActionLogsRequestSchema)` to create a new message.
  */
 export const GetActionLogsRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 24);
+  messageDesc(file_olivetin_api_v1_olivetin, 30);
 
 /**
  * Describes the message olivetin.api.v1.GetActionLogsResponse.
  * Use `create(GetActionLogsResponseSchema)` to create a new message.
  */
 export const GetActionLogsResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 25);
+  messageDesc(file_olivetin_api_v1_olivetin, 31);
 
 /**
  * Describes the message olivetin.api.v1.GetExecutionQueueRequest.
  * Use `create(GetExecutionQueueRequestSchema)` to create a new message.
  */
 export const GetExecutionQueueRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 26);
+  messageDesc(file_olivetin_api_v1_olivetin, 32);
 
 /**
  * Describes the message olivetin.api.v1.ExecutionQueueAction.
  * Use `create(ExecutionQueueActionSchema)` to create a new message.
  */
 export const ExecutionQueueActionSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 27);
+  messageDesc(file_olivetin_api_v1_olivetin, 33);
 
 /**
  * Describes the message olivetin.api.v1.ExecutionQueueGroup.
  * Use `create(ExecutionQueueGroupSchema)` to create a new message.
  */
 export const ExecutionQueueGroupSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 28);
+  messageDesc(file_olivetin_api_v1_olivetin, 34);
 
 /**
  * Describes the message olivetin.api.v1.GetExecutionQueueResponse.
  * Use `create(GetExecutionQueueResponseSchema)` to create a new message.
  */
 export const GetExecutionQueueResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 29);
+  messageDesc(file_olivetin_api_v1_olivetin, 35);
+
+/**
+ * Describes the message olivetin.api.v1.ScheduledExecution.
+ * Use `create(ScheduledExecutionSchema)` to create a new message.
+ */
+export const ScheduledExecutionSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 36);
+
+/**
+ * Describes the message olivetin.api.v1.ScheduleActionRequest.
+ * Use `create(ScheduleActionRequestSchema)` to create a new message.
+ */
+export const ScheduleActionRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 37);
+
+/**
+ * Describes the message olivetin.api.v1.ScheduleActionResponse.
+ * Use `create(ScheduleActionResponseSchema)` to create a new message.
+ */
+export const ScheduleActionResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 38);
+
+/**
+ * Describes the message olivetin.api.v1.ListScheduledExecutionsRequest.
+ * Use `create(ListScheduledExecutionsRequestSchema)` to create a new message.
+ */
+export const ListScheduledExecutionsRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 39);
+
+/**
+ * Describes the message olivetin.api.v1.ListScheduledExecutionsResponse.
+ * Use `create(ListScheduledExecutionsResponseSchema)` to create a new message.
+ */
+export const ListScheduledExecutionsResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 40);
+
+/**
+ * Describes the message olivetin.api.v1.CancelScheduledExecutionRequest.
+ * Use `create(CancelScheduledExecutionRequestSchema)` to create a new message.
+ */
+export const CancelScheduledExecutionRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 41);
+
+/**
+ * Describes the message olivetin.api.v1.CancelScheduledExecutionResponse.
+ * Use `create(CancelScheduledExecutionResponseSchema)` to create a new message.
+ */
+export const CancelScheduledExecutionResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 42);
+
+/**
+ * Describes the message olivetin.api.v1.CronSchedule.
+ * Use `create(CronScheduleSchema)` to create a new message.
+ */
+export const CronScheduleSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 43);
+
+/**
+ * Describes the message olivetin.api.v1.ListCronSchedulesRequest.
+ * Use `create(ListCronSchedulesRequestSchema)` to create a new message.
+ */
+export const ListCronSchedulesRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 44);
+
+/**
+ * Describes the message olivetin.api.v1.ListCronSchedulesResponse.
+ * Use `create(ListCronSchedulesResponseSchema)` to create a new message.
+ */
+export const ListCronSchedulesResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 45);
+
+/**
+ * Describes the message olivetin.api.v1.PauseCronScheduleRequest.
+ * Use `create(PauseCronScheduleRequestSchema)` to create a new message.
+ */
+export const PauseCronScheduleRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 46);
+
+/**
+ * Describes the message olivetin.api.v1.PauseCronScheduleResponse.
+ * Use `create(PauseCronScheduleResponseSchema)` to create a new message.
+ */
+export const PauseCronScheduleResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 47);
+
+/**
+ * Describes the message olivetin.api.v1.ResumeCronScheduleRequest.
+ * Use `create(ResumeCronScheduleRequestSchema)` to create a new message.
+ */
+export const ResumeCronScheduleRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 48);
+
+/**
+ * Describes the message olivetin.api.v1.ResumeCronScheduleResponse.
+ * Use `create(ResumeCronScheduleResponseSchema)` to create a new message.
+ */
+export const ResumeCronScheduleResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 49);
 
 /**
  * Describes the message olivetin.api.v1.ValidateArgumentTypeRequest.
  * Use `create(ValidateArgumentTypeRequestSchema)` to create a new message.
  */
 export const ValidateArgumentTypeRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 30);
+  messageDesc(file_olivetin_api_v1_olivetin, 50);
 
 /**
  * Describes the message olivetin.api.v1.ValidateArgumentTypeResponse.
  * Use `create(ValidateArgumentTypeResponseSchema)` to create a new message.
  */
 export const ValidateArgumentTypeResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 31);
+  messageDesc(file_olivetin_api_v1_olivetin, 51);
 
 /**
  * Describes the message olivetin.api.v1.WatchExecutionRequest.
  * Use `create(WatchExecutionRequestSchema)` to create a new message.
  */
 export const WatchExecutionRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 32);
+  messageDesc(file_olivetin_api_v1_olivetin, 52);
 
 /**
  * Describes the message olivetin.api.v1.WatchExecutionUpdate.
  * Use `create(WatchExecutionUpdateSchema)` to create a new message.
  */
 export const WatchExecutionUpdateSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 33);
+  messageDesc(file_olivetin_api_v1_olivetin, 53);
 
 /**
  * Describes the message olivetin.api.v1.ExecutionStatusRequest.
  * Use `create(ExecutionStatusRequestSchema)` to create a new message.
  */
 export const ExecutionStatusRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 34);
+  messageDesc(file_olivetin_api_v1_olivetin, 54);
 
 /**
  * Describes the message olivetin.api.v1.DashboardNavigationTarget.
  * Use `create(DashboardNavigationTargetSchema)` to create a new message.
  */
 export const DashboardNavigationTargetSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 35);
+  messageDesc(file_olivetin_api_v1_olivetin, 55);
 
 /**
  * Describes the message olivetin.api.v1.ExecutionStatusResponse.
  * Use `create(ExecutionStatusResponseSchema)` to create a new message.
  */
 export const ExecutionStatusResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 36);
+  messageDesc(file_olivetin_api_v1_olivetin, 56);
 
 /**
  * Describes the message olivetin.api.v1.WhoAmIRequest.
  * Use `create(WhoAmIRequestSchema)` to create a new message.
  */
 export const WhoAmIRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 37);
+  messageDesc(file_olivetin_api_v1_olivetin, 57);
 
 /**
  * Describes the message olivetin.api.v1.WhoAmIResponse.
  * Use `create(WhoAmIResponseSchema)` to create a new message.
  */
 export const WhoAmIResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 38);
+  messageDesc(file_olivetin_api_v1_olivetin, 58);
 
 /**
  * Describes the message olivetin.api.v1.ServerDiagnosticsRequest.
  * Use `create(ServerDiagnosticsRequestSchema)` to create a new message.
  */
 export const ServerDiagnosticsRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 39);
+  messageDesc(file_olivetin_api_v1_olivetin, 59);
 
 /**
  * Describes the message olivetin.api.v1.ServerDiagnosticsResponse.
  * Use `create(ServerDiagnosticsResponseSchema)` to create a new message.
  */
 export const ServerDiagnosticsResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 40);
+  messageDesc(file_olivetin_api_v1_olivetin, 60);
 
 /**
  * Describes the message olivetin.api.v1.DumpVarsRequest.
  * Use `create(DumpVarsRequestSchema)` to create a new message.
  */
 export const DumpVarsRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 41);
+  messageDesc(file_olivetin_api_v1_olivetin, 61);
 
 /**
  * Describes the message olivetin.api.v1.DumpVarsResponse.
  * Use `create(DumpVarsResponseSchema)` to create a new message.
  */
 export const DumpVarsResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 42);
+  messageDesc(file_olivetin_api_v1_olivetin, 62);
 
 /**
  * Describes the message olivetin.api.v1.DebugBinding.
  * Use `create(DebugBindingSchema)` to create a new message.
  */
 export const DebugBindingSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 43);
+  messageDesc(file_olivetin_api_v1_olivetin, 63);
 
 /**
  * Describes the message olivetin.api.v1.DumpPublicIdActionMapRequest.
  * Use `create(DumpPublicIdActionMapRequestSchema)` to create a new message.
  */
 export const DumpPublicIdActionMapRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 44);
+  messageDesc(file_olivetin_api_v1_olivetin, 64);
 
 /**
  * Describes the message olivetin.api.v1.DumpPublicIdActionMapResponse.
  * Use `create(DumpPublicIdActionMapResponseSchema)` to create a new message.
  */
 export const DumpPublicIdActionMapResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 45);
+  messageDesc(file_olivetin_api_v1_olivetin, 65);
 
 /**
  * Describes the message olivetin.api.v1.GetReadyzRequest.
  * Use `create(GetReadyzRequestSchema)` to create a new message.
  */
 export const GetReadyzRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 46);
+  messageDesc(file_olivetin_api_v1_olivetin, 66);
 
 /**
  * Describes the message olivetin.api.v1.GetReadyzResponse.
  * Use `create(GetReadyzResponseSchema)` to create a new message.
  */
 export const GetReadyzResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 47);
+  messageDesc(file_olivetin_api_v1_olivetin, 67);
 
 /**
  * Describes the message olivetin.api.v1.EventStreamRequest.
  * Use `create(EventStreamRequestSchema)` to create a new message.
  */
 export const EventStreamRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 48);
+  messageDesc(file_olivetin_api_v1_olivetin, 68);
 
 /**
  * Describes the message olivetin.api.v1.EventStreamResponse.
  * Use `create(EventStreamResponseSchema)` to create a new message.
  */
 export const EventStreamResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 49);
+  messageDesc(file_olivetin_api_v1_olivetin, 69);
 
 /**
  * Describes the message olivetin.api.v1.EventOutputChunk.
  * Use `create(EventOutputChunkSchema)` to create a new message.
  */
 export const EventOutputChunkSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 50);
+  messageDesc(file_olivetin_api_v1_olivetin, 70);
 
 /**
  * Describes the message olivetin.api.v1.EventEntityChanged.
  * Use `create(EventEntityChangedSchema)` to create a new message.
  */
 export const EventEntityChangedSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 51);
+  messageDesc(file_olivetin_api_v1_olivetin, 71);
 
 /**
  * Describes the message olivetin.api.v1.EventConfigChanged.
  * Use `create(EventConfigChangedSchema)` to create a new message.
  */
 export const EventConfigChangedSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 52);
+  messageDesc(file_olivetin_api_v1_olivetin, 72);
 
 /**
  * Describes the message olivetin.api.v1.EventHeartbeat.
  * Use `create(EventHeartbeatSchema)` to create a new message.
  */
 export const EventHeartbeatSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 53);
+  messageDesc(file_olivetin_api_v1_olivetin, 73);
 
 /**
  * Describes the message olivetin.api.v1.EventExecutionFinished.
  * Use `create(EventExecutionFinishedSchema)` to create a new message.
  */
 export const EventExecutionFinishedSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 54);
+  messageDesc(file_olivetin_api_v1_olivetin, 74);
 
 /**
  * Describes the message olivetin.api.v1.EventExecutionStarted.
  * Use `create(EventExecutionStartedSchema)` to create a new message.
  */
 export const EventExecutionStartedSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 55);
+  messageDesc(file_olivetin_api_v1_olivetin, 75);
 
 /**
  * Describes the message olivetin.api.v1.KillActionRequest.
  * Use `create(KillActionRequestSchema)` to create a new message.
  */
 export const KillActionRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 56);
+  messageDesc(file_olivetin_api_v1_olivetin, 76);
 
 /**
  * Describes the message olivetin.api.v1.KillActionResponse.
  * Use `create(KillActionResponseSchema)` to create a new message.
  */
 export const KillActionResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 57);
+  messageDesc(file_olivetin_api_v1_olivetin, 77);
 
 /**
  * Describes the message olivetin.api.v1.LocalUserLoginRequest.
  * Use `create(LocalUserLoginRequestSchema)` to create a new message.
  */
 export const LocalUserLoginRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 58);
+  messageDesc(file_olivetin_api_v1_olivetin, 78);
 
 /**
  * Describes the message olivetin.api.v1.LocalUserLoginResponse.
  * Use `create(LocalUserLoginResponseSchema)` to create a new message.
  */
 export const LocalUserLoginResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 59);
+  messageDesc(file_olivetin_api_v1_olivetin, 79);
+
+/**
+ * Describes the message olivetin.api.v1.BeginTotpEnrolmentRequest.
+ * Use `create(BeginTotpEnrolmentRequestSchema)` to create a new message.
+ */
+export const BeginTotpEnrolmentRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 80);
+
+/**
+ * Describes the message olivetin.api.v1.BeginTotpEnrolmentResponse.
+ * Use `create(BeginTotpEnrolmentResponseSchema)` to create a new message.
+ */
+export const BeginTotpEnrolmentResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 81);
+
+/**
+ * Describes the message olivetin.api.v1.ConfirmTotpEnrolmentRequest.
+ * Use `create(ConfirmTotpEnrolmentRequestSchema)` to create a new message.
+ */
+export const ConfirmTotpEnrolmentRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 82);
+
+/**
+ * Describes the message olivetin.api.v1.ConfirmTotpEnrolmentResponse.
+ * Use `create(ConfirmTotpEnrolmentResponseSchema)` to create a new message.
+ */
+export const ConfirmTotpEnrolmentResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 83);
+
+/**
+ * Describes the message olivetin.api.v1.DisableTotpRequest.
+ * Use `create(DisableTotpRequestSchema)` to create a new message.
+ */
+export const DisableTotpRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 84);
+
+/**
+ * Describes the message olivetin.api.v1.DisableTotpResponse.
+ * Use `create(DisableTotpResponseSchema)` to create a new message.
+ */
+export const DisableTotpResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 85);
 
 /**
  * Describes the message olivetin.api.v1.PasswordHashRequest.
  * Use `create(PasswordHashRequestSchema)` to create a new message.
  */
 export const PasswordHashRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 60);
+  messageDesc(file_olivetin_api_v1_olivetin, 86);
 
 /**
  * Describes the message olivetin.api.v1.PasswordHashResponse.
  * Use `create(PasswordHashResponseSchema)` to create a new message.
  */
 export const PasswordHashResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 61);
+  messageDesc(file_olivetin_api_v1_olivetin, 87);
 
 /**
  * Describes the message olivetin.api.v1.LogoutRequest.
  * Use `create(LogoutRequestSchema)` to create a new message.
  */
 export const LogoutRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 62);
+  messageDesc(file_olivetin_api_v1_olivetin, 88);
 
 /**
  * Describes the message olivetin.api.v1.LogoutResponse.
  * Use `create(LogoutResponseSchema)` to create a new message.
  */
 export const LogoutResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 63);
+  messageDesc(file_olivetin_api_v1_olivetin, 89);
 
 /**
  * Describes the message olivetin.api.v1.GetDiagnosticsRequest.
  * Use `create(GetDiagnosticsRequestSchema)` to create a new message.
  */
 export const GetDiagnosticsRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 64);
+  messageDesc(file_olivetin_api_v1_olivetin, 90);
 
 /**
  * Describes the message olivetin.api.v1.GetDiagnosticsResponse.
  * Use `create(GetDiagnosticsResponseSchema)` to create a new message.
  */
 export const GetDiagnosticsResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 65);
+  messageDesc(file_olivetin_api_v1_olivetin, 91);
+
+/**
+ * Describes the message olivetin.api.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 92);
+
+/**
+ * Describes the message olivetin.api.v1.GetAuditLogRequest.
+ * Use `create(GetAuditLogRequestSchema)` to create a new message.
+ */
+export const GetAuditLogRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 93);
+
+/**
+ * Describes the message olivetin.api.v1.GetAuditLogResponse.
+ * Use `create(GetAuditLogResponseSchema)` to create a new message.
+ */
+export const GetAuditLogResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 94);
+
+/**
+ * Describes the message olivetin.api.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 95);
 
 /**
  * Describes the message olivetin.api.v1.InitRequest.
  * Use `create(InitRequestSchema)` to create a new message.
  */
 export const InitRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 66);
+  messageDesc(file_olivetin_api_v1_olivetin, 96);
 
 /**
  * Describes the message olivetin.api.v1.InitResponse.
  * Use `create(InitResponseSchema)` to create a new message.
  */
 export const InitResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 67);
+  messageDesc(file_olivetin_api_v1_olivetin, 97);
 
 /**
  * Describes the message olivetin.api.v1.AdditionalLink.
  * Use `create(AdditionalLinkSchema)` to create a new message.
  */
 export const AdditionalLinkSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 68);
+  messageDesc(file_olivetin_api_v1_olivetin, 98);
 
 /**
  * Describes the message olivetin.api.v1.OAuth2Provider.
  * Use `create(OAuth2ProviderSchema)` to create a new message.
  */
 export const OAuth2ProviderSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 69);
+  messageDesc(file_olivetin_api_v1_olivetin, 99);
 
 /**
  * Describes the message olivetin.api.v1.GetActionBindingRequest.
  * Use `create(GetActionBindingRequestSchema)` to create a new message.
  */
 export const GetActionBindingRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 70);
+  messageDesc(file_olivetin_api_v1_olivetin, 100);
 
 /**
  * Describes the message olivetin.api.v1.GetActionBindingResponse.
  * Use `create(GetActionBindingResponseSchema)` to create a new message.
  */
 export const GetActionBindingResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 71);
+  messageDesc(file_olivetin_api_v1_olivetin, 101);
 
 /**
  * Describes the message olivetin.api.v1.GetEntitiesRequest.
  * Use `create(GetEntitiesRequestSchema)` to create a new message.
  */
 export const GetEntitiesRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 72);
+  messageDesc(file_olivetin_api_v1_olivetin, 102);
 
 /**
  * Describes the message olivetin.api.v1.GetEntitiesResponse.
  * Use `create(GetEntitiesResponseSchema)` to create a new message.
  */
 export const GetEntitiesResponseSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 73);
+  messageDesc(file_olivetin_api_v1_olivetin, 103);
 
 /**
  * Describes the message olivetin.api.v1.EntityDefinition.
  * Use `create(EntityDefinitionSchema)` to create a new message.
  */
 export const EntityDefinitionSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 74);
+  messageDesc(file_olivetin_api_v1_olivetin, 104);
 
 /**
  * Describes the message olivetin.api.v1.EntityProperty.
  * Use `create(EntityPropertySchema)` to create a new message.
  */
 export const EntityPropertySchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 75);
+  messageDesc(file_olivetin_api_v1_olivetin, 105);
 
 /**
  * Describes the message olivetin.api.v1.GetEntityRequest.
  * Use `create(GetEntityRequestSchema)` to create a new message.
  */
 export const GetEntityRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 76);
+  messageDesc(file_olivetin_api_v1_olivetin, 106);
 
 /**
  * Describes the message olivetin.api.v1.RestartActionRequest.
  * Use `create(RestartActionRequestSchema)` to create a new message.
  */
 export const RestartActionRequestSchema = /*@__PURE__*/
-  messageDesc(file_olivetin_api_v1_olivetin, 77);
+  messageDesc(file_olivetin_api_v1_olivetin, 107);
+
+/**
+ * Describes the message olivetin.api.v1.PendingApproval.
+ * Use `create(PendingApprovalSchema)` to create a new message.
+ */
+export const PendingApprovalSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 108);
+
+/**
+ * Describes the message olivetin.api.v1.ListPendingApprovalsRequest.
+ * Use `create(ListPendingApprovalsRequestSchema)` to create a new message.
+ */
+export const ListPendingApprovalsRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 109);
+
+/**
+ * Describes the message olivetin.api.v1.ListPendingApprovalsResponse.
+ * Use `create(ListPendingApprovalsResponseSchema)` to create a new message.
+ */
+export const ListPendingApprovalsResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 110);
+
+/**
+ * Describes the message olivetin.api.v1.ApproveExecutionRequest.
+ * Use `create(ApproveExecutionRequestSchema)` to create a new message.
+ */
+export const ApproveExecutionRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 111);
+
+/**
+ * Describes the message olivetin.api.v1.ApproveExecutionResponse.
+ * Use `create(ApproveExecutionResponseSchema)` to create a new message.
+ */
+export const ApproveExecutionResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 112);
+
+/**
+ * Describes the message olivetin.api.v1.RejectExecutionRequest.
+ * Use `create(RejectExecutionRequestSchema)` to create a new message.
+ */
+export const RejectExecutionRequestSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 113);
+
+/**
+ * Describes the message olivetin.api.v1.RejectExecutionResponse.
+ * Use `create(RejectExecutionResponseSchema)` to create a new message.
+ */
+export const RejectExecutionResponseSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 114);
+
+/**
+ * Describes the message olivetin.api.v1.EventApprovalRequested.
+ * Use `create(EventApprovalRequestedSchema)` to create a new message.
+ */
+export const EventApprovalRequestedSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 115);
+
+/**
+ * Describes the message olivetin.api.v1.EventApprovalResolved.
+ * Use `create(EventApprovalResolvedSchema)` to create a new message.
+ */
+export const EventApprovalResolvedSchema = /*@__PURE__*/
+  messageDesc(file_olivetin_api_v1_olivetin, 116);
 
 /**
  * @generated from service olivetin.api.v1.OliveTinApiService
@@ -58,6 +58,18 @@ const routes = [
       ]
     }
   },
+  {
+    path: '/logs/schedules',
+    name: 'LogsSchedules',
+    component: () => import('./views/LogsSchedulesView.vue'),
+    meta: {
+      title: 'Cron Schedules',
+      breadcrumb: [
+        { name: 'Logs', href: '/logs' },
+        { name: 'Schedules' }
+      ]
+    }
+  },
   {
     path: '/entities',
     name: 'Entities',
@@ -27,6 +27,9 @@ export function countExecutionConditions (action) {
   if (nonEmptyList(action.execOnWebhooks)) {
     count++
   }
+  if (nonEmptyList(action.execOnMqtt)) {
+    count++
+  }
 
   return count
 }
@@ -0,0 +1,53 @@
+package main
+
+/*
+olivetin-agent runs actions on behalf of an OliveTin server. It dials in to the
+server, so the host it runs on does not need to accept any connections.
+*/
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/OliveTin/OliveTin/internal/agents"
+	log "github.com/sirupsen/logrus"
+)
+
+var version = "dev"
+
+func main() {
+	hostname, _ := os.Hostname()
+
+	serverURL := flag.String("server", "http://localhost:1337", "URL of the OliveTin server")
+	name := flag.String("name", hostname, "Name of this agent, used by runOn in actions")
+	flag.Parse()
+
+	log.SetFormatter(&log.TextFormatter{
+		ForceQuote:       true,
+		DisableTimestamp: true,
+	})
+
+	token := os.Getenv("OLIVETIN_AGENT_TOKEN")
+
+	if token == "" {
+		log.Fatal("OLIVETIN_AGENT_TOKEN must be set to the agents token in the server config")
+	}
+
+	log.WithFields(log.Fields{
+		"server":  *serverURL,
+		"name":    *name,
+		"version": version,
+	}).Info("OliveTin agent started")
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	client := agents.NewClient(http.DefaultClient, *serverURL, token, *name)
+	client.Hostname = hostname
+	client.Version = version
+	client.Run(ctx)
+}
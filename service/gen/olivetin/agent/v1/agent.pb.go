@@ -0,0 +1,476 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: olivetin/agent/v1/agent.proto
+
+package agentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_agent_v1_agent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConnectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type AgentJob struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	Kill                bool                   `protobuf:"varint,2,opt,name=kill,proto3" json:"kill,omitempty"`
+	Shell               string                 `protobuf:"bytes,3,opt,name=shell,proto3" json:"shell,omitempty"`
+	Exec                []string               `protobuf:"bytes,4,rep,name=exec,proto3" json:"exec,omitempty"`
+	Env                 []string               `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`          // KEY=value pairs, added to the agent's own environment.
+	Timeout             int32                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"` // Seconds
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AgentJob) Reset() {
+	*x = AgentJob{}
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentJob) ProtoMessage() {}
+
+func (x *AgentJob) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentJob.ProtoReflect.Descriptor instead.
+func (*AgentJob) Descriptor() ([]byte, []int) {
+	return file_olivetin_agent_v1_agent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AgentJob) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+func (x *AgentJob) GetKill() bool {
+	if x != nil {
+		return x.Kill
+	}
+	return false
+}
+
+func (x *AgentJob) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *AgentJob) GetExec() []string {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *AgentJob) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *AgentJob) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type SendOutputRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExecutionTrackingId string                 `protobuf:"bytes,2,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	Output              string                 `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	Stream              string                 `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"` // "stdout" or "stderr"
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SendOutputRequest) Reset() {
+	*x = SendOutputRequest{}
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendOutputRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOutputRequest) ProtoMessage() {}
+
+func (x *SendOutputRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOutputRequest.ProtoReflect.Descriptor instead.
+func (*SendOutputRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_agent_v1_agent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendOutputRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SendOutputRequest) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+func (x *SendOutputRequest) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *SendOutputRequest) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+type SendOutputResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendOutputResponse) Reset() {
+	*x = SendOutputResponse{}
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendOutputResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendOutputResponse) ProtoMessage() {}
+
+func (x *SendOutputResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendOutputResponse.ProtoReflect.Descriptor instead.
+func (*SendOutputResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_agent_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+type FinishJobRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExecutionTrackingId string                 `protobuf:"bytes,2,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	ExitCode            int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	TimedOut            bool                   `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Error               string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FinishJobRequest) Reset() {
+	*x = FinishJobRequest{}
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishJobRequest) ProtoMessage() {}
+
+func (x *FinishJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishJobRequest.ProtoReflect.Descriptor instead.
+func (*FinishJobRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_agent_v1_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FinishJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FinishJobRequest) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+func (x *FinishJobRequest) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *FinishJobRequest) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *FinishJobRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FinishJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishJobResponse) Reset() {
+	*x = FinishJobResponse{}
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishJobResponse) ProtoMessage() {}
+
+func (x *FinishJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_agent_v1_agent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishJobResponse.ProtoReflect.Descriptor instead.
+func (*FinishJobResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_agent_v1_agent_proto_rawDescGZIP(), []int{5}
+}
+
+var File_olivetin_agent_v1_agent_proto protoreflect.FileDescriptor
+
+const file_olivetin_agent_v1_agent_proto_rawDesc = "" +
+	"\n" +
+	"\x1dolivetin/agent/v1/agent.proto\x12\x11olivetin.agent.v1\"Z\n" +
+	"\x0eConnectRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"\xa8\x01\n" +
+	"\bAgentJob\x122\n" +
+	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\x12\x12\n" +
+	"\x04kill\x18\x02 \x01(\bR\x04kill\x12\x14\n" +
+	"\x05shell\x18\x03 \x01(\tR\x05shell\x12\x12\n" +
+	"\x04exec\x18\x04 \x03(\tR\x04exec\x12\x10\n" +
+	"\x03env\x18\x05 \x03(\tR\x03env\x12\x18\n" +
+	"\atimeout\x18\x06 \x01(\x05R\atimeout\"\x8b\x01\n" +
+	"\x11SendOutputRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x15execution_tracking_id\x18\x02 \x01(\tR\x13executionTrackingId\x12\x16\n" +
+	"\x06output\x18\x03 \x01(\tR\x06output\x12\x16\n" +
+	"\x06stream\x18\x04 \x01(\tR\x06stream\"\x14\n" +
+	"\x12SendOutputResponse\"\xaa\x01\n" +
+	"\x10FinishJobRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x15execution_tracking_id\x18\x02 \x01(\tR\x13executionTrackingId\x12\x1b\n" +
+	"\texit_code\x18\x03 \x01(\x05R\bexitCode\x12\x1b\n" +
+	"\ttimed_out\x18\x04 \x01(\bR\btimedOut\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x13\n" +
+	"\x11FinishJobResponse2\x94\x02\n" +
+	"\fAgentService\x12M\n" +
+	"\aConnect\x12!.olivetin.agent.v1.ConnectRequest\x1a\x1b.olivetin.agent.v1.AgentJob\"\x000\x01\x12[\n" +
+	"\n" +
+	"SendOutput\x12$.olivetin.agent.v1.SendOutputRequest\x1a%.olivetin.agent.v1.SendOutputResponse\"\x00\x12X\n" +
+	"\tFinishJob\x12#.olivetin.agent.v1.FinishJobRequest\x1a$.olivetin.agent.v1.FinishJobResponse\"\x00B<Z:github.com/OliveTin/OliveTin/gen/olivetin/agent/v1;agentv1b\x06proto3"
+
+var (
+	file_olivetin_agent_v1_agent_proto_rawDescOnce sync.Once
+	file_olivetin_agent_v1_agent_proto_rawDescData []byte
+)
+
+func file_olivetin_agent_v1_agent_proto_rawDescGZIP() []byte {
+	file_olivetin_agent_v1_agent_proto_rawDescOnce.Do(func() {
+		file_olivetin_agent_v1_agent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_olivetin_agent_v1_agent_proto_rawDesc), len(file_olivetin_agent_v1_agent_proto_rawDesc)))
+	})
+	return file_olivetin_agent_v1_agent_proto_rawDescData
+}
+
+var file_olivetin_agent_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_olivetin_agent_v1_agent_proto_goTypes = []any{
+	(*ConnectRequest)(nil),     // 0: olivetin.agent.v1.ConnectRequest
+	(*AgentJob)(nil),           // 1: olivetin.agent.v1.AgentJob
+	(*SendOutputRequest)(nil),  // 2: olivetin.agent.v1.SendOutputRequest
+	(*SendOutputResponse)(nil), // 3: olivetin.agent.v1.SendOutputResponse
+	(*FinishJobRequest)(nil),   // 4: olivetin.agent.v1.FinishJobRequest
+	(*FinishJobResponse)(nil),  // 5: olivetin.agent.v1.FinishJobResponse
+}
+var file_olivetin_agent_v1_agent_proto_depIdxs = []int32{
+	0, // 0: olivetin.agent.v1.AgentService.Connect:input_type -> olivetin.agent.v1.ConnectRequest
+	2, // 1: olivetin.agent.v1.AgentService.SendOutput:input_type -> olivetin.agent.v1.SendOutputRequest
+	4, // 2: olivetin.agent.v1.AgentService.FinishJob:input_type -> olivetin.agent.v1.FinishJobRequest
+	1, // 3: olivetin.agent.v1.AgentService.Connect:output_type -> olivetin.agent.v1.AgentJob
+	3, // 4: olivetin.agent.v1.AgentService.SendOutput:output_type -> olivetin.agent.v1.SendOutputResponse
+	5, // 5: olivetin.agent.v1.AgentService.FinishJob:output_type -> olivetin.agent.v1.FinishJobResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_olivetin_agent_v1_agent_proto_init() }
+func file_olivetin_agent_v1_agent_proto_init() {
+	if File_olivetin_agent_v1_agent_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_olivetin_agent_v1_agent_proto_rawDesc), len(file_olivetin_agent_v1_agent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_olivetin_agent_v1_agent_proto_goTypes,
+		DependencyIndexes: file_olivetin_agent_v1_agent_proto_depIdxs,
+		MessageInfos:      file_olivetin_agent_v1_agent_proto_msgTypes,
+	}.Build()
+	File_olivetin_agent_v1_agent_proto = out.File
+	file_olivetin_agent_v1_agent_proto_goTypes = nil
+	file_olivetin_agent_v1_agent_proto_depIdxs = nil
+}
@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: olivetin/agent/v1/agent.proto
+
+package agentv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/OliveTin/OliveTin/gen/olivetin/agent/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AgentServiceName is the fully-qualified name of the AgentService service.
+	AgentServiceName = "olivetin.agent.v1.AgentService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AgentServiceConnectProcedure is the fully-qualified name of the AgentService's Connect RPC.
+	AgentServiceConnectProcedure = "/olivetin.agent.v1.AgentService/Connect"
+	// AgentServiceSendOutputProcedure is the fully-qualified name of the AgentService's SendOutput RPC.
+	AgentServiceSendOutputProcedure = "/olivetin.agent.v1.AgentService/SendOutput"
+	// AgentServiceFinishJobProcedure is the fully-qualified name of the AgentService's FinishJob RPC.
+	AgentServiceFinishJobProcedure = "/olivetin.agent.v1.AgentService/FinishJob"
+)
+
+// AgentServiceClient is a client for the olivetin.agent.v1.AgentService service.
+type AgentServiceClient interface {
+	Connect(context.Context, *connect.Request[v1.ConnectRequest]) (*connect.ServerStreamForClient[v1.AgentJob], error)
+	SendOutput(context.Context, *connect.Request[v1.SendOutputRequest]) (*connect.Response[v1.SendOutputResponse], error)
+	FinishJob(context.Context, *connect.Request[v1.FinishJobRequest]) (*connect.Response[v1.FinishJobResponse], error)
+}
+
+// NewAgentServiceClient constructs a client for the olivetin.agent.v1.AgentService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAgentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AgentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	agentServiceMethods := v1.File_olivetin_agent_v1_agent_proto.Services().ByName("AgentService").Methods()
+	return &agentServiceClient{
+		connect: connect.NewClient[v1.ConnectRequest, v1.AgentJob](
+			httpClient,
+			baseURL+AgentServiceConnectProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("Connect")),
+			connect.WithClientOptions(opts...),
+		),
+		sendOutput: connect.NewClient[v1.SendOutputRequest, v1.SendOutputResponse](
+			httpClient,
+			baseURL+AgentServiceSendOutputProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("SendOutput")),
+			connect.WithClientOptions(opts...),
+		),
+		finishJob: connect.NewClient[v1.FinishJobRequest, v1.FinishJobResponse](
+			httpClient,
+			baseURL+AgentServiceFinishJobProcedure,
+			connect.WithSchema(agentServiceMethods.ByName("FinishJob")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// agentServiceClient implements AgentServiceClient.
+type agentServiceClient struct {
+	connect    *connect.Client[v1.ConnectRequest, v1.AgentJob]
+	sendOutput *connect.Client[v1.SendOutputRequest, v1.SendOutputResponse]
+	finishJob  *connect.Client[v1.FinishJobRequest, v1.FinishJobResponse]
+}
+
+// Connect calls olivetin.agent.v1.AgentService.Connect.
+func (c *agentServiceClient) Connect(ctx context.Context, req *connect.Request[v1.ConnectRequest]) (*connect.ServerStreamForClient[v1.AgentJob], error) {
+	return c.connect.CallServerStream(ctx, req)
+}
+
+// SendOutput calls olivetin.agent.v1.AgentService.SendOutput.
+func (c *agentServiceClient) SendOutput(ctx context.Context, req *connect.Request[v1.SendOutputRequest]) (*connect.Response[v1.SendOutputResponse], error) {
+	return c.sendOutput.CallUnary(ctx, req)
+}
+
+// FinishJob calls olivetin.agent.v1.AgentService.FinishJob.
+func (c *agentServiceClient) FinishJob(ctx context.Context, req *connect.Request[v1.FinishJobRequest]) (*connect.Response[v1.FinishJobResponse], error) {
+	return c.finishJob.CallUnary(ctx, req)
+}
+
+// AgentServiceHandler is an implementation of the olivetin.agent.v1.AgentService service.
+type AgentServiceHandler interface {
+	Connect(context.Context, *connect.Request[v1.ConnectRequest], *connect.ServerStream[v1.AgentJob]) error
+	SendOutput(context.Context, *connect.Request[v1.SendOutputRequest]) (*connect.Response[v1.SendOutputResponse], error)
+	FinishJob(context.Context, *connect.Request[v1.FinishJobRequest]) (*connect.Response[v1.FinishJobResponse], error)
+}
+
+// NewAgentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAgentServiceHandler(svc AgentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	agentServiceMethods := v1.File_olivetin_agent_v1_agent_proto.Services().ByName("AgentService").Methods()
+	agentServiceConnectHandler := connect.NewServerStreamHandler(
+		AgentServiceConnectProcedure,
+		svc.Connect,
+		connect.WithSchema(agentServiceMethods.ByName("Connect")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentServiceSendOutputHandler := connect.NewUnaryHandler(
+		AgentServiceSendOutputProcedure,
+		svc.SendOutput,
+		connect.WithSchema(agentServiceMethods.ByName("SendOutput")),
+		connect.WithHandlerOptions(opts...),
+	)
+	agentServiceFinishJobHandler := connect.NewUnaryHandler(
+		AgentServiceFinishJobProcedure,
+		svc.FinishJob,
+		connect.WithSchema(agentServiceMethods.ByName("FinishJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/olivetin.agent.v1.AgentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AgentServiceConnectProcedure:
+			agentServiceConnectHandler.ServeHTTP(w, r)
+		case AgentServiceSendOutputProcedure:
+			agentServiceSendOutputHandler.ServeHTTP(w, r)
+		case AgentServiceFinishJobProcedure:
+			agentServiceFinishJobHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAgentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAgentServiceHandler struct{}
+
+func (UnimplementedAgentServiceHandler) Connect(context.Context, *connect.Request[v1.ConnectRequest], *connect.ServerStream[v1.AgentJob]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.agent.v1.AgentService.Connect is not implemented"))
+}
+
+func (UnimplementedAgentServiceHandler) SendOutput(context.Context, *connect.Request[v1.SendOutputRequest]) (*connect.Response[v1.SendOutputResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.agent.v1.AgentService.SendOutput is not implemented"))
+}
+
+func (UnimplementedAgentServiceHandler) FinishJob(context.Context, *connect.Request[v1.FinishJobRequest]) (*connect.Response[v1.FinishJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.agent.v1.AgentService.FinishJob is not implemented"))
+}
@@ -60,12 +60,42 @@ const (
 	// OliveTinApiServiceGetLogsProcedure is the fully-qualified name of the OliveTinApiService's
 	// GetLogs RPC.
 	OliveTinApiServiceGetLogsProcedure = "/olivetin.api.v1.OliveTinApiService/GetLogs"
+	// OliveTinApiServiceStartWorkflowProcedure is the fully-qualified name of the OliveTinApiService's
+	// StartWorkflow RPC.
+	OliveTinApiServiceStartWorkflowProcedure = "/olivetin.api.v1.OliveTinApiService/StartWorkflow"
 	// OliveTinApiServiceGetActionLogsProcedure is the fully-qualified name of the OliveTinApiService's
 	// GetActionLogs RPC.
 	OliveTinApiServiceGetActionLogsProcedure = "/olivetin.api.v1.OliveTinApiService/GetActionLogs"
 	// OliveTinApiServiceGetExecutionQueueProcedure is the fully-qualified name of the
 	// OliveTinApiService's GetExecutionQueue RPC.
 	OliveTinApiServiceGetExecutionQueueProcedure = "/olivetin.api.v1.OliveTinApiService/GetExecutionQueue"
+	// OliveTinApiServiceScheduleActionProcedure is the fully-qualified name of the OliveTinApiService's
+	// ScheduleAction RPC.
+	OliveTinApiServiceScheduleActionProcedure = "/olivetin.api.v1.OliveTinApiService/ScheduleAction"
+	// OliveTinApiServiceListScheduledExecutionsProcedure is the fully-qualified name of the
+	// OliveTinApiService's ListScheduledExecutions RPC.
+	OliveTinApiServiceListScheduledExecutionsProcedure = "/olivetin.api.v1.OliveTinApiService/ListScheduledExecutions"
+	// OliveTinApiServiceCancelScheduledExecutionProcedure is the fully-qualified name of the
+	// OliveTinApiService's CancelScheduledExecution RPC.
+	OliveTinApiServiceCancelScheduledExecutionProcedure = "/olivetin.api.v1.OliveTinApiService/CancelScheduledExecution"
+	// OliveTinApiServiceListCronSchedulesProcedure is the fully-qualified name of the
+	// OliveTinApiService's ListCronSchedules RPC.
+	OliveTinApiServiceListCronSchedulesProcedure = "/olivetin.api.v1.OliveTinApiService/ListCronSchedules"
+	// OliveTinApiServicePauseCronScheduleProcedure is the fully-qualified name of the
+	// OliveTinApiService's PauseCronSchedule RPC.
+	OliveTinApiServicePauseCronScheduleProcedure = "/olivetin.api.v1.OliveTinApiService/PauseCronSchedule"
+	// OliveTinApiServiceResumeCronScheduleProcedure is the fully-qualified name of the
+	// OliveTinApiService's ResumeCronSchedule RPC.
+	OliveTinApiServiceResumeCronScheduleProcedure = "/olivetin.api.v1.OliveTinApiService/ResumeCronSchedule"
+	// OliveTinApiServiceListPendingApprovalsProcedure is the fully-qualified name of the
+	// OliveTinApiService's ListPendingApprovals RPC.
+	OliveTinApiServiceListPendingApprovalsProcedure = "/olivetin.api.v1.OliveTinApiService/ListPendingApprovals"
+	// OliveTinApiServiceApproveExecutionProcedure is the fully-qualified name of the
+	// OliveTinApiService's ApproveExecution RPC.
+	OliveTinApiServiceApproveExecutionProcedure = "/olivetin.api.v1.OliveTinApiService/ApproveExecution"
+	// OliveTinApiServiceRejectExecutionProcedure is the fully-qualified name of the
+	// OliveTinApiService's RejectExecution RPC.
+	OliveTinApiServiceRejectExecutionProcedure = "/olivetin.api.v1.OliveTinApiService/RejectExecution"
 	// OliveTinApiServiceValidateArgumentTypeProcedure is the fully-qualified name of the
 	// OliveTinApiService's ValidateArgumentType RPC.
 	OliveTinApiServiceValidateArgumentTypeProcedure = "/olivetin.api.v1.OliveTinApiService/ValidateArgumentType"
@@ -87,6 +117,15 @@ const (
 	// OliveTinApiServiceLocalUserLoginProcedure is the fully-qualified name of the OliveTinApiService's
 	// LocalUserLogin RPC.
 	OliveTinApiServiceLocalUserLoginProcedure = "/olivetin.api.v1.OliveTinApiService/LocalUserLogin"
+	// OliveTinApiServiceBeginTotpEnrolmentProcedure is the fully-qualified name of the
+	// OliveTinApiService's BeginTotpEnrolment RPC.
+	OliveTinApiServiceBeginTotpEnrolmentProcedure = "/olivetin.api.v1.OliveTinApiService/BeginTotpEnrolment"
+	// OliveTinApiServiceConfirmTotpEnrolmentProcedure is the fully-qualified name of the
+	// OliveTinApiService's ConfirmTotpEnrolment RPC.
+	OliveTinApiServiceConfirmTotpEnrolmentProcedure = "/olivetin.api.v1.OliveTinApiService/ConfirmTotpEnrolment"
+	// OliveTinApiServiceDisableTotpProcedure is the fully-qualified name of the OliveTinApiService's
+	// DisableTotp RPC.
+	OliveTinApiServiceDisableTotpProcedure = "/olivetin.api.v1.OliveTinApiService/DisableTotp"
 	// OliveTinApiServicePasswordHashProcedure is the fully-qualified name of the OliveTinApiService's
 	// PasswordHash RPC.
 	OliveTinApiServicePasswordHashProcedure = "/olivetin.api.v1.OliveTinApiService/PasswordHash"
@@ -99,6 +138,9 @@ const (
 	// OliveTinApiServiceGetDiagnosticsProcedure is the fully-qualified name of the OliveTinApiService's
 	// GetDiagnostics RPC.
 	OliveTinApiServiceGetDiagnosticsProcedure = "/olivetin.api.v1.OliveTinApiService/GetDiagnostics"
+	// OliveTinApiServiceGetAuditLogProcedure is the fully-qualified name of the OliveTinApiService's
+	// GetAuditLog RPC.
+	OliveTinApiServiceGetAuditLogProcedure = "/olivetin.api.v1.OliveTinApiService/GetAuditLog"
 	// OliveTinApiServiceInitProcedure is the fully-qualified name of the OliveTinApiService's Init RPC.
 	OliveTinApiServiceInitProcedure = "/olivetin.api.v1.OliveTinApiService/Init"
 	// OliveTinApiServiceGetActionBindingProcedure is the fully-qualified name of the
@@ -123,8 +165,18 @@ type OliveTinApiServiceClient interface {
 	KillAction(context.Context, *connect.Request[v1.KillActionRequest]) (*connect.Response[v1.KillActionResponse], error)
 	ExecutionStatus(context.Context, *connect.Request[v1.ExecutionStatusRequest]) (*connect.Response[v1.ExecutionStatusResponse], error)
 	GetLogs(context.Context, *connect.Request[v1.GetLogsRequest]) (*connect.Response[v1.GetLogsResponse], error)
+	StartWorkflow(context.Context, *connect.Request[v1.StartWorkflowRequest]) (*connect.Response[v1.StartWorkflowResponse], error)
 	GetActionLogs(context.Context, *connect.Request[v1.GetActionLogsRequest]) (*connect.Response[v1.GetActionLogsResponse], error)
 	GetExecutionQueue(context.Context, *connect.Request[v1.GetExecutionQueueRequest]) (*connect.Response[v1.GetExecutionQueueResponse], error)
+	ScheduleAction(context.Context, *connect.Request[v1.ScheduleActionRequest]) (*connect.Response[v1.ScheduleActionResponse], error)
+	ListScheduledExecutions(context.Context, *connect.Request[v1.ListScheduledExecutionsRequest]) (*connect.Response[v1.ListScheduledExecutionsResponse], error)
+	CancelScheduledExecution(context.Context, *connect.Request[v1.CancelScheduledExecutionRequest]) (*connect.Response[v1.CancelScheduledExecutionResponse], error)
+	ListCronSchedules(context.Context, *connect.Request[v1.ListCronSchedulesRequest]) (*connect.Response[v1.ListCronSchedulesResponse], error)
+	PauseCronSchedule(context.Context, *connect.Request[v1.PauseCronScheduleRequest]) (*connect.Response[v1.PauseCronScheduleResponse], error)
+	ResumeCronSchedule(context.Context, *connect.Request[v1.ResumeCronScheduleRequest]) (*connect.Response[v1.ResumeCronScheduleResponse], error)
+	ListPendingApprovals(context.Context, *connect.Request[v1.ListPendingApprovalsRequest]) (*connect.Response[v1.ListPendingApprovalsResponse], error)
+	ApproveExecution(context.Context, *connect.Request[v1.ApproveExecutionRequest]) (*connect.Response[v1.ApproveExecutionResponse], error)
+	RejectExecution(context.Context, *connect.Request[v1.RejectExecutionRequest]) (*connect.Response[v1.RejectExecutionResponse], error)
 	ValidateArgumentType(context.Context, *connect.Request[v1.ValidateArgumentTypeRequest]) (*connect.Response[v1.ValidateArgumentTypeResponse], error)
 	WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error)
 	ServerDiagnostics(context.Context, *connect.Request[v1.ServerDiagnosticsRequest]) (*connect.Response[v1.ServerDiagnosticsResponse], error)
@@ -132,10 +184,14 @@ type OliveTinApiServiceClient interface {
 	DumpPublicIdActionMap(context.Context, *connect.Request[v1.DumpPublicIdActionMapRequest]) (*connect.Response[v1.DumpPublicIdActionMapResponse], error)
 	GetReadyz(context.Context, *connect.Request[v1.GetReadyzRequest]) (*connect.Response[v1.GetReadyzResponse], error)
 	LocalUserLogin(context.Context, *connect.Request[v1.LocalUserLoginRequest]) (*connect.Response[v1.LocalUserLoginResponse], error)
+	BeginTotpEnrolment(context.Context, *connect.Request[v1.BeginTotpEnrolmentRequest]) (*connect.Response[v1.BeginTotpEnrolmentResponse], error)
+	ConfirmTotpEnrolment(context.Context, *connect.Request[v1.ConfirmTotpEnrolmentRequest]) (*connect.Response[v1.ConfirmTotpEnrolmentResponse], error)
+	DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error)
 	PasswordHash(context.Context, *connect.Request[v1.PasswordHashRequest]) (*connect.Response[v1.PasswordHashResponse], error)
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
 	EventStream(context.Context, *connect.Request[v1.EventStreamRequest]) (*connect.ServerStreamForClient[v1.EventStreamResponse], error)
 	GetDiagnostics(context.Context, *connect.Request[v1.GetDiagnosticsRequest]) (*connect.Response[v1.GetDiagnosticsResponse], error)
+	GetAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.GetAuditLogResponse], error)
 	Init(context.Context, *connect.Request[v1.InitRequest]) (*connect.Response[v1.InitResponse], error)
 	GetActionBinding(context.Context, *connect.Request[v1.GetActionBindingRequest]) (*connect.Response[v1.GetActionBindingResponse], error)
 	GetEntities(context.Context, *connect.Request[v1.GetEntitiesRequest]) (*connect.Response[v1.GetEntitiesResponse], error)
@@ -207,6 +263,12 @@ func NewOliveTinApiServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(oliveTinApiServiceMethods.ByName("GetLogs")),
 			connect.WithClientOptions(opts...),
 		),
+		startWorkflow: connect.NewClient[v1.StartWorkflowRequest, v1.StartWorkflowResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceStartWorkflowProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("StartWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
 		getActionLogs: connect.NewClient[v1.GetActionLogsRequest, v1.GetActionLogsResponse](
 			httpClient,
 			baseURL+OliveTinApiServiceGetActionLogsProcedure,
@@ -219,6 +281,60 @@ func NewOliveTinApiServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(oliveTinApiServiceMethods.ByName("GetExecutionQueue")),
 			connect.WithClientOptions(opts...),
 		),
+		scheduleAction: connect.NewClient[v1.ScheduleActionRequest, v1.ScheduleActionResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceScheduleActionProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ScheduleAction")),
+			connect.WithClientOptions(opts...),
+		),
+		listScheduledExecutions: connect.NewClient[v1.ListScheduledExecutionsRequest, v1.ListScheduledExecutionsResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceListScheduledExecutionsProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ListScheduledExecutions")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelScheduledExecution: connect.NewClient[v1.CancelScheduledExecutionRequest, v1.CancelScheduledExecutionResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceCancelScheduledExecutionProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("CancelScheduledExecution")),
+			connect.WithClientOptions(opts...),
+		),
+		listCronSchedules: connect.NewClient[v1.ListCronSchedulesRequest, v1.ListCronSchedulesResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceListCronSchedulesProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ListCronSchedules")),
+			connect.WithClientOptions(opts...),
+		),
+		pauseCronSchedule: connect.NewClient[v1.PauseCronScheduleRequest, v1.PauseCronScheduleResponse](
+			httpClient,
+			baseURL+OliveTinApiServicePauseCronScheduleProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("PauseCronSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeCronSchedule: connect.NewClient[v1.ResumeCronScheduleRequest, v1.ResumeCronScheduleResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceResumeCronScheduleProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ResumeCronSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		listPendingApprovals: connect.NewClient[v1.ListPendingApprovalsRequest, v1.ListPendingApprovalsResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceListPendingApprovalsProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ListPendingApprovals")),
+			connect.WithClientOptions(opts...),
+		),
+		approveExecution: connect.NewClient[v1.ApproveExecutionRequest, v1.ApproveExecutionResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceApproveExecutionProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ApproveExecution")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectExecution: connect.NewClient[v1.RejectExecutionRequest, v1.RejectExecutionResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceRejectExecutionProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("RejectExecution")),
+			connect.WithClientOptions(opts...),
+		),
 		validateArgumentType: connect.NewClient[v1.ValidateArgumentTypeRequest, v1.ValidateArgumentTypeResponse](
 			httpClient,
 			baseURL+OliveTinApiServiceValidateArgumentTypeProcedure,
@@ -261,6 +377,24 @@ func NewOliveTinApiServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(oliveTinApiServiceMethods.ByName("LocalUserLogin")),
 			connect.WithClientOptions(opts...),
 		),
+		beginTotpEnrolment: connect.NewClient[v1.BeginTotpEnrolmentRequest, v1.BeginTotpEnrolmentResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceBeginTotpEnrolmentProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("BeginTotpEnrolment")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTotpEnrolment: connect.NewClient[v1.ConfirmTotpEnrolmentRequest, v1.ConfirmTotpEnrolmentResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceConfirmTotpEnrolmentProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("ConfirmTotpEnrolment")),
+			connect.WithClientOptions(opts...),
+		),
+		disableTotp: connect.NewClient[v1.DisableTotpRequest, v1.DisableTotpResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceDisableTotpProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("DisableTotp")),
+			connect.WithClientOptions(opts...),
+		),
 		passwordHash: connect.NewClient[v1.PasswordHashRequest, v1.PasswordHashResponse](
 			httpClient,
 			baseURL+OliveTinApiServicePasswordHashProcedure,
@@ -285,6 +419,12 @@ func NewOliveTinApiServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(oliveTinApiServiceMethods.ByName("GetDiagnostics")),
 			connect.WithClientOptions(opts...),
 		),
+		getAuditLog: connect.NewClient[v1.GetAuditLogRequest, v1.GetAuditLogResponse](
+			httpClient,
+			baseURL+OliveTinApiServiceGetAuditLogProcedure,
+			connect.WithSchema(oliveTinApiServiceMethods.ByName("GetAuditLog")),
+			connect.WithClientOptions(opts...),
+		),
 		init: connect.NewClient[v1.InitRequest, v1.InitResponse](
 			httpClient,
 			baseURL+OliveTinApiServiceInitProcedure,
@@ -314,32 +454,46 @@ func NewOliveTinApiServiceClient(httpClient connect.HTTPClient, baseURL string,
 
 // oliveTinApiServiceClient implements OliveTinApiServiceClient.
 type oliveTinApiServiceClient struct {
-	getDashboard            *connect.Client[v1.GetDashboardRequest, v1.GetDashboardResponse]
-	startAction             *connect.Client[v1.StartActionRequest, v1.StartActionResponse]
-	startActionAndWait      *connect.Client[v1.StartActionAndWaitRequest, v1.StartActionAndWaitResponse]
-	startActionByGet        *connect.Client[v1.StartActionByGetRequest, v1.StartActionByGetResponse]
-	startActionByGetAndWait *connect.Client[v1.StartActionByGetAndWaitRequest, v1.StartActionByGetAndWaitResponse]
-	restartAction           *connect.Client[v1.RestartActionRequest, v1.StartActionResponse]
-	killAction              *connect.Client[v1.KillActionRequest, v1.KillActionResponse]
-	executionStatus         *connect.Client[v1.ExecutionStatusRequest, v1.ExecutionStatusResponse]
-	getLogs                 *connect.Client[v1.GetLogsRequest, v1.GetLogsResponse]
-	getActionLogs           *connect.Client[v1.GetActionLogsRequest, v1.GetActionLogsResponse]
-	getExecutionQueue       *connect.Client[v1.GetExecutionQueueRequest, v1.GetExecutionQueueResponse]
-	validateArgumentType    *connect.Client[v1.ValidateArgumentTypeRequest, v1.ValidateArgumentTypeResponse]
-	whoAmI                  *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
-	serverDiagnostics       *connect.Client[v1.ServerDiagnosticsRequest, v1.ServerDiagnosticsResponse]
-	dumpVars                *connect.Client[v1.DumpVarsRequest, v1.DumpVarsResponse]
-	dumpPublicIdActionMap   *connect.Client[v1.DumpPublicIdActionMapRequest, v1.DumpPublicIdActionMapResponse]
-	getReadyz               *connect.Client[v1.GetReadyzRequest, v1.GetReadyzResponse]
-	localUserLogin          *connect.Client[v1.LocalUserLoginRequest, v1.LocalUserLoginResponse]
-	passwordHash            *connect.Client[v1.PasswordHashRequest, v1.PasswordHashResponse]
-	logout                  *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
-	eventStream             *connect.Client[v1.EventStreamRequest, v1.EventStreamResponse]
-	getDiagnostics          *connect.Client[v1.GetDiagnosticsRequest, v1.GetDiagnosticsResponse]
-	init                    *connect.Client[v1.InitRequest, v1.InitResponse]
-	getActionBinding        *connect.Client[v1.GetActionBindingRequest, v1.GetActionBindingResponse]
-	getEntities             *connect.Client[v1.GetEntitiesRequest, v1.GetEntitiesResponse]
-	getEntity               *connect.Client[v1.GetEntityRequest, v1.Entity]
+	getDashboard             *connect.Client[v1.GetDashboardRequest, v1.GetDashboardResponse]
+	startAction              *connect.Client[v1.StartActionRequest, v1.StartActionResponse]
+	startActionAndWait       *connect.Client[v1.StartActionAndWaitRequest, v1.StartActionAndWaitResponse]
+	startActionByGet         *connect.Client[v1.StartActionByGetRequest, v1.StartActionByGetResponse]
+	startActionByGetAndWait  *connect.Client[v1.StartActionByGetAndWaitRequest, v1.StartActionByGetAndWaitResponse]
+	restartAction            *connect.Client[v1.RestartActionRequest, v1.StartActionResponse]
+	killAction               *connect.Client[v1.KillActionRequest, v1.KillActionResponse]
+	executionStatus          *connect.Client[v1.ExecutionStatusRequest, v1.ExecutionStatusResponse]
+	getLogs                  *connect.Client[v1.GetLogsRequest, v1.GetLogsResponse]
+	startWorkflow            *connect.Client[v1.StartWorkflowRequest, v1.StartWorkflowResponse]
+	getActionLogs            *connect.Client[v1.GetActionLogsRequest, v1.GetActionLogsResponse]
+	getExecutionQueue        *connect.Client[v1.GetExecutionQueueRequest, v1.GetExecutionQueueResponse]
+	scheduleAction           *connect.Client[v1.ScheduleActionRequest, v1.ScheduleActionResponse]
+	listScheduledExecutions  *connect.Client[v1.ListScheduledExecutionsRequest, v1.ListScheduledExecutionsResponse]
+	cancelScheduledExecution *connect.Client[v1.CancelScheduledExecutionRequest, v1.CancelScheduledExecutionResponse]
+	listCronSchedules        *connect.Client[v1.ListCronSchedulesRequest, v1.ListCronSchedulesResponse]
+	pauseCronSchedule        *connect.Client[v1.PauseCronScheduleRequest, v1.PauseCronScheduleResponse]
+	resumeCronSchedule       *connect.Client[v1.ResumeCronScheduleRequest, v1.ResumeCronScheduleResponse]
+	listPendingApprovals     *connect.Client[v1.ListPendingApprovalsRequest, v1.ListPendingApprovalsResponse]
+	approveExecution         *connect.Client[v1.ApproveExecutionRequest, v1.ApproveExecutionResponse]
+	rejectExecution          *connect.Client[v1.RejectExecutionRequest, v1.RejectExecutionResponse]
+	validateArgumentType     *connect.Client[v1.ValidateArgumentTypeRequest, v1.ValidateArgumentTypeResponse]
+	whoAmI                   *connect.Client[v1.WhoAmIRequest, v1.WhoAmIResponse]
+	serverDiagnostics        *connect.Client[v1.ServerDiagnosticsRequest, v1.ServerDiagnosticsResponse]
+	dumpVars                 *connect.Client[v1.DumpVarsRequest, v1.DumpVarsResponse]
+	dumpPublicIdActionMap    *connect.Client[v1.DumpPublicIdActionMapRequest, v1.DumpPublicIdActionMapResponse]
+	getReadyz                *connect.Client[v1.GetReadyzRequest, v1.GetReadyzResponse]
+	localUserLogin           *connect.Client[v1.LocalUserLoginRequest, v1.LocalUserLoginResponse]
+	beginTotpEnrolment       *connect.Client[v1.BeginTotpEnrolmentRequest, v1.BeginTotpEnrolmentResponse]
+	confirmTotpEnrolment     *connect.Client[v1.ConfirmTotpEnrolmentRequest, v1.ConfirmTotpEnrolmentResponse]
+	disableTotp              *connect.Client[v1.DisableTotpRequest, v1.DisableTotpResponse]
+	passwordHash             *connect.Client[v1.PasswordHashRequest, v1.PasswordHashResponse]
+	logout                   *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	eventStream              *connect.Client[v1.EventStreamRequest, v1.EventStreamResponse]
+	getDiagnostics           *connect.Client[v1.GetDiagnosticsRequest, v1.GetDiagnosticsResponse]
+	getAuditLog              *connect.Client[v1.GetAuditLogRequest, v1.GetAuditLogResponse]
+	init                     *connect.Client[v1.InitRequest, v1.InitResponse]
+	getActionBinding         *connect.Client[v1.GetActionBindingRequest, v1.GetActionBindingResponse]
+	getEntities              *connect.Client[v1.GetEntitiesRequest, v1.GetEntitiesResponse]
+	getEntity                *connect.Client[v1.GetEntityRequest, v1.Entity]
 }
 
 // GetDashboard calls olivetin.api.v1.OliveTinApiService.GetDashboard.
@@ -387,6 +541,11 @@ func (c *oliveTinApiServiceClient) GetLogs(ctx context.Context, req *connect.Req
 	return c.getLogs.CallUnary(ctx, req)
 }
 
+// StartWorkflow calls olivetin.api.v1.OliveTinApiService.StartWorkflow.
+func (c *oliveTinApiServiceClient) StartWorkflow(ctx context.Context, req *connect.Request[v1.StartWorkflowRequest]) (*connect.Response[v1.StartWorkflowResponse], error) {
+	return c.startWorkflow.CallUnary(ctx, req)
+}
+
 // GetActionLogs calls olivetin.api.v1.OliveTinApiService.GetActionLogs.
 func (c *oliveTinApiServiceClient) GetActionLogs(ctx context.Context, req *connect.Request[v1.GetActionLogsRequest]) (*connect.Response[v1.GetActionLogsResponse], error) {
 	return c.getActionLogs.CallUnary(ctx, req)
@@ -397,6 +556,51 @@ func (c *oliveTinApiServiceClient) GetExecutionQueue(ctx context.Context, req *c
 	return c.getExecutionQueue.CallUnary(ctx, req)
 }
 
+// ScheduleAction calls olivetin.api.v1.OliveTinApiService.ScheduleAction.
+func (c *oliveTinApiServiceClient) ScheduleAction(ctx context.Context, req *connect.Request[v1.ScheduleActionRequest]) (*connect.Response[v1.ScheduleActionResponse], error) {
+	return c.scheduleAction.CallUnary(ctx, req)
+}
+
+// ListScheduledExecutions calls olivetin.api.v1.OliveTinApiService.ListScheduledExecutions.
+func (c *oliveTinApiServiceClient) ListScheduledExecutions(ctx context.Context, req *connect.Request[v1.ListScheduledExecutionsRequest]) (*connect.Response[v1.ListScheduledExecutionsResponse], error) {
+	return c.listScheduledExecutions.CallUnary(ctx, req)
+}
+
+// CancelScheduledExecution calls olivetin.api.v1.OliveTinApiService.CancelScheduledExecution.
+func (c *oliveTinApiServiceClient) CancelScheduledExecution(ctx context.Context, req *connect.Request[v1.CancelScheduledExecutionRequest]) (*connect.Response[v1.CancelScheduledExecutionResponse], error) {
+	return c.cancelScheduledExecution.CallUnary(ctx, req)
+}
+
+// ListCronSchedules calls olivetin.api.v1.OliveTinApiService.ListCronSchedules.
+func (c *oliveTinApiServiceClient) ListCronSchedules(ctx context.Context, req *connect.Request[v1.ListCronSchedulesRequest]) (*connect.Response[v1.ListCronSchedulesResponse], error) {
+	return c.listCronSchedules.CallUnary(ctx, req)
+}
+
+// PauseCronSchedule calls olivetin.api.v1.OliveTinApiService.PauseCronSchedule.
+func (c *oliveTinApiServiceClient) PauseCronSchedule(ctx context.Context, req *connect.Request[v1.PauseCronScheduleRequest]) (*connect.Response[v1.PauseCronScheduleResponse], error) {
+	return c.pauseCronSchedule.CallUnary(ctx, req)
+}
+
+// ResumeCronSchedule calls olivetin.api.v1.OliveTinApiService.ResumeCronSchedule.
+func (c *oliveTinApiServiceClient) ResumeCronSchedule(ctx context.Context, req *connect.Request[v1.ResumeCronScheduleRequest]) (*connect.Response[v1.ResumeCronScheduleResponse], error) {
+	return c.resumeCronSchedule.CallUnary(ctx, req)
+}
+
+// ListPendingApprovals calls olivetin.api.v1.OliveTinApiService.ListPendingApprovals.
+func (c *oliveTinApiServiceClient) ListPendingApprovals(ctx context.Context, req *connect.Request[v1.ListPendingApprovalsRequest]) (*connect.Response[v1.ListPendingApprovalsResponse], error) {
+	return c.listPendingApprovals.CallUnary(ctx, req)
+}
+
+// ApproveExecution calls olivetin.api.v1.OliveTinApiService.ApproveExecution.
+func (c *oliveTinApiServiceClient) ApproveExecution(ctx context.Context, req *connect.Request[v1.ApproveExecutionRequest]) (*connect.Response[v1.ApproveExecutionResponse], error) {
+	return c.approveExecution.CallUnary(ctx, req)
+}
+
+// RejectExecution calls olivetin.api.v1.OliveTinApiService.RejectExecution.
+func (c *oliveTinApiServiceClient) RejectExecution(ctx context.Context, req *connect.Request[v1.RejectExecutionRequest]) (*connect.Response[v1.RejectExecutionResponse], error) {
+	return c.rejectExecution.CallUnary(ctx, req)
+}
+
 // ValidateArgumentType calls olivetin.api.v1.OliveTinApiService.ValidateArgumentType.
 func (c *oliveTinApiServiceClient) ValidateArgumentType(ctx context.Context, req *connect.Request[v1.ValidateArgumentTypeRequest]) (*connect.Response[v1.ValidateArgumentTypeResponse], error) {
 	return c.validateArgumentType.CallUnary(ctx, req)
@@ -432,6 +636,21 @@ func (c *oliveTinApiServiceClient) LocalUserLogin(ctx context.Context, req *conn
 	return c.localUserLogin.CallUnary(ctx, req)
 }
 
+// BeginTotpEnrolment calls olivetin.api.v1.OliveTinApiService.BeginTotpEnrolment.
+func (c *oliveTinApiServiceClient) BeginTotpEnrolment(ctx context.Context, req *connect.Request[v1.BeginTotpEnrolmentRequest]) (*connect.Response[v1.BeginTotpEnrolmentResponse], error) {
+	return c.beginTotpEnrolment.CallUnary(ctx, req)
+}
+
+// ConfirmTotpEnrolment calls olivetin.api.v1.OliveTinApiService.ConfirmTotpEnrolment.
+func (c *oliveTinApiServiceClient) ConfirmTotpEnrolment(ctx context.Context, req *connect.Request[v1.ConfirmTotpEnrolmentRequest]) (*connect.Response[v1.ConfirmTotpEnrolmentResponse], error) {
+	return c.confirmTotpEnrolment.CallUnary(ctx, req)
+}
+
+// DisableTotp calls olivetin.api.v1.OliveTinApiService.DisableTotp.
+func (c *oliveTinApiServiceClient) DisableTotp(ctx context.Context, req *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error) {
+	return c.disableTotp.CallUnary(ctx, req)
+}
+
 // PasswordHash calls olivetin.api.v1.OliveTinApiService.PasswordHash.
 func (c *oliveTinApiServiceClient) PasswordHash(ctx context.Context, req *connect.Request[v1.PasswordHashRequest]) (*connect.Response[v1.PasswordHashResponse], error) {
 	return c.passwordHash.CallUnary(ctx, req)
@@ -452,6 +671,11 @@ func (c *oliveTinApiServiceClient) GetDiagnostics(ctx context.Context, req *conn
 	return c.getDiagnostics.CallUnary(ctx, req)
 }
 
+// GetAuditLog calls olivetin.api.v1.OliveTinApiService.GetAuditLog.
+func (c *oliveTinApiServiceClient) GetAuditLog(ctx context.Context, req *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.GetAuditLogResponse], error) {
+	return c.getAuditLog.CallUnary(ctx, req)
+}
+
 // Init calls olivetin.api.v1.OliveTinApiService.Init.
 func (c *oliveTinApiServiceClient) Init(ctx context.Context, req *connect.Request[v1.InitRequest]) (*connect.Response[v1.InitResponse], error) {
 	return c.init.CallUnary(ctx, req)
@@ -483,8 +707,18 @@ type OliveTinApiServiceHandler interface {
 	KillAction(context.Context, *connect.Request[v1.KillActionRequest]) (*connect.Response[v1.KillActionResponse], error)
 	ExecutionStatus(context.Context, *connect.Request[v1.ExecutionStatusRequest]) (*connect.Response[v1.ExecutionStatusResponse], error)
 	GetLogs(context.Context, *connect.Request[v1.GetLogsRequest]) (*connect.Response[v1.GetLogsResponse], error)
+	StartWorkflow(context.Context, *connect.Request[v1.StartWorkflowRequest]) (*connect.Response[v1.StartWorkflowResponse], error)
 	GetActionLogs(context.Context, *connect.Request[v1.GetActionLogsRequest]) (*connect.Response[v1.GetActionLogsResponse], error)
 	GetExecutionQueue(context.Context, *connect.Request[v1.GetExecutionQueueRequest]) (*connect.Response[v1.GetExecutionQueueResponse], error)
+	ScheduleAction(context.Context, *connect.Request[v1.ScheduleActionRequest]) (*connect.Response[v1.ScheduleActionResponse], error)
+	ListScheduledExecutions(context.Context, *connect.Request[v1.ListScheduledExecutionsRequest]) (*connect.Response[v1.ListScheduledExecutionsResponse], error)
+	CancelScheduledExecution(context.Context, *connect.Request[v1.CancelScheduledExecutionRequest]) (*connect.Response[v1.CancelScheduledExecutionResponse], error)
+	ListCronSchedules(context.Context, *connect.Request[v1.ListCronSchedulesRequest]) (*connect.Response[v1.ListCronSchedulesResponse], error)
+	PauseCronSchedule(context.Context, *connect.Request[v1.PauseCronScheduleRequest]) (*connect.Response[v1.PauseCronScheduleResponse], error)
+	ResumeCronSchedule(context.Context, *connect.Request[v1.ResumeCronScheduleRequest]) (*connect.Response[v1.ResumeCronScheduleResponse], error)
+	ListPendingApprovals(context.Context, *connect.Request[v1.ListPendingApprovalsRequest]) (*connect.Response[v1.ListPendingApprovalsResponse], error)
+	ApproveExecution(context.Context, *connect.Request[v1.ApproveExecutionRequest]) (*connect.Response[v1.ApproveExecutionResponse], error)
+	RejectExecution(context.Context, *connect.Request[v1.RejectExecutionRequest]) (*connect.Response[v1.RejectExecutionResponse], error)
 	ValidateArgumentType(context.Context, *connect.Request[v1.ValidateArgumentTypeRequest]) (*connect.Response[v1.ValidateArgumentTypeResponse], error)
 	WhoAmI(context.Context, *connect.Request[v1.WhoAmIRequest]) (*connect.Response[v1.WhoAmIResponse], error)
 	ServerDiagnostics(context.Context, *connect.Request[v1.ServerDiagnosticsRequest]) (*connect.Response[v1.ServerDiagnosticsResponse], error)
@@ -492,10 +726,14 @@ type OliveTinApiServiceHandler interface {
 	DumpPublicIdActionMap(context.Context, *connect.Request[v1.DumpPublicIdActionMapRequest]) (*connect.Response[v1.DumpPublicIdActionMapResponse], error)
 	GetReadyz(context.Context, *connect.Request[v1.GetReadyzRequest]) (*connect.Response[v1.GetReadyzResponse], error)
 	LocalUserLogin(context.Context, *connect.Request[v1.LocalUserLoginRequest]) (*connect.Response[v1.LocalUserLoginResponse], error)
+	BeginTotpEnrolment(context.Context, *connect.Request[v1.BeginTotpEnrolmentRequest]) (*connect.Response[v1.BeginTotpEnrolmentResponse], error)
+	ConfirmTotpEnrolment(context.Context, *connect.Request[v1.ConfirmTotpEnrolmentRequest]) (*connect.Response[v1.ConfirmTotpEnrolmentResponse], error)
+	DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error)
 	PasswordHash(context.Context, *connect.Request[v1.PasswordHashRequest]) (*connect.Response[v1.PasswordHashResponse], error)
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
 	EventStream(context.Context, *connect.Request[v1.EventStreamRequest], *connect.ServerStream[v1.EventStreamResponse]) error
 	GetDiagnostics(context.Context, *connect.Request[v1.GetDiagnosticsRequest]) (*connect.Response[v1.GetDiagnosticsResponse], error)
+	GetAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.GetAuditLogResponse], error)
 	Init(context.Context, *connect.Request[v1.InitRequest]) (*connect.Response[v1.InitResponse], error)
 	GetActionBinding(context.Context, *connect.Request[v1.GetActionBindingRequest]) (*connect.Response[v1.GetActionBindingResponse], error)
 	GetEntities(context.Context, *connect.Request[v1.GetEntitiesRequest]) (*connect.Response[v1.GetEntitiesResponse], error)
@@ -563,6 +801,12 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 		connect.WithSchema(oliveTinApiServiceMethods.ByName("GetLogs")),
 		connect.WithHandlerOptions(opts...),
 	)
+	oliveTinApiServiceStartWorkflowHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceStartWorkflowProcedure,
+		svc.StartWorkflow,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("StartWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
 	oliveTinApiServiceGetActionLogsHandler := connect.NewUnaryHandler(
 		OliveTinApiServiceGetActionLogsProcedure,
 		svc.GetActionLogs,
@@ -575,6 +819,60 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 		connect.WithSchema(oliveTinApiServiceMethods.ByName("GetExecutionQueue")),
 		connect.WithHandlerOptions(opts...),
 	)
+	oliveTinApiServiceScheduleActionHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceScheduleActionProcedure,
+		svc.ScheduleAction,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ScheduleAction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceListScheduledExecutionsHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceListScheduledExecutionsProcedure,
+		svc.ListScheduledExecutions,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ListScheduledExecutions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceCancelScheduledExecutionHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceCancelScheduledExecutionProcedure,
+		svc.CancelScheduledExecution,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("CancelScheduledExecution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceListCronSchedulesHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceListCronSchedulesProcedure,
+		svc.ListCronSchedules,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ListCronSchedules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServicePauseCronScheduleHandler := connect.NewUnaryHandler(
+		OliveTinApiServicePauseCronScheduleProcedure,
+		svc.PauseCronSchedule,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("PauseCronSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceResumeCronScheduleHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceResumeCronScheduleProcedure,
+		svc.ResumeCronSchedule,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ResumeCronSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceListPendingApprovalsHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceListPendingApprovalsProcedure,
+		svc.ListPendingApprovals,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ListPendingApprovals")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceApproveExecutionHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceApproveExecutionProcedure,
+		svc.ApproveExecution,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ApproveExecution")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceRejectExecutionHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceRejectExecutionProcedure,
+		svc.RejectExecution,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("RejectExecution")),
+		connect.WithHandlerOptions(opts...),
+	)
 	oliveTinApiServiceValidateArgumentTypeHandler := connect.NewUnaryHandler(
 		OliveTinApiServiceValidateArgumentTypeProcedure,
 		svc.ValidateArgumentType,
@@ -617,6 +915,24 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 		connect.WithSchema(oliveTinApiServiceMethods.ByName("LocalUserLogin")),
 		connect.WithHandlerOptions(opts...),
 	)
+	oliveTinApiServiceBeginTotpEnrolmentHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceBeginTotpEnrolmentProcedure,
+		svc.BeginTotpEnrolment,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("BeginTotpEnrolment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceConfirmTotpEnrolmentHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceConfirmTotpEnrolmentProcedure,
+		svc.ConfirmTotpEnrolment,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("ConfirmTotpEnrolment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	oliveTinApiServiceDisableTotpHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceDisableTotpProcedure,
+		svc.DisableTotp,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("DisableTotp")),
+		connect.WithHandlerOptions(opts...),
+	)
 	oliveTinApiServicePasswordHashHandler := connect.NewUnaryHandler(
 		OliveTinApiServicePasswordHashProcedure,
 		svc.PasswordHash,
@@ -641,6 +957,12 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 		connect.WithSchema(oliveTinApiServiceMethods.ByName("GetDiagnostics")),
 		connect.WithHandlerOptions(opts...),
 	)
+	oliveTinApiServiceGetAuditLogHandler := connect.NewUnaryHandler(
+		OliveTinApiServiceGetAuditLogProcedure,
+		svc.GetAuditLog,
+		connect.WithSchema(oliveTinApiServiceMethods.ByName("GetAuditLog")),
+		connect.WithHandlerOptions(opts...),
+	)
 	oliveTinApiServiceInitHandler := connect.NewUnaryHandler(
 		OliveTinApiServiceInitProcedure,
 		svc.Init,
@@ -685,10 +1007,30 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 			oliveTinApiServiceExecutionStatusHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceGetLogsProcedure:
 			oliveTinApiServiceGetLogsHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceStartWorkflowProcedure:
+			oliveTinApiServiceStartWorkflowHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceGetActionLogsProcedure:
 			oliveTinApiServiceGetActionLogsHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceGetExecutionQueueProcedure:
 			oliveTinApiServiceGetExecutionQueueHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceScheduleActionProcedure:
+			oliveTinApiServiceScheduleActionHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceListScheduledExecutionsProcedure:
+			oliveTinApiServiceListScheduledExecutionsHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceCancelScheduledExecutionProcedure:
+			oliveTinApiServiceCancelScheduledExecutionHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceListCronSchedulesProcedure:
+			oliveTinApiServiceListCronSchedulesHandler.ServeHTTP(w, r)
+		case OliveTinApiServicePauseCronScheduleProcedure:
+			oliveTinApiServicePauseCronScheduleHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceResumeCronScheduleProcedure:
+			oliveTinApiServiceResumeCronScheduleHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceListPendingApprovalsProcedure:
+			oliveTinApiServiceListPendingApprovalsHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceApproveExecutionProcedure:
+			oliveTinApiServiceApproveExecutionHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceRejectExecutionProcedure:
+			oliveTinApiServiceRejectExecutionHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceValidateArgumentTypeProcedure:
 			oliveTinApiServiceValidateArgumentTypeHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceWhoAmIProcedure:
@@ -703,6 +1045,12 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 			oliveTinApiServiceGetReadyzHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceLocalUserLoginProcedure:
 			oliveTinApiServiceLocalUserLoginHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceBeginTotpEnrolmentProcedure:
+			oliveTinApiServiceBeginTotpEnrolmentHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceConfirmTotpEnrolmentProcedure:
+			oliveTinApiServiceConfirmTotpEnrolmentHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceDisableTotpProcedure:
+			oliveTinApiServiceDisableTotpHandler.ServeHTTP(w, r)
 		case OliveTinApiServicePasswordHashProcedure:
 			oliveTinApiServicePasswordHashHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceLogoutProcedure:
@@ -711,6 +1059,8 @@ func NewOliveTinApiServiceHandler(svc OliveTinApiServiceHandler, opts ...connect
 			oliveTinApiServiceEventStreamHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceGetDiagnosticsProcedure:
 			oliveTinApiServiceGetDiagnosticsHandler.ServeHTTP(w, r)
+		case OliveTinApiServiceGetAuditLogProcedure:
+			oliveTinApiServiceGetAuditLogHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceInitProcedure:
 			oliveTinApiServiceInitHandler.ServeHTTP(w, r)
 		case OliveTinApiServiceGetActionBindingProcedure:
@@ -764,6 +1114,10 @@ func (UnimplementedOliveTinApiServiceHandler) GetLogs(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.GetLogs is not implemented"))
 }
 
+func (UnimplementedOliveTinApiServiceHandler) StartWorkflow(context.Context, *connect.Request[v1.StartWorkflowRequest]) (*connect.Response[v1.StartWorkflowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.StartWorkflow is not implemented"))
+}
+
 func (UnimplementedOliveTinApiServiceHandler) GetActionLogs(context.Context, *connect.Request[v1.GetActionLogsRequest]) (*connect.Response[v1.GetActionLogsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.GetActionLogs is not implemented"))
 }
@@ -772,6 +1126,42 @@ func (UnimplementedOliveTinApiServiceHandler) GetExecutionQueue(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.GetExecutionQueue is not implemented"))
 }
 
+func (UnimplementedOliveTinApiServiceHandler) ScheduleAction(context.Context, *connect.Request[v1.ScheduleActionRequest]) (*connect.Response[v1.ScheduleActionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ScheduleAction is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) ListScheduledExecutions(context.Context, *connect.Request[v1.ListScheduledExecutionsRequest]) (*connect.Response[v1.ListScheduledExecutionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ListScheduledExecutions is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) CancelScheduledExecution(context.Context, *connect.Request[v1.CancelScheduledExecutionRequest]) (*connect.Response[v1.CancelScheduledExecutionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.CancelScheduledExecution is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) ListCronSchedules(context.Context, *connect.Request[v1.ListCronSchedulesRequest]) (*connect.Response[v1.ListCronSchedulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ListCronSchedules is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) PauseCronSchedule(context.Context, *connect.Request[v1.PauseCronScheduleRequest]) (*connect.Response[v1.PauseCronScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.PauseCronSchedule is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) ResumeCronSchedule(context.Context, *connect.Request[v1.ResumeCronScheduleRequest]) (*connect.Response[v1.ResumeCronScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ResumeCronSchedule is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) ListPendingApprovals(context.Context, *connect.Request[v1.ListPendingApprovalsRequest]) (*connect.Response[v1.ListPendingApprovalsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ListPendingApprovals is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) ApproveExecution(context.Context, *connect.Request[v1.ApproveExecutionRequest]) (*connect.Response[v1.ApproveExecutionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ApproveExecution is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) RejectExecution(context.Context, *connect.Request[v1.RejectExecutionRequest]) (*connect.Response[v1.RejectExecutionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.RejectExecution is not implemented"))
+}
+
 func (UnimplementedOliveTinApiServiceHandler) ValidateArgumentType(context.Context, *connect.Request[v1.ValidateArgumentTypeRequest]) (*connect.Response[v1.ValidateArgumentTypeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ValidateArgumentType is not implemented"))
 }
@@ -800,6 +1190,18 @@ func (UnimplementedOliveTinApiServiceHandler) LocalUserLogin(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.LocalUserLogin is not implemented"))
 }
 
+func (UnimplementedOliveTinApiServiceHandler) BeginTotpEnrolment(context.Context, *connect.Request[v1.BeginTotpEnrolmentRequest]) (*connect.Response[v1.BeginTotpEnrolmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.BeginTotpEnrolment is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) ConfirmTotpEnrolment(context.Context, *connect.Request[v1.ConfirmTotpEnrolmentRequest]) (*connect.Response[v1.ConfirmTotpEnrolmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.ConfirmTotpEnrolment is not implemented"))
+}
+
+func (UnimplementedOliveTinApiServiceHandler) DisableTotp(context.Context, *connect.Request[v1.DisableTotpRequest]) (*connect.Response[v1.DisableTotpResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.DisableTotp is not implemented"))
+}
+
 func (UnimplementedOliveTinApiServiceHandler) PasswordHash(context.Context, *connect.Request[v1.PasswordHashRequest]) (*connect.Response[v1.PasswordHashResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.PasswordHash is not implemented"))
 }
@@ -816,6 +1218,10 @@ func (UnimplementedOliveTinApiServiceHandler) GetDiagnostics(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.GetDiagnostics is not implemented"))
 }
 
+func (UnimplementedOliveTinApiServiceHandler) GetAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.GetAuditLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.GetAuditLog is not implemented"))
+}
+
 func (UnimplementedOliveTinApiServiceHandler) Init(context.Context, *connect.Request[v1.InitRequest]) (*connect.Response[v1.InitResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("olivetin.api.v1.OliveTinApiService.Init is not implemented"))
 }
@@ -42,6 +42,7 @@ type Action struct {
 	HasRunningInstance       bool                     `protobuf:"varint,17,opt,name=has_running_instance,json=hasRunningInstance,proto3" json:"has_running_instance,omitempty"`
 	HasQueuedInstance        bool                     `protobuf:"varint,18,opt,name=has_queued_instance,json=hasQueuedInstance,proto3" json:"has_queued_instance,omitempty"`
 	Groups                   []*ActionGroupMembership `protobuf:"bytes,19,rep,name=groups,proto3" json:"groups,omitempty"`
+	ExecOnMqtt               []*ActionMqttExecHint    `protobuf:"bytes,21,rep,name=exec_on_mqtt,json=execOnMqtt,proto3" json:"exec_on_mqtt,omitempty"`
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -209,6 +210,13 @@ func (x *Action) GetGroups() []*ActionGroupMembership {
 	return nil
 }
 
+func (x *Action) GetExecOnMqtt() []*ActionMqttExecHint {
+	if x != nil {
+		return x.ExecOnMqtt
+	}
+	return nil
+}
+
 type ActionGroupMembership struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -337,6 +345,66 @@ func (x *ActionWebhookExecHint) GetMatchQuery() map[string]string {
 	return nil
 }
 
+type ActionMqttExecHint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Broker        string                 `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
+	Topic         string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	MatchPath     string                 `protobuf:"bytes,3,opt,name=match_path,json=matchPath,proto3" json:"match_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActionMqttExecHint) Reset() {
+	*x = ActionMqttExecHint{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActionMqttExecHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionMqttExecHint) ProtoMessage() {}
+
+func (x *ActionMqttExecHint) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionMqttExecHint.ProtoReflect.Descriptor instead.
+func (*ActionMqttExecHint) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActionMqttExecHint) GetBroker() string {
+	if x != nil {
+		return x.Broker
+	}
+	return ""
+}
+
+func (x *ActionMqttExecHint) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ActionMqttExecHint) GetMatchPath() string {
+	if x != nil {
+		return x.MatchPath
+	}
+	return ""
+}
+
 type ActionArgument struct {
 	state                 protoimpl.MessageState  `protogen:"open.v1"`
 	Name                  string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -353,7 +421,7 @@ type ActionArgument struct {
 
 func (x *ActionArgument) Reset() {
 	*x = ActionArgument{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[3]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +433,7 @@ func (x *ActionArgument) String() string {
 func (*ActionArgument) ProtoMessage() {}
 
 func (x *ActionArgument) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[3]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +446,7 @@ func (x *ActionArgument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionArgument.ProtoReflect.Descriptor instead.
 func (*ActionArgument) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{3}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ActionArgument) GetName() string {
@@ -447,7 +515,7 @@ type ActionArgumentChoice struct {
 
 func (x *ActionArgumentChoice) Reset() {
 	*x = ActionArgumentChoice{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[4]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +527,7 @@ func (x *ActionArgumentChoice) String() string {
 func (*ActionArgumentChoice) ProtoMessage() {}
 
 func (x *ActionArgumentChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[4]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +540,7 @@ func (x *ActionArgumentChoice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActionArgumentChoice.ProtoReflect.Descriptor instead.
 func (*ActionArgumentChoice) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{4}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ActionArgumentChoice) GetValue() string {
@@ -499,7 +567,7 @@ type EntityRelatedAction struct {
 
 func (x *EntityRelatedAction) Reset() {
 	*x = EntityRelatedAction{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[5]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -511,7 +579,7 @@ func (x *EntityRelatedAction) String() string {
 func (*EntityRelatedAction) ProtoMessage() {}
 
 func (x *EntityRelatedAction) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[5]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +592,7 @@ func (x *EntityRelatedAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityRelatedAction.ProtoReflect.Descriptor instead.
 func (*EntityRelatedAction) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{5}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EntityRelatedAction) GetAction() *Action {
@@ -556,7 +624,7 @@ type Entity struct {
 
 func (x *Entity) Reset() {
 	*x = Entity{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[6]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +636,7 @@ func (x *Entity) String() string {
 func (*Entity) ProtoMessage() {}
 
 func (x *Entity) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[6]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +649,7 @@ func (x *Entity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entity.ProtoReflect.Descriptor instead.
 func (*Entity) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{6}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Entity) GetTitle() string {
@@ -643,7 +711,7 @@ type GetDashboardResponse struct {
 
 func (x *GetDashboardResponse) Reset() {
 	*x = GetDashboardResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[7]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +723,7 @@ func (x *GetDashboardResponse) String() string {
 func (*GetDashboardResponse) ProtoMessage() {}
 
 func (x *GetDashboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[7]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +736,7 @@ func (x *GetDashboardResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDashboardResponse.ProtoReflect.Descriptor instead.
 func (*GetDashboardResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{7}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetDashboardResponse) GetTitle() string {
@@ -690,13 +758,14 @@ type EffectivePolicy struct {
 	ShowDiagnostics   bool                   `protobuf:"varint,1,opt,name=show_diagnostics,json=showDiagnostics,proto3" json:"show_diagnostics,omitempty"`
 	ShowLogList       bool                   `protobuf:"varint,2,opt,name=show_log_list,json=showLogList,proto3" json:"show_log_list,omitempty"`
 	ShowVersionNumber bool                   `protobuf:"varint,3,opt,name=show_version_number,json=showVersionNumber,proto3" json:"show_version_number,omitempty"`
+	ShowAuditLog      bool                   `protobuf:"varint,4,opt,name=show_audit_log,json=showAuditLog,proto3" json:"show_audit_log,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *EffectivePolicy) Reset() {
 	*x = EffectivePolicy{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[8]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +777,7 @@ func (x *EffectivePolicy) String() string {
 func (*EffectivePolicy) ProtoMessage() {}
 
 func (x *EffectivePolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[8]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +790,7 @@ func (x *EffectivePolicy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EffectivePolicy.ProtoReflect.Descriptor instead.
 func (*EffectivePolicy) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{8}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EffectivePolicy) GetShowDiagnostics() bool {
@@ -745,6 +814,13 @@ func (x *EffectivePolicy) GetShowVersionNumber() bool {
 	return false
 }
 
+func (x *EffectivePolicy) GetShowAuditLog() bool {
+	if x != nil {
+		return x.ShowAuditLog
+	}
+	return false
+}
+
 type GetDashboardRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -756,7 +832,7 @@ type GetDashboardRequest struct {
 
 func (x *GetDashboardRequest) Reset() {
 	*x = GetDashboardRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[9]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -768,7 +844,7 @@ func (x *GetDashboardRequest) String() string {
 func (*GetDashboardRequest) ProtoMessage() {}
 
 func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[9]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +857,7 @@ func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDashboardRequest.ProtoReflect.Descriptor instead.
 func (*GetDashboardRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{9}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetDashboardRequest) GetTitle() string {
@@ -815,7 +891,7 @@ type Dashboard struct {
 
 func (x *Dashboard) Reset() {
 	*x = Dashboard{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[10]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -827,7 +903,7 @@ func (x *Dashboard) String() string {
 func (*Dashboard) ProtoMessage() {}
 
 func (x *Dashboard) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[10]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +916,7 @@ func (x *Dashboard) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dashboard.ProtoReflect.Descriptor instead.
 func (*Dashboard) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{10}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Dashboard) GetTitle() string {
@@ -873,7 +949,7 @@ type DashboardComponent struct {
 
 func (x *DashboardComponent) Reset() {
 	*x = DashboardComponent{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[11]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -885,7 +961,7 @@ func (x *DashboardComponent) String() string {
 func (*DashboardComponent) ProtoMessage() {}
 
 func (x *DashboardComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[11]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +974,7 @@ func (x *DashboardComponent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DashboardComponent.ProtoReflect.Descriptor instead.
 func (*DashboardComponent) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{11}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DashboardComponent) GetTitle() string {
@@ -969,7 +1045,7 @@ type StartActionRequest struct {
 
 func (x *StartActionRequest) Reset() {
 	*x = StartActionRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[12]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -981,7 +1057,7 @@ func (x *StartActionRequest) String() string {
 func (*StartActionRequest) ProtoMessage() {}
 
 func (x *StartActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[12]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -994,7 +1070,7 @@ func (x *StartActionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionRequest.ProtoReflect.Descriptor instead.
 func (*StartActionRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{12}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *StartActionRequest) GetBindingId() string {
@@ -1035,7 +1111,7 @@ type StartActionArgument struct {
 
 func (x *StartActionArgument) Reset() {
 	*x = StartActionArgument{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[13]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1047,7 +1123,7 @@ func (x *StartActionArgument) String() string {
 func (*StartActionArgument) ProtoMessage() {}
 
 func (x *StartActionArgument) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[13]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,7 +1136,7 @@ func (x *StartActionArgument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionArgument.ProtoReflect.Descriptor instead.
 func (*StartActionArgument) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{13}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *StartActionArgument) GetName() string {
@@ -1086,7 +1162,7 @@ type StartActionResponse struct {
 
 func (x *StartActionResponse) Reset() {
 	*x = StartActionResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[14]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1098,7 +1174,7 @@ func (x *StartActionResponse) String() string {
 func (*StartActionResponse) ProtoMessage() {}
 
 func (x *StartActionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[14]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1111,7 +1187,7 @@ func (x *StartActionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionResponse.ProtoReflect.Descriptor instead.
 func (*StartActionResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{14}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StartActionResponse) GetExecutionTrackingId() string {
@@ -1132,7 +1208,7 @@ type StartActionAndWaitRequest struct {
 
 func (x *StartActionAndWaitRequest) Reset() {
 	*x = StartActionAndWaitRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[15]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1144,7 +1220,7 @@ func (x *StartActionAndWaitRequest) String() string {
 func (*StartActionAndWaitRequest) ProtoMessage() {}
 
 func (x *StartActionAndWaitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[15]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,7 +1233,7 @@ func (x *StartActionAndWaitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionAndWaitRequest.ProtoReflect.Descriptor instead.
 func (*StartActionAndWaitRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{15}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StartActionAndWaitRequest) GetActionId() string {
@@ -1190,7 +1266,7 @@ type StartActionAndWaitResponse struct {
 
 func (x *StartActionAndWaitResponse) Reset() {
 	*x = StartActionAndWaitResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[16]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1202,7 +1278,7 @@ func (x *StartActionAndWaitResponse) String() string {
 func (*StartActionAndWaitResponse) ProtoMessage() {}
 
 func (x *StartActionAndWaitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[16]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1291,7 @@ func (x *StartActionAndWaitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionAndWaitResponse.ProtoReflect.Descriptor instead.
 func (*StartActionAndWaitResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{16}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StartActionAndWaitResponse) GetLogEntry() *LogEntry {
@@ -1234,7 +1310,7 @@ type StartActionByGetRequest struct {
 
 func (x *StartActionByGetRequest) Reset() {
 	*x = StartActionByGetRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[17]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1246,7 +1322,7 @@ func (x *StartActionByGetRequest) String() string {
 func (*StartActionByGetRequest) ProtoMessage() {}
 
 func (x *StartActionByGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[17]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1259,7 +1335,7 @@ func (x *StartActionByGetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionByGetRequest.ProtoReflect.Descriptor instead.
 func (*StartActionByGetRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{17}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *StartActionByGetRequest) GetActionId() string {
@@ -1278,7 +1354,7 @@ type StartActionByGetResponse struct {
 
 func (x *StartActionByGetResponse) Reset() {
 	*x = StartActionByGetResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[18]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1290,7 +1366,7 @@ func (x *StartActionByGetResponse) String() string {
 func (*StartActionByGetResponse) ProtoMessage() {}
 
 func (x *StartActionByGetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[18]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1379,7 @@ func (x *StartActionByGetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionByGetResponse.ProtoReflect.Descriptor instead.
 func (*StartActionByGetResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{18}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StartActionByGetResponse) GetExecutionTrackingId() string {
@@ -1322,7 +1398,7 @@ type StartActionByGetAndWaitRequest struct {
 
 func (x *StartActionByGetAndWaitRequest) Reset() {
 	*x = StartActionByGetAndWaitRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[19]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1410,7 @@ func (x *StartActionByGetAndWaitRequest) String() string {
 func (*StartActionByGetAndWaitRequest) ProtoMessage() {}
 
 func (x *StartActionByGetAndWaitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[19]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1423,7 @@ func (x *StartActionByGetAndWaitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionByGetAndWaitRequest.ProtoReflect.Descriptor instead.
 func (*StartActionByGetAndWaitRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{19}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *StartActionByGetAndWaitRequest) GetActionId() string {
@@ -1366,7 +1442,7 @@ type StartActionByGetAndWaitResponse struct {
 
 func (x *StartActionByGetAndWaitResponse) Reset() {
 	*x = StartActionByGetAndWaitResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[20]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1378,7 +1454,7 @@ func (x *StartActionByGetAndWaitResponse) String() string {
 func (*StartActionByGetAndWaitResponse) ProtoMessage() {}
 
 func (x *StartActionByGetAndWaitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[20]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1467,7 @@ func (x *StartActionByGetAndWaitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartActionByGetAndWaitResponse.ProtoReflect.Descriptor instead.
 func (*StartActionByGetAndWaitResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{20}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *StartActionByGetAndWaitResponse) GetLogEntry() *LogEntry {
@@ -1402,18 +1478,19 @@ func (x *StartActionByGetAndWaitResponse) GetLogEntry() *LogEntry {
 }
 
 type GetLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartOffset   int64                  `protobuf:"varint,1,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
-	DateFilter    string                 `protobuf:"bytes,2,opt,name=date_filter,json=dateFilter,proto3" json:"date_filter,omitempty"` // Optional date filter in YYYY-MM-DD format
-	PageSize      int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`      // Number of logs per page (optional; server default used if 0 or unset)
-	Filter        string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                           // Optional filter expression (see logs UI syntax help)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StartOffset        int64                  `protobuf:"varint,1,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	DateFilter         string                 `protobuf:"bytes,2,opt,name=date_filter,json=dateFilter,proto3" json:"date_filter,omitempty"`                           // Optional date filter in YYYY-MM-DD format
+	PageSize           int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                                // Number of logs per page (optional; server default used if 0 or unset)
+	Filter             string                 `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                                     // Optional filter expression (see logs UI syntax help)
+	WorkflowTrackingId string                 `protobuf:"bytes,5,opt,name=workflow_tracking_id,json=workflowTrackingId,proto3" json:"workflow_tracking_id,omitempty"` // Optional; returns all the executions of a workflow run, and the run.
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetLogsRequest) Reset() {
 	*x = GetLogsRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[21]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1425,7 +1502,7 @@ func (x *GetLogsRequest) String() string {
 func (*GetLogsRequest) ProtoMessage() {}
 
 func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[21]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1438,7 +1515,7 @@ func (x *GetLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLogsRequest.ProtoReflect.Descriptor instead.
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{21}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetLogsRequest) GetStartOffset() int64 {
@@ -1469,6 +1546,13 @@ func (x *GetLogsRequest) GetFilter() string {
 	return ""
 }
 
+func (x *GetLogsRequest) GetWorkflowTrackingId() string {
+	if x != nil {
+		return x.WorkflowTrackingId
+	}
+	return ""
+}
+
 type LogEntry struct {
 	state                    protoimpl.MessageState `protogen:"open.v1"`
 	DatetimeStarted          string                 `protobuf:"bytes,1,opt,name=datetime_started,json=datetimeStarted,proto3" json:"datetime_started,omitempty"`
@@ -1493,13 +1577,22 @@ type LogEntry struct {
 	QueuedForGroup           string                 `protobuf:"bytes,22,opt,name=queued_for_group,json=queuedForGroup,proto3" json:"queued_for_group,omitempty"`
 	Justification            string                 `protobuf:"bytes,23,opt,name=justification,proto3" json:"justification,omitempty"`
 	Arguments                []*StartActionArgument `protobuf:"bytes,24,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	AwaitingApproval         bool                   `protobuf:"varint,25,opt,name=awaiting_approval,json=awaitingApproval,proto3" json:"awaiting_approval,omitempty"`
+	ApprovedBy               []string               `protobuf:"bytes,26,rep,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	Stdout                   string                 `protobuf:"bytes,27,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr                   string                 `protobuf:"bytes,28,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	ResultJson               string                 `protobuf:"bytes,29,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`                           // The parsed output of actions with an outputFormat, as a JSON object. Empty if there is no result.
+	WorkflowTrackingId       string                 `protobuf:"bytes,30,opt,name=workflow_tracking_id,json=workflowTrackingId,proto3" json:"workflow_tracking_id,omitempty"` // Empty unless the execution is a step of a workflow run.
+	WorkflowStep             string                 `protobuf:"bytes,31,opt,name=workflow_step,json=workflowStep,proto3" json:"workflow_step,omitempty"`
+	Attempt                  int32                  `protobuf:"varint,32,opt,name=attempt,proto3" json:"attempt,omitempty"`  // The current attempt, for actions with a retry policy. 0 before the action is executed.
+	Attempts                 []*ExecutionAttempt    `protobuf:"bytes,33,rep,name=attempts,proto3" json:"attempts,omitempty"` // The attempts that failed before the current one.
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[22]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1511,7 +1604,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[22]
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +1617,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{22}
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *LogEntry) GetDatetimeStarted() string {
@@ -1681,104 +1774,95 @@ func (x *LogEntry) GetArguments() []*StartActionArgument {
 	return nil
 }
 
-type GetLogsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Logs           []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	CountRemaining int64                  `protobuf:"varint,2,opt,name=count_remaining,json=countRemaining,proto3" json:"count_remaining,omitempty"`
-	PageSize       int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalCount     int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	StartOffset    int64                  `protobuf:"varint,5,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetLogsResponse) Reset() {
-	*x = GetLogsResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[23]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LogEntry) GetAwaitingApproval() bool {
+	if x != nil {
+		return x.AwaitingApproval
+	}
+	return false
 }
 
-func (x *GetLogsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LogEntry) GetApprovedBy() []string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return nil
 }
 
-func (*GetLogsResponse) ProtoMessage() {}
-
-func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[23]
+func (x *LogEntry) GetStdout() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Stdout
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetLogsResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{23}
+func (x *LogEntry) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
 }
 
-func (x *GetLogsResponse) GetLogs() []*LogEntry {
+func (x *LogEntry) GetResultJson() string {
 	if x != nil {
-		return x.Logs
+		return x.ResultJson
 	}
-	return nil
+	return ""
 }
 
-func (x *GetLogsResponse) GetCountRemaining() int64 {
+func (x *LogEntry) GetWorkflowTrackingId() string {
 	if x != nil {
-		return x.CountRemaining
+		return x.WorkflowTrackingId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLogsResponse) GetPageSize() int64 {
+func (x *LogEntry) GetWorkflowStep() string {
 	if x != nil {
-		return x.PageSize
+		return x.WorkflowStep
 	}
-	return 0
+	return ""
 }
 
-func (x *GetLogsResponse) GetTotalCount() int64 {
+func (x *LogEntry) GetAttempt() int32 {
 	if x != nil {
-		return x.TotalCount
+		return x.Attempt
 	}
 	return 0
 }
 
-func (x *GetLogsResponse) GetStartOffset() int64 {
+func (x *LogEntry) GetAttempts() []*ExecutionAttempt {
 	if x != nil {
-		return x.StartOffset
+		return x.Attempts
 	}
-	return 0
+	return nil
 }
 
-type GetActionLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActionId      string                 `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
-	StartOffset   int64                  `protobuf:"varint,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ExecutionAttempt struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Attempt          int32                  `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	DatetimeStarted  string                 `protobuf:"bytes,2,opt,name=datetime_started,json=datetimeStarted,proto3" json:"datetime_started,omitempty"`
+	DatetimeFinished string                 `protobuf:"bytes,3,opt,name=datetime_finished,json=datetimeFinished,proto3" json:"datetime_finished,omitempty"`
+	ExitCode         int32                  `protobuf:"varint,4,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	TimedOut         bool                   `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Output           string                 `protobuf:"bytes,6,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetActionLogsRequest) Reset() {
-	*x = GetActionLogsRequest{}
+func (x *ExecutionAttempt) Reset() {
+	*x = ExecutionAttempt{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActionLogsRequest) String() string {
+func (x *ExecutionAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActionLogsRequest) ProtoMessage() {}
+func (*ExecutionAttempt) ProtoMessage() {}
 
-func (x *GetActionLogsRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecutionAttempt) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1790,50 +1874,79 @@ func (x *GetActionLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActionLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetActionLogsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionAttempt.ProtoReflect.Descriptor instead.
+func (*ExecutionAttempt) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetActionLogsRequest) GetActionId() string {
+func (x *ExecutionAttempt) GetAttempt() int32 {
 	if x != nil {
-		return x.ActionId
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ExecutionAttempt) GetDatetimeStarted() string {
+	if x != nil {
+		return x.DatetimeStarted
 	}
 	return ""
 }
 
-func (x *GetActionLogsRequest) GetStartOffset() int64 {
+func (x *ExecutionAttempt) GetDatetimeFinished() string {
 	if x != nil {
-		return x.StartOffset
+		return x.DatetimeFinished
+	}
+	return ""
+}
+
+func (x *ExecutionAttempt) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
 	}
 	return 0
 }
 
-type GetActionLogsResponse struct {
+func (x *ExecutionAttempt) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *ExecutionAttempt) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type GetLogsResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Logs           []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 	CountRemaining int64                  `protobuf:"varint,2,opt,name=count_remaining,json=countRemaining,proto3" json:"count_remaining,omitempty"`
 	PageSize       int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	TotalCount     int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	StartOffset    int64                  `protobuf:"varint,5,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	WorkflowRun    *WorkflowRun           `protobuf:"bytes,6,opt,name=workflow_run,json=workflowRun,proto3" json:"workflow_run,omitempty"` // Set when workflow_tracking_id was requested and the run is still known.
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetActionLogsResponse) Reset() {
-	*x = GetActionLogsResponse{}
+func (x *GetLogsResponse) Reset() {
+	*x = GetLogsResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActionLogsResponse) String() string {
+func (x *GetLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActionLogsResponse) ProtoMessage() {}
+func (*GetLogsResponse) ProtoMessage() {}
 
-func (x *GetActionLogsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1845,66 +1958,81 @@ func (x *GetActionLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActionLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetActionLogsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetActionLogsResponse) GetLogs() []*LogEntry {
+func (x *GetLogsResponse) GetLogs() []*LogEntry {
 	if x != nil {
 		return x.Logs
 	}
 	return nil
 }
 
-func (x *GetActionLogsResponse) GetCountRemaining() int64 {
+func (x *GetLogsResponse) GetCountRemaining() int64 {
 	if x != nil {
 		return x.CountRemaining
 	}
 	return 0
 }
 
-func (x *GetActionLogsResponse) GetPageSize() int64 {
+func (x *GetLogsResponse) GetPageSize() int64 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetActionLogsResponse) GetTotalCount() int64 {
+func (x *GetLogsResponse) GetTotalCount() int64 {
 	if x != nil {
 		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *GetActionLogsResponse) GetStartOffset() int64 {
+func (x *GetLogsResponse) GetStartOffset() int64 {
 	if x != nil {
 		return x.StartOffset
 	}
 	return 0
 }
 
-type GetExecutionQueueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetLogsResponse) GetWorkflowRun() *WorkflowRun {
+	if x != nil {
+		return x.WorkflowRun
+	}
+	return nil
 }
 
-func (x *GetExecutionQueueRequest) Reset() {
-	*x = GetExecutionQueueRequest{}
+type WorkflowRun struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowTrackingId string                 `protobuf:"bytes,1,opt,name=workflow_tracking_id,json=workflowTrackingId,proto3" json:"workflow_tracking_id,omitempty"`
+	WorkflowId         string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Title              string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	User               string                 `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	Status             string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	DatetimeStarted    string                 `protobuf:"bytes,6,opt,name=datetime_started,json=datetimeStarted,proto3" json:"datetime_started,omitempty"`
+	DatetimeFinished   string                 `protobuf:"bytes,7,opt,name=datetime_finished,json=datetimeFinished,proto3" json:"datetime_finished,omitempty"`
+	Steps              []*WorkflowStepRun     `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WorkflowRun) Reset() {
+	*x = WorkflowRun{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionQueueRequest) String() string {
+func (x *WorkflowRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionQueueRequest) ProtoMessage() {}
+func (*WorkflowRun) ProtoMessage() {}
 
-func (x *GetExecutionQueueRequest) ProtoReflect() protoreflect.Message {
+func (x *WorkflowRun) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1916,131 +2044,94 @@ func (x *GetExecutionQueueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionQueueRequest.ProtoReflect.Descriptor instead.
-func (*GetExecutionQueueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkflowRun.ProtoReflect.Descriptor instead.
+func (*WorkflowRun) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{26}
 }
 
-type ExecutionQueueAction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BindingId     string                 `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
-	ActionTitle   string                 `protobuf:"bytes,2,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
-	ActionIcon    string                 `protobuf:"bytes,3,opt,name=action_icon,json=actionIcon,proto3" json:"action_icon,omitempty"`
-	MaxConcurrent int32                  `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
-	ActiveCount   int32                  `protobuf:"varint,5,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
-	EntityPrefix  string                 `protobuf:"bytes,6,opt,name=entity_prefix,json=entityPrefix,proto3" json:"entity_prefix,omitempty"`
-	Entries       []*LogEntry            `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ExecutionQueueAction) Reset() {
-	*x = ExecutionQueueAction{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ExecutionQueueAction) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ExecutionQueueAction) ProtoMessage() {}
-
-func (x *ExecutionQueueAction) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[27]
+func (x *WorkflowRun) GetWorkflowTrackingId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WorkflowTrackingId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ExecutionQueueAction.ProtoReflect.Descriptor instead.
-func (*ExecutionQueueAction) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{27}
+	return ""
 }
 
-func (x *ExecutionQueueAction) GetBindingId() string {
+func (x *WorkflowRun) GetWorkflowId() string {
 	if x != nil {
-		return x.BindingId
+		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *ExecutionQueueAction) GetActionTitle() string {
+func (x *WorkflowRun) GetTitle() string {
 	if x != nil {
-		return x.ActionTitle
+		return x.Title
 	}
 	return ""
 }
 
-func (x *ExecutionQueueAction) GetActionIcon() string {
+func (x *WorkflowRun) GetUser() string {
 	if x != nil {
-		return x.ActionIcon
+		return x.User
 	}
 	return ""
 }
 
-func (x *ExecutionQueueAction) GetMaxConcurrent() int32 {
+func (x *WorkflowRun) GetStatus() string {
 	if x != nil {
-		return x.MaxConcurrent
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecutionQueueAction) GetActiveCount() int32 {
+func (x *WorkflowRun) GetDatetimeStarted() string {
 	if x != nil {
-		return x.ActiveCount
+		return x.DatetimeStarted
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecutionQueueAction) GetEntityPrefix() string {
+func (x *WorkflowRun) GetDatetimeFinished() string {
 	if x != nil {
-		return x.EntityPrefix
+		return x.DatetimeFinished
 	}
 	return ""
 }
 
-func (x *ExecutionQueueAction) GetEntries() []*LogEntry {
+func (x *WorkflowRun) GetSteps() []*WorkflowStepRun {
 	if x != nil {
-		return x.Entries
+		return x.Steps
 	}
 	return nil
 }
 
-type ExecutionQueueGroup struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Icon          string                  `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
-	MaxConcurrent int32                   `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
-	ActiveCount   int32                   `protobuf:"varint,4,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
-	Actions       []*ExecutionQueueAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
-	QueuedCount   int32                   `protobuf:"varint,6,opt,name=queued_count,json=queuedCount,proto3" json:"queued_count,omitempty"`
-	QueueSize     int32                   `protobuf:"varint,7,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WorkflowStepRun struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionTitle         string                 `protobuf:"bytes,2,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
+	Status              string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ExecutionTrackingId string                 `protobuf:"bytes,4,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	ExitCode            int32                  `protobuf:"varint,5,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Error               string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ExecutionQueueGroup) Reset() {
-	*x = ExecutionQueueGroup{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[28]
+func (x *WorkflowStepRun) Reset() {
+	*x = WorkflowStepRun{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutionQueueGroup) String() string {
+func (x *WorkflowStepRun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionQueueGroup) ProtoMessage() {}
+func (*WorkflowStepRun) ProtoMessage() {}
 
-func (x *ExecutionQueueGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[28]
+func (x *WorkflowStepRun) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,83 +2142,76 @@ func (x *ExecutionQueueGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionQueueGroup.ProtoReflect.Descriptor instead.
-func (*ExecutionQueueGroup) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use WorkflowStepRun.ProtoReflect.Descriptor instead.
+func (*WorkflowStepRun) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ExecutionQueueGroup) GetName() string {
+func (x *WorkflowStepRun) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ExecutionQueueGroup) GetIcon() string {
+func (x *WorkflowStepRun) GetActionTitle() string {
 	if x != nil {
-		return x.Icon
+		return x.ActionTitle
 	}
 	return ""
 }
 
-func (x *ExecutionQueueGroup) GetMaxConcurrent() int32 {
-	if x != nil {
-		return x.MaxConcurrent
-	}
-	return 0
-}
-
-func (x *ExecutionQueueGroup) GetActiveCount() int32 {
+func (x *WorkflowStepRun) GetStatus() string {
 	if x != nil {
-		return x.ActiveCount
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *ExecutionQueueGroup) GetActions() []*ExecutionQueueAction {
+func (x *WorkflowStepRun) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.Actions
+		return x.ExecutionTrackingId
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecutionQueueGroup) GetQueuedCount() int32 {
+func (x *WorkflowStepRun) GetExitCode() int32 {
 	if x != nil {
-		return x.QueuedCount
+		return x.ExitCode
 	}
 	return 0
 }
 
-func (x *ExecutionQueueGroup) GetQueueSize() int32 {
+func (x *WorkflowStepRun) GetError() string {
 	if x != nil {
-		return x.QueueSize
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type GetExecutionQueueResponse struct {
+type StartWorkflowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Groups        []*ExecutionQueueGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	TotalActive   int32                  `protobuf:"varint,2,opt,name=total_active,json=totalActive,proto3" json:"total_active,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Arguments     []*StartActionArgument `protobuf:"bytes,2,rep,name=arguments,proto3" json:"arguments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExecutionQueueResponse) Reset() {
-	*x = GetExecutionQueueResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[29]
+func (x *StartWorkflowRequest) Reset() {
+	*x = StartWorkflowRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExecutionQueueResponse) String() string {
+func (x *StartWorkflowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExecutionQueueResponse) ProtoMessage() {}
+func (*StartWorkflowRequest) ProtoMessage() {}
 
-func (x *GetExecutionQueueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[29]
+func (x *StartWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2138,50 +2222,47 @@ func (x *GetExecutionQueueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExecutionQueueResponse.ProtoReflect.Descriptor instead.
-func (*GetExecutionQueueResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use StartWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*StartWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetExecutionQueueResponse) GetGroups() []*ExecutionQueueGroup {
+func (x *StartWorkflowRequest) GetWorkflowId() string {
 	if x != nil {
-		return x.Groups
+		return x.WorkflowId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetExecutionQueueResponse) GetTotalActive() int32 {
+func (x *StartWorkflowRequest) GetArguments() []*StartActionArgument {
 	if x != nil {
-		return x.TotalActive
+		return x.Arguments
 	}
-	return 0
+	return nil
 }
 
-type ValidateArgumentTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	BindingId     string                 `protobuf:"bytes,3,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
-	ArgumentName  string                 `protobuf:"bytes,4,opt,name=argument_name,json=argumentName,proto3" json:"argument_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StartWorkflowResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowTrackingId string                 `protobuf:"bytes,1,opt,name=workflow_tracking_id,json=workflowTrackingId,proto3" json:"workflow_tracking_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ValidateArgumentTypeRequest) Reset() {
-	*x = ValidateArgumentTypeRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[30]
+func (x *StartWorkflowResponse) Reset() {
+	*x = StartWorkflowResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateArgumentTypeRequest) String() string {
+func (x *StartWorkflowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateArgumentTypeRequest) ProtoMessage() {}
+func (*StartWorkflowResponse) ProtoMessage() {}
 
-func (x *ValidateArgumentTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[30]
+func (x *StartWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2192,62 +2273,41 @@ func (x *ValidateArgumentTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateArgumentTypeRequest.ProtoReflect.Descriptor instead.
-func (*ValidateArgumentTypeRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ValidateArgumentTypeRequest) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
+// Deprecated: Use StartWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*StartWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ValidateArgumentTypeRequest) GetType() string {
+func (x *StartWorkflowResponse) GetWorkflowTrackingId() string {
 	if x != nil {
-		return x.Type
+		return x.WorkflowTrackingId
 	}
 	return ""
 }
 
-func (x *ValidateArgumentTypeRequest) GetBindingId() string {
-	if x != nil {
-		return x.BindingId
-	}
-	return ""
-}
-
-func (x *ValidateArgumentTypeRequest) GetArgumentName() string {
-	if x != nil {
-		return x.ArgumentName
-	}
-	return ""
-}
-
-type ValidateArgumentTypeResponse struct {
+type GetActionLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ActionId      string                 `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	StartOffset   int64                  `protobuf:"varint,2,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateArgumentTypeResponse) Reset() {
-	*x = ValidateArgumentTypeResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[31]
+func (x *GetActionLogsRequest) Reset() {
+	*x = GetActionLogsRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateArgumentTypeResponse) String() string {
+func (x *GetActionLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateArgumentTypeResponse) ProtoMessage() {}
+func (*GetActionLogsRequest) ProtoMessage() {}
 
-func (x *ValidateArgumentTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[31]
+func (x *GetActionLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,47 +2318,51 @@ func (x *ValidateArgumentTypeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateArgumentTypeResponse.ProtoReflect.Descriptor instead.
-func (*ValidateArgumentTypeResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetActionLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetActionLogsRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ValidateArgumentTypeResponse) GetValid() bool {
+func (x *GetActionLogsRequest) GetActionId() string {
 	if x != nil {
-		return x.Valid
+		return x.ActionId
 	}
-	return false
+	return ""
 }
 
-func (x *ValidateArgumentTypeResponse) GetDescription() string {
+func (x *GetActionLogsRequest) GetStartOffset() int64 {
 	if x != nil {
-		return x.Description
+		return x.StartOffset
 	}
-	return ""
+	return 0
 }
 
-type WatchExecutionRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetActionLogsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Logs           []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	CountRemaining int64                  `protobuf:"varint,2,opt,name=count_remaining,json=countRemaining,proto3" json:"count_remaining,omitempty"`
+	PageSize       int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalCount     int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	StartOffset    int64                  `protobuf:"varint,5,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WatchExecutionRequest) Reset() {
-	*x = WatchExecutionRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[32]
+func (x *GetActionLogsResponse) Reset() {
+	*x = GetActionLogsResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WatchExecutionRequest) String() string {
+func (x *GetActionLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchExecutionRequest) ProtoMessage() {}
+func (*GetActionLogsResponse) ProtoMessage() {}
 
-func (x *WatchExecutionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[32]
+func (x *GetActionLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,85 +2373,67 @@ func (x *WatchExecutionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchExecutionRequest.ProtoReflect.Descriptor instead.
-func (*WatchExecutionRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetActionLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetActionLogsResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *WatchExecutionRequest) GetExecutionTrackingId() string {
+func (x *GetActionLogsResponse) GetLogs() []*LogEntry {
 	if x != nil {
-		return x.ExecutionTrackingId
+		return x.Logs
 	}
-	return ""
-}
-
-type WatchExecutionUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Update        string                 `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WatchExecutionUpdate) Reset() {
-	*x = WatchExecutionUpdate{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *WatchExecutionUpdate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetActionLogsResponse) GetCountRemaining() int64 {
+	if x != nil {
+		return x.CountRemaining
+	}
+	return 0
 }
 
-func (*WatchExecutionUpdate) ProtoMessage() {}
-
-func (x *WatchExecutionUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[33]
+func (x *GetActionLogsResponse) GetPageSize() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PageSize
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WatchExecutionUpdate.ProtoReflect.Descriptor instead.
-func (*WatchExecutionUpdate) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{33}
+func (x *GetActionLogsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
 }
 
-func (x *WatchExecutionUpdate) GetUpdate() string {
+func (x *GetActionLogsResponse) GetStartOffset() int64 {
 	if x != nil {
-		return x.Update
+		return x.StartOffset
 	}
-	return ""
+	return 0
 }
 
-type ExecutionStatusRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
-	ActionId            string                 `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetExecutionQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecutionStatusRequest) Reset() {
-	*x = ExecutionStatusRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[34]
+func (x *GetExecutionQueueRequest) Reset() {
+	*x = GetExecutionQueueRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecutionStatusRequest) String() string {
+func (x *GetExecutionQueueRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionStatusRequest) ProtoMessage() {}
+func (*GetExecutionQueueRequest) ProtoMessage() {}
 
-func (x *ExecutionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[34]
+func (x *GetExecutionQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,50 +2444,39 @@ func (x *ExecutionStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionStatusRequest.ProtoReflect.Descriptor instead.
-func (*ExecutionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *ExecutionStatusRequest) GetExecutionTrackingId() string {
-	if x != nil {
-		return x.ExecutionTrackingId
-	}
-	return ""
-}
-
-func (x *ExecutionStatusRequest) GetActionId() string {
-	if x != nil {
-		return x.ActionId
-	}
-	return ""
+// Deprecated: Use GetExecutionQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetExecutionQueueRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{32}
 }
 
-type DashboardNavigationTarget struct {
+type ExecutionQueueAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	EntityType    string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	EntityKey     string                 `protobuf:"bytes,3,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
-	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	BindingId     string                 `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	ActionTitle   string                 `protobuf:"bytes,2,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
+	ActionIcon    string                 `protobuf:"bytes,3,opt,name=action_icon,json=actionIcon,proto3" json:"action_icon,omitempty"`
+	MaxConcurrent int32                  `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	ActiveCount   int32                  `protobuf:"varint,5,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	EntityPrefix  string                 `protobuf:"bytes,6,opt,name=entity_prefix,json=entityPrefix,proto3" json:"entity_prefix,omitempty"`
+	Entries       []*LogEntry            `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DashboardNavigationTarget) Reset() {
-	*x = DashboardNavigationTarget{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[35]
+func (x *ExecutionQueueAction) Reset() {
+	*x = ExecutionQueueAction{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DashboardNavigationTarget) String() string {
+func (x *ExecutionQueueAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DashboardNavigationTarget) ProtoMessage() {}
+func (*ExecutionQueueAction) ProtoMessage() {}
 
-func (x *DashboardNavigationTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[35]
+func (x *ExecutionQueueAction) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2452,62 +2487,88 @@ func (x *DashboardNavigationTarget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DashboardNavigationTarget.ProtoReflect.Descriptor instead.
-func (*DashboardNavigationTarget) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ExecutionQueueAction.ProtoReflect.Descriptor instead.
+func (*ExecutionQueueAction) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DashboardNavigationTarget) GetTitle() string {
+func (x *ExecutionQueueAction) GetBindingId() string {
 	if x != nil {
-		return x.Title
+		return x.BindingId
 	}
 	return ""
 }
 
-func (x *DashboardNavigationTarget) GetEntityType() string {
+func (x *ExecutionQueueAction) GetActionTitle() string {
 	if x != nil {
-		return x.EntityType
+		return x.ActionTitle
 	}
 	return ""
 }
 
-func (x *DashboardNavigationTarget) GetEntityKey() string {
+func (x *ExecutionQueueAction) GetActionIcon() string {
 	if x != nil {
-		return x.EntityKey
+		return x.ActionIcon
 	}
 	return ""
 }
 
-func (x *DashboardNavigationTarget) GetPath() string {
+func (x *ExecutionQueueAction) GetMaxConcurrent() int32 {
 	if x != nil {
-		return x.Path
+		return x.MaxConcurrent
 	}
-	return ""
+	return 0
 }
 
-type ExecutionStatusResponse struct {
-	state            protoimpl.MessageState       `protogen:"open.v1"`
-	LogEntry         *LogEntry                    `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
-	BackToDashboards []*DashboardNavigationTarget `protobuf:"bytes,2,rep,name=back_to_dashboards,json=backToDashboards,proto3" json:"back_to_dashboards,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ExecutionQueueAction) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
 }
 
-func (x *ExecutionStatusResponse) Reset() {
-	*x = ExecutionStatusResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[36]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ExecutionQueueAction) GetEntityPrefix() string {
+	if x != nil {
+		return x.EntityPrefix
+	}
+	return ""
 }
 
-func (x *ExecutionStatusResponse) String() string {
+func (x *ExecutionQueueAction) GetEntries() []*LogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ExecutionQueueGroup struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          string                  `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
+	MaxConcurrent int32                   `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	ActiveCount   int32                   `protobuf:"varint,4,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	Actions       []*ExecutionQueueAction `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	QueuedCount   int32                   `protobuf:"varint,6,opt,name=queued_count,json=queuedCount,proto3" json:"queued_count,omitempty"`
+	QueueSize     int32                   `protobuf:"varint,7,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionQueueGroup) Reset() {
+	*x = ExecutionQueueGroup{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionQueueGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecutionStatusResponse) ProtoMessage() {}
+func (*ExecutionQueueGroup) ProtoMessage() {}
 
-func (x *ExecutionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[36]
+func (x *ExecutionQueueGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2518,46 +2579,84 @@ func (x *ExecutionStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecutionStatusResponse.ProtoReflect.Descriptor instead.
-func (*ExecutionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ExecutionQueueGroup.ProtoReflect.Descriptor instead.
+func (*ExecutionQueueGroup) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ExecutionStatusResponse) GetLogEntry() *LogEntry {
+func (x *ExecutionQueueGroup) GetName() string {
 	if x != nil {
-		return x.LogEntry
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecutionStatusResponse) GetBackToDashboards() []*DashboardNavigationTarget {
+func (x *ExecutionQueueGroup) GetIcon() string {
 	if x != nil {
-		return x.BackToDashboards
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *ExecutionQueueGroup) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *ExecutionQueueGroup) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *ExecutionQueueGroup) GetActions() []*ExecutionQueueAction {
+	if x != nil {
+		return x.Actions
 	}
 	return nil
 }
 
-type WhoAmIRequest struct {
+func (x *ExecutionQueueGroup) GetQueuedCount() int32 {
+	if x != nil {
+		return x.QueuedCount
+	}
+	return 0
+}
+
+func (x *ExecutionQueueGroup) GetQueueSize() int32 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+type GetExecutionQueueResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*ExecutionQueueGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	TotalActive   int32                  `protobuf:"varint,2,opt,name=total_active,json=totalActive,proto3" json:"total_active,omitempty"`
+	Scheduled     []*ScheduledExecution  `protobuf:"bytes,3,rep,name=scheduled,proto3" json:"scheduled,omitempty"` // Executions that are scheduled to run later, soonest first.
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WhoAmIRequest) Reset() {
-	*x = WhoAmIRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[37]
+func (x *GetExecutionQueueResponse) Reset() {
+	*x = GetExecutionQueueResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhoAmIRequest) String() string {
+func (x *GetExecutionQueueResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhoAmIRequest) ProtoMessage() {}
+func (*GetExecutionQueueResponse) ProtoMessage() {}
 
-func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[37]
+func (x *GetExecutionQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,37 +2667,63 @@ func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
-func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetExecutionQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetExecutionQueueResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{35}
 }
 
-type WhoAmIResponse struct {
+func (x *GetExecutionQueueResponse) GetGroups() []*ExecutionQueueGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetExecutionQueueResponse) GetTotalActive() int32 {
+	if x != nil {
+		return x.TotalActive
+	}
+	return 0
+}
+
+func (x *GetExecutionQueueResponse) GetScheduled() []*ScheduledExecution {
+	if x != nil {
+		return x.Scheduled
+	}
+	return nil
+}
+
+type ScheduledExecution struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
-	AuthenticatedUser string                 `protobuf:"bytes,1,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
-	Usergroup         string                 `protobuf:"bytes,2,opt,name=usergroup,proto3" json:"usergroup,omitempty"`
-	Provider          string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
-	Acls              []string               `protobuf:"bytes,4,rep,name=acls,proto3" json:"acls,omitempty"`
-	Sid               string                 `protobuf:"bytes,5,opt,name=sid,proto3" json:"sid,omitempty"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Also the execution tracking ID, once it runs.
+	BindingId         string                 `protobuf:"bytes,2,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	ActionTitle       string                 `protobuf:"bytes,3,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
+	ActionIcon        string                 `protobuf:"bytes,4,opt,name=action_icon,json=actionIcon,proto3" json:"action_icon,omitempty"`
+	Datetime          string                 `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty"`                                            // RFC3339
+	DatetimeScheduled string                 `protobuf:"bytes,6,opt,name=datetime_scheduled,json=datetimeScheduled,proto3" json:"datetime_scheduled,omitempty"` // RFC3339
+	Arguments         []*StartActionArgument `protobuf:"bytes,7,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Justification     string                 `protobuf:"bytes,8,opt,name=justification,proto3" json:"justification,omitempty"`
+	User              string                 `protobuf:"bytes,9,opt,name=user,proto3" json:"user,omitempty"`
+	CanCancel         bool                   `protobuf:"varint,10,opt,name=can_cancel,json=canCancel,proto3" json:"can_cancel,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
-func (x *WhoAmIResponse) Reset() {
-	*x = WhoAmIResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[38]
+func (x *ScheduledExecution) Reset() {
+	*x = ScheduledExecution{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhoAmIResponse) String() string {
+func (x *ScheduledExecution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhoAmIResponse) ProtoMessage() {}
+func (*ScheduledExecution) ProtoMessage() {}
 
-func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[38]
+func (x *ScheduledExecution) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2609,104 +2734,106 @@ func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
-func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use ScheduledExecution.ProtoReflect.Descriptor instead.
+func (*ScheduledExecution) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *WhoAmIResponse) GetAuthenticatedUser() string {
+func (x *ScheduledExecution) GetId() string {
 	if x != nil {
-		return x.AuthenticatedUser
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WhoAmIResponse) GetUsergroup() string {
+func (x *ScheduledExecution) GetBindingId() string {
 	if x != nil {
-		return x.Usergroup
+		return x.BindingId
 	}
 	return ""
 }
 
-func (x *WhoAmIResponse) GetProvider() string {
+func (x *ScheduledExecution) GetActionTitle() string {
 	if x != nil {
-		return x.Provider
+		return x.ActionTitle
 	}
 	return ""
 }
 
-func (x *WhoAmIResponse) GetAcls() []string {
+func (x *ScheduledExecution) GetActionIcon() string {
 	if x != nil {
-		return x.Acls
+		return x.ActionIcon
 	}
-	return nil
+	return ""
 }
 
-func (x *WhoAmIResponse) GetSid() string {
+func (x *ScheduledExecution) GetDatetime() string {
 	if x != nil {
-		return x.Sid
+		return x.Datetime
 	}
 	return ""
 }
 
-type ServerDiagnosticsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScheduledExecution) GetDatetimeScheduled() string {
+	if x != nil {
+		return x.DatetimeScheduled
+	}
+	return ""
 }
 
-func (x *ServerDiagnosticsRequest) Reset() {
-	*x = ServerDiagnosticsRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScheduledExecution) GetArguments() []*StartActionArgument {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
 }
 
-func (x *ServerDiagnosticsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScheduledExecution) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
 }
 
-func (*ServerDiagnosticsRequest) ProtoMessage() {}
-
-func (x *ServerDiagnosticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[39]
+func (x *ScheduledExecution) GetUser() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.User
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ServerDiagnosticsRequest.ProtoReflect.Descriptor instead.
-func (*ServerDiagnosticsRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{39}
+func (x *ScheduledExecution) GetCanCancel() bool {
+	if x != nil {
+		return x.CanCancel
+	}
+	return false
 }
 
-type ServerDiagnosticsResponse struct {
+type ScheduleActionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Alert         string                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	BindingId     string                 `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	Datetime      string                 `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"` // RFC3339, for example 2026-01-02T02:00:00+01:00
+	Arguments     []*StartActionArgument `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	Justification string                 `protobuf:"bytes,4,opt,name=justification,proto3" json:"justification,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerDiagnosticsResponse) Reset() {
-	*x = ServerDiagnosticsResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[40]
+func (x *ScheduleActionRequest) Reset() {
+	*x = ScheduleActionRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerDiagnosticsResponse) String() string {
+func (x *ScheduleActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerDiagnosticsResponse) ProtoMessage() {}
+func (*ScheduleActionRequest) ProtoMessage() {}
 
-func (x *ServerDiagnosticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[40]
+func (x *ScheduleActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2717,39 +2844,61 @@ func (x *ServerDiagnosticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerDiagnosticsResponse.ProtoReflect.Descriptor instead.
-func (*ServerDiagnosticsResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ScheduleActionRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleActionRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ServerDiagnosticsResponse) GetAlert() string {
+func (x *ScheduleActionRequest) GetBindingId() string {
 	if x != nil {
-		return x.Alert
+		return x.BindingId
 	}
 	return ""
 }
 
-type DumpVarsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScheduleActionRequest) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
 }
 
-func (x *DumpVarsRequest) Reset() {
-	*x = DumpVarsRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[41]
+func (x *ScheduleActionRequest) GetArguments() []*StartActionArgument {
+	if x != nil {
+		return x.Arguments
+	}
+	return nil
+}
+
+func (x *ScheduleActionRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+type ScheduleActionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledExecution *ScheduledExecution    `protobuf:"bytes,1,opt,name=scheduled_execution,json=scheduledExecution,proto3" json:"scheduled_execution,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ScheduleActionResponse) Reset() {
+	*x = ScheduleActionResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DumpVarsRequest) String() string {
+func (x *ScheduleActionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DumpVarsRequest) ProtoMessage() {}
+func (*ScheduleActionResponse) ProtoMessage() {}
 
-func (x *DumpVarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[41]
+func (x *ScheduleActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2760,34 +2909,39 @@ func (x *DumpVarsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DumpVarsRequest.ProtoReflect.Descriptor instead.
-func (*DumpVarsRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ScheduleActionResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleActionResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{38}
 }
 
-type DumpVarsResponse struct {
+func (x *ScheduleActionResponse) GetScheduledExecution() *ScheduledExecution {
+	if x != nil {
+		return x.ScheduledExecution
+	}
+	return nil
+}
+
+type ListScheduledExecutionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Alert         string                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
-	Contents      map[string]string      `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DumpVarsResponse) Reset() {
-	*x = DumpVarsResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[42]
+func (x *ListScheduledExecutionsRequest) Reset() {
+	*x = ListScheduledExecutionsRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DumpVarsResponse) String() string {
+func (x *ListScheduledExecutionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DumpVarsResponse) ProtoMessage() {}
+func (*ListScheduledExecutionsRequest) ProtoMessage() {}
 
-func (x *DumpVarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[42]
+func (x *ListScheduledExecutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2798,48 +2952,77 @@ func (x *DumpVarsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DumpVarsResponse.ProtoReflect.Descriptor instead.
-func (*DumpVarsResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ListScheduledExecutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledExecutionsRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DumpVarsResponse) GetAlert() string {
+type ListScheduledExecutionsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledExecutions []*ScheduledExecution  `protobuf:"bytes,1,rep,name=scheduled_executions,json=scheduledExecutions,proto3" json:"scheduled_executions,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ListScheduledExecutionsResponse) Reset() {
+	*x = ListScheduledExecutionsResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledExecutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledExecutionsResponse) ProtoMessage() {}
+
+func (x *ListScheduledExecutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[40]
 	if x != nil {
-		return x.Alert
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DumpVarsResponse) GetContents() map[string]string {
+// Deprecated: Use ListScheduledExecutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledExecutionsResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListScheduledExecutionsResponse) GetScheduledExecutions() []*ScheduledExecution {
 	if x != nil {
-		return x.Contents
+		return x.ScheduledExecutions
 	}
 	return nil
 }
 
-type DebugBinding struct {
+type CancelScheduledExecutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActionTitle   string                 `protobuf:"bytes,1,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
-	EntityPrefix  string                 `protobuf:"bytes,2,opt,name=entity_prefix,json=entityPrefix,proto3" json:"entity_prefix,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebugBinding) Reset() {
-	*x = DebugBinding{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[43]
+func (x *CancelScheduledExecutionRequest) Reset() {
+	*x = CancelScheduledExecutionRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebugBinding) String() string {
+func (x *CancelScheduledExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugBinding) ProtoMessage() {}
+func (*CancelScheduledExecutionRequest) ProtoMessage() {}
 
-func (x *DebugBinding) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[43]
+func (x *CancelScheduledExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2850,46 +3033,39 @@ func (x *DebugBinding) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugBinding.ProtoReflect.Descriptor instead.
-func (*DebugBinding) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *DebugBinding) GetActionTitle() string {
-	if x != nil {
-		return x.ActionTitle
-	}
-	return ""
+// Deprecated: Use CancelScheduledExecutionRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DebugBinding) GetEntityPrefix() string {
+func (x *CancelScheduledExecutionRequest) GetId() string {
 	if x != nil {
-		return x.EntityPrefix
+		return x.Id
 	}
 	return ""
 }
 
-type DumpPublicIdActionMapRequest struct {
+type CancelScheduledExecutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DumpPublicIdActionMapRequest) Reset() {
-	*x = DumpPublicIdActionMapRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[44]
+func (x *CancelScheduledExecutionResponse) Reset() {
+	*x = CancelScheduledExecutionResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DumpPublicIdActionMapRequest) String() string {
+func (x *CancelScheduledExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DumpPublicIdActionMapRequest) ProtoMessage() {}
+func (*CancelScheduledExecutionResponse) ProtoMessage() {}
 
-func (x *DumpPublicIdActionMapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[44]
+func (x *CancelScheduledExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2900,34 +3076,43 @@ func (x *DumpPublicIdActionMapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DumpPublicIdActionMapRequest.ProtoReflect.Descriptor instead.
-func (*DumpPublicIdActionMapRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CancelScheduledExecutionResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{42}
 }
 
-type DumpPublicIdActionMapResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Alert         string                   `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
-	Contents      map[string]*DebugBinding `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+type CronSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BindingId     string                 `protobuf:"bytes,2,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	ActionTitle   string                 `protobuf:"bytes,3,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
+	ActionIcon    string                 `protobuf:"bytes,4,opt,name=action_icon,json=actionIcon,proto3" json:"action_icon,omitempty"`
+	CronLine      string                 `protobuf:"bytes,5,opt,name=cron_line,json=cronLine,proto3" json:"cron_line,omitempty"`
+	NextRun       string                 `protobuf:"bytes,6,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`             // RFC3339, empty when paused or invalid
+	PreviousRun   string                 `protobuf:"bytes,7,opt,name=previous_run,json=previousRun,proto3" json:"previous_run,omitempty"` // RFC3339, empty when it has not run since startup
+	Paused        bool                   `protobuf:"varint,8,opt,name=paused,proto3" json:"paused,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"` // Set when the cron line is invalid
+	LastExecution *LogEntry              `protobuf:"bytes,10,opt,name=last_execution,json=lastExecution,proto3" json:"last_execution,omitempty"`
+	CanPause      bool                   `protobuf:"varint,11,opt,name=can_pause,json=canPause,proto3" json:"can_pause,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DumpPublicIdActionMapResponse) Reset() {
-	*x = DumpPublicIdActionMapResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[45]
+func (x *CronSchedule) Reset() {
+	*x = CronSchedule{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DumpPublicIdActionMapResponse) String() string {
+func (x *CronSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DumpPublicIdActionMapResponse) ProtoMessage() {}
+func (*CronSchedule) ProtoMessage() {}
 
-func (x *DumpPublicIdActionMapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[45]
+func (x *CronSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2938,46 +3123,109 @@ func (x *DumpPublicIdActionMapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DumpPublicIdActionMapResponse.ProtoReflect.Descriptor instead.
-func (*DumpPublicIdActionMapResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CronSchedule.ProtoReflect.Descriptor instead.
+func (*CronSchedule) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DumpPublicIdActionMapResponse) GetAlert() string {
+func (x *CronSchedule) GetId() string {
 	if x != nil {
-		return x.Alert
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DumpPublicIdActionMapResponse) GetContents() map[string]*DebugBinding {
+func (x *CronSchedule) GetBindingId() string {
 	if x != nil {
-		return x.Contents
+		return x.BindingId
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetActionTitle() string {
+	if x != nil {
+		return x.ActionTitle
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetActionIcon() string {
+	if x != nil {
+		return x.ActionIcon
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetCronLine() string {
+	if x != nil {
+		return x.CronLine
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetPreviousRun() string {
+	if x != nil {
+		return x.PreviousRun
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *CronSchedule) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CronSchedule) GetLastExecution() *LogEntry {
+	if x != nil {
+		return x.LastExecution
 	}
 	return nil
 }
 
-type GetReadyzRequest struct {
+func (x *CronSchedule) GetCanPause() bool {
+	if x != nil {
+		return x.CanPause
+	}
+	return false
+}
+
+type ListCronSchedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReadyzRequest) Reset() {
-	*x = GetReadyzRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[46]
+func (x *ListCronSchedulesRequest) Reset() {
+	*x = ListCronSchedulesRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReadyzRequest) String() string {
+func (x *ListCronSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReadyzRequest) ProtoMessage() {}
+func (*ListCronSchedulesRequest) ProtoMessage() {}
 
-func (x *GetReadyzRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[46]
+func (x *ListCronSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2988,33 +3236,33 @@ func (x *GetReadyzRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReadyzRequest.ProtoReflect.Descriptor instead.
-func (*GetReadyzRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListCronSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCronSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{44}
 }
 
-type GetReadyzResponse struct {
+type ListCronSchedulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Schedules     []*CronSchedule        `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReadyzResponse) Reset() {
-	*x = GetReadyzResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[47]
+func (x *ListCronSchedulesResponse) Reset() {
+	*x = ListCronSchedulesResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReadyzResponse) String() string {
+func (x *ListCronSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReadyzResponse) ProtoMessage() {}
+func (*ListCronSchedulesResponse) ProtoMessage() {}
 
-func (x *GetReadyzResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[47]
+func (x *ListCronSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3025,39 +3273,40 @@ func (x *GetReadyzResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReadyzResponse.ProtoReflect.Descriptor instead.
-func (*GetReadyzResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListCronSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCronSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetReadyzResponse) GetStatus() string {
+func (x *ListCronSchedulesResponse) GetSchedules() []*CronSchedule {
 	if x != nil {
-		return x.Status
+		return x.Schedules
 	}
-	return ""
+	return nil
 }
 
-type EventStreamRequest struct {
+type PauseCronScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventStreamRequest) Reset() {
-	*x = EventStreamRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[48]
+func (x *PauseCronScheduleRequest) Reset() {
+	*x = PauseCronScheduleRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventStreamRequest) String() string {
+func (x *PauseCronScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStreamRequest) ProtoMessage() {}
+func (*PauseCronScheduleRequest) ProtoMessage() {}
 
-func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[48]
+func (x *PauseCronScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3068,41 +3317,40 @@ func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStreamRequest.ProtoReflect.Descriptor instead.
-func (*EventStreamRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use PauseCronScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PauseCronScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{46}
 }
 
-type EventStreamResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Event:
-	//
-	//	*EventStreamResponse_EntityChanged
-	//	*EventStreamResponse_ConfigChanged
-	//	*EventStreamResponse_ExecutionFinished
-	//	*EventStreamResponse_ExecutionStarted
-	//	*EventStreamResponse_OutputChunk
-	//	*EventStreamResponse_Heartbeat
-	Event         isEventStreamResponse_Event `protobuf_oneof:"event"`
+func (x *PauseCronScheduleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PauseCronScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *CronSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventStreamResponse) Reset() {
-	*x = EventStreamResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[49]
+func (x *PauseCronScheduleResponse) Reset() {
+	*x = PauseCronScheduleResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventStreamResponse) String() string {
+func (x *PauseCronScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventStreamResponse) ProtoMessage() {}
+func (*PauseCronScheduleResponse) ProtoMessage() {}
 
-func (x *EventStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[49]
+func (x *PauseCronScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,134 +3361,130 @@ func (x *EventStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventStreamResponse.ProtoReflect.Descriptor instead.
-func (*EventStreamResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use PauseCronScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PauseCronScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *EventStreamResponse) GetEvent() isEventStreamResponse_Event {
+func (x *PauseCronScheduleResponse) GetSchedule() *CronSchedule {
 	if x != nil {
-		return x.Event
+		return x.Schedule
 	}
 	return nil
 }
 
-func (x *EventStreamResponse) GetEntityChanged() *EventEntityChanged {
-	if x != nil {
-		if x, ok := x.Event.(*EventStreamResponse_EntityChanged); ok {
-			return x.EntityChanged
-		}
-	}
-	return nil
+type ResumeCronScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventStreamResponse) GetConfigChanged() *EventConfigChanged {
-	if x != nil {
-		if x, ok := x.Event.(*EventStreamResponse_ConfigChanged); ok {
-			return x.ConfigChanged
-		}
-	}
-	return nil
+func (x *ResumeCronScheduleRequest) Reset() {
+	*x = ResumeCronScheduleRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventStreamResponse) GetExecutionFinished() *EventExecutionFinished {
-	if x != nil {
-		if x, ok := x.Event.(*EventStreamResponse_ExecutionFinished); ok {
-			return x.ExecutionFinished
-		}
-	}
-	return nil
+func (x *ResumeCronScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EventStreamResponse) GetExecutionStarted() *EventExecutionStarted {
+func (*ResumeCronScheduleRequest) ProtoMessage() {}
+
+func (x *ResumeCronScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[48]
 	if x != nil {
-		if x, ok := x.Event.(*EventStreamResponse_ExecutionStarted); ok {
-			return x.ExecutionStarted
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *EventStreamResponse) GetOutputChunk() *EventOutputChunk {
-	if x != nil {
-		if x, ok := x.Event.(*EventStreamResponse_OutputChunk); ok {
-			return x.OutputChunk
-		}
-	}
-	return nil
+// Deprecated: Use ResumeCronScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ResumeCronScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *EventStreamResponse) GetHeartbeat() *EventHeartbeat {
+func (x *ResumeCronScheduleRequest) GetId() string {
 	if x != nil {
-		if x, ok := x.Event.(*EventStreamResponse_Heartbeat); ok {
-			return x.Heartbeat
-		}
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type isEventStreamResponse_Event interface {
-	isEventStreamResponse_Event()
+type ResumeCronScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *CronSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type EventStreamResponse_EntityChanged struct {
-	EntityChanged *EventEntityChanged `protobuf:"bytes,2,opt,name=entity_changed,json=entityChanged,proto3,oneof"`
+func (x *ResumeCronScheduleResponse) Reset() {
+	*x = ResumeCronScheduleResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type EventStreamResponse_ConfigChanged struct {
-	ConfigChanged *EventConfigChanged `protobuf:"bytes,3,opt,name=config_changed,json=configChanged,proto3,oneof"`
+func (x *ResumeCronScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type EventStreamResponse_ExecutionFinished struct {
-	ExecutionFinished *EventExecutionFinished `protobuf:"bytes,4,opt,name=execution_finished,json=executionFinished,proto3,oneof"`
-}
+func (*ResumeCronScheduleResponse) ProtoMessage() {}
 
-type EventStreamResponse_ExecutionStarted struct {
-	ExecutionStarted *EventExecutionStarted `protobuf:"bytes,5,opt,name=execution_started,json=executionStarted,proto3,oneof"`
+func (x *ResumeCronScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type EventStreamResponse_OutputChunk struct {
-	OutputChunk *EventOutputChunk `protobuf:"bytes,6,opt,name=output_chunk,json=outputChunk,proto3,oneof"`
+// Deprecated: Use ResumeCronScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ResumeCronScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{49}
 }
 
-type EventStreamResponse_Heartbeat struct {
-	Heartbeat *EventHeartbeat `protobuf:"bytes,7,opt,name=heartbeat,proto3,oneof"`
+func (x *ResumeCronScheduleResponse) GetSchedule() *CronSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
 }
 
-func (*EventStreamResponse_EntityChanged) isEventStreamResponse_Event() {}
-
-func (*EventStreamResponse_ConfigChanged) isEventStreamResponse_Event() {}
-
-func (*EventStreamResponse_ExecutionFinished) isEventStreamResponse_Event() {}
-
-func (*EventStreamResponse_ExecutionStarted) isEventStreamResponse_Event() {}
-
-func (*EventStreamResponse_OutputChunk) isEventStreamResponse_Event() {}
-
-func (*EventStreamResponse_Heartbeat) isEventStreamResponse_Event() {}
-
-type EventOutputChunk struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
-	Output              string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ValidateArgumentTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	BindingId     string                 `protobuf:"bytes,3,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	ArgumentName  string                 `protobuf:"bytes,4,opt,name=argument_name,json=argumentName,proto3" json:"argument_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventOutputChunk) Reset() {
-	*x = EventOutputChunk{}
+func (x *ValidateArgumentTypeRequest) Reset() {
+	*x = ValidateArgumentTypeRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventOutputChunk) String() string {
+func (x *ValidateArgumentTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventOutputChunk) ProtoMessage() {}
+func (*ValidateArgumentTypeRequest) ProtoMessage() {}
 
-func (x *EventOutputChunk) ProtoReflect() protoreflect.Message {
+func (x *ValidateArgumentTypeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3252,45 +3496,61 @@ func (x *EventOutputChunk) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventOutputChunk.ProtoReflect.Descriptor instead.
-func (*EventOutputChunk) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateArgumentTypeRequest.ProtoReflect.Descriptor instead.
+func (*ValidateArgumentTypeRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *EventOutputChunk) GetExecutionTrackingId() string {
+func (x *ValidateArgumentTypeRequest) GetValue() string {
 	if x != nil {
-		return x.ExecutionTrackingId
+		return x.Value
 	}
 	return ""
 }
 
-func (x *EventOutputChunk) GetOutput() string {
+func (x *ValidateArgumentTypeRequest) GetType() string {
 	if x != nil {
-		return x.Output
+		return x.Type
 	}
 	return ""
 }
 
-type EventEntityChanged struct {
+func (x *ValidateArgumentTypeRequest) GetBindingId() string {
+	if x != nil {
+		return x.BindingId
+	}
+	return ""
+}
+
+func (x *ValidateArgumentTypeRequest) GetArgumentName() string {
+	if x != nil {
+		return x.ArgumentName
+	}
+	return ""
+}
+
+type ValidateArgumentTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventEntityChanged) Reset() {
-	*x = EventEntityChanged{}
+func (x *ValidateArgumentTypeResponse) Reset() {
+	*x = ValidateArgumentTypeResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventEntityChanged) String() string {
+func (x *ValidateArgumentTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventEntityChanged) ProtoMessage() {}
+func (*ValidateArgumentTypeResponse) ProtoMessage() {}
 
-func (x *EventEntityChanged) ProtoReflect() protoreflect.Message {
+func (x *ValidateArgumentTypeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3302,31 +3562,46 @@ func (x *EventEntityChanged) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventEntityChanged.ProtoReflect.Descriptor instead.
-func (*EventEntityChanged) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateArgumentTypeResponse.ProtoReflect.Descriptor instead.
+func (*ValidateArgumentTypeResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{51}
 }
 
-type EventConfigChanged struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ValidateArgumentTypeResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
 }
 
-func (x *EventConfigChanged) Reset() {
-	*x = EventConfigChanged{}
+func (x *ValidateArgumentTypeResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type WatchExecutionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WatchExecutionRequest) Reset() {
+	*x = WatchExecutionRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventConfigChanged) String() string {
+func (x *WatchExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventConfigChanged) ProtoMessage() {}
+func (*WatchExecutionRequest) ProtoMessage() {}
 
-func (x *EventConfigChanged) ProtoReflect() protoreflect.Message {
+func (x *WatchExecutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3338,31 +3613,39 @@ func (x *EventConfigChanged) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventConfigChanged.ProtoReflect.Descriptor instead.
-func (*EventConfigChanged) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchExecutionRequest.ProtoReflect.Descriptor instead.
+func (*WatchExecutionRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{52}
 }
 
-type EventHeartbeat struct {
+func (x *WatchExecutionRequest) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+type WatchExecutionUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Update        string                 `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventHeartbeat) Reset() {
-	*x = EventHeartbeat{}
+func (x *WatchExecutionUpdate) Reset() {
+	*x = WatchExecutionUpdate{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventHeartbeat) String() string {
+func (x *WatchExecutionUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventHeartbeat) ProtoMessage() {}
+func (*WatchExecutionUpdate) ProtoMessage() {}
 
-func (x *EventHeartbeat) ProtoReflect() protoreflect.Message {
+func (x *WatchExecutionUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3374,32 +3657,40 @@ func (x *EventHeartbeat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventHeartbeat.ProtoReflect.Descriptor instead.
-func (*EventHeartbeat) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchExecutionUpdate.ProtoReflect.Descriptor instead.
+func (*WatchExecutionUpdate) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{53}
 }
 
-type EventExecutionFinished struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LogEntry      *LogEntry              `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WatchExecutionUpdate) GetUpdate() string {
+	if x != nil {
+		return x.Update
+	}
+	return ""
 }
 
-func (x *EventExecutionFinished) Reset() {
-	*x = EventExecutionFinished{}
+type ExecutionStatusRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	ActionId            string                 `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ExecutionStatusRequest) Reset() {
+	*x = ExecutionStatusRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventExecutionFinished) String() string {
+func (x *ExecutionStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventExecutionFinished) ProtoMessage() {}
+func (*ExecutionStatusRequest) ProtoMessage() {}
 
-func (x *EventExecutionFinished) ProtoReflect() protoreflect.Message {
+func (x *ExecutionStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3411,39 +3702,49 @@ func (x *EventExecutionFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventExecutionFinished.ProtoReflect.Descriptor instead.
-func (*EventExecutionFinished) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionStatusRequest.ProtoReflect.Descriptor instead.
+func (*ExecutionStatusRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *EventExecutionFinished) GetLogEntry() *LogEntry {
+func (x *ExecutionStatusRequest) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.LogEntry
+		return x.ExecutionTrackingId
 	}
-	return nil
+	return ""
 }
 
-type EventExecutionStarted struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LogEntry      *LogEntry              `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
-	unknownFields protoimpl.UnknownFields
+func (x *ExecutionStatusRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+type DashboardNavigationTarget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	EntityType    string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityKey     string                 `protobuf:"bytes,3,opt,name=entity_key,json=entityKey,proto3" json:"entity_key,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventExecutionStarted) Reset() {
-	*x = EventExecutionStarted{}
+func (x *DashboardNavigationTarget) Reset() {
+	*x = DashboardNavigationTarget{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventExecutionStarted) String() string {
+func (x *DashboardNavigationTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventExecutionStarted) ProtoMessage() {}
+func (*DashboardNavigationTarget) ProtoMessage() {}
 
-func (x *EventExecutionStarted) ProtoReflect() protoreflect.Message {
+func (x *DashboardNavigationTarget) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3455,39 +3756,61 @@ func (x *EventExecutionStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventExecutionStarted.ProtoReflect.Descriptor instead.
-func (*EventExecutionStarted) Descriptor() ([]byte, []int) {
+// Deprecated: Use DashboardNavigationTarget.ProtoReflect.Descriptor instead.
+func (*DashboardNavigationTarget) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *EventExecutionStarted) GetLogEntry() *LogEntry {
+func (x *DashboardNavigationTarget) GetTitle() string {
 	if x != nil {
-		return x.LogEntry
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-type KillActionRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *DashboardNavigationTarget) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
 }
 
-func (x *KillActionRequest) Reset() {
-	*x = KillActionRequest{}
+func (x *DashboardNavigationTarget) GetEntityKey() string {
+	if x != nil {
+		return x.EntityKey
+	}
+	return ""
+}
+
+func (x *DashboardNavigationTarget) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ExecutionStatusResponse struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	LogEntry         *LogEntry                    `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	BackToDashboards []*DashboardNavigationTarget `protobuf:"bytes,2,rep,name=back_to_dashboards,json=backToDashboards,proto3" json:"back_to_dashboards,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExecutionStatusResponse) Reset() {
+	*x = ExecutionStatusResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KillActionRequest) String() string {
+func (x *ExecutionStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillActionRequest) ProtoMessage() {}
+func (*ExecutionStatusResponse) ProtoMessage() {}
 
-func (x *KillActionRequest) ProtoReflect() protoreflect.Message {
+func (x *ExecutionStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3499,42 +3822,45 @@ func (x *KillActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillActionRequest.ProtoReflect.Descriptor instead.
-func (*KillActionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExecutionStatusResponse.ProtoReflect.Descriptor instead.
+func (*ExecutionStatusResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *KillActionRequest) GetExecutionTrackingId() string {
+func (x *ExecutionStatusResponse) GetLogEntry() *LogEntry {
 	if x != nil {
-		return x.ExecutionTrackingId
+		return x.LogEntry
 	}
-	return ""
+	return nil
 }
 
-type KillActionResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
-	Killed              bool                   `protobuf:"varint,2,opt,name=killed,proto3" json:"killed,omitempty"`
-	AlreadyCompleted    bool                   `protobuf:"varint,3,opt,name=already_completed,json=alreadyCompleted,proto3" json:"already_completed,omitempty"`
-	Found               bool                   `protobuf:"varint,4,opt,name=found,proto3" json:"found,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *ExecutionStatusResponse) GetBackToDashboards() []*DashboardNavigationTarget {
+	if x != nil {
+		return x.BackToDashboards
+	}
+	return nil
 }
 
-func (x *KillActionResponse) Reset() {
-	*x = KillActionResponse{}
+type WhoAmIRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *KillActionResponse) String() string {
+func (x *WhoAmIRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KillActionResponse) ProtoMessage() {}
+func (*WhoAmIRequest) ProtoMessage() {}
 
-func (x *KillActionResponse) ProtoReflect() protoreflect.Message {
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3546,61 +3872,38 @@ func (x *KillActionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KillActionResponse.ProtoReflect.Descriptor instead.
-func (*KillActionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *KillActionResponse) GetExecutionTrackingId() string {
-	if x != nil {
-		return x.ExecutionTrackingId
-	}
-	return ""
-}
-
-func (x *KillActionResponse) GetKilled() bool {
-	if x != nil {
-		return x.Killed
-	}
-	return false
-}
-
-func (x *KillActionResponse) GetAlreadyCompleted() bool {
-	if x != nil {
-		return x.AlreadyCompleted
-	}
-	return false
-}
-
-func (x *KillActionResponse) GetFound() bool {
-	if x != nil {
-		return x.Found
-	}
-	return false
-}
-
-type LocalUserLoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WhoAmIResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	AuthenticatedUser string                 `protobuf:"bytes,1,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
+	Usergroup         string                 `protobuf:"bytes,2,opt,name=usergroup,proto3" json:"usergroup,omitempty"`
+	Provider          string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	Acls              []string               `protobuf:"bytes,4,rep,name=acls,proto3" json:"acls,omitempty"`
+	Sid               string                 `protobuf:"bytes,5,opt,name=sid,proto3" json:"sid,omitempty"`
+	TotpAvailable     bool                   `protobuf:"varint,6,opt,name=totp_available,json=totpAvailable,proto3" json:"totp_available,omitempty"`
+	TotpEnrolled      bool                   `protobuf:"varint,7,opt,name=totp_enrolled,json=totpEnrolled,proto3" json:"totp_enrolled,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *LocalUserLoginRequest) Reset() {
-	*x = LocalUserLoginRequest{}
+func (x *WhoAmIResponse) Reset() {
+	*x = WhoAmIResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocalUserLoginRequest) String() string {
+func (x *WhoAmIResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocalUserLoginRequest) ProtoMessage() {}
+func (*WhoAmIResponse) ProtoMessage() {}
 
-func (x *LocalUserLoginRequest) ProtoReflect() protoreflect.Message {
+func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3612,46 +3915,80 @@ func (x *LocalUserLoginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocalUserLoginRequest.ProtoReflect.Descriptor instead.
-func (*LocalUserLoginRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *LocalUserLoginRequest) GetUsername() string {
+func (x *WhoAmIResponse) GetAuthenticatedUser() string {
 	if x != nil {
-		return x.Username
+		return x.AuthenticatedUser
 	}
 	return ""
 }
 
-func (x *LocalUserLoginRequest) GetPassword() string {
+func (x *WhoAmIResponse) GetUsergroup() string {
 	if x != nil {
-		return x.Password
+		return x.Usergroup
 	}
 	return ""
 }
 
-type LocalUserLoginResponse struct {
+func (x *WhoAmIResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetAcls() []string {
+	if x != nil {
+		return x.Acls
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetTotpAvailable() bool {
+	if x != nil {
+		return x.TotpAvailable
+	}
+	return false
+}
+
+func (x *WhoAmIResponse) GetTotpEnrolled() bool {
+	if x != nil {
+		return x.TotpEnrolled
+	}
+	return false
+}
+
+type ServerDiagnosticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LocalUserLoginResponse) Reset() {
-	*x = LocalUserLoginResponse{}
+func (x *ServerDiagnosticsRequest) Reset() {
+	*x = ServerDiagnosticsRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LocalUserLoginResponse) String() string {
+func (x *ServerDiagnosticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LocalUserLoginResponse) ProtoMessage() {}
+func (*ServerDiagnosticsRequest) ProtoMessage() {}
 
-func (x *LocalUserLoginResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerDiagnosticsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3663,39 +4000,32 @@ func (x *LocalUserLoginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LocalUserLoginResponse.ProtoReflect.Descriptor instead.
-func (*LocalUserLoginResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*ServerDiagnosticsRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *LocalUserLoginResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type PasswordHashRequest struct {
+type ServerDiagnosticsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Alert         string                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordHashRequest) Reset() {
-	*x = PasswordHashRequest{}
+func (x *ServerDiagnosticsResponse) Reset() {
+	*x = ServerDiagnosticsResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordHashRequest) String() string {
+func (x *ServerDiagnosticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordHashRequest) ProtoMessage() {}
+func (*ServerDiagnosticsResponse) ProtoMessage() {}
 
-func (x *PasswordHashRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerDiagnosticsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3707,39 +4037,38 @@ func (x *PasswordHashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordHashRequest.ProtoReflect.Descriptor instead.
-func (*PasswordHashRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use ServerDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*ServerDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *PasswordHashRequest) GetPassword() string {
+func (x *ServerDiagnosticsResponse) GetAlert() string {
 	if x != nil {
-		return x.Password
+		return x.Alert
 	}
 	return ""
 }
 
-type PasswordHashResponse struct {
+type DumpVarsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordHashResponse) Reset() {
-	*x = PasswordHashResponse{}
+func (x *DumpVarsRequest) Reset() {
+	*x = DumpVarsRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordHashResponse) String() string {
+func (x *DumpVarsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordHashResponse) ProtoMessage() {}
+func (*DumpVarsRequest) ProtoMessage() {}
 
-func (x *PasswordHashResponse) ProtoReflect() protoreflect.Message {
+func (x *DumpVarsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3751,38 +4080,33 @@ func (x *PasswordHashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordHashResponse.ProtoReflect.Descriptor instead.
-func (*PasswordHashResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DumpVarsRequest.ProtoReflect.Descriptor instead.
+func (*DumpVarsRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *PasswordHashResponse) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
-}
-
-type LogoutRequest struct {
+type DumpVarsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         string                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	Contents      map[string]string      `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutRequest) Reset() {
-	*x = LogoutRequest{}
+func (x *DumpVarsResponse) Reset() {
+	*x = DumpVarsResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutRequest) String() string {
+func (x *DumpVarsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutRequest) ProtoMessage() {}
+func (*DumpVarsResponse) ProtoMessage() {}
 
-func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+func (x *DumpVarsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3794,31 +4118,47 @@ func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
-func (*LogoutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DumpVarsResponse.ProtoReflect.Descriptor instead.
+func (*DumpVarsResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{62}
 }
 
-type LogoutResponse struct {
+func (x *DumpVarsResponse) GetAlert() string {
+	if x != nil {
+		return x.Alert
+	}
+	return ""
+}
+
+func (x *DumpVarsResponse) GetContents() map[string]string {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+type DebugBinding struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActionTitle   string                 `protobuf:"bytes,1,opt,name=action_title,json=actionTitle,proto3" json:"action_title,omitempty"`
+	EntityPrefix  string                 `protobuf:"bytes,2,opt,name=entity_prefix,json=entityPrefix,proto3" json:"entity_prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogoutResponse) Reset() {
-	*x = LogoutResponse{}
+func (x *DebugBinding) Reset() {
+	*x = DebugBinding{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogoutResponse) String() string {
+func (x *DebugBinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogoutResponse) ProtoMessage() {}
+func (*DebugBinding) ProtoMessage() {}
 
-func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+func (x *DebugBinding) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3830,31 +4170,45 @@ func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
-func (*LogoutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DebugBinding.ProtoReflect.Descriptor instead.
+func (*DebugBinding) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{63}
 }
 
-type GetDiagnosticsRequest struct {
+func (x *DebugBinding) GetActionTitle() string {
+	if x != nil {
+		return x.ActionTitle
+	}
+	return ""
+}
+
+func (x *DebugBinding) GetEntityPrefix() string {
+	if x != nil {
+		return x.EntityPrefix
+	}
+	return ""
+}
+
+type DumpPublicIdActionMapRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDiagnosticsRequest) Reset() {
-	*x = GetDiagnosticsRequest{}
+func (x *DumpPublicIdActionMapRequest) Reset() {
+	*x = DumpPublicIdActionMapRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDiagnosticsRequest) String() string {
+func (x *DumpPublicIdActionMapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiagnosticsRequest) ProtoMessage() {}
+func (*DumpPublicIdActionMapRequest) ProtoMessage() {}
 
-func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+func (x *DumpPublicIdActionMapRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3866,33 +4220,33 @@ func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
-func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DumpPublicIdActionMapRequest.ProtoReflect.Descriptor instead.
+func (*DumpPublicIdActionMapRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{64}
 }
 
-type GetDiagnosticsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SshFoundKey    string                 `protobuf:"bytes,1,opt,name=SshFoundKey,proto3" json:"SshFoundKey,omitempty"`
-	SshFoundConfig string                 `protobuf:"bytes,2,opt,name=SshFoundConfig,proto3" json:"SshFoundConfig,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DumpPublicIdActionMapResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Alert         string                   `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	Contents      map[string]*DebugBinding `protobuf:"bytes,2,rep,name=contents,proto3" json:"contents,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDiagnosticsResponse) Reset() {
-	*x = GetDiagnosticsResponse{}
+func (x *DumpPublicIdActionMapResponse) Reset() {
+	*x = DumpPublicIdActionMapResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDiagnosticsResponse) String() string {
+func (x *DumpPublicIdActionMapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiagnosticsResponse) ProtoMessage() {}
+func (*DumpPublicIdActionMapResponse) ProtoMessage() {}
 
-func (x *GetDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+func (x *DumpPublicIdActionMapResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3904,45 +4258,45 @@ func (x *GetDiagnosticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiagnosticsResponse.ProtoReflect.Descriptor instead.
-func (*GetDiagnosticsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DumpPublicIdActionMapResponse.ProtoReflect.Descriptor instead.
+func (*DumpPublicIdActionMapResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetDiagnosticsResponse) GetSshFoundKey() string {
+func (x *DumpPublicIdActionMapResponse) GetAlert() string {
 	if x != nil {
-		return x.SshFoundKey
+		return x.Alert
 	}
 	return ""
 }
 
-func (x *GetDiagnosticsResponse) GetSshFoundConfig() string {
+func (x *DumpPublicIdActionMapResponse) GetContents() map[string]*DebugBinding {
 	if x != nil {
-		return x.SshFoundConfig
+		return x.Contents
 	}
-	return ""
+	return nil
 }
 
-type InitRequest struct {
+type GetReadyzRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitRequest) Reset() {
-	*x = InitRequest{}
+func (x *GetReadyzRequest) Reset() {
+	*x = GetReadyzRequest{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitRequest) String() string {
+func (x *GetReadyzRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitRequest) ProtoMessage() {}
+func (*GetReadyzRequest) ProtoMessage() {}
 
-func (x *InitRequest) ProtoReflect() protoreflect.Message {
+func (x *GetReadyzRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3954,56 +4308,32 @@ func (x *InitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
-func (*InitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReadyzRequest.ProtoReflect.Descriptor instead.
+func (*GetReadyzRequest) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{66}
 }
 
-type InitResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	ShowFooter                bool                   `protobuf:"varint,1,opt,name=showFooter,proto3" json:"showFooter,omitempty"`
-	ShowNavigation            bool                   `protobuf:"varint,2,opt,name=showNavigation,proto3" json:"showNavigation,omitempty"`
-	ShowNewVersions           bool                   `protobuf:"varint,3,opt,name=showNewVersions,proto3" json:"showNewVersions,omitempty"`
-	AvailableVersion          string                 `protobuf:"bytes,4,opt,name=availableVersion,proto3" json:"availableVersion,omitempty"`
-	CurrentVersion            string                 `protobuf:"bytes,5,opt,name=currentVersion,proto3" json:"currentVersion,omitempty"`
-	PageTitle                 string                 `protobuf:"bytes,6,opt,name=pageTitle,proto3" json:"pageTitle,omitempty"`
-	SectionNavigationStyle    string                 `protobuf:"bytes,7,opt,name=sectionNavigationStyle,proto3" json:"sectionNavigationStyle,omitempty"`
-	DefaultIconForBack        string                 `protobuf:"bytes,8,opt,name=defaultIconForBack,proto3" json:"defaultIconForBack,omitempty"`
-	EnableCustomJs            bool                   `protobuf:"varint,9,opt,name=enableCustomJs,proto3" json:"enableCustomJs,omitempty"`
-	AuthLoginUrl              string                 `protobuf:"bytes,10,opt,name=authLoginUrl,proto3" json:"authLoginUrl,omitempty"`
-	AuthLocalLogin            bool                   `protobuf:"varint,11,opt,name=authLocalLogin,proto3" json:"authLocalLogin,omitempty"`
-	StyleMods                 []string               `protobuf:"bytes,12,rep,name=styleMods,proto3" json:"styleMods,omitempty"`
-	OAuth2Providers           []*OAuth2Provider      `protobuf:"bytes,13,rep,name=oAuth2Providers,proto3" json:"oAuth2Providers,omitempty"`
-	AdditionalLinks           []*AdditionalLink      `protobuf:"bytes,14,rep,name=additionalLinks,proto3" json:"additionalLinks,omitempty"`
-	RootDashboards            []string               `protobuf:"bytes,15,rep,name=rootDashboards,proto3" json:"rootDashboards,omitempty"`
-	AuthenticatedUser         string                 `protobuf:"bytes,16,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
-	AuthenticatedUserProvider string                 `protobuf:"bytes,17,opt,name=authenticated_user_provider,json=authenticatedUserProvider,proto3" json:"authenticated_user_provider,omitempty"`
-	EffectivePolicy           *EffectivePolicy       `protobuf:"bytes,18,opt,name=effective_policy,json=effectivePolicy,proto3" json:"effective_policy,omitempty"`
-	BannerMessage             string                 `protobuf:"bytes,19,opt,name=banner_message,json=bannerMessage,proto3" json:"banner_message,omitempty"`
-	BannerCss                 string                 `protobuf:"bytes,20,opt,name=banner_css,json=bannerCss,proto3" json:"banner_css,omitempty"`
-	ShowDiagnostics           bool                   `protobuf:"varint,21,opt,name=show_diagnostics,json=showDiagnostics,proto3" json:"show_diagnostics,omitempty"`
-	ShowLogList               bool                   `protobuf:"varint,22,opt,name=show_log_list,json=showLogList,proto3" json:"show_log_list,omitempty"`
-	LoginRequired             bool                   `protobuf:"varint,23,opt,name=login_required,json=loginRequired,proto3" json:"login_required,omitempty"`
-	AvailableThemes           []string               `protobuf:"bytes,24,rep,name=available_themes,json=availableThemes,proto3" json:"available_themes,omitempty"` // List of available theme names
-	ShowNavigateOnStartIcons  bool                   `protobuf:"varint,25,opt,name=show_navigate_on_start_icons,json=showNavigateOnStartIcons,proto3" json:"show_navigate_on_start_icons,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type GetReadyzResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitResponse) Reset() {
-	*x = InitResponse{}
+func (x *GetReadyzResponse) Reset() {
+	*x = GetReadyzResponse{}
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitResponse) String() string {
+func (x *GetReadyzResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitResponse) ProtoMessage() {}
+func (*GetReadyzResponse) ProtoMessage() {}
 
-func (x *InitResponse) ProtoReflect() protoreflect.Message {
+func (x *GetReadyzResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4015,209 +4345,2348 @@ func (x *InitResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
-func (*InitResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReadyzResponse.ProtoReflect.Descriptor instead.
+func (*GetReadyzResponse) Descriptor() ([]byte, []int) {
 	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *InitResponse) GetShowFooter() bool {
+func (x *GetReadyzResponse) GetStatus() string {
 	if x != nil {
-		return x.ShowFooter
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-func (x *InitResponse) GetShowNavigation() bool {
-	if x != nil {
-		return x.ShowNavigation
-	}
-	return false
+type EventStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InitResponse) GetShowNewVersions() bool {
-	if x != nil {
-		return x.ShowNewVersions
-	}
-	return false
+func (x *EventStreamRequest) Reset() {
+	*x = EventStreamRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitResponse) GetAvailableVersion() string {
-	if x != nil {
-		return x.AvailableVersion
-	}
-	return ""
+func (x *EventStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InitResponse) GetCurrentVersion() string {
+func (*EventStreamRequest) ProtoMessage() {}
+
+func (x *EventStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[68]
 	if x != nil {
-		return x.CurrentVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InitResponse) GetPageTitle() string {
-	if x != nil {
+// Deprecated: Use EventStreamRequest.ProtoReflect.Descriptor instead.
+func (*EventStreamRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{68}
+}
+
+type EventStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*EventStreamResponse_EntityChanged
+	//	*EventStreamResponse_ConfigChanged
+	//	*EventStreamResponse_ExecutionFinished
+	//	*EventStreamResponse_ExecutionStarted
+	//	*EventStreamResponse_OutputChunk
+	//	*EventStreamResponse_Heartbeat
+	//	*EventStreamResponse_ApprovalRequested
+	//	*EventStreamResponse_ApprovalResolved
+	Event         isEventStreamResponse_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventStreamResponse) Reset() {
+	*x = EventStreamResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventStreamResponse) ProtoMessage() {}
+
+func (x *EventStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventStreamResponse.ProtoReflect.Descriptor instead.
+func (*EventStreamResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *EventStreamResponse) GetEvent() isEventStreamResponse_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetEntityChanged() *EventEntityChanged {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_EntityChanged); ok {
+			return x.EntityChanged
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetConfigChanged() *EventConfigChanged {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_ConfigChanged); ok {
+			return x.ConfigChanged
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetExecutionFinished() *EventExecutionFinished {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_ExecutionFinished); ok {
+			return x.ExecutionFinished
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetExecutionStarted() *EventExecutionStarted {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_ExecutionStarted); ok {
+			return x.ExecutionStarted
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetOutputChunk() *EventOutputChunk {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_OutputChunk); ok {
+			return x.OutputChunk
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetHeartbeat() *EventHeartbeat {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetApprovalRequested() *EventApprovalRequested {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_ApprovalRequested); ok {
+			return x.ApprovalRequested
+		}
+	}
+	return nil
+}
+
+func (x *EventStreamResponse) GetApprovalResolved() *EventApprovalResolved {
+	if x != nil {
+		if x, ok := x.Event.(*EventStreamResponse_ApprovalResolved); ok {
+			return x.ApprovalResolved
+		}
+	}
+	return nil
+}
+
+type isEventStreamResponse_Event interface {
+	isEventStreamResponse_Event()
+}
+
+type EventStreamResponse_EntityChanged struct {
+	EntityChanged *EventEntityChanged `protobuf:"bytes,2,opt,name=entity_changed,json=entityChanged,proto3,oneof"`
+}
+
+type EventStreamResponse_ConfigChanged struct {
+	ConfigChanged *EventConfigChanged `protobuf:"bytes,3,opt,name=config_changed,json=configChanged,proto3,oneof"`
+}
+
+type EventStreamResponse_ExecutionFinished struct {
+	ExecutionFinished *EventExecutionFinished `protobuf:"bytes,4,opt,name=execution_finished,json=executionFinished,proto3,oneof"`
+}
+
+type EventStreamResponse_ExecutionStarted struct {
+	ExecutionStarted *EventExecutionStarted `protobuf:"bytes,5,opt,name=execution_started,json=executionStarted,proto3,oneof"`
+}
+
+type EventStreamResponse_OutputChunk struct {
+	OutputChunk *EventOutputChunk `protobuf:"bytes,6,opt,name=output_chunk,json=outputChunk,proto3,oneof"`
+}
+
+type EventStreamResponse_Heartbeat struct {
+	Heartbeat *EventHeartbeat `protobuf:"bytes,7,opt,name=heartbeat,proto3,oneof"`
+}
+
+type EventStreamResponse_ApprovalRequested struct {
+	ApprovalRequested *EventApprovalRequested `protobuf:"bytes,8,opt,name=approval_requested,json=approvalRequested,proto3,oneof"`
+}
+
+type EventStreamResponse_ApprovalResolved struct {
+	ApprovalResolved *EventApprovalResolved `protobuf:"bytes,9,opt,name=approval_resolved,json=approvalResolved,proto3,oneof"`
+}
+
+func (*EventStreamResponse_EntityChanged) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_ConfigChanged) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_ExecutionFinished) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_ExecutionStarted) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_OutputChunk) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_Heartbeat) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_ApprovalRequested) isEventStreamResponse_Event() {}
+
+func (*EventStreamResponse_ApprovalResolved) isEventStreamResponse_Event() {}
+
+type EventOutputChunk struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	Output              string                 `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	Stream              string                 `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`     // "stdout" or "stderr"
+	Datetime            string                 `protobuf:"bytes,4,opt,name=datetime,proto3" json:"datetime,omitempty"` // When the chunk was written, format: "2006-01-02 15:04:05.000"
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EventOutputChunk) Reset() {
+	*x = EventOutputChunk{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventOutputChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventOutputChunk) ProtoMessage() {}
+
+func (x *EventOutputChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventOutputChunk.ProtoReflect.Descriptor instead.
+func (*EventOutputChunk) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *EventOutputChunk) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+func (x *EventOutputChunk) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *EventOutputChunk) GetStream() string {
+	if x != nil {
+		return x.Stream
+	}
+	return ""
+}
+
+func (x *EventOutputChunk) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
+}
+
+type EventEntityChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityName    string                 `protobuf:"bytes,1,opt,name=entity_name,json=entityName,proto3" json:"entity_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventEntityChanged) Reset() {
+	*x = EventEntityChanged{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventEntityChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventEntityChanged) ProtoMessage() {}
+
+func (x *EventEntityChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventEntityChanged.ProtoReflect.Descriptor instead.
+func (*EventEntityChanged) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *EventEntityChanged) GetEntityName() string {
+	if x != nil {
+		return x.EntityName
+	}
+	return ""
+}
+
+type EventConfigChanged struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventConfigChanged) Reset() {
+	*x = EventConfigChanged{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventConfigChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConfigChanged) ProtoMessage() {}
+
+func (x *EventConfigChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventConfigChanged.ProtoReflect.Descriptor instead.
+func (*EventConfigChanged) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{72}
+}
+
+type EventHeartbeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventHeartbeat) Reset() {
+	*x = EventHeartbeat{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHeartbeat) ProtoMessage() {}
+
+func (x *EventHeartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventHeartbeat.ProtoReflect.Descriptor instead.
+func (*EventHeartbeat) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{73}
+}
+
+type EventExecutionFinished struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogEntry      *LogEntry              `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventExecutionFinished) Reset() {
+	*x = EventExecutionFinished{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventExecutionFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventExecutionFinished) ProtoMessage() {}
+
+func (x *EventExecutionFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventExecutionFinished.ProtoReflect.Descriptor instead.
+func (*EventExecutionFinished) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *EventExecutionFinished) GetLogEntry() *LogEntry {
+	if x != nil {
+		return x.LogEntry
+	}
+	return nil
+}
+
+type EventExecutionStarted struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogEntry      *LogEntry              `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventExecutionStarted) Reset() {
+	*x = EventExecutionStarted{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventExecutionStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventExecutionStarted) ProtoMessage() {}
+
+func (x *EventExecutionStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventExecutionStarted.ProtoReflect.Descriptor instead.
+func (*EventExecutionStarted) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *EventExecutionStarted) GetLogEntry() *LogEntry {
+	if x != nil {
+		return x.LogEntry
+	}
+	return nil
+}
+
+type KillActionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KillActionRequest) Reset() {
+	*x = KillActionRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillActionRequest) ProtoMessage() {}
+
+func (x *KillActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillActionRequest.ProtoReflect.Descriptor instead.
+func (*KillActionRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *KillActionRequest) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+type KillActionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	Killed              bool                   `protobuf:"varint,2,opt,name=killed,proto3" json:"killed,omitempty"`
+	AlreadyCompleted    bool                   `protobuf:"varint,3,opt,name=already_completed,json=alreadyCompleted,proto3" json:"already_completed,omitempty"`
+	Found               bool                   `protobuf:"varint,4,opt,name=found,proto3" json:"found,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *KillActionResponse) Reset() {
+	*x = KillActionResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillActionResponse) ProtoMessage() {}
+
+func (x *KillActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillActionResponse.ProtoReflect.Descriptor instead.
+func (*KillActionResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *KillActionResponse) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+func (x *KillActionResponse) GetKilled() bool {
+	if x != nil {
+		return x.Killed
+	}
+	return false
+}
+
+func (x *KillActionResponse) GetAlreadyCompleted() bool {
+	if x != nil {
+		return x.AlreadyCompleted
+	}
+	return false
+}
+
+func (x *KillActionResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type LocalUserLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	TotpChallenge string                 `protobuf:"bytes,3,opt,name=totp_challenge,json=totpChallenge,proto3" json:"totp_challenge,omitempty"`
+	TotpCode      string                 `protobuf:"bytes,4,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalUserLoginRequest) Reset() {
+	*x = LocalUserLoginRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalUserLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalUserLoginRequest) ProtoMessage() {}
+
+func (x *LocalUserLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalUserLoginRequest.ProtoReflect.Descriptor instead.
+func (*LocalUserLoginRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LocalUserLoginRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LocalUserLoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LocalUserLoginRequest) GetTotpChallenge() string {
+	if x != nil {
+		return x.TotpChallenge
+	}
+	return ""
+}
+
+func (x *LocalUserLoginRequest) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type LocalUserLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TotpRequired  bool                   `protobuf:"varint,2,opt,name=totp_required,json=totpRequired,proto3" json:"totp_required,omitempty"`
+	TotpChallenge string                 `protobuf:"bytes,3,opt,name=totp_challenge,json=totpChallenge,proto3" json:"totp_challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalUserLoginResponse) Reset() {
+	*x = LocalUserLoginResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalUserLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalUserLoginResponse) ProtoMessage() {}
+
+func (x *LocalUserLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalUserLoginResponse.ProtoReflect.Descriptor instead.
+func (*LocalUserLoginResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LocalUserLoginResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *LocalUserLoginResponse) GetTotpRequired() bool {
+	if x != nil {
+		return x.TotpRequired
+	}
+	return false
+}
+
+func (x *LocalUserLoginResponse) GetTotpChallenge() string {
+	if x != nil {
+		return x.TotpChallenge
+	}
+	return ""
+}
+
+type BeginTotpEnrolmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrolmentRequest) Reset() {
+	*x = BeginTotpEnrolmentRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrolmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrolmentRequest) ProtoMessage() {}
+
+func (x *BeginTotpEnrolmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrolmentRequest.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrolmentRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{80}
+}
+
+type BeginTotpEnrolmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	QrCodePng     []byte                 `protobuf:"bytes,3,opt,name=qr_code_png,json=qrCodePng,proto3" json:"qr_code_png,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrolmentResponse) Reset() {
+	*x = BeginTotpEnrolmentResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrolmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrolmentResponse) ProtoMessage() {}
+
+func (x *BeginTotpEnrolmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrolmentResponse.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrolmentResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BeginTotpEnrolmentResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTotpEnrolmentResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *BeginTotpEnrolmentResponse) GetQrCodePng() []byte {
+	if x != nil {
+		return x.QrCodePng
+	}
+	return nil
+}
+
+type ConfirmTotpEnrolmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpEnrolmentRequest) Reset() {
+	*x = ConfirmTotpEnrolmentRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpEnrolmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpEnrolmentRequest) ProtoMessage() {}
+
+func (x *ConfirmTotpEnrolmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpEnrolmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrolmentRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ConfirmTotpEnrolmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTotpEnrolmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpEnrolmentResponse) Reset() {
+	*x = ConfirmTotpEnrolmentResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpEnrolmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpEnrolmentResponse) ProtoMessage() {}
+
+func (x *ConfirmTotpEnrolmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpEnrolmentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrolmentResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ConfirmTotpEnrolmentResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpRequest) Reset() {
+	*x = DisableTotpRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpRequest) ProtoMessage() {}
+
+func (x *DisableTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpRequest.ProtoReflect.Descriptor instead.
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DisableTotpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpResponse) Reset() {
+	*x = DisableTotpResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpResponse) ProtoMessage() {}
+
+func (x *DisableTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpResponse.ProtoReflect.Descriptor instead.
+func (*DisableTotpResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{85}
+}
+
+type PasswordHashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordHashRequest) Reset() {
+	*x = PasswordHashRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordHashRequest) ProtoMessage() {}
+
+func (x *PasswordHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordHashRequest.ProtoReflect.Descriptor instead.
+func (*PasswordHashRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *PasswordHashRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type PasswordHashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordHashResponse) Reset() {
+	*x = PasswordHashResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordHashResponse) ProtoMessage() {}
+
+func (x *PasswordHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordHashResponse.ProtoReflect.Descriptor instead.
+func (*PasswordHashResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PasswordHashResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{88}
+}
+
+type LogoutResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set when the user should also be logged out of an OpenID Connect provider.
+	RedirectUrl   string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *LogoutResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{90}
+}
+
+type GetDiagnosticsResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	SshFoundKey              string                 `protobuf:"bytes,1,opt,name=SshFoundKey,proto3" json:"SshFoundKey,omitempty"`
+	SshFoundConfig           string                 `protobuf:"bytes,2,opt,name=SshFoundConfig,proto3" json:"SshFoundConfig,omitempty"`
+	WebhookDeliveries        []*WebhookDelivery     `protobuf:"bytes,3,rep,name=webhook_deliveries,json=webhookDeliveries,proto3" json:"webhook_deliveries,omitempty"` // Newest first
+	WebhookDeliveriesPending int32                  `protobuf:"varint,4,opt,name=webhook_deliveries_pending,json=webhookDeliveriesPending,proto3" json:"webhook_deliveries_pending,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsResponse) Reset() {
+	*x = GetDiagnosticsResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetDiagnosticsResponse) GetSshFoundKey() string {
+	if x != nil {
+		return x.SshFoundKey
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetSshFoundConfig() string {
+	if x != nil {
+		return x.SshFoundConfig
+	}
+	return ""
+}
+
+func (x *GetDiagnosticsResponse) GetWebhookDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.WebhookDeliveries
+	}
+	return nil
+}
+
+func (x *GetDiagnosticsResponse) GetWebhookDeliveriesPending() int32 {
+	if x != nil {
+		return x.WebhookDeliveriesPending
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Datetime            string                 `protobuf:"bytes,1,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Webhook             string                 `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Event               string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	ExecutionTrackingId string                 `protobuf:"bytes,4,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	Attempt             int32                  `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Status              string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Delivered           bool                   `protobuf:"varint,7,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	WillRetry           bool                   `protobuf:"varint,8,opt,name=will_retry,json=willRetry,proto3" json:"will_retry,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *WebhookDelivery) GetDatetime() string {
+	if x != nil {
+		return x.Datetime
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhook() string {
+	if x != nil {
+		return x.Webhook
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetExecutionTrackingId() string {
+	if x != nil {
+		return x.ExecutionTrackingId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *WebhookDelivery) GetWillRetry() bool {
+	if x != nil {
+		return x.WillRetry
+	}
+	return false
+}
+
+type GetAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Since         string                 `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"` // RFC3339
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogRequest) ProtoMessage() {}
+
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetAuditLogRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Newest first
+	ChainValid    bool                   `protobuf:"varint,2,opt,name=chain_valid,json=chainValid,proto3" json:"chain_valid,omitempty"`
+	ChainError    string                 `protobuf:"bytes,3,opt,name=chain_error,json=chainError,proto3" json:"chain_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *GetAuditLogResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetAuditLogResponse) GetChainValid() bool {
+	if x != nil {
+		return x.ChainValid
+	}
+	return false
+}
+
+func (x *GetAuditLogResponse) GetChainError() string {
+	if x != nil {
+		return x.ChainError
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        int32                  `protobuf:"varint,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Seq           uint64                 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Time          string                 `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Outcome       string                 `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Username      string                 `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	Provider      string                 `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`
+	TrackingId    string                 `protobuf:"bytes,9,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	Permission    string                 `protobuf:"bytes,10,opt,name=permission,proto3" json:"permission,omitempty"`
+	Detail        string                 `protobuf:"bytes,11,opt,name=detail,proto3" json:"detail,omitempty"`
+	PrevHash      string                 `protobuf:"bytes,12,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash          string                 `protobuf:"bytes,13,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *AuditEvent) GetSchema() int32 {
+	if x != nil {
+		return x.Schema
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTrackingId() string {
+	if x != nil {
+		return x.TrackingId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type InitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitRequest) Reset() {
+	*x = InitRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitRequest) ProtoMessage() {}
+
+func (x *InitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitRequest.ProtoReflect.Descriptor instead.
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{96}
+}
+
+type InitResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	ShowFooter                bool                   `protobuf:"varint,1,opt,name=showFooter,proto3" json:"showFooter,omitempty"`
+	ShowNavigation            bool                   `protobuf:"varint,2,opt,name=showNavigation,proto3" json:"showNavigation,omitempty"`
+	ShowNewVersions           bool                   `protobuf:"varint,3,opt,name=showNewVersions,proto3" json:"showNewVersions,omitempty"`
+	AvailableVersion          string                 `protobuf:"bytes,4,opt,name=availableVersion,proto3" json:"availableVersion,omitempty"`
+	CurrentVersion            string                 `protobuf:"bytes,5,opt,name=currentVersion,proto3" json:"currentVersion,omitempty"`
+	PageTitle                 string                 `protobuf:"bytes,6,opt,name=pageTitle,proto3" json:"pageTitle,omitempty"`
+	SectionNavigationStyle    string                 `protobuf:"bytes,7,opt,name=sectionNavigationStyle,proto3" json:"sectionNavigationStyle,omitempty"`
+	DefaultIconForBack        string                 `protobuf:"bytes,8,opt,name=defaultIconForBack,proto3" json:"defaultIconForBack,omitempty"`
+	EnableCustomJs            bool                   `protobuf:"varint,9,opt,name=enableCustomJs,proto3" json:"enableCustomJs,omitempty"`
+	AuthLoginUrl              string                 `protobuf:"bytes,10,opt,name=authLoginUrl,proto3" json:"authLoginUrl,omitempty"`
+	AuthLocalLogin            bool                   `protobuf:"varint,11,opt,name=authLocalLogin,proto3" json:"authLocalLogin,omitempty"`
+	StyleMods                 []string               `protobuf:"bytes,12,rep,name=styleMods,proto3" json:"styleMods,omitempty"`
+	OAuth2Providers           []*OAuth2Provider      `protobuf:"bytes,13,rep,name=oAuth2Providers,proto3" json:"oAuth2Providers,omitempty"`
+	AdditionalLinks           []*AdditionalLink      `protobuf:"bytes,14,rep,name=additionalLinks,proto3" json:"additionalLinks,omitempty"`
+	RootDashboards            []string               `protobuf:"bytes,15,rep,name=rootDashboards,proto3" json:"rootDashboards,omitempty"`
+	AuthenticatedUser         string                 `protobuf:"bytes,16,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
+	AuthenticatedUserProvider string                 `protobuf:"bytes,17,opt,name=authenticated_user_provider,json=authenticatedUserProvider,proto3" json:"authenticated_user_provider,omitempty"`
+	EffectivePolicy           *EffectivePolicy       `protobuf:"bytes,18,opt,name=effective_policy,json=effectivePolicy,proto3" json:"effective_policy,omitempty"`
+	BannerMessage             string                 `protobuf:"bytes,19,opt,name=banner_message,json=bannerMessage,proto3" json:"banner_message,omitempty"`
+	BannerCss                 string                 `protobuf:"bytes,20,opt,name=banner_css,json=bannerCss,proto3" json:"banner_css,omitempty"`
+	ShowDiagnostics           bool                   `protobuf:"varint,21,opt,name=show_diagnostics,json=showDiagnostics,proto3" json:"show_diagnostics,omitempty"`
+	ShowLogList               bool                   `protobuf:"varint,22,opt,name=show_log_list,json=showLogList,proto3" json:"show_log_list,omitempty"`
+	LoginRequired             bool                   `protobuf:"varint,23,opt,name=login_required,json=loginRequired,proto3" json:"login_required,omitempty"`
+	AvailableThemes           []string               `protobuf:"bytes,24,rep,name=available_themes,json=availableThemes,proto3" json:"available_themes,omitempty"` // List of available theme names
+	ShowNavigateOnStartIcons  bool                   `protobuf:"varint,25,opt,name=show_navigate_on_start_icons,json=showNavigateOnStartIcons,proto3" json:"show_navigate_on_start_icons,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *InitResponse) Reset() {
+	*x = InitResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitResponse) ProtoMessage() {}
+
+func (x *InitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitResponse.ProtoReflect.Descriptor instead.
+func (*InitResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *InitResponse) GetShowFooter() bool {
+	if x != nil {
+		return x.ShowFooter
+	}
+	return false
+}
+
+func (x *InitResponse) GetShowNavigation() bool {
+	if x != nil {
+		return x.ShowNavigation
+	}
+	return false
+}
+
+func (x *InitResponse) GetShowNewVersions() bool {
+	if x != nil {
+		return x.ShowNewVersions
+	}
+	return false
+}
+
+func (x *InitResponse) GetAvailableVersion() string {
+	if x != nil {
+		return x.AvailableVersion
+	}
+	return ""
+}
+
+func (x *InitResponse) GetCurrentVersion() string {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return ""
+}
+
+func (x *InitResponse) GetPageTitle() string {
+	if x != nil {
 		return x.PageTitle
 	}
 	return ""
 }
 
-func (x *InitResponse) GetSectionNavigationStyle() string {
+func (x *InitResponse) GetSectionNavigationStyle() string {
+	if x != nil {
+		return x.SectionNavigationStyle
+	}
+	return ""
+}
+
+func (x *InitResponse) GetDefaultIconForBack() string {
+	if x != nil {
+		return x.DefaultIconForBack
+	}
+	return ""
+}
+
+func (x *InitResponse) GetEnableCustomJs() bool {
+	if x != nil {
+		return x.EnableCustomJs
+	}
+	return false
+}
+
+func (x *InitResponse) GetAuthLoginUrl() string {
+	if x != nil {
+		return x.AuthLoginUrl
+	}
+	return ""
+}
+
+func (x *InitResponse) GetAuthLocalLogin() bool {
+	if x != nil {
+		return x.AuthLocalLogin
+	}
+	return false
+}
+
+func (x *InitResponse) GetStyleMods() []string {
+	if x != nil {
+		return x.StyleMods
+	}
+	return nil
+}
+
+func (x *InitResponse) GetOAuth2Providers() []*OAuth2Provider {
+	if x != nil {
+		return x.OAuth2Providers
+	}
+	return nil
+}
+
+func (x *InitResponse) GetAdditionalLinks() []*AdditionalLink {
+	if x != nil {
+		return x.AdditionalLinks
+	}
+	return nil
+}
+
+func (x *InitResponse) GetRootDashboards() []string {
+	if x != nil {
+		return x.RootDashboards
+	}
+	return nil
+}
+
+func (x *InitResponse) GetAuthenticatedUser() string {
+	if x != nil {
+		return x.AuthenticatedUser
+	}
+	return ""
+}
+
+func (x *InitResponse) GetAuthenticatedUserProvider() string {
+	if x != nil {
+		return x.AuthenticatedUserProvider
+	}
+	return ""
+}
+
+func (x *InitResponse) GetEffectivePolicy() *EffectivePolicy {
+	if x != nil {
+		return x.EffectivePolicy
+	}
+	return nil
+}
+
+func (x *InitResponse) GetBannerMessage() string {
+	if x != nil {
+		return x.BannerMessage
+	}
+	return ""
+}
+
+func (x *InitResponse) GetBannerCss() string {
+	if x != nil {
+		return x.BannerCss
+	}
+	return ""
+}
+
+func (x *InitResponse) GetShowDiagnostics() bool {
+	if x != nil {
+		return x.ShowDiagnostics
+	}
+	return false
+}
+
+func (x *InitResponse) GetShowLogList() bool {
+	if x != nil {
+		return x.ShowLogList
+	}
+	return false
+}
+
+func (x *InitResponse) GetLoginRequired() bool {
+	if x != nil {
+		return x.LoginRequired
+	}
+	return false
+}
+
+func (x *InitResponse) GetAvailableThemes() []string {
+	if x != nil {
+		return x.AvailableThemes
+	}
+	return nil
+}
+
+func (x *InitResponse) GetShowNavigateOnStartIcons() bool {
+	if x != nil {
+		return x.ShowNavigateOnStartIcons
+	}
+	return false
+}
+
+type AdditionalLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdditionalLink) Reset() {
+	*x = AdditionalLink{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdditionalLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdditionalLink) ProtoMessage() {}
+
+func (x *AdditionalLink) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdditionalLink.ProtoReflect.Descriptor instead.
+func (*AdditionalLink) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AdditionalLink) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AdditionalLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type OAuth2Provider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth2Provider) Reset() {
+	*x = OAuth2Provider{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2Provider) ProtoMessage() {}
+
+func (x *OAuth2Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2Provider.ProtoReflect.Descriptor instead.
+func (*OAuth2Provider) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *OAuth2Provider) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OAuth2Provider) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *OAuth2Provider) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type GetActionBindingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BindingId     string                 `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetActionBindingRequest) Reset() {
+	*x = GetActionBindingRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActionBindingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionBindingRequest) ProtoMessage() {}
+
+func (x *GetActionBindingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionBindingRequest.ProtoReflect.Descriptor instead.
+func (*GetActionBindingRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetActionBindingRequest) GetBindingId() string {
+	if x != nil {
+		return x.BindingId
+	}
+	return ""
+}
+
+type GetActionBindingResponse struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	Action           *Action                      `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	BackToDashboards []*DashboardNavigationTarget `protobuf:"bytes,2,rep,name=back_to_dashboards,json=backToDashboards,proto3" json:"back_to_dashboards,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetActionBindingResponse) Reset() {
+	*x = GetActionBindingResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetActionBindingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActionBindingResponse) ProtoMessage() {}
+
+func (x *GetActionBindingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActionBindingResponse.ProtoReflect.Descriptor instead.
+func (*GetActionBindingResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetActionBindingResponse) GetAction() *Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *GetActionBindingResponse) GetBackToDashboards() []*DashboardNavigationTarget {
+	if x != nil {
+		return x.BackToDashboards
+	}
+	return nil
+}
+
+type GetEntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntitiesRequest) Reset() {
+	*x = GetEntitiesRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitiesRequest) ProtoMessage() {}
+
+func (x *GetEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetEntitiesRequest) GetEntityType() string {
 	if x != nil {
-		return x.SectionNavigationStyle
+		return x.EntityType
 	}
 	return ""
 }
 
-func (x *InitResponse) GetDefaultIconForBack() string {
+func (x *GetEntitiesRequest) GetFilter() string {
 	if x != nil {
-		return x.DefaultIconForBack
+		return x.Filter
 	}
 	return ""
 }
 
-func (x *InitResponse) GetEnableCustomJs() bool {
+func (x *GetEntitiesRequest) GetPage() int32 {
 	if x != nil {
-		return x.EnableCustomJs
+		return x.Page
 	}
-	return false
+	return 0
 }
 
-func (x *InitResponse) GetAuthLoginUrl() string {
+func (x *GetEntitiesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.AuthLoginUrl
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *InitResponse) GetAuthLocalLogin() bool {
-	if x != nil {
-		return x.AuthLocalLogin
-	}
-	return false
+type GetEntitiesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	EntityDefinitions []*EntityDefinition    `protobuf:"bytes,1,rep,name=entity_definitions,json=entityDefinitions,proto3" json:"entity_definitions,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *InitResponse) GetStyleMods() []string {
-	if x != nil {
-		return x.StyleMods
-	}
-	return nil
+func (x *GetEntitiesResponse) Reset() {
+	*x = GetEntitiesResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitResponse) GetOAuth2Providers() []*OAuth2Provider {
-	if x != nil {
-		return x.OAuth2Providers
-	}
-	return nil
+func (x *GetEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InitResponse) GetAdditionalLinks() []*AdditionalLink {
+func (*GetEntitiesResponse) ProtoMessage() {}
+
+func (x *GetEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[103]
 	if x != nil {
-		return x.AdditionalLinks
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InitResponse) GetRootDashboards() []string {
+// Deprecated: Use GetEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetEntitiesResponse) GetEntityDefinitions() []*EntityDefinition {
 	if x != nil {
-		return x.RootDashboards
+		return x.EntityDefinitions
 	}
 	return nil
 }
 
-func (x *InitResponse) GetAuthenticatedUser() string {
-	if x != nil {
-		return x.AuthenticatedUser
-	}
-	return ""
+type EntityDefinition struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Instances        []*Entity              `protobuf:"bytes,2,rep,name=instances,proto3" json:"instances,omitempty"`
+	UsedOnDashboards []string               `protobuf:"bytes,3,rep,name=used_on_dashboards,json=usedOnDashboards,proto3" json:"used_on_dashboards,omitempty"`
+	Icon             string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Properties       []*EntityProperty      `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
+	TotalInstances   int32                  `protobuf:"varint,6,opt,name=total_instances,json=totalInstances,proto3" json:"total_instances,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *InitResponse) GetAuthenticatedUserProvider() string {
-	if x != nil {
-		return x.AuthenticatedUserProvider
-	}
-	return ""
+func (x *EntityDefinition) Reset() {
+	*x = EntityDefinition{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *InitResponse) GetEffectivePolicy() *EffectivePolicy {
-	if x != nil {
-		return x.EffectivePolicy
-	}
-	return nil
+func (x *EntityDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *InitResponse) GetBannerMessage() string {
+func (*EntityDefinition) ProtoMessage() {}
+
+func (x *EntityDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[104]
 	if x != nil {
-		return x.BannerMessage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InitResponse) GetBannerCss() string {
+// Deprecated: Use EntityDefinition.ProtoReflect.Descriptor instead.
+func (*EntityDefinition) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *EntityDefinition) GetTitle() string {
 	if x != nil {
-		return x.BannerCss
+		return x.Title
 	}
 	return ""
 }
 
-func (x *InitResponse) GetShowDiagnostics() bool {
+func (x *EntityDefinition) GetInstances() []*Entity {
 	if x != nil {
-		return x.ShowDiagnostics
+		return x.Instances
 	}
-	return false
+	return nil
 }
 
-func (x *InitResponse) GetShowLogList() bool {
+func (x *EntityDefinition) GetUsedOnDashboards() []string {
 	if x != nil {
-		return x.ShowLogList
+		return x.UsedOnDashboards
 	}
-	return false
+	return nil
 }
 
-func (x *InitResponse) GetLoginRequired() bool {
+func (x *EntityDefinition) GetIcon() string {
 	if x != nil {
-		return x.LoginRequired
+		return x.Icon
 	}
-	return false
+	return ""
 }
 
-func (x *InitResponse) GetAvailableThemes() []string {
+func (x *EntityDefinition) GetProperties() []*EntityProperty {
 	if x != nil {
-		return x.AvailableThemes
+		return x.Properties
 	}
 	return nil
 }
 
-func (x *InitResponse) GetShowNavigateOnStartIcons() bool {
+func (x *EntityDefinition) GetTotalInstances() int32 {
 	if x != nil {
-		return x.ShowNavigateOnStartIcons
+		return x.TotalInstances
 	}
-	return false
+	return 0
 }
 
-type AdditionalLink struct {
+type EntityProperty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AdditionalLink) Reset() {
-	*x = AdditionalLink{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[68]
+func (x *EntityProperty) Reset() {
+	*x = EntityProperty{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AdditionalLink) String() string {
+func (x *EntityProperty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdditionalLink) ProtoMessage() {}
+func (*EntityProperty) ProtoMessage() {}
 
-func (x *AdditionalLink) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[68]
+func (x *EntityProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4228,49 +6697,48 @@ func (x *AdditionalLink) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdditionalLink.ProtoReflect.Descriptor instead.
-func (*AdditionalLink) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use EntityProperty.ProtoReflect.Descriptor instead.
+func (*EntityProperty) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *AdditionalLink) GetTitle() string {
+func (x *EntityProperty) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AdditionalLink) GetUrl() string {
+func (x *EntityProperty) GetTitle() string {
 	if x != nil {
-		return x.Url
+		return x.Title
 	}
 	return ""
 }
 
-type OAuth2Provider struct {
+type GetEntityRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Icon          string                 `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	UniqueKey     string                 `protobuf:"bytes,1,opt,name=unique_key,json=uniqueKey,proto3" json:"unique_key,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OAuth2Provider) Reset() {
-	*x = OAuth2Provider{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[69]
+func (x *GetEntityRequest) Reset() {
+	*x = GetEntityRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OAuth2Provider) String() string {
+func (x *GetEntityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OAuth2Provider) ProtoMessage() {}
+func (*GetEntityRequest) ProtoMessage() {}
 
-func (x *OAuth2Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[69]
+func (x *GetEntityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4281,54 +6749,47 @@ func (x *OAuth2Provider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OAuth2Provider.ProtoReflect.Descriptor instead.
-func (*OAuth2Provider) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *OAuth2Provider) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use GetEntityRequest.ProtoReflect.Descriptor instead.
+func (*GetEntityRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *OAuth2Provider) GetIcon() string {
+func (x *GetEntityRequest) GetUniqueKey() string {
 	if x != nil {
-		return x.Icon
+		return x.UniqueKey
 	}
 	return ""
 }
 
-func (x *OAuth2Provider) GetKey() string {
+func (x *GetEntityRequest) GetType() string {
 	if x != nil {
-		return x.Key
+		return x.Type
 	}
 	return ""
 }
 
-type GetActionBindingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BindingId     string                 `protobuf:"bytes,1,opt,name=binding_id,json=bindingId,proto3" json:"binding_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RestartActionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetActionBindingRequest) Reset() {
-	*x = GetActionBindingRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[70]
+func (x *RestartActionRequest) Reset() {
+	*x = RestartActionRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActionBindingRequest) String() string {
+func (x *RestartActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActionBindingRequest) ProtoMessage() {}
+func (*RestartActionRequest) ProtoMessage() {}
 
-func (x *GetActionBindingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[70]
+func (x *RestartActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4339,41 +6800,43 @@ func (x *GetActionBindingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActionBindingRequest.ProtoReflect.Descriptor instead.
-func (*GetActionBindingRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use RestartActionRequest.ProtoReflect.Descriptor instead.
+func (*RestartActionRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetActionBindingRequest) GetBindingId() string {
+func (x *RestartActionRequest) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.BindingId
+		return x.ExecutionTrackingId
 	}
 	return ""
 }
 
-type GetActionBindingResponse struct {
-	state            protoimpl.MessageState       `protogen:"open.v1"`
-	Action           *Action                      `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
-	BackToDashboards []*DashboardNavigationTarget `protobuf:"bytes,2,rep,name=back_to_dashboards,json=backToDashboards,proto3" json:"back_to_dashboards,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type PendingApproval struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	LogEntry          *LogEntry              `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	ApprovalsRequired int32                  `protobuf:"varint,2,opt,name=approvals_required,json=approvalsRequired,proto3" json:"approvals_required,omitempty"`
+	DatetimeExpires   string                 `protobuf:"bytes,3,opt,name=datetime_expires,json=datetimeExpires,proto3" json:"datetime_expires,omitempty"`
+	CanApprove        bool                   `protobuf:"varint,4,opt,name=can_approve,json=canApprove,proto3" json:"can_approve,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetActionBindingResponse) Reset() {
-	*x = GetActionBindingResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[71]
+func (x *PendingApproval) Reset() {
+	*x = PendingApproval{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActionBindingResponse) String() string {
+func (x *PendingApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActionBindingResponse) ProtoMessage() {}
+func (*PendingApproval) ProtoMessage() {}
 
-func (x *GetActionBindingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[71]
+func (x *PendingApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4384,50 +6847,60 @@ func (x *GetActionBindingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActionBindingResponse.ProtoReflect.Descriptor instead.
-func (*GetActionBindingResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use PendingApproval.ProtoReflect.Descriptor instead.
+func (*PendingApproval) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetActionBindingResponse) GetAction() *Action {
+func (x *PendingApproval) GetLogEntry() *LogEntry {
 	if x != nil {
-		return x.Action
+		return x.LogEntry
 	}
 	return nil
 }
 
-func (x *GetActionBindingResponse) GetBackToDashboards() []*DashboardNavigationTarget {
+func (x *PendingApproval) GetApprovalsRequired() int32 {
 	if x != nil {
-		return x.BackToDashboards
+		return x.ApprovalsRequired
 	}
-	return nil
+	return 0
 }
 
-type GetEntitiesRequest struct {
+func (x *PendingApproval) GetDatetimeExpires() string {
+	if x != nil {
+		return x.DatetimeExpires
+	}
+	return ""
+}
+
+func (x *PendingApproval) GetCanApprove() bool {
+	if x != nil {
+		return x.CanApprove
+	}
+	return false
+}
+
+type ListPendingApprovalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
-	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntitiesRequest) Reset() {
-	*x = GetEntitiesRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[72]
+func (x *ListPendingApprovalsRequest) Reset() {
+	*x = ListPendingApprovalsRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntitiesRequest) String() string {
+func (x *ListPendingApprovalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntitiesRequest) ProtoMessage() {}
+func (*ListPendingApprovalsRequest) ProtoMessage() {}
 
-func (x *GetEntitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[72]
+func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4438,61 +6911,77 @@ func (x *GetEntitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntitiesRequest.ProtoReflect.Descriptor instead.
-func (*GetEntitiesRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use ListPendingApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetEntitiesRequest) GetEntityType() string {
-	if x != nil {
-		return x.EntityType
-	}
-	return ""
+type ListPendingApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*PendingApproval     `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntitiesRequest) GetFilter() string {
-	if x != nil {
-		return x.Filter
-	}
-	return ""
+func (x *ListPendingApprovalsResponse) Reset() {
+	*x = ListPendingApprovalsResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntitiesRequest) GetPage() int32 {
+func (x *ListPendingApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsResponse) ProtoMessage() {}
+
+func (x *ListPendingApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[110]
 	if x != nil {
-		return x.Page
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetEntitiesRequest) GetPageSize() int32 {
+// Deprecated: Use ListPendingApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListPendingApprovalsResponse) GetApprovals() []*PendingApproval {
 	if x != nil {
-		return x.PageSize
+		return x.Approvals
 	}
-	return 0
+	return nil
 }
 
-type GetEntitiesResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	EntityDefinitions []*EntityDefinition    `protobuf:"bytes,1,rep,name=entity_definitions,json=entityDefinitions,proto3" json:"entity_definitions,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ApproveExecutionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetEntitiesResponse) Reset() {
-	*x = GetEntitiesResponse{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[73]
+func (x *ApproveExecutionRequest) Reset() {
+	*x = ApproveExecutionRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntitiesResponse) String() string {
+func (x *ApproveExecutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntitiesResponse) ProtoMessage() {}
+func (*ApproveExecutionRequest) ProtoMessage() {}
 
-func (x *GetEntitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[73]
+func (x *ApproveExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4503,45 +6992,41 @@ func (x *GetEntitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntitiesResponse.ProtoReflect.Descriptor instead.
-func (*GetEntitiesResponse) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ApproveExecutionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetEntitiesResponse) GetEntityDefinitions() []*EntityDefinition {
+func (x *ApproveExecutionRequest) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.EntityDefinitions
+		return x.ExecutionTrackingId
 	}
-	return nil
+	return ""
 }
 
-type EntityDefinition struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Title            string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Instances        []*Entity              `protobuf:"bytes,2,rep,name=instances,proto3" json:"instances,omitempty"`
-	UsedOnDashboards []string               `protobuf:"bytes,3,rep,name=used_on_dashboards,json=usedOnDashboards,proto3" json:"used_on_dashboards,omitempty"`
-	Icon             string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
-	Properties       []*EntityProperty      `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
-	TotalInstances   int32                  `protobuf:"varint,6,opt,name=total_instances,json=totalInstances,proto3" json:"total_instances,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ApproveExecutionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	ApprovalsRemaining  int32                  `protobuf:"varint,2,opt,name=approvals_remaining,json=approvalsRemaining,proto3" json:"approvals_remaining,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *EntityDefinition) Reset() {
-	*x = EntityDefinition{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[74]
+func (x *ApproveExecutionResponse) Reset() {
+	*x = ApproveExecutionResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityDefinition) String() string {
+func (x *ApproveExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityDefinition) ProtoMessage() {}
+func (*ApproveExecutionResponse) ProtoMessage() {}
 
-func (x *EntityDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[74]
+func (x *ApproveExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4552,76 +7037,91 @@ func (x *EntityDefinition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityDefinition.ProtoReflect.Descriptor instead.
-func (*EntityDefinition) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use ApproveExecutionResponse.ProtoReflect.Descriptor instead.
+func (*ApproveExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *EntityDefinition) GetTitle() string {
+func (x *ApproveExecutionResponse) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.Title
+		return x.ExecutionTrackingId
 	}
 	return ""
 }
 
-func (x *EntityDefinition) GetInstances() []*Entity {
+func (x *ApproveExecutionResponse) GetApprovalsRemaining() int32 {
 	if x != nil {
-		return x.Instances
+		return x.ApprovalsRemaining
 	}
-	return nil
+	return 0
 }
 
-func (x *EntityDefinition) GetUsedOnDashboards() []string {
-	if x != nil {
-		return x.UsedOnDashboards
-	}
-	return nil
+type RejectExecutionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *EntityDefinition) GetIcon() string {
-	if x != nil {
-		return x.Icon
-	}
-	return ""
+func (x *RejectExecutionRequest) Reset() {
+	*x = RejectExecutionRequest{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityDefinition) GetProperties() []*EntityProperty {
+func (x *RejectExecutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectExecutionRequest) ProtoMessage() {}
+
+func (x *RejectExecutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[113]
 	if x != nil {
-		return x.Properties
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *EntityDefinition) GetTotalInstances() int32 {
+// Deprecated: Use RejectExecutionRequest.ProtoReflect.Descriptor instead.
+func (*RejectExecutionRequest) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RejectExecutionRequest) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.TotalInstances
+		return x.ExecutionTrackingId
 	}
-	return 0
+	return ""
 }
 
-type EntityProperty struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RejectExecutionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *EntityProperty) Reset() {
-	*x = EntityProperty{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[75]
+func (x *RejectExecutionResponse) Reset() {
+	*x = RejectExecutionResponse{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EntityProperty) String() string {
+func (x *RejectExecutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EntityProperty) ProtoMessage() {}
+func (*RejectExecutionResponse) ProtoMessage() {}
 
-func (x *EntityProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[75]
+func (x *RejectExecutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4632,48 +7132,40 @@ func (x *EntityProperty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EntityProperty.ProtoReflect.Descriptor instead.
-func (*EntityProperty) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *EntityProperty) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use RejectExecutionResponse.ProtoReflect.Descriptor instead.
+func (*RejectExecutionResponse) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *EntityProperty) GetTitle() string {
+func (x *RejectExecutionResponse) GetExecutionTrackingId() string {
 	if x != nil {
-		return x.Title
+		return x.ExecutionTrackingId
 	}
 	return ""
 }
 
-type GetEntityRequest struct {
+type EventApprovalRequested struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UniqueKey     string                 `protobuf:"bytes,1,opt,name=unique_key,json=uniqueKey,proto3" json:"unique_key,omitempty"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Approval      *PendingApproval       `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEntityRequest) Reset() {
-	*x = GetEntityRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[76]
+func (x *EventApprovalRequested) Reset() {
+	*x = EventApprovalRequested{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEntityRequest) String() string {
+func (x *EventApprovalRequested) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEntityRequest) ProtoMessage() {}
+func (*EventApprovalRequested) ProtoMessage() {}
 
-func (x *GetEntityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[76]
+func (x *EventApprovalRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4684,47 +7176,41 @@ func (x *GetEntityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEntityRequest.ProtoReflect.Descriptor instead.
-func (*GetEntityRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *GetEntityRequest) GetUniqueKey() string {
-	if x != nil {
-		return x.UniqueKey
-	}
-	return ""
+// Deprecated: Use EventApprovalRequested.ProtoReflect.Descriptor instead.
+func (*EventApprovalRequested) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetEntityRequest) GetType() string {
+func (x *EventApprovalRequested) GetApproval() *PendingApproval {
 	if x != nil {
-		return x.Type
+		return x.Approval
 	}
-	return ""
+	return nil
 }
 
-type RestartActionRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ExecutionTrackingId string                 `protobuf:"bytes,1,opt,name=execution_tracking_id,json=executionTrackingId,proto3" json:"execution_tracking_id,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type EventApprovalResolved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LogEntry      *LogEntry              `protobuf:"bytes,1,opt,name=log_entry,json=logEntry,proto3" json:"log_entry,omitempty"`
+	Approved      bool                   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestartActionRequest) Reset() {
-	*x = RestartActionRequest{}
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[77]
+func (x *EventApprovalResolved) Reset() {
+	*x = EventApprovalResolved{}
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestartActionRequest) String() string {
+func (x *EventApprovalResolved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartActionRequest) ProtoMessage() {}
+func (*EventApprovalResolved) ProtoMessage() {}
 
-func (x *RestartActionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[77]
+func (x *EventApprovalResolved) ProtoReflect() protoreflect.Message {
+	mi := &file_olivetin_api_v1_olivetin_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4735,23 +7221,30 @@ func (x *RestartActionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartActionRequest.ProtoReflect.Descriptor instead.
-func (*RestartActionRequest) Descriptor() ([]byte, []int) {
-	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use EventApprovalResolved.ProtoReflect.Descriptor instead.
+func (*EventApprovalResolved) Descriptor() ([]byte, []int) {
+	return file_olivetin_api_v1_olivetin_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *RestartActionRequest) GetExecutionTrackingId() string {
+func (x *EventApprovalResolved) GetLogEntry() *LogEntry {
 	if x != nil {
-		return x.ExecutionTrackingId
+		return x.LogEntry
 	}
-	return ""
+	return nil
+}
+
+func (x *EventApprovalResolved) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
 }
 
 var File_olivetin_api_v1_olivetin_proto protoreflect.FileDescriptor
 
 const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\n" +
-	"\x1eolivetin/api/v1/olivetin.proto\x12\x0folivetin.api.v1\"\xd7\x06\n" +
+	"\x1eolivetin/api/v1/olivetin.proto\x12\x0folivetin.api.v1\"\x9e\a\n" +
 	"\x06Action\x12\x1d\n" +
 	"\n" +
 	"binding_id\x18\x01 \x01(\tR\tbindingId\x12\x14\n" +
@@ -4774,7 +7267,9 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\rjustification\x18\x14 \x01(\tR\rjustification\x120\n" +
 	"\x14has_running_instance\x18\x11 \x01(\bR\x12hasRunningInstance\x12.\n" +
 	"\x13has_queued_instance\x18\x12 \x01(\bR\x11hasQueuedInstance\x12>\n" +
-	"\x06groups\x18\x13 \x03(\v2&.olivetin.api.v1.ActionGroupMembershipR\x06groupsJ\x04\b\x10\x10\x11\"q\n" +
+	"\x06groups\x18\x13 \x03(\v2&.olivetin.api.v1.ActionGroupMembershipR\x06groups\x12E\n" +
+	"\fexec_on_mqtt\x18\x15 \x03(\v2#.olivetin.api.v1.ActionMqttExecHintR\n" +
+	"execOnMqttJ\x04\b\x10\x10\x11\"q\n" +
 	"\x15ActionGroupMembership\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
 	"\x0emax_concurrent\x18\x02 \x01(\x05R\rmaxConcurrent\x12\x1d\n" +
@@ -4792,7 +7287,12 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
 	"\x0fMatchQueryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa2\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"a\n" +
+	"\x12ActionMqttExecHint\x12\x16\n" +
+	"\x06broker\x18\x01 \x01(\tR\x06broker\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x1d\n" +
+	"\n" +
+	"match_path\x18\x03 \x01(\tR\tmatchPath\"\xa2\x03\n" +
 	"\x0eActionArgument\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -4828,11 +7328,12 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
 	"\x14GetDashboardResponse\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x128\n" +
-	"\tdashboard\x18\x04 \x01(\v2\x1a.olivetin.api.v1.DashboardR\tdashboard\"\x90\x01\n" +
+	"\tdashboard\x18\x04 \x01(\v2\x1a.olivetin.api.v1.DashboardR\tdashboard\"\xb6\x01\n" +
 	"\x0fEffectivePolicy\x12)\n" +
 	"\x10show_diagnostics\x18\x01 \x01(\bR\x0fshowDiagnostics\x12\"\n" +
 	"\rshow_log_list\x18\x02 \x01(\bR\vshowLogList\x12.\n" +
-	"\x13show_version_number\x18\x03 \x01(\bR\x11showVersionNumber\"k\n" +
+	"\x13show_version_number\x18\x03 \x01(\bR\x11showVersionNumber\x12$\n" +
+	"\x0eshow_audit_log\x18\x04 \x01(\bR\fshowAuditLog\"k\n" +
 	"\x13GetDashboardRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1f\n" +
 	"\ventity_type\x18\x02 \x01(\tR\n" +
@@ -4877,13 +7378,14 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x1eStartActionByGetAndWaitRequest\x12\x1b\n" +
 	"\taction_id\x18\x01 \x01(\tR\bactionId\"Y\n" +
 	"\x1fStartActionByGetAndWaitResponse\x126\n" +
-	"\tlog_entry\x18\x01 \x01(\v2\x19.olivetin.api.v1.LogEntryR\blogEntry\"\x89\x01\n" +
+	"\tlog_entry\x18\x01 \x01(\v2\x19.olivetin.api.v1.LogEntryR\blogEntry\"\xbb\x01\n" +
 	"\x0eGetLogsRequest\x12!\n" +
 	"\fstart_offset\x18\x01 \x01(\x03R\vstartOffset\x12\x1f\n" +
 	"\vdate_filter\x18\x02 \x01(\tR\n" +
 	"dateFilter\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12\x16\n" +
-	"\x06filter\x18\x04 \x01(\tR\x06filter\"\xb5\x06\n" +
+	"\x06filter\x18\x04 \x01(\tR\x06filter\x120\n" +
+	"\x14workflow_tracking_id\x18\x05 \x01(\tR\x12workflowTrackingId\"\x84\t\n" +
 	"\bLogEntry\x12)\n" +
 	"\x10datetime_started\x18\x01 \x01(\tR\x0fdatetimeStarted\x12!\n" +
 	"\faction_title\x18\x02 \x01(\tR\vactionTitle\x12\x16\n" +
@@ -4910,14 +7412,56 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x06queued\x18\x15 \x01(\bR\x06queued\x12(\n" +
 	"\x10queued_for_group\x18\x16 \x01(\tR\x0equeuedForGroup\x12$\n" +
 	"\rjustification\x18\x17 \x01(\tR\rjustification\x12B\n" +
-	"\targuments\x18\x18 \x03(\v2$.olivetin.api.v1.StartActionArgumentR\targuments\"\xca\x01\n" +
+	"\targuments\x18\x18 \x03(\v2$.olivetin.api.v1.StartActionArgumentR\targuments\x12+\n" +
+	"\x11awaiting_approval\x18\x19 \x01(\bR\x10awaitingApproval\x12\x1f\n" +
+	"\vapproved_by\x18\x1a \x03(\tR\n" +
+	"approvedBy\x12\x16\n" +
+	"\x06stdout\x18\x1b \x01(\tR\x06stdout\x12\x16\n" +
+	"\x06stderr\x18\x1c \x01(\tR\x06stderr\x12\x1f\n" +
+	"\vresult_json\x18\x1d \x01(\tR\n" +
+	"resultJson\x120\n" +
+	"\x14workflow_tracking_id\x18\x1e \x01(\tR\x12workflowTrackingId\x12#\n" +
+	"\rworkflow_step\x18\x1f \x01(\tR\fworkflowStep\x12\x18\n" +
+	"\aattempt\x18  \x01(\x05R\aattempt\x12=\n" +
+	"\battempts\x18! \x03(\v2!.olivetin.api.v1.ExecutionAttemptR\battempts\"\xd6\x01\n" +
+	"\x10ExecutionAttempt\x12\x18\n" +
+	"\aattempt\x18\x01 \x01(\x05R\aattempt\x12)\n" +
+	"\x10datetime_started\x18\x02 \x01(\tR\x0fdatetimeStarted\x12+\n" +
+	"\x11datetime_finished\x18\x03 \x01(\tR\x10datetimeFinished\x12\x1b\n" +
+	"\texit_code\x18\x04 \x01(\x05R\bexitCode\x12\x1b\n" +
+	"\ttimed_out\x18\x05 \x01(\bR\btimedOut\x12\x16\n" +
+	"\x06output\x18\x06 \x01(\tR\x06output\"\x8b\x02\n" +
 	"\x0fGetLogsResponse\x12-\n" +
 	"\x04logs\x18\x01 \x03(\v2\x19.olivetin.api.v1.LogEntryR\x04logs\x12'\n" +
 	"\x0fcount_remaining\x18\x02 \x01(\x03R\x0ecountRemaining\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12\x1f\n" +
 	"\vtotal_count\x18\x04 \x01(\x03R\n" +
 	"totalCount\x12!\n" +
-	"\fstart_offset\x18\x05 \x01(\x03R\vstartOffset\"V\n" +
+	"\fstart_offset\x18\x05 \x01(\x03R\vstartOffset\x12?\n" +
+	"\fworkflow_run\x18\x06 \x01(\v2\x1c.olivetin.api.v1.WorkflowRunR\vworkflowRun\"\xb2\x02\n" +
+	"\vWorkflowRun\x120\n" +
+	"\x14workflow_tracking_id\x18\x01 \x01(\tR\x12workflowTrackingId\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04user\x18\x04 \x01(\tR\x04user\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12)\n" +
+	"\x10datetime_started\x18\x06 \x01(\tR\x0fdatetimeStarted\x12+\n" +
+	"\x11datetime_finished\x18\a \x01(\tR\x10datetimeFinished\x126\n" +
+	"\x05steps\x18\b \x03(\v2 .olivetin.api.v1.WorkflowStepRunR\x05steps\"\xc3\x01\n" +
+	"\x0fWorkflowStepRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\faction_title\x18\x02 \x01(\tR\vactionTitle\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x122\n" +
+	"\x15execution_tracking_id\x18\x04 \x01(\tR\x13executionTrackingId\x12\x1b\n" +
+	"\texit_code\x18\x05 \x01(\x05R\bexitCode\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"{\n" +
+	"\x14StartWorkflowRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12B\n" +
+	"\targuments\x18\x02 \x03(\v2$.olivetin.api.v1.StartActionArgumentR\targuments\"I\n" +
+	"\x15StartWorkflowResponse\x120\n" +
+	"\x14workflow_tracking_id\x18\x01 \x01(\tR\x12workflowTrackingId\"V\n" +
 	"\x14GetActionLogsRequest\x12\x1b\n" +
 	"\taction_id\x18\x01 \x01(\tR\bactionId\x12!\n" +
 	"\fstart_offset\x18\x02 \x01(\x03R\vstartOffset\"\xd0\x01\n" +
@@ -4947,10 +7491,66 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\aactions\x18\x05 \x03(\v2%.olivetin.api.v1.ExecutionQueueActionR\aactions\x12!\n" +
 	"\fqueued_count\x18\x06 \x01(\x05R\vqueuedCount\x12\x1d\n" +
 	"\n" +
-	"queue_size\x18\a \x01(\x05R\tqueueSize\"|\n" +
+	"queue_size\x18\a \x01(\x05R\tqueueSize\"\xbf\x01\n" +
 	"\x19GetExecutionQueueResponse\x12<\n" +
 	"\x06groups\x18\x01 \x03(\v2$.olivetin.api.v1.ExecutionQueueGroupR\x06groups\x12!\n" +
-	"\ftotal_active\x18\x02 \x01(\x05R\vtotalActive\"\x8b\x01\n" +
+	"\ftotal_active\x18\x02 \x01(\x05R\vtotalActive\x12A\n" +
+	"\tscheduled\x18\x03 \x03(\v2#.olivetin.api.v1.ScheduledExecutionR\tscheduled\"\xef\x02\n" +
+	"\x12ScheduledExecution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"binding_id\x18\x02 \x01(\tR\tbindingId\x12!\n" +
+	"\faction_title\x18\x03 \x01(\tR\vactionTitle\x12\x1f\n" +
+	"\vaction_icon\x18\x04 \x01(\tR\n" +
+	"actionIcon\x12\x1a\n" +
+	"\bdatetime\x18\x05 \x01(\tR\bdatetime\x12-\n" +
+	"\x12datetime_scheduled\x18\x06 \x01(\tR\x11datetimeScheduled\x12B\n" +
+	"\targuments\x18\a \x03(\v2$.olivetin.api.v1.StartActionArgumentR\targuments\x12$\n" +
+	"\rjustification\x18\b \x01(\tR\rjustification\x12\x12\n" +
+	"\x04user\x18\t \x01(\tR\x04user\x12\x1d\n" +
+	"\n" +
+	"can_cancel\x18\n" +
+	" \x01(\bR\tcanCancel\"\xbc\x01\n" +
+	"\x15ScheduleActionRequest\x12\x1d\n" +
+	"\n" +
+	"binding_id\x18\x01 \x01(\tR\tbindingId\x12\x1a\n" +
+	"\bdatetime\x18\x02 \x01(\tR\bdatetime\x12B\n" +
+	"\targuments\x18\x03 \x03(\v2$.olivetin.api.v1.StartActionArgumentR\targuments\x12$\n" +
+	"\rjustification\x18\x04 \x01(\tR\rjustification\"n\n" +
+	"\x16ScheduleActionResponse\x12T\n" +
+	"\x13scheduled_execution\x18\x01 \x01(\v2#.olivetin.api.v1.ScheduledExecutionR\x12scheduledExecution\" \n" +
+	"\x1eListScheduledExecutionsRequest\"y\n" +
+	"\x1fListScheduledExecutionsResponse\x12V\n" +
+	"\x14scheduled_executions\x18\x01 \x03(\v2#.olivetin.api.v1.ScheduledExecutionR\x13scheduledExecutions\"1\n" +
+	"\x1fCancelScheduledExecutionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\"\n" +
+	" CancelScheduledExecutionResponse\"\xe9\x02\n" +
+	"\fCronSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"binding_id\x18\x02 \x01(\tR\tbindingId\x12!\n" +
+	"\faction_title\x18\x03 \x01(\tR\vactionTitle\x12\x1f\n" +
+	"\vaction_icon\x18\x04 \x01(\tR\n" +
+	"actionIcon\x12\x1b\n" +
+	"\tcron_line\x18\x05 \x01(\tR\bcronLine\x12\x19\n" +
+	"\bnext_run\x18\x06 \x01(\tR\anextRun\x12!\n" +
+	"\fprevious_run\x18\a \x01(\tR\vpreviousRun\x12\x16\n" +
+	"\x06paused\x18\b \x01(\bR\x06paused\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12@\n" +
+	"\x0elast_execution\x18\n" +
+	" \x01(\v2\x19.olivetin.api.v1.LogEntryR\rlastExecution\x12\x1b\n" +
+	"\tcan_pause\x18\v \x01(\bR\bcanPause\"\x1a\n" +
+	"\x18ListCronSchedulesRequest\"X\n" +
+	"\x19ListCronSchedulesResponse\x12;\n" +
+	"\tschedules\x18\x01 \x03(\v2\x1d.olivetin.api.v1.CronScheduleR\tschedules\"*\n" +
+	"\x18PauseCronScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"V\n" +
+	"\x19PauseCronScheduleResponse\x129\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1d.olivetin.api.v1.CronScheduleR\bschedule\"+\n" +
+	"\x19ResumeCronScheduleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	"\x1aResumeCronScheduleResponse\x129\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1d.olivetin.api.v1.CronScheduleR\bschedule\"\x8b\x01\n" +
 	"\x1bValidateArgumentTypeRequest\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\tR\x05value\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
@@ -4977,13 +7577,15 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x17ExecutionStatusResponse\x126\n" +
 	"\tlog_entry\x18\x01 \x01(\v2\x19.olivetin.api.v1.LogEntryR\blogEntry\x12X\n" +
 	"\x12back_to_dashboards\x18\x02 \x03(\v2*.olivetin.api.v1.DashboardNavigationTargetR\x10backToDashboards\"\x0f\n" +
-	"\rWhoAmIRequest\"\x9f\x01\n" +
+	"\rWhoAmIRequest\"\xeb\x01\n" +
 	"\x0eWhoAmIResponse\x12-\n" +
 	"\x12authenticated_user\x18\x01 \x01(\tR\x11authenticatedUser\x12\x1c\n" +
 	"\tusergroup\x18\x02 \x01(\tR\tusergroup\x12\x1a\n" +
 	"\bprovider\x18\x03 \x01(\tR\bprovider\x12\x12\n" +
 	"\x04acls\x18\x04 \x03(\tR\x04acls\x12\x10\n" +
-	"\x03sid\x18\x05 \x01(\tR\x03sid\"\x1a\n" +
+	"\x03sid\x18\x05 \x01(\tR\x03sid\x12%\n" +
+	"\x0etotp_available\x18\x06 \x01(\bR\rtotpAvailable\x12#\n" +
+	"\rtotp_enrolled\x18\a \x01(\bR\ftotpEnrolled\"\x1a\n" +
 	"\x18ServerDiagnosticsRequest\"1\n" +
 	"\x19ServerDiagnosticsResponse\x12\x14\n" +
 	"\x05alert\x18\x01 \x01(\tR\x05alert\"\x11\n" +
@@ -5007,19 +7609,25 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x10GetReadyzRequest\"+\n" +
 	"\x11GetReadyzResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\"\x14\n" +
-	"\x12EventStreamRequest\"\xf4\x03\n" +
+	"\x12EventStreamRequest\"\xa5\x05\n" +
 	"\x13EventStreamResponse\x12L\n" +
 	"\x0eentity_changed\x18\x02 \x01(\v2#.olivetin.api.v1.EventEntityChangedH\x00R\rentityChanged\x12L\n" +
 	"\x0econfig_changed\x18\x03 \x01(\v2#.olivetin.api.v1.EventConfigChangedH\x00R\rconfigChanged\x12X\n" +
 	"\x12execution_finished\x18\x04 \x01(\v2'.olivetin.api.v1.EventExecutionFinishedH\x00R\x11executionFinished\x12U\n" +
 	"\x11execution_started\x18\x05 \x01(\v2&.olivetin.api.v1.EventExecutionStartedH\x00R\x10executionStarted\x12F\n" +
 	"\foutput_chunk\x18\x06 \x01(\v2!.olivetin.api.v1.EventOutputChunkH\x00R\voutputChunk\x12?\n" +
-	"\theartbeat\x18\a \x01(\v2\x1f.olivetin.api.v1.EventHeartbeatH\x00R\theartbeatB\a\n" +
-	"\x05event\"^\n" +
+	"\theartbeat\x18\a \x01(\v2\x1f.olivetin.api.v1.EventHeartbeatH\x00R\theartbeat\x12X\n" +
+	"\x12approval_requested\x18\b \x01(\v2'.olivetin.api.v1.EventApprovalRequestedH\x00R\x11approvalRequested\x12U\n" +
+	"\x11approval_resolved\x18\t \x01(\v2&.olivetin.api.v1.EventApprovalResolvedH\x00R\x10approvalResolvedB\a\n" +
+	"\x05event\"\x92\x01\n" +
 	"\x10EventOutputChunk\x122\n" +
 	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\x12\x16\n" +
-	"\x06output\x18\x02 \x01(\tR\x06output\"\x14\n" +
-	"\x12EventEntityChanged\"\x14\n" +
+	"\x06output\x18\x02 \x01(\tR\x06output\x12\x16\n" +
+	"\x06stream\x18\x03 \x01(\tR\x06stream\x12\x1a\n" +
+	"\bdatetime\x18\x04 \x01(\tR\bdatetime\"5\n" +
+	"\x12EventEntityChanged\x12\x1f\n" +
+	"\ventity_name\x18\x01 \x01(\tR\n" +
+	"entityName\"\x14\n" +
 	"\x12EventConfigChanged\"\x10\n" +
 	"\x0eEventHeartbeat\"P\n" +
 	"\x16EventExecutionFinished\x126\n" +
@@ -5032,22 +7640,81 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\x12\x16\n" +
 	"\x06killed\x18\x02 \x01(\bR\x06killed\x12+\n" +
 	"\x11already_completed\x18\x03 \x01(\bR\x10alreadyCompleted\x12\x14\n" +
-	"\x05found\x18\x04 \x01(\bR\x05found\"O\n" +
+	"\x05found\x18\x04 \x01(\bR\x05found\"\x93\x01\n" +
 	"\x15LocalUserLoginRequest\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"2\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12%\n" +
+	"\x0etotp_challenge\x18\x03 \x01(\tR\rtotpChallenge\x12\x1b\n" +
+	"\ttotp_code\x18\x04 \x01(\tR\btotpCode\"~\n" +
 	"\x16LocalUserLoginResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"1\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rtotp_required\x18\x02 \x01(\bR\ftotpRequired\x12%\n" +
+	"\x0etotp_challenge\x18\x03 \x01(\tR\rtotpChallenge\"\x1b\n" +
+	"\x19BeginTotpEnrolmentRequest\"f\n" +
+	"\x1aBeginTotpEnrolmentResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1e\n" +
+	"\vqr_code_png\x18\x03 \x01(\fR\tqrCodePng\"1\n" +
+	"\x1bConfirmTotpEnrolmentRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"E\n" +
+	"\x1cConfirmTotpEnrolmentResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"(\n" +
+	"\x12DisableTotpRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x15\n" +
+	"\x13DisableTotpResponse\"1\n" +
 	"\x13PasswordHashRequest\x12\x1a\n" +
 	"\bpassword\x18\x01 \x01(\tR\bpassword\"*\n" +
 	"\x14PasswordHashResponse\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\tR\x04hash\"\x0f\n" +
-	"\rLogoutRequest\"\x10\n" +
-	"\x0eLogoutResponse\"\x17\n" +
-	"\x15GetDiagnosticsRequest\"b\n" +
+	"\rLogoutRequest\"3\n" +
+	"\x0eLogoutResponse\x12!\n" +
+	"\fredirect_url\x18\x01 \x01(\tR\vredirectUrl\"\x17\n" +
+	"\x15GetDiagnosticsRequest\"\xf1\x01\n" +
 	"\x16GetDiagnosticsResponse\x12 \n" +
 	"\vSshFoundKey\x18\x01 \x01(\tR\vSshFoundKey\x12&\n" +
-	"\x0eSshFoundConfig\x18\x02 \x01(\tR\x0eSshFoundConfig\"\r\n" +
+	"\x0eSshFoundConfig\x18\x02 \x01(\tR\x0eSshFoundConfig\x12O\n" +
+	"\x12webhook_deliveries\x18\x03 \x03(\v2 .olivetin.api.v1.WebhookDeliveryR\x11webhookDeliveries\x12<\n" +
+	"\x1awebhook_deliveries_pending\x18\x04 \x01(\x05R\x18webhookDeliveriesPending\"\x80\x02\n" +
+	"\x0fWebhookDelivery\x12\x1a\n" +
+	"\bdatetime\x18\x01 \x01(\tR\bdatetime\x12\x18\n" +
+	"\awebhook\x18\x02 \x01(\tR\awebhook\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x122\n" +
+	"\x15execution_tracking_id\x18\x04 \x01(\tR\x13executionTrackingId\x12\x18\n" +
+	"\aattempt\x18\x05 \x01(\x05R\aattempt\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1c\n" +
+	"\tdelivered\x18\a \x01(\bR\tdelivered\x12\x1d\n" +
+	"\n" +
+	"will_retry\x18\b \x01(\bR\twillRetry\"p\n" +
+	"\x12GetAuditLogRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x8c\x01\n" +
+	"\x13GetAuditLogResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.olivetin.api.v1.AuditEventR\x06events\x12\x1f\n" +
+	"\vchain_valid\x18\x02 \x01(\bR\n" +
+	"chainValid\x12\x1f\n" +
+	"\vchain_error\x18\x03 \x01(\tR\n" +
+	"chainError\"\xd2\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\x05R\x06schema\x12\x10\n" +
+	"\x03seq\x18\x02 \x01(\x04R\x03seq\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\tR\x04time\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\aoutcome\x18\x05 \x01(\tR\aoutcome\x12\x1a\n" +
+	"\busername\x18\x06 \x01(\tR\busername\x12\x1a\n" +
+	"\bprovider\x18\a \x01(\tR\bprovider\x12\x16\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12\x1f\n" +
+	"\vtracking_id\x18\t \x01(\tR\n" +
+	"trackingId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\n" +
+	" \x01(\tR\n" +
+	"permission\x12\x16\n" +
+	"\x06detail\x18\v \x01(\tR\x06detail\x12\x1b\n" +
+	"\tprev_hash\x18\f \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\r \x01(\tR\x04hash\"\r\n" +
 	"\vInitRequest\"\x8d\t\n" +
 	"\fInitResponse\x12\x1e\n" +
 	"\n" +
@@ -5117,7 +7784,30 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"unique_key\x18\x01 \x01(\tR\tuniqueKey\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\"J\n" +
 	"\x14RestartActionRequest\x122\n" +
-	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId2\xee\x13\n" +
+	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\"\xc4\x01\n" +
+	"\x0fPendingApproval\x126\n" +
+	"\tlog_entry\x18\x01 \x01(\v2\x19.olivetin.api.v1.LogEntryR\blogEntry\x12-\n" +
+	"\x12approvals_required\x18\x02 \x01(\x05R\x11approvalsRequired\x12)\n" +
+	"\x10datetime_expires\x18\x03 \x01(\tR\x0fdatetimeExpires\x12\x1f\n" +
+	"\vcan_approve\x18\x04 \x01(\bR\n" +
+	"canApprove\"\x1d\n" +
+	"\x1bListPendingApprovalsRequest\"^\n" +
+	"\x1cListPendingApprovalsResponse\x12>\n" +
+	"\tapprovals\x18\x01 \x03(\v2 .olivetin.api.v1.PendingApprovalR\tapprovals\"M\n" +
+	"\x17ApproveExecutionRequest\x122\n" +
+	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\"\x7f\n" +
+	"\x18ApproveExecutionResponse\x122\n" +
+	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\x12/\n" +
+	"\x13approvals_remaining\x18\x02 \x01(\x05R\x12approvalsRemaining\"L\n" +
+	"\x16RejectExecutionRequest\x122\n" +
+	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\"M\n" +
+	"\x17RejectExecutionResponse\x122\n" +
+	"\x15execution_tracking_id\x18\x01 \x01(\tR\x13executionTrackingId\"V\n" +
+	"\x16EventApprovalRequested\x12<\n" +
+	"\bapproval\x18\x01 \x01(\v2 .olivetin.api.v1.PendingApprovalR\bapproval\"k\n" +
+	"\x15EventApprovalResolved\x126\n" +
+	"\tlog_entry\x18\x01 \x01(\v2\x19.olivetin.api.v1.LogEntryR\blogEntry\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\bR\bapproved2\xf0\x1f\n" +
 	"\x12OliveTinApiService\x12]\n" +
 	"\fGetDashboard\x12$.olivetin.api.v1.GetDashboardRequest\x1a%.olivetin.api.v1.GetDashboardResponse\"\x00\x12Z\n" +
 	"\vStartAction\x12#.olivetin.api.v1.StartActionRequest\x1a$.olivetin.api.v1.StartActionResponse\"\x00\x12o\n" +
@@ -5129,19 +7819,33 @@ const file_olivetin_api_v1_olivetin_proto_rawDesc = "" +
 	"KillAction\x12\".olivetin.api.v1.KillActionRequest\x1a#.olivetin.api.v1.KillActionResponse\"\x00\x12f\n" +
 	"\x0fExecutionStatus\x12'.olivetin.api.v1.ExecutionStatusRequest\x1a(.olivetin.api.v1.ExecutionStatusResponse\"\x00\x12N\n" +
 	"\aGetLogs\x12\x1f.olivetin.api.v1.GetLogsRequest\x1a .olivetin.api.v1.GetLogsResponse\"\x00\x12`\n" +
+	"\rStartWorkflow\x12%.olivetin.api.v1.StartWorkflowRequest\x1a&.olivetin.api.v1.StartWorkflowResponse\"\x00\x12`\n" +
 	"\rGetActionLogs\x12%.olivetin.api.v1.GetActionLogsRequest\x1a&.olivetin.api.v1.GetActionLogsResponse\"\x00\x12l\n" +
-	"\x11GetExecutionQueue\x12).olivetin.api.v1.GetExecutionQueueRequest\x1a*.olivetin.api.v1.GetExecutionQueueResponse\"\x00\x12u\n" +
+	"\x11GetExecutionQueue\x12).olivetin.api.v1.GetExecutionQueueRequest\x1a*.olivetin.api.v1.GetExecutionQueueResponse\"\x00\x12c\n" +
+	"\x0eScheduleAction\x12&.olivetin.api.v1.ScheduleActionRequest\x1a'.olivetin.api.v1.ScheduleActionResponse\"\x00\x12~\n" +
+	"\x17ListScheduledExecutions\x12/.olivetin.api.v1.ListScheduledExecutionsRequest\x1a0.olivetin.api.v1.ListScheduledExecutionsResponse\"\x00\x12\x81\x01\n" +
+	"\x18CancelScheduledExecution\x120.olivetin.api.v1.CancelScheduledExecutionRequest\x1a1.olivetin.api.v1.CancelScheduledExecutionResponse\"\x00\x12l\n" +
+	"\x11ListCronSchedules\x12).olivetin.api.v1.ListCronSchedulesRequest\x1a*.olivetin.api.v1.ListCronSchedulesResponse\"\x00\x12l\n" +
+	"\x11PauseCronSchedule\x12).olivetin.api.v1.PauseCronScheduleRequest\x1a*.olivetin.api.v1.PauseCronScheduleResponse\"\x00\x12o\n" +
+	"\x12ResumeCronSchedule\x12*.olivetin.api.v1.ResumeCronScheduleRequest\x1a+.olivetin.api.v1.ResumeCronScheduleResponse\"\x00\x12u\n" +
+	"\x14ListPendingApprovals\x12,.olivetin.api.v1.ListPendingApprovalsRequest\x1a-.olivetin.api.v1.ListPendingApprovalsResponse\"\x00\x12i\n" +
+	"\x10ApproveExecution\x12(.olivetin.api.v1.ApproveExecutionRequest\x1a).olivetin.api.v1.ApproveExecutionResponse\"\x00\x12f\n" +
+	"\x0fRejectExecution\x12'.olivetin.api.v1.RejectExecutionRequest\x1a(.olivetin.api.v1.RejectExecutionResponse\"\x00\x12u\n" +
 	"\x14ValidateArgumentType\x12,.olivetin.api.v1.ValidateArgumentTypeRequest\x1a-.olivetin.api.v1.ValidateArgumentTypeResponse\"\x00\x12K\n" +
 	"\x06WhoAmI\x12\x1e.olivetin.api.v1.WhoAmIRequest\x1a\x1f.olivetin.api.v1.WhoAmIResponse\"\x00\x12l\n" +
 	"\x11ServerDiagnostics\x12).olivetin.api.v1.ServerDiagnosticsRequest\x1a*.olivetin.api.v1.ServerDiagnosticsResponse\"\x00\x12Q\n" +
 	"\bDumpVars\x12 .olivetin.api.v1.DumpVarsRequest\x1a!.olivetin.api.v1.DumpVarsResponse\"\x00\x12x\n" +
 	"\x15DumpPublicIdActionMap\x12-.olivetin.api.v1.DumpPublicIdActionMapRequest\x1a..olivetin.api.v1.DumpPublicIdActionMapResponse\"\x00\x12T\n" +
 	"\tGetReadyz\x12!.olivetin.api.v1.GetReadyzRequest\x1a\".olivetin.api.v1.GetReadyzResponse\"\x00\x12c\n" +
-	"\x0eLocalUserLogin\x12&.olivetin.api.v1.LocalUserLoginRequest\x1a'.olivetin.api.v1.LocalUserLoginResponse\"\x00\x12]\n" +
+	"\x0eLocalUserLogin\x12&.olivetin.api.v1.LocalUserLoginRequest\x1a'.olivetin.api.v1.LocalUserLoginResponse\"\x00\x12o\n" +
+	"\x12BeginTotpEnrolment\x12*.olivetin.api.v1.BeginTotpEnrolmentRequest\x1a+.olivetin.api.v1.BeginTotpEnrolmentResponse\"\x00\x12u\n" +
+	"\x14ConfirmTotpEnrolment\x12,.olivetin.api.v1.ConfirmTotpEnrolmentRequest\x1a-.olivetin.api.v1.ConfirmTotpEnrolmentResponse\"\x00\x12Z\n" +
+	"\vDisableTotp\x12#.olivetin.api.v1.DisableTotpRequest\x1a$.olivetin.api.v1.DisableTotpResponse\"\x00\x12]\n" +
 	"\fPasswordHash\x12$.olivetin.api.v1.PasswordHashRequest\x1a%.olivetin.api.v1.PasswordHashResponse\"\x00\x12K\n" +
 	"\x06Logout\x12\x1e.olivetin.api.v1.LogoutRequest\x1a\x1f.olivetin.api.v1.LogoutResponse\"\x00\x12\\\n" +
 	"\vEventStream\x12#.olivetin.api.v1.EventStreamRequest\x1a$.olivetin.api.v1.EventStreamResponse\"\x000\x01\x12c\n" +
-	"\x0eGetDiagnostics\x12&.olivetin.api.v1.GetDiagnosticsRequest\x1a'.olivetin.api.v1.GetDiagnosticsResponse\"\x00\x12E\n" +
+	"\x0eGetDiagnostics\x12&.olivetin.api.v1.GetDiagnosticsRequest\x1a'.olivetin.api.v1.GetDiagnosticsResponse\"\x00\x12Z\n" +
+	"\vGetAuditLog\x12#.olivetin.api.v1.GetAuditLogRequest\x1a$.olivetin.api.v1.GetAuditLogResponse\"\x00\x12E\n" +
 	"\x04Init\x12\x1c.olivetin.api.v1.InitRequest\x1a\x1d.olivetin.api.v1.InitResponse\"\x00\x12i\n" +
 	"\x10GetActionBinding\x12(.olivetin.api.v1.GetActionBindingRequest\x1a).olivetin.api.v1.GetActionBindingResponse\"\x00\x12Z\n" +
 	"\vGetEntities\x12#.olivetin.api.v1.GetEntitiesRequest\x1a$.olivetin.api.v1.GetEntitiesResponse\"\x00\x12I\n" +
@@ -5159,198 +7863,287 @@ func file_olivetin_api_v1_olivetin_proto_rawDescGZIP() []byte {
 	return file_olivetin_api_v1_olivetin_proto_rawDescData
 }
 
-var file_olivetin_api_v1_olivetin_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
+var file_olivetin_api_v1_olivetin_proto_msgTypes = make([]protoimpl.MessageInfo, 124)
 var file_olivetin_api_v1_olivetin_proto_goTypes = []any{
-	(*Action)(nil),                          // 0: olivetin.api.v1.Action
-	(*ActionGroupMembership)(nil),           // 1: olivetin.api.v1.ActionGroupMembership
-	(*ActionWebhookExecHint)(nil),           // 2: olivetin.api.v1.ActionWebhookExecHint
-	(*ActionArgument)(nil),                  // 3: olivetin.api.v1.ActionArgument
-	(*ActionArgumentChoice)(nil),            // 4: olivetin.api.v1.ActionArgumentChoice
-	(*EntityRelatedAction)(nil),             // 5: olivetin.api.v1.EntityRelatedAction
-	(*Entity)(nil),                          // 6: olivetin.api.v1.Entity
-	(*GetDashboardResponse)(nil),            // 7: olivetin.api.v1.GetDashboardResponse
-	(*EffectivePolicy)(nil),                 // 8: olivetin.api.v1.EffectivePolicy
-	(*GetDashboardRequest)(nil),             // 9: olivetin.api.v1.GetDashboardRequest
-	(*Dashboard)(nil),                       // 10: olivetin.api.v1.Dashboard
-	(*DashboardComponent)(nil),              // 11: olivetin.api.v1.DashboardComponent
-	(*StartActionRequest)(nil),              // 12: olivetin.api.v1.StartActionRequest
-	(*StartActionArgument)(nil),             // 13: olivetin.api.v1.StartActionArgument
-	(*StartActionResponse)(nil),             // 14: olivetin.api.v1.StartActionResponse
-	(*StartActionAndWaitRequest)(nil),       // 15: olivetin.api.v1.StartActionAndWaitRequest
-	(*StartActionAndWaitResponse)(nil),      // 16: olivetin.api.v1.StartActionAndWaitResponse
-	(*StartActionByGetRequest)(nil),         // 17: olivetin.api.v1.StartActionByGetRequest
-	(*StartActionByGetResponse)(nil),        // 18: olivetin.api.v1.StartActionByGetResponse
-	(*StartActionByGetAndWaitRequest)(nil),  // 19: olivetin.api.v1.StartActionByGetAndWaitRequest
-	(*StartActionByGetAndWaitResponse)(nil), // 20: olivetin.api.v1.StartActionByGetAndWaitResponse
-	(*GetLogsRequest)(nil),                  // 21: olivetin.api.v1.GetLogsRequest
-	(*LogEntry)(nil),                        // 22: olivetin.api.v1.LogEntry
-	(*GetLogsResponse)(nil),                 // 23: olivetin.api.v1.GetLogsResponse
-	(*GetActionLogsRequest)(nil),            // 24: olivetin.api.v1.GetActionLogsRequest
-	(*GetActionLogsResponse)(nil),           // 25: olivetin.api.v1.GetActionLogsResponse
-	(*GetExecutionQueueRequest)(nil),        // 26: olivetin.api.v1.GetExecutionQueueRequest
-	(*ExecutionQueueAction)(nil),            // 27: olivetin.api.v1.ExecutionQueueAction
-	(*ExecutionQueueGroup)(nil),             // 28: olivetin.api.v1.ExecutionQueueGroup
-	(*GetExecutionQueueResponse)(nil),       // 29: olivetin.api.v1.GetExecutionQueueResponse
-	(*ValidateArgumentTypeRequest)(nil),     // 30: olivetin.api.v1.ValidateArgumentTypeRequest
-	(*ValidateArgumentTypeResponse)(nil),    // 31: olivetin.api.v1.ValidateArgumentTypeResponse
-	(*WatchExecutionRequest)(nil),           // 32: olivetin.api.v1.WatchExecutionRequest
-	(*WatchExecutionUpdate)(nil),            // 33: olivetin.api.v1.WatchExecutionUpdate
-	(*ExecutionStatusRequest)(nil),          // 34: olivetin.api.v1.ExecutionStatusRequest
-	(*DashboardNavigationTarget)(nil),       // 35: olivetin.api.v1.DashboardNavigationTarget
-	(*ExecutionStatusResponse)(nil),         // 36: olivetin.api.v1.ExecutionStatusResponse
-	(*WhoAmIRequest)(nil),                   // 37: olivetin.api.v1.WhoAmIRequest
-	(*WhoAmIResponse)(nil),                  // 38: olivetin.api.v1.WhoAmIResponse
-	(*ServerDiagnosticsRequest)(nil),        // 39: olivetin.api.v1.ServerDiagnosticsRequest
-	(*ServerDiagnosticsResponse)(nil),       // 40: olivetin.api.v1.ServerDiagnosticsResponse
-	(*DumpVarsRequest)(nil),                 // 41: olivetin.api.v1.DumpVarsRequest
-	(*DumpVarsResponse)(nil),                // 42: olivetin.api.v1.DumpVarsResponse
-	(*DebugBinding)(nil),                    // 43: olivetin.api.v1.DebugBinding
-	(*DumpPublicIdActionMapRequest)(nil),    // 44: olivetin.api.v1.DumpPublicIdActionMapRequest
-	(*DumpPublicIdActionMapResponse)(nil),   // 45: olivetin.api.v1.DumpPublicIdActionMapResponse
-	(*GetReadyzRequest)(nil),                // 46: olivetin.api.v1.GetReadyzRequest
-	(*GetReadyzResponse)(nil),               // 47: olivetin.api.v1.GetReadyzResponse
-	(*EventStreamRequest)(nil),              // 48: olivetin.api.v1.EventStreamRequest
-	(*EventStreamResponse)(nil),             // 49: olivetin.api.v1.EventStreamResponse
-	(*EventOutputChunk)(nil),                // 50: olivetin.api.v1.EventOutputChunk
-	(*EventEntityChanged)(nil),              // 51: olivetin.api.v1.EventEntityChanged
-	(*EventConfigChanged)(nil),              // 52: olivetin.api.v1.EventConfigChanged
-	(*EventHeartbeat)(nil),                  // 53: olivetin.api.v1.EventHeartbeat
-	(*EventExecutionFinished)(nil),          // 54: olivetin.api.v1.EventExecutionFinished
-	(*EventExecutionStarted)(nil),           // 55: olivetin.api.v1.EventExecutionStarted
-	(*KillActionRequest)(nil),               // 56: olivetin.api.v1.KillActionRequest
-	(*KillActionResponse)(nil),              // 57: olivetin.api.v1.KillActionResponse
-	(*LocalUserLoginRequest)(nil),           // 58: olivetin.api.v1.LocalUserLoginRequest
-	(*LocalUserLoginResponse)(nil),          // 59: olivetin.api.v1.LocalUserLoginResponse
-	(*PasswordHashRequest)(nil),             // 60: olivetin.api.v1.PasswordHashRequest
-	(*PasswordHashResponse)(nil),            // 61: olivetin.api.v1.PasswordHashResponse
-	(*LogoutRequest)(nil),                   // 62: olivetin.api.v1.LogoutRequest
-	(*LogoutResponse)(nil),                  // 63: olivetin.api.v1.LogoutResponse
-	(*GetDiagnosticsRequest)(nil),           // 64: olivetin.api.v1.GetDiagnosticsRequest
-	(*GetDiagnosticsResponse)(nil),          // 65: olivetin.api.v1.GetDiagnosticsResponse
-	(*InitRequest)(nil),                     // 66: olivetin.api.v1.InitRequest
-	(*InitResponse)(nil),                    // 67: olivetin.api.v1.InitResponse
-	(*AdditionalLink)(nil),                  // 68: olivetin.api.v1.AdditionalLink
-	(*OAuth2Provider)(nil),                  // 69: olivetin.api.v1.OAuth2Provider
-	(*GetActionBindingRequest)(nil),         // 70: olivetin.api.v1.GetActionBindingRequest
-	(*GetActionBindingResponse)(nil),        // 71: olivetin.api.v1.GetActionBindingResponse
-	(*GetEntitiesRequest)(nil),              // 72: olivetin.api.v1.GetEntitiesRequest
-	(*GetEntitiesResponse)(nil),             // 73: olivetin.api.v1.GetEntitiesResponse
-	(*EntityDefinition)(nil),                // 74: olivetin.api.v1.EntityDefinition
-	(*EntityProperty)(nil),                  // 75: olivetin.api.v1.EntityProperty
-	(*GetEntityRequest)(nil),                // 76: olivetin.api.v1.GetEntityRequest
-	(*RestartActionRequest)(nil),            // 77: olivetin.api.v1.RestartActionRequest
-	nil,                                     // 78: olivetin.api.v1.ActionWebhookExecHint.MatchHeadersEntry
-	nil,                                     // 79: olivetin.api.v1.ActionWebhookExecHint.MatchQueryEntry
-	nil,                                     // 80: olivetin.api.v1.ActionArgument.SuggestionsEntry
-	nil,                                     // 81: olivetin.api.v1.EntityRelatedAction.PrefilledArgumentsEntry
-	nil,                                     // 82: olivetin.api.v1.Entity.FieldsEntry
-	nil,                                     // 83: olivetin.api.v1.DumpVarsResponse.ContentsEntry
-	nil,                                     // 84: olivetin.api.v1.DumpPublicIdActionMapResponse.ContentsEntry
+	(*Action)(nil),                           // 0: olivetin.api.v1.Action
+	(*ActionGroupMembership)(nil),            // 1: olivetin.api.v1.ActionGroupMembership
+	(*ActionWebhookExecHint)(nil),            // 2: olivetin.api.v1.ActionWebhookExecHint
+	(*ActionMqttExecHint)(nil),               // 3: olivetin.api.v1.ActionMqttExecHint
+	(*ActionArgument)(nil),                   // 4: olivetin.api.v1.ActionArgument
+	(*ActionArgumentChoice)(nil),             // 5: olivetin.api.v1.ActionArgumentChoice
+	(*EntityRelatedAction)(nil),              // 6: olivetin.api.v1.EntityRelatedAction
+	(*Entity)(nil),                           // 7: olivetin.api.v1.Entity
+	(*GetDashboardResponse)(nil),             // 8: olivetin.api.v1.GetDashboardResponse
+	(*EffectivePolicy)(nil),                  // 9: olivetin.api.v1.EffectivePolicy
+	(*GetDashboardRequest)(nil),              // 10: olivetin.api.v1.GetDashboardRequest
+	(*Dashboard)(nil),                        // 11: olivetin.api.v1.Dashboard
+	(*DashboardComponent)(nil),               // 12: olivetin.api.v1.DashboardComponent
+	(*StartActionRequest)(nil),               // 13: olivetin.api.v1.StartActionRequest
+	(*StartActionArgument)(nil),              // 14: olivetin.api.v1.StartActionArgument
+	(*StartActionResponse)(nil),              // 15: olivetin.api.v1.StartActionResponse
+	(*StartActionAndWaitRequest)(nil),        // 16: olivetin.api.v1.StartActionAndWaitRequest
+	(*StartActionAndWaitResponse)(nil),       // 17: olivetin.api.v1.StartActionAndWaitResponse
+	(*StartActionByGetRequest)(nil),          // 18: olivetin.api.v1.StartActionByGetRequest
+	(*StartActionByGetResponse)(nil),         // 19: olivetin.api.v1.StartActionByGetResponse
+	(*StartActionByGetAndWaitRequest)(nil),   // 20: olivetin.api.v1.StartActionByGetAndWaitRequest
+	(*StartActionByGetAndWaitResponse)(nil),  // 21: olivetin.api.v1.StartActionByGetAndWaitResponse
+	(*GetLogsRequest)(nil),                   // 22: olivetin.api.v1.GetLogsRequest
+	(*LogEntry)(nil),                         // 23: olivetin.api.v1.LogEntry
+	(*ExecutionAttempt)(nil),                 // 24: olivetin.api.v1.ExecutionAttempt
+	(*GetLogsResponse)(nil),                  // 25: olivetin.api.v1.GetLogsResponse
+	(*WorkflowRun)(nil),                      // 26: olivetin.api.v1.WorkflowRun
+	(*WorkflowStepRun)(nil),                  // 27: olivetin.api.v1.WorkflowStepRun
+	(*StartWorkflowRequest)(nil),             // 28: olivetin.api.v1.StartWorkflowRequest
+	(*StartWorkflowResponse)(nil),            // 29: olivetin.api.v1.StartWorkflowResponse
+	(*GetActionLogsRequest)(nil),             // 30: olivetin.api.v1.GetActionLogsRequest
+	(*GetActionLogsResponse)(nil),            // 31: olivetin.api.v1.GetActionLogsResponse
+	(*GetExecutionQueueRequest)(nil),         // 32: olivetin.api.v1.GetExecutionQueueRequest
+	(*ExecutionQueueAction)(nil),             // 33: olivetin.api.v1.ExecutionQueueAction
+	(*ExecutionQueueGroup)(nil),              // 34: olivetin.api.v1.ExecutionQueueGroup
+	(*GetExecutionQueueResponse)(nil),        // 35: olivetin.api.v1.GetExecutionQueueResponse
+	(*ScheduledExecution)(nil),               // 36: olivetin.api.v1.ScheduledExecution
+	(*ScheduleActionRequest)(nil),            // 37: olivetin.api.v1.ScheduleActionRequest
+	(*ScheduleActionResponse)(nil),           // 38: olivetin.api.v1.ScheduleActionResponse
+	(*ListScheduledExecutionsRequest)(nil),   // 39: olivetin.api.v1.ListScheduledExecutionsRequest
+	(*ListScheduledExecutionsResponse)(nil),  // 40: olivetin.api.v1.ListScheduledExecutionsResponse
+	(*CancelScheduledExecutionRequest)(nil),  // 41: olivetin.api.v1.CancelScheduledExecutionRequest
+	(*CancelScheduledExecutionResponse)(nil), // 42: olivetin.api.v1.CancelScheduledExecutionResponse
+	(*CronSchedule)(nil),                     // 43: olivetin.api.v1.CronSchedule
+	(*ListCronSchedulesRequest)(nil),         // 44: olivetin.api.v1.ListCronSchedulesRequest
+	(*ListCronSchedulesResponse)(nil),        // 45: olivetin.api.v1.ListCronSchedulesResponse
+	(*PauseCronScheduleRequest)(nil),         // 46: olivetin.api.v1.PauseCronScheduleRequest
+	(*PauseCronScheduleResponse)(nil),        // 47: olivetin.api.v1.PauseCronScheduleResponse
+	(*ResumeCronScheduleRequest)(nil),        // 48: olivetin.api.v1.ResumeCronScheduleRequest
+	(*ResumeCronScheduleResponse)(nil),       // 49: olivetin.api.v1.ResumeCronScheduleResponse
+	(*ValidateArgumentTypeRequest)(nil),      // 50: olivetin.api.v1.ValidateArgumentTypeRequest
+	(*ValidateArgumentTypeResponse)(nil),     // 51: olivetin.api.v1.ValidateArgumentTypeResponse
+	(*WatchExecutionRequest)(nil),            // 52: olivetin.api.v1.WatchExecutionRequest
+	(*WatchExecutionUpdate)(nil),             // 53: olivetin.api.v1.WatchExecutionUpdate
+	(*ExecutionStatusRequest)(nil),           // 54: olivetin.api.v1.ExecutionStatusRequest
+	(*DashboardNavigationTarget)(nil),        // 55: olivetin.api.v1.DashboardNavigationTarget
+	(*ExecutionStatusResponse)(nil),          // 56: olivetin.api.v1.ExecutionStatusResponse
+	(*WhoAmIRequest)(nil),                    // 57: olivetin.api.v1.WhoAmIRequest
+	(*WhoAmIResponse)(nil),                   // 58: olivetin.api.v1.WhoAmIResponse
+	(*ServerDiagnosticsRequest)(nil),         // 59: olivetin.api.v1.ServerDiagnosticsRequest
+	(*ServerDiagnosticsResponse)(nil),        // 60: olivetin.api.v1.ServerDiagnosticsResponse
+	(*DumpVarsRequest)(nil),                  // 61: olivetin.api.v1.DumpVarsRequest
+	(*DumpVarsResponse)(nil),                 // 62: olivetin.api.v1.DumpVarsResponse
+	(*DebugBinding)(nil),                     // 63: olivetin.api.v1.DebugBinding
+	(*DumpPublicIdActionMapRequest)(nil),     // 64: olivetin.api.v1.DumpPublicIdActionMapRequest
+	(*DumpPublicIdActionMapResponse)(nil),    // 65: olivetin.api.v1.DumpPublicIdActionMapResponse
+	(*GetReadyzRequest)(nil),                 // 66: olivetin.api.v1.GetReadyzRequest
+	(*GetReadyzResponse)(nil),                // 67: olivetin.api.v1.GetReadyzResponse
+	(*EventStreamRequest)(nil),               // 68: olivetin.api.v1.EventStreamRequest
+	(*EventStreamResponse)(nil),              // 69: olivetin.api.v1.EventStreamResponse
+	(*EventOutputChunk)(nil),                 // 70: olivetin.api.v1.EventOutputChunk
+	(*EventEntityChanged)(nil),               // 71: olivetin.api.v1.EventEntityChanged
+	(*EventConfigChanged)(nil),               // 72: olivetin.api.v1.EventConfigChanged
+	(*EventHeartbeat)(nil),                   // 73: olivetin.api.v1.EventHeartbeat
+	(*EventExecutionFinished)(nil),           // 74: olivetin.api.v1.EventExecutionFinished
+	(*EventExecutionStarted)(nil),            // 75: olivetin.api.v1.EventExecutionStarted
+	(*KillActionRequest)(nil),                // 76: olivetin.api.v1.KillActionRequest
+	(*KillActionResponse)(nil),               // 77: olivetin.api.v1.KillActionResponse
+	(*LocalUserLoginRequest)(nil),            // 78: olivetin.api.v1.LocalUserLoginRequest
+	(*LocalUserLoginResponse)(nil),           // 79: olivetin.api.v1.LocalUserLoginResponse
+	(*BeginTotpEnrolmentRequest)(nil),        // 80: olivetin.api.v1.BeginTotpEnrolmentRequest
+	(*BeginTotpEnrolmentResponse)(nil),       // 81: olivetin.api.v1.BeginTotpEnrolmentResponse
+	(*ConfirmTotpEnrolmentRequest)(nil),      // 82: olivetin.api.v1.ConfirmTotpEnrolmentRequest
+	(*ConfirmTotpEnrolmentResponse)(nil),     // 83: olivetin.api.v1.ConfirmTotpEnrolmentResponse
+	(*DisableTotpRequest)(nil),               // 84: olivetin.api.v1.DisableTotpRequest
+	(*DisableTotpResponse)(nil),              // 85: olivetin.api.v1.DisableTotpResponse
+	(*PasswordHashRequest)(nil),              // 86: olivetin.api.v1.PasswordHashRequest
+	(*PasswordHashResponse)(nil),             // 87: olivetin.api.v1.PasswordHashResponse
+	(*LogoutRequest)(nil),                    // 88: olivetin.api.v1.LogoutRequest
+	(*LogoutResponse)(nil),                   // 89: olivetin.api.v1.LogoutResponse
+	(*GetDiagnosticsRequest)(nil),            // 90: olivetin.api.v1.GetDiagnosticsRequest
+	(*GetDiagnosticsResponse)(nil),           // 91: olivetin.api.v1.GetDiagnosticsResponse
+	(*WebhookDelivery)(nil),                  // 92: olivetin.api.v1.WebhookDelivery
+	(*GetAuditLogRequest)(nil),               // 93: olivetin.api.v1.GetAuditLogRequest
+	(*GetAuditLogResponse)(nil),              // 94: olivetin.api.v1.GetAuditLogResponse
+	(*AuditEvent)(nil),                       // 95: olivetin.api.v1.AuditEvent
+	(*InitRequest)(nil),                      // 96: olivetin.api.v1.InitRequest
+	(*InitResponse)(nil),                     // 97: olivetin.api.v1.InitResponse
+	(*AdditionalLink)(nil),                   // 98: olivetin.api.v1.AdditionalLink
+	(*OAuth2Provider)(nil),                   // 99: olivetin.api.v1.OAuth2Provider
+	(*GetActionBindingRequest)(nil),          // 100: olivetin.api.v1.GetActionBindingRequest
+	(*GetActionBindingResponse)(nil),         // 101: olivetin.api.v1.GetActionBindingResponse
+	(*GetEntitiesRequest)(nil),               // 102: olivetin.api.v1.GetEntitiesRequest
+	(*GetEntitiesResponse)(nil),              // 103: olivetin.api.v1.GetEntitiesResponse
+	(*EntityDefinition)(nil),                 // 104: olivetin.api.v1.EntityDefinition
+	(*EntityProperty)(nil),                   // 105: olivetin.api.v1.EntityProperty
+	(*GetEntityRequest)(nil),                 // 106: olivetin.api.v1.GetEntityRequest
+	(*RestartActionRequest)(nil),             // 107: olivetin.api.v1.RestartActionRequest
+	(*PendingApproval)(nil),                  // 108: olivetin.api.v1.PendingApproval
+	(*ListPendingApprovalsRequest)(nil),      // 109: olivetin.api.v1.ListPendingApprovalsRequest
+	(*ListPendingApprovalsResponse)(nil),     // 110: olivetin.api.v1.ListPendingApprovalsResponse
+	(*ApproveExecutionRequest)(nil),          // 111: olivetin.api.v1.ApproveExecutionRequest
+	(*ApproveExecutionResponse)(nil),         // 112: olivetin.api.v1.ApproveExecutionResponse
+	(*RejectExecutionRequest)(nil),           // 113: olivetin.api.v1.RejectExecutionRequest
+	(*RejectExecutionResponse)(nil),          // 114: olivetin.api.v1.RejectExecutionResponse
+	(*EventApprovalRequested)(nil),           // 115: olivetin.api.v1.EventApprovalRequested
+	(*EventApprovalResolved)(nil),            // 116: olivetin.api.v1.EventApprovalResolved
+	nil,                                      // 117: olivetin.api.v1.ActionWebhookExecHint.MatchHeadersEntry
+	nil,                                      // 118: olivetin.api.v1.ActionWebhookExecHint.MatchQueryEntry
+	nil,                                      // 119: olivetin.api.v1.ActionArgument.SuggestionsEntry
+	nil,                                      // 120: olivetin.api.v1.EntityRelatedAction.PrefilledArgumentsEntry
+	nil,                                      // 121: olivetin.api.v1.Entity.FieldsEntry
+	nil,                                      // 122: olivetin.api.v1.DumpVarsResponse.ContentsEntry
+	nil,                                      // 123: olivetin.api.v1.DumpPublicIdActionMapResponse.ContentsEntry
 }
 var file_olivetin_api_v1_olivetin_proto_depIdxs = []int32{
-	3,  // 0: olivetin.api.v1.Action.arguments:type_name -> olivetin.api.v1.ActionArgument
-	2,  // 1: olivetin.api.v1.Action.exec_on_webhooks:type_name -> olivetin.api.v1.ActionWebhookExecHint
-	1,  // 2: olivetin.api.v1.Action.groups:type_name -> olivetin.api.v1.ActionGroupMembership
-	78, // 3: olivetin.api.v1.ActionWebhookExecHint.match_headers:type_name -> olivetin.api.v1.ActionWebhookExecHint.MatchHeadersEntry
-	79, // 4: olivetin.api.v1.ActionWebhookExecHint.match_query:type_name -> olivetin.api.v1.ActionWebhookExecHint.MatchQueryEntry
-	4,  // 5: olivetin.api.v1.ActionArgument.choices:type_name -> olivetin.api.v1.ActionArgumentChoice
-	80, // 6: olivetin.api.v1.ActionArgument.suggestions:type_name -> olivetin.api.v1.ActionArgument.SuggestionsEntry
-	0,  // 7: olivetin.api.v1.EntityRelatedAction.action:type_name -> olivetin.api.v1.Action
-	81, // 8: olivetin.api.v1.EntityRelatedAction.prefilled_arguments:type_name -> olivetin.api.v1.EntityRelatedAction.PrefilledArgumentsEntry
-	82, // 9: olivetin.api.v1.Entity.fields:type_name -> olivetin.api.v1.Entity.FieldsEntry
-	5,  // 10: olivetin.api.v1.Entity.related_actions:type_name -> olivetin.api.v1.EntityRelatedAction
-	10, // 11: olivetin.api.v1.GetDashboardResponse.dashboard:type_name -> olivetin.api.v1.Dashboard
-	11, // 12: olivetin.api.v1.Dashboard.contents:type_name -> olivetin.api.v1.DashboardComponent
-	11, // 13: olivetin.api.v1.DashboardComponent.contents:type_name -> olivetin.api.v1.DashboardComponent
-	0,  // 14: olivetin.api.v1.DashboardComponent.action:type_name -> olivetin.api.v1.Action
-	13, // 15: olivetin.api.v1.StartActionRequest.arguments:type_name -> olivetin.api.v1.StartActionArgument
-	13, // 16: olivetin.api.v1.StartActionAndWaitRequest.arguments:type_name -> olivetin.api.v1.StartActionArgument
-	22, // 17: olivetin.api.v1.StartActionAndWaitResponse.log_entry:type_name -> olivetin.api.v1.LogEntry
-	22, // 18: olivetin.api.v1.StartActionByGetAndWaitResponse.log_entry:type_name -> olivetin.api.v1.LogEntry
-	13, // 19: olivetin.api.v1.LogEntry.arguments:type_name -> olivetin.api.v1.StartActionArgument
-	22, // 20: olivetin.api.v1.GetLogsResponse.logs:type_name -> olivetin.api.v1.LogEntry
-	22, // 21: olivetin.api.v1.GetActionLogsResponse.logs:type_name -> olivetin.api.v1.LogEntry
-	22, // 22: olivetin.api.v1.ExecutionQueueAction.entries:type_name -> olivetin.api.v1.LogEntry
-	27, // 23: olivetin.api.v1.ExecutionQueueGroup.actions:type_name -> olivetin.api.v1.ExecutionQueueAction
-	28, // 24: olivetin.api.v1.GetExecutionQueueResponse.groups:type_name -> olivetin.api.v1.ExecutionQueueGroup
-	22, // 25: olivetin.api.v1.ExecutionStatusResponse.log_entry:type_name -> olivetin.api.v1.LogEntry
-	35, // 26: olivetin.api.v1.ExecutionStatusResponse.back_to_dashboards:type_name -> olivetin.api.v1.DashboardNavigationTarget
-	83, // 27: olivetin.api.v1.DumpVarsResponse.contents:type_name -> olivetin.api.v1.DumpVarsResponse.ContentsEntry
-	84, // 28: olivetin.api.v1.DumpPublicIdActionMapResponse.contents:type_name -> olivetin.api.v1.DumpPublicIdActionMapResponse.ContentsEntry
-	51, // 29: olivetin.api.v1.EventStreamResponse.entity_changed:type_name -> olivetin.api.v1.EventEntityChanged
-	52, // 30: olivetin.api.v1.EventStreamResponse.config_changed:type_name -> olivetin.api.v1.EventConfigChanged
-	54, // 31: olivetin.api.v1.EventStreamResponse.execution_finished:type_name -> olivetin.api.v1.EventExecutionFinished
-	55, // 32: olivetin.api.v1.EventStreamResponse.execution_started:type_name -> olivetin.api.v1.EventExecutionStarted
-	50, // 33: olivetin.api.v1.EventStreamResponse.output_chunk:type_name -> olivetin.api.v1.EventOutputChunk
-	53, // 34: olivetin.api.v1.EventStreamResponse.heartbeat:type_name -> olivetin.api.v1.EventHeartbeat
-	22, // 35: olivetin.api.v1.EventExecutionFinished.log_entry:type_name -> olivetin.api.v1.LogEntry
-	22, // 36: olivetin.api.v1.EventExecutionStarted.log_entry:type_name -> olivetin.api.v1.LogEntry
-	69, // 37: olivetin.api.v1.InitResponse.oAuth2Providers:type_name -> olivetin.api.v1.OAuth2Provider
-	68, // 38: olivetin.api.v1.InitResponse.additionalLinks:type_name -> olivetin.api.v1.AdditionalLink
-	8,  // 39: olivetin.api.v1.InitResponse.effective_policy:type_name -> olivetin.api.v1.EffectivePolicy
-	0,  // 40: olivetin.api.v1.GetActionBindingResponse.action:type_name -> olivetin.api.v1.Action
-	35, // 41: olivetin.api.v1.GetActionBindingResponse.back_to_dashboards:type_name -> olivetin.api.v1.DashboardNavigationTarget
-	74, // 42: olivetin.api.v1.GetEntitiesResponse.entity_definitions:type_name -> olivetin.api.v1.EntityDefinition
-	6,  // 43: olivetin.api.v1.EntityDefinition.instances:type_name -> olivetin.api.v1.Entity
-	75, // 44: olivetin.api.v1.EntityDefinition.properties:type_name -> olivetin.api.v1.EntityProperty
-	43, // 45: olivetin.api.v1.DumpPublicIdActionMapResponse.ContentsEntry.value:type_name -> olivetin.api.v1.DebugBinding
-	9,  // 46: olivetin.api.v1.OliveTinApiService.GetDashboard:input_type -> olivetin.api.v1.GetDashboardRequest
-	12, // 47: olivetin.api.v1.OliveTinApiService.StartAction:input_type -> olivetin.api.v1.StartActionRequest
-	15, // 48: olivetin.api.v1.OliveTinApiService.StartActionAndWait:input_type -> olivetin.api.v1.StartActionAndWaitRequest
-	17, // 49: olivetin.api.v1.OliveTinApiService.StartActionByGet:input_type -> olivetin.api.v1.StartActionByGetRequest
-	19, // 50: olivetin.api.v1.OliveTinApiService.StartActionByGetAndWait:input_type -> olivetin.api.v1.StartActionByGetAndWaitRequest
-	77, // 51: olivetin.api.v1.OliveTinApiService.RestartAction:input_type -> olivetin.api.v1.RestartActionRequest
-	56, // 52: olivetin.api.v1.OliveTinApiService.KillAction:input_type -> olivetin.api.v1.KillActionRequest
-	34, // 53: olivetin.api.v1.OliveTinApiService.ExecutionStatus:input_type -> olivetin.api.v1.ExecutionStatusRequest
-	21, // 54: olivetin.api.v1.OliveTinApiService.GetLogs:input_type -> olivetin.api.v1.GetLogsRequest
-	24, // 55: olivetin.api.v1.OliveTinApiService.GetActionLogs:input_type -> olivetin.api.v1.GetActionLogsRequest
-	26, // 56: olivetin.api.v1.OliveTinApiService.GetExecutionQueue:input_type -> olivetin.api.v1.GetExecutionQueueRequest
-	30, // 57: olivetin.api.v1.OliveTinApiService.ValidateArgumentType:input_type -> olivetin.api.v1.ValidateArgumentTypeRequest
-	37, // 58: olivetin.api.v1.OliveTinApiService.WhoAmI:input_type -> olivetin.api.v1.WhoAmIRequest
-	39, // 59: olivetin.api.v1.OliveTinApiService.ServerDiagnostics:input_type -> olivetin.api.v1.ServerDiagnosticsRequest
-	41, // 60: olivetin.api.v1.OliveTinApiService.DumpVars:input_type -> olivetin.api.v1.DumpVarsRequest
-	44, // 61: olivetin.api.v1.OliveTinApiService.DumpPublicIdActionMap:input_type -> olivetin.api.v1.DumpPublicIdActionMapRequest
-	46, // 62: olivetin.api.v1.OliveTinApiService.GetReadyz:input_type -> olivetin.api.v1.GetReadyzRequest
-	58, // 63: olivetin.api.v1.OliveTinApiService.LocalUserLogin:input_type -> olivetin.api.v1.LocalUserLoginRequest
-	60, // 64: olivetin.api.v1.OliveTinApiService.PasswordHash:input_type -> olivetin.api.v1.PasswordHashRequest
-	62, // 65: olivetin.api.v1.OliveTinApiService.Logout:input_type -> olivetin.api.v1.LogoutRequest
-	48, // 66: olivetin.api.v1.OliveTinApiService.EventStream:input_type -> olivetin.api.v1.EventStreamRequest
-	64, // 67: olivetin.api.v1.OliveTinApiService.GetDiagnostics:input_type -> olivetin.api.v1.GetDiagnosticsRequest
-	66, // 68: olivetin.api.v1.OliveTinApiService.Init:input_type -> olivetin.api.v1.InitRequest
-	70, // 69: olivetin.api.v1.OliveTinApiService.GetActionBinding:input_type -> olivetin.api.v1.GetActionBindingRequest
-	72, // 70: olivetin.api.v1.OliveTinApiService.GetEntities:input_type -> olivetin.api.v1.GetEntitiesRequest
-	76, // 71: olivetin.api.v1.OliveTinApiService.GetEntity:input_type -> olivetin.api.v1.GetEntityRequest
-	7,  // 72: olivetin.api.v1.OliveTinApiService.GetDashboard:output_type -> olivetin.api.v1.GetDashboardResponse
-	14, // 73: olivetin.api.v1.OliveTinApiService.StartAction:output_type -> olivetin.api.v1.StartActionResponse
-	16, // 74: olivetin.api.v1.OliveTinApiService.StartActionAndWait:output_type -> olivetin.api.v1.StartActionAndWaitResponse
-	18, // 75: olivetin.api.v1.OliveTinApiService.StartActionByGet:output_type -> olivetin.api.v1.StartActionByGetResponse
-	20, // 76: olivetin.api.v1.OliveTinApiService.StartActionByGetAndWait:output_type -> olivetin.api.v1.StartActionByGetAndWaitResponse
-	14, // 77: olivetin.api.v1.OliveTinApiService.RestartAction:output_type -> olivetin.api.v1.StartActionResponse
-	57, // 78: olivetin.api.v1.OliveTinApiService.KillAction:output_type -> olivetin.api.v1.KillActionResponse
-	36, // 79: olivetin.api.v1.OliveTinApiService.ExecutionStatus:output_type -> olivetin.api.v1.ExecutionStatusResponse
-	23, // 80: olivetin.api.v1.OliveTinApiService.GetLogs:output_type -> olivetin.api.v1.GetLogsResponse
-	25, // 81: olivetin.api.v1.OliveTinApiService.GetActionLogs:output_type -> olivetin.api.v1.GetActionLogsResponse
-	29, // 82: olivetin.api.v1.OliveTinApiService.GetExecutionQueue:output_type -> olivetin.api.v1.GetExecutionQueueResponse
-	31, // 83: olivetin.api.v1.OliveTinApiService.ValidateArgumentType:output_type -> olivetin.api.v1.ValidateArgumentTypeResponse
-	38, // 84: olivetin.api.v1.OliveTinApiService.WhoAmI:output_type -> olivetin.api.v1.WhoAmIResponse
-	40, // 85: olivetin.api.v1.OliveTinApiService.ServerDiagnostics:output_type -> olivetin.api.v1.ServerDiagnosticsResponse
-	42, // 86: olivetin.api.v1.OliveTinApiService.DumpVars:output_type -> olivetin.api.v1.DumpVarsResponse
-	45, // 87: olivetin.api.v1.OliveTinApiService.DumpPublicIdActionMap:output_type -> olivetin.api.v1.DumpPublicIdActionMapResponse
-	47, // 88: olivetin.api.v1.OliveTinApiService.GetReadyz:output_type -> olivetin.api.v1.GetReadyzResponse
-	59, // 89: olivetin.api.v1.OliveTinApiService.LocalUserLogin:output_type -> olivetin.api.v1.LocalUserLoginResponse
-	61, // 90: olivetin.api.v1.OliveTinApiService.PasswordHash:output_type -> olivetin.api.v1.PasswordHashResponse
-	63, // 91: olivetin.api.v1.OliveTinApiService.Logout:output_type -> olivetin.api.v1.LogoutResponse
-	49, // 92: olivetin.api.v1.OliveTinApiService.EventStream:output_type -> olivetin.api.v1.EventStreamResponse
-	65, // 93: olivetin.api.v1.OliveTinApiService.GetDiagnostics:output_type -> olivetin.api.v1.GetDiagnosticsResponse
-	67, // 94: olivetin.api.v1.OliveTinApiService.Init:output_type -> olivetin.api.v1.InitResponse
-	71, // 95: olivetin.api.v1.OliveTinApiService.GetActionBinding:output_type -> olivetin.api.v1.GetActionBindingResponse
-	73, // 96: olivetin.api.v1.OliveTinApiService.GetEntities:output_type -> olivetin.api.v1.GetEntitiesResponse
-	6,  // 97: olivetin.api.v1.OliveTinApiService.GetEntity:output_type -> olivetin.api.v1.Entity
-	72, // [72:98] is the sub-list for method output_type
-	46, // [46:72] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+	4,   // 0: olivetin.api.v1.Action.arguments:type_name -> olivetin.api.v1.ActionArgument
+	2,   // 1: olivetin.api.v1.Action.exec_on_webhooks:type_name -> olivetin.api.v1.ActionWebhookExecHint
+	1,   // 2: olivetin.api.v1.Action.groups:type_name -> olivetin.api.v1.ActionGroupMembership
+	3,   // 3: olivetin.api.v1.Action.exec_on_mqtt:type_name -> olivetin.api.v1.ActionMqttExecHint
+	117, // 4: olivetin.api.v1.ActionWebhookExecHint.match_headers:type_name -> olivetin.api.v1.ActionWebhookExecHint.MatchHeadersEntry
+	118, // 5: olivetin.api.v1.ActionWebhookExecHint.match_query:type_name -> olivetin.api.v1.ActionWebhookExecHint.MatchQueryEntry
+	5,   // 6: olivetin.api.v1.ActionArgument.choices:type_name -> olivetin.api.v1.ActionArgumentChoice
+	119, // 7: olivetin.api.v1.ActionArgument.suggestions:type_name -> olivetin.api.v1.ActionArgument.SuggestionsEntry
+	0,   // 8: olivetin.api.v1.EntityRelatedAction.action:type_name -> olivetin.api.v1.Action
+	120, // 9: olivetin.api.v1.EntityRelatedAction.prefilled_arguments:type_name -> olivetin.api.v1.EntityRelatedAction.PrefilledArgumentsEntry
+	121, // 10: olivetin.api.v1.Entity.fields:type_name -> olivetin.api.v1.Entity.FieldsEntry
+	6,   // 11: olivetin.api.v1.Entity.related_actions:type_name -> olivetin.api.v1.EntityRelatedAction
+	11,  // 12: olivetin.api.v1.GetDashboardResponse.dashboard:type_name -> olivetin.api.v1.Dashboard
+	12,  // 13: olivetin.api.v1.Dashboard.contents:type_name -> olivetin.api.v1.DashboardComponent
+	12,  // 14: olivetin.api.v1.DashboardComponent.contents:type_name -> olivetin.api.v1.DashboardComponent
+	0,   // 15: olivetin.api.v1.DashboardComponent.action:type_name -> olivetin.api.v1.Action
+	14,  // 16: olivetin.api.v1.StartActionRequest.arguments:type_name -> olivetin.api.v1.StartActionArgument
+	14,  // 17: olivetin.api.v1.StartActionAndWaitRequest.arguments:type_name -> olivetin.api.v1.StartActionArgument
+	23,  // 18: olivetin.api.v1.StartActionAndWaitResponse.log_entry:type_name -> olivetin.api.v1.LogEntry
+	23,  // 19: olivetin.api.v1.StartActionByGetAndWaitResponse.log_entry:type_name -> olivetin.api.v1.LogEntry
+	14,  // 20: olivetin.api.v1.LogEntry.arguments:type_name -> olivetin.api.v1.StartActionArgument
+	24,  // 21: olivetin.api.v1.LogEntry.attempts:type_name -> olivetin.api.v1.ExecutionAttempt
+	23,  // 22: olivetin.api.v1.GetLogsResponse.logs:type_name -> olivetin.api.v1.LogEntry
+	26,  // 23: olivetin.api.v1.GetLogsResponse.workflow_run:type_name -> olivetin.api.v1.WorkflowRun
+	27,  // 24: olivetin.api.v1.WorkflowRun.steps:type_name -> olivetin.api.v1.WorkflowStepRun
+	14,  // 25: olivetin.api.v1.StartWorkflowRequest.arguments:type_name -> olivetin.api.v1.StartActionArgument
+	23,  // 26: olivetin.api.v1.GetActionLogsResponse.logs:type_name -> olivetin.api.v1.LogEntry
+	23,  // 27: olivetin.api.v1.ExecutionQueueAction.entries:type_name -> olivetin.api.v1.LogEntry
+	33,  // 28: olivetin.api.v1.ExecutionQueueGroup.actions:type_name -> olivetin.api.v1.ExecutionQueueAction
+	34,  // 29: olivetin.api.v1.GetExecutionQueueResponse.groups:type_name -> olivetin.api.v1.ExecutionQueueGroup
+	36,  // 30: olivetin.api.v1.GetExecutionQueueResponse.scheduled:type_name -> olivetin.api.v1.ScheduledExecution
+	14,  // 31: olivetin.api.v1.ScheduledExecution.arguments:type_name -> olivetin.api.v1.StartActionArgument
+	14,  // 32: olivetin.api.v1.ScheduleActionRequest.arguments:type_name -> olivetin.api.v1.StartActionArgument
+	36,  // 33: olivetin.api.v1.ScheduleActionResponse.scheduled_execution:type_name -> olivetin.api.v1.ScheduledExecution
+	36,  // 34: olivetin.api.v1.ListScheduledExecutionsResponse.scheduled_executions:type_name -> olivetin.api.v1.ScheduledExecution
+	23,  // 35: olivetin.api.v1.CronSchedule.last_execution:type_name -> olivetin.api.v1.LogEntry
+	43,  // 36: olivetin.api.v1.ListCronSchedulesResponse.schedules:type_name -> olivetin.api.v1.CronSchedule
+	43,  // 37: olivetin.api.v1.PauseCronScheduleResponse.schedule:type_name -> olivetin.api.v1.CronSchedule
+	43,  // 38: olivetin.api.v1.ResumeCronScheduleResponse.schedule:type_name -> olivetin.api.v1.CronSchedule
+	23,  // 39: olivetin.api.v1.ExecutionStatusResponse.log_entry:type_name -> olivetin.api.v1.LogEntry
+	55,  // 40: olivetin.api.v1.ExecutionStatusResponse.back_to_dashboards:type_name -> olivetin.api.v1.DashboardNavigationTarget
+	122, // 41: olivetin.api.v1.DumpVarsResponse.contents:type_name -> olivetin.api.v1.DumpVarsResponse.ContentsEntry
+	123, // 42: olivetin.api.v1.DumpPublicIdActionMapResponse.contents:type_name -> olivetin.api.v1.DumpPublicIdActionMapResponse.ContentsEntry
+	71,  // 43: olivetin.api.v1.EventStreamResponse.entity_changed:type_name -> olivetin.api.v1.EventEntityChanged
+	72,  // 44: olivetin.api.v1.EventStreamResponse.config_changed:type_name -> olivetin.api.v1.EventConfigChanged
+	74,  // 45: olivetin.api.v1.EventStreamResponse.execution_finished:type_name -> olivetin.api.v1.EventExecutionFinished
+	75,  // 46: olivetin.api.v1.EventStreamResponse.execution_started:type_name -> olivetin.api.v1.EventExecutionStarted
+	70,  // 47: olivetin.api.v1.EventStreamResponse.output_chunk:type_name -> olivetin.api.v1.EventOutputChunk
+	73,  // 48: olivetin.api.v1.EventStreamResponse.heartbeat:type_name -> olivetin.api.v1.EventHeartbeat
+	115, // 49: olivetin.api.v1.EventStreamResponse.approval_requested:type_name -> olivetin.api.v1.EventApprovalRequested
+	116, // 50: olivetin.api.v1.EventStreamResponse.approval_resolved:type_name -> olivetin.api.v1.EventApprovalResolved
+	23,  // 51: olivetin.api.v1.EventExecutionFinished.log_entry:type_name -> olivetin.api.v1.LogEntry
+	23,  // 52: olivetin.api.v1.EventExecutionStarted.log_entry:type_name -> olivetin.api.v1.LogEntry
+	92,  // 53: olivetin.api.v1.GetDiagnosticsResponse.webhook_deliveries:type_name -> olivetin.api.v1.WebhookDelivery
+	95,  // 54: olivetin.api.v1.GetAuditLogResponse.events:type_name -> olivetin.api.v1.AuditEvent
+	99,  // 55: olivetin.api.v1.InitResponse.oAuth2Providers:type_name -> olivetin.api.v1.OAuth2Provider
+	98,  // 56: olivetin.api.v1.InitResponse.additionalLinks:type_name -> olivetin.api.v1.AdditionalLink
+	9,   // 57: olivetin.api.v1.InitResponse.effective_policy:type_name -> olivetin.api.v1.EffectivePolicy
+	0,   // 58: olivetin.api.v1.GetActionBindingResponse.action:type_name -> olivetin.api.v1.Action
+	55,  // 59: olivetin.api.v1.GetActionBindingResponse.back_to_dashboards:type_name -> olivetin.api.v1.DashboardNavigationTarget
+	104, // 60: olivetin.api.v1.GetEntitiesResponse.entity_definitions:type_name -> olivetin.api.v1.EntityDefinition
+	7,   // 61: olivetin.api.v1.EntityDefinition.instances:type_name -> olivetin.api.v1.Entity
+	105, // 62: olivetin.api.v1.EntityDefinition.properties:type_name -> olivetin.api.v1.EntityProperty
+	23,  // 63: olivetin.api.v1.PendingApproval.log_entry:type_name -> olivetin.api.v1.LogEntry
+	108, // 64: olivetin.api.v1.ListPendingApprovalsResponse.approvals:type_name -> olivetin.api.v1.PendingApproval
+	108, // 65: olivetin.api.v1.EventApprovalRequested.approval:type_name -> olivetin.api.v1.PendingApproval
+	23,  // 66: olivetin.api.v1.EventApprovalResolved.log_entry:type_name -> olivetin.api.v1.LogEntry
+	63,  // 67: olivetin.api.v1.DumpPublicIdActionMapResponse.ContentsEntry.value:type_name -> olivetin.api.v1.DebugBinding
+	10,  // 68: olivetin.api.v1.OliveTinApiService.GetDashboard:input_type -> olivetin.api.v1.GetDashboardRequest
+	13,  // 69: olivetin.api.v1.OliveTinApiService.StartAction:input_type -> olivetin.api.v1.StartActionRequest
+	16,  // 70: olivetin.api.v1.OliveTinApiService.StartActionAndWait:input_type -> olivetin.api.v1.StartActionAndWaitRequest
+	18,  // 71: olivetin.api.v1.OliveTinApiService.StartActionByGet:input_type -> olivetin.api.v1.StartActionByGetRequest
+	20,  // 72: olivetin.api.v1.OliveTinApiService.StartActionByGetAndWait:input_type -> olivetin.api.v1.StartActionByGetAndWaitRequest
+	107, // 73: olivetin.api.v1.OliveTinApiService.RestartAction:input_type -> olivetin.api.v1.RestartActionRequest
+	76,  // 74: olivetin.api.v1.OliveTinApiService.KillAction:input_type -> olivetin.api.v1.KillActionRequest
+	54,  // 75: olivetin.api.v1.OliveTinApiService.ExecutionStatus:input_type -> olivetin.api.v1.ExecutionStatusRequest
+	22,  // 76: olivetin.api.v1.OliveTinApiService.GetLogs:input_type -> olivetin.api.v1.GetLogsRequest
+	28,  // 77: olivetin.api.v1.OliveTinApiService.StartWorkflow:input_type -> olivetin.api.v1.StartWorkflowRequest
+	30,  // 78: olivetin.api.v1.OliveTinApiService.GetActionLogs:input_type -> olivetin.api.v1.GetActionLogsRequest
+	32,  // 79: olivetin.api.v1.OliveTinApiService.GetExecutionQueue:input_type -> olivetin.api.v1.GetExecutionQueueRequest
+	37,  // 80: olivetin.api.v1.OliveTinApiService.ScheduleAction:input_type -> olivetin.api.v1.ScheduleActionRequest
+	39,  // 81: olivetin.api.v1.OliveTinApiService.ListScheduledExecutions:input_type -> olivetin.api.v1.ListScheduledExecutionsRequest
+	41,  // 82: olivetin.api.v1.OliveTinApiService.CancelScheduledExecution:input_type -> olivetin.api.v1.CancelScheduledExecutionRequest
+	44,  // 83: olivetin.api.v1.OliveTinApiService.ListCronSchedules:input_type -> olivetin.api.v1.ListCronSchedulesRequest
+	46,  // 84: olivetin.api.v1.OliveTinApiService.PauseCronSchedule:input_type -> olivetin.api.v1.PauseCronScheduleRequest
+	48,  // 85: olivetin.api.v1.OliveTinApiService.ResumeCronSchedule:input_type -> olivetin.api.v1.ResumeCronScheduleRequest
+	109, // 86: olivetin.api.v1.OliveTinApiService.ListPendingApprovals:input_type -> olivetin.api.v1.ListPendingApprovalsRequest
+	111, // 87: olivetin.api.v1.OliveTinApiService.ApproveExecution:input_type -> olivetin.api.v1.ApproveExecutionRequest
+	113, // 88: olivetin.api.v1.OliveTinApiService.RejectExecution:input_type -> olivetin.api.v1.RejectExecutionRequest
+	50,  // 89: olivetin.api.v1.OliveTinApiService.ValidateArgumentType:input_type -> olivetin.api.v1.ValidateArgumentTypeRequest
+	57,  // 90: olivetin.api.v1.OliveTinApiService.WhoAmI:input_type -> olivetin.api.v1.WhoAmIRequest
+	59,  // 91: olivetin.api.v1.OliveTinApiService.ServerDiagnostics:input_type -> olivetin.api.v1.ServerDiagnosticsRequest
+	61,  // 92: olivetin.api.v1.OliveTinApiService.DumpVars:input_type -> olivetin.api.v1.DumpVarsRequest
+	64,  // 93: olivetin.api.v1.OliveTinApiService.DumpPublicIdActionMap:input_type -> olivetin.api.v1.DumpPublicIdActionMapRequest
+	66,  // 94: olivetin.api.v1.OliveTinApiService.GetReadyz:input_type -> olivetin.api.v1.GetReadyzRequest
+	78,  // 95: olivetin.api.v1.OliveTinApiService.LocalUserLogin:input_type -> olivetin.api.v1.LocalUserLoginRequest
+	80,  // 96: olivetin.api.v1.OliveTinApiService.BeginTotpEnrolment:input_type -> olivetin.api.v1.BeginTotpEnrolmentRequest
+	82,  // 97: olivetin.api.v1.OliveTinApiService.ConfirmTotpEnrolment:input_type -> olivetin.api.v1.ConfirmTotpEnrolmentRequest
+	84,  // 98: olivetin.api.v1.OliveTinApiService.DisableTotp:input_type -> olivetin.api.v1.DisableTotpRequest
+	86,  // 99: olivetin.api.v1.OliveTinApiService.PasswordHash:input_type -> olivetin.api.v1.PasswordHashRequest
+	88,  // 100: olivetin.api.v1.OliveTinApiService.Logout:input_type -> olivetin.api.v1.LogoutRequest
+	68,  // 101: olivetin.api.v1.OliveTinApiService.EventStream:input_type -> olivetin.api.v1.EventStreamRequest
+	90,  // 102: olivetin.api.v1.OliveTinApiService.GetDiagnostics:input_type -> olivetin.api.v1.GetDiagnosticsRequest
+	93,  // 103: olivetin.api.v1.OliveTinApiService.GetAuditLog:input_type -> olivetin.api.v1.GetAuditLogRequest
+	96,  // 104: olivetin.api.v1.OliveTinApiService.Init:input_type -> olivetin.api.v1.InitRequest
+	100, // 105: olivetin.api.v1.OliveTinApiService.GetActionBinding:input_type -> olivetin.api.v1.GetActionBindingRequest
+	102, // 106: olivetin.api.v1.OliveTinApiService.GetEntities:input_type -> olivetin.api.v1.GetEntitiesRequest
+	106, // 107: olivetin.api.v1.OliveTinApiService.GetEntity:input_type -> olivetin.api.v1.GetEntityRequest
+	8,   // 108: olivetin.api.v1.OliveTinApiService.GetDashboard:output_type -> olivetin.api.v1.GetDashboardResponse
+	15,  // 109: olivetin.api.v1.OliveTinApiService.StartAction:output_type -> olivetin.api.v1.StartActionResponse
+	17,  // 110: olivetin.api.v1.OliveTinApiService.StartActionAndWait:output_type -> olivetin.api.v1.StartActionAndWaitResponse
+	19,  // 111: olivetin.api.v1.OliveTinApiService.StartActionByGet:output_type -> olivetin.api.v1.StartActionByGetResponse
+	21,  // 112: olivetin.api.v1.OliveTinApiService.StartActionByGetAndWait:output_type -> olivetin.api.v1.StartActionByGetAndWaitResponse
+	15,  // 113: olivetin.api.v1.OliveTinApiService.RestartAction:output_type -> olivetin.api.v1.StartActionResponse
+	77,  // 114: olivetin.api.v1.OliveTinApiService.KillAction:output_type -> olivetin.api.v1.KillActionResponse
+	56,  // 115: olivetin.api.v1.OliveTinApiService.ExecutionStatus:output_type -> olivetin.api.v1.ExecutionStatusResponse
+	25,  // 116: olivetin.api.v1.OliveTinApiService.GetLogs:output_type -> olivetin.api.v1.GetLogsResponse
+	29,  // 117: olivetin.api.v1.OliveTinApiService.StartWorkflow:output_type -> olivetin.api.v1.StartWorkflowResponse
+	31,  // 118: olivetin.api.v1.OliveTinApiService.GetActionLogs:output_type -> olivetin.api.v1.GetActionLogsResponse
+	35,  // 119: olivetin.api.v1.OliveTinApiService.GetExecutionQueue:output_type -> olivetin.api.v1.GetExecutionQueueResponse
+	38,  // 120: olivetin.api.v1.OliveTinApiService.ScheduleAction:output_type -> olivetin.api.v1.ScheduleActionResponse
+	40,  // 121: olivetin.api.v1.OliveTinApiService.ListScheduledExecutions:output_type -> olivetin.api.v1.ListScheduledExecutionsResponse
+	42,  // 122: olivetin.api.v1.OliveTinApiService.CancelScheduledExecution:output_type -> olivetin.api.v1.CancelScheduledExecutionResponse
+	45,  // 123: olivetin.api.v1.OliveTinApiService.ListCronSchedules:output_type -> olivetin.api.v1.ListCronSchedulesResponse
+	47,  // 124: olivetin.api.v1.OliveTinApiService.PauseCronSchedule:output_type -> olivetin.api.v1.PauseCronScheduleResponse
+	49,  // 125: olivetin.api.v1.OliveTinApiService.ResumeCronSchedule:output_type -> olivetin.api.v1.ResumeCronScheduleResponse
+	110, // 126: olivetin.api.v1.OliveTinApiService.ListPendingApprovals:output_type -> olivetin.api.v1.ListPendingApprovalsResponse
+	112, // 127: olivetin.api.v1.OliveTinApiService.ApproveExecution:output_type -> olivetin.api.v1.ApproveExecutionResponse
+	114, // 128: olivetin.api.v1.OliveTinApiService.RejectExecution:output_type -> olivetin.api.v1.RejectExecutionResponse
+	51,  // 129: olivetin.api.v1.OliveTinApiService.ValidateArgumentType:output_type -> olivetin.api.v1.ValidateArgumentTypeResponse
+	58,  // 130: olivetin.api.v1.OliveTinApiService.WhoAmI:output_type -> olivetin.api.v1.WhoAmIResponse
+	60,  // 131: olivetin.api.v1.OliveTinApiService.ServerDiagnostics:output_type -> olivetin.api.v1.ServerDiagnosticsResponse
+	62,  // 132: olivetin.api.v1.OliveTinApiService.DumpVars:output_type -> olivetin.api.v1.DumpVarsResponse
+	65,  // 133: olivetin.api.v1.OliveTinApiService.DumpPublicIdActionMap:output_type -> olivetin.api.v1.DumpPublicIdActionMapResponse
+	67,  // 134: olivetin.api.v1.OliveTinApiService.GetReadyz:output_type -> olivetin.api.v1.GetReadyzResponse
+	79,  // 135: olivetin.api.v1.OliveTinApiService.LocalUserLogin:output_type -> olivetin.api.v1.LocalUserLoginResponse
+	81,  // 136: olivetin.api.v1.OliveTinApiService.BeginTotpEnrolment:output_type -> olivetin.api.v1.BeginTotpEnrolmentResponse
+	83,  // 137: olivetin.api.v1.OliveTinApiService.ConfirmTotpEnrolment:output_type -> olivetin.api.v1.ConfirmTotpEnrolmentResponse
+	85,  // 138: olivetin.api.v1.OliveTinApiService.DisableTotp:output_type -> olivetin.api.v1.DisableTotpResponse
+	87,  // 139: olivetin.api.v1.OliveTinApiService.PasswordHash:output_type -> olivetin.api.v1.PasswordHashResponse
+	89,  // 140: olivetin.api.v1.OliveTinApiService.Logout:output_type -> olivetin.api.v1.LogoutResponse
+	69,  // 141: olivetin.api.v1.OliveTinApiService.EventStream:output_type -> olivetin.api.v1.EventStreamResponse
+	91,  // 142: olivetin.api.v1.OliveTinApiService.GetDiagnostics:output_type -> olivetin.api.v1.GetDiagnosticsResponse
+	94,  // 143: olivetin.api.v1.OliveTinApiService.GetAuditLog:output_type -> olivetin.api.v1.GetAuditLogResponse
+	97,  // 144: olivetin.api.v1.OliveTinApiService.Init:output_type -> olivetin.api.v1.InitResponse
+	101, // 145: olivetin.api.v1.OliveTinApiService.GetActionBinding:output_type -> olivetin.api.v1.GetActionBindingResponse
+	103, // 146: olivetin.api.v1.OliveTinApiService.GetEntities:output_type -> olivetin.api.v1.GetEntitiesResponse
+	7,   // 147: olivetin.api.v1.OliveTinApiService.GetEntity:output_type -> olivetin.api.v1.Entity
+	108, // [108:148] is the sub-list for method output_type
+	68,  // [68:108] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_olivetin_api_v1_olivetin_proto_init() }
@@ -5358,13 +8151,15 @@ func file_olivetin_api_v1_olivetin_proto_init() {
 	if File_olivetin_api_v1_olivetin_proto != nil {
 		return
 	}
-	file_olivetin_api_v1_olivetin_proto_msgTypes[49].OneofWrappers = []any{
+	file_olivetin_api_v1_olivetin_proto_msgTypes[69].OneofWrappers = []any{
 		(*EventStreamResponse_EntityChanged)(nil),
 		(*EventStreamResponse_ConfigChanged)(nil),
 		(*EventStreamResponse_ExecutionFinished)(nil),
 		(*EventStreamResponse_ExecutionStarted)(nil),
 		(*EventStreamResponse_OutputChunk)(nil),
 		(*EventStreamResponse_Heartbeat)(nil),
+		(*EventStreamResponse_ApprovalRequested)(nil),
+		(*EventStreamResponse_ApprovalResolved)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5372,7 +8167,7 @@ func file_olivetin_api_v1_olivetin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_olivetin_api_v1_olivetin_proto_rawDesc), len(file_olivetin_api_v1_olivetin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   85,
+			NumMessages:   124,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
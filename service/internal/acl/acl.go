@@ -1,6 +1,7 @@
 package acl
 
 import (
+	"github.com/OliveTin/OliveTin/internal/audit"
 	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
 	config "github.com/OliveTin/OliveTin/internal/config"
 	log "github.com/sirupsen/logrus"
@@ -79,6 +80,34 @@ func permissionsConfigToBits(permissions config.PermissionsList) PermissionBits
 	return ret
 }
 
+// aclPermissionBits drops exec from ACLs that require TOTP, for users who did
+// not enter a code when they logged in.
+func aclPermissionBits(acl *config.AccessControlList, user *authpublic.AuthenticatedUser) PermissionBits {
+	permissionBits := permissionsConfigToBits(acl.Permissions)
+
+	if acl.RequireTotpForExec && !user.TotpVerified {
+		permissionBits &^= Exec
+	}
+
+	return permissionBits
+}
+
+// isMissingTotp is true when an ACL that would have given exec requires TOTP,
+// so that the user does not get exec from the default permissions instead.
+func isMissingTotp(requiredPermission PermissionBits, relevantAcls []*config.AccessControlList, user *authpublic.AuthenticatedUser) bool {
+	if !requiredPermission.Has(Exec) {
+		return false
+	}
+
+	for _, acl := range relevantAcls {
+		if acl.RequireTotpForExec {
+			return !user.TotpVerified
+		}
+	}
+
+	return false
+}
+
 func aclCheck(requiredPermission PermissionBits, defaultValue bool, cfg *config.Config, aclFunction string, user *authpublic.AuthenticatedUser, resourceTitle string, resourceAcls []string, includeAddToEvery bool) bool {
 	relevantAcls := getRelevantAcls(cfg, resourceAcls, user, includeAddToEvery)
 
@@ -93,7 +122,7 @@ func aclCheck(requiredPermission PermissionBits, defaultValue bool, cfg *config.
 	}
 
 	for _, acl := range relevantAcls {
-		permissionBits := permissionsConfigToBits(acl.Permissions)
+		permissionBits := aclPermissionBits(acl, user)
 
 		if permissionBits.Has(requiredPermission) {
 			logAclMatched(cfg, aclFunction, user, resourceTitle, acl)
@@ -104,6 +133,10 @@ func aclCheck(requiredPermission PermissionBits, defaultValue bool, cfg *config.
 		logAclNotMatched(cfg, aclFunction, user, resourceTitle, acl)
 	}
 
+	if isMissingTotp(requiredPermission, relevantAcls, user) {
+		return false
+	}
+
 	logAclNoneMatched(cfg, aclFunction, user, resourceTitle, defaultValue)
 
 	return defaultValue
@@ -132,6 +165,55 @@ func IsAllowedKill(cfg *config.Config, user *authpublic.AuthenticatedUser, actio
 	return aclCheck(Kill, cfg.DefaultPermissions.Kill, cfg, "isAllowedKill", user, action.Title, action.Acls, true)
 }
 
+// RequireExec is IsAllowedExec for where executing is enforced, rather than
+// where it is only shown. Denials are recorded in the audit log.
+func RequireExec(cfg *config.Config, user *authpublic.AuthenticatedUser, action *config.Action) bool {
+	return recordIfDenied(IsAllowedExec(cfg, user, action), "exec", user, action)
+}
+
+// RequireLogs is IsAllowedLogs for where viewing logs is enforced.
+func RequireLogs(cfg *config.Config, user *authpublic.AuthenticatedUser, action *config.Action) bool {
+	return recordIfDenied(IsAllowedLogs(cfg, user, action), "logs", user, action)
+}
+
+// RequireKill is IsAllowedKill for where killing is enforced.
+func RequireKill(cfg *config.Config, user *authpublic.AuthenticatedUser, action *config.Action) bool {
+	return recordIfDenied(IsAllowedKill(cfg, user, action), "kill", user, action)
+}
+
+// recordIfDenied is not called from aclCheck, as permissions are also checked
+// to build every dashboard, which would fill the audit log with denials that
+// the user never attempted.
+func recordIfDenied(allowed bool, permission string, user *authpublic.AuthenticatedUser, action *config.Action) bool {
+	if !allowed {
+		audit.Record(audit.Event{
+			Type:       audit.TypeAclDenied,
+			Outcome:    audit.OutcomeDenied,
+			Username:   user.Username,
+			Provider:   user.Provider,
+			Action:     action.Title,
+			Permission: permission,
+		})
+	}
+
+	return allowed
+}
+
+// IsAllowedApprove checks if a user may approve executions of an action that
+// requires approval. Guests never approve, and with no approval usergroups set,
+// any logged in user may.
+func IsAllowedApprove(cfg *config.Config, user *authpublic.AuthenticatedUser, action *config.Action) bool {
+	if user == nil || user.IsGuest() || !action.RequiresApproval() {
+		return false
+	}
+
+	if len(action.Approval.Usergroups) == 0 {
+		return true
+	}
+
+	return user.MatchesUsergroupAcl(action.Approval.Usergroups, cfg.AuthHttpHeaderUserGroupSep)
+}
+
 // IsAllowedViewDashboard checks if a user may see a root dashboard.
 // Dashboards with no acls are unrestricted. AddToEveryAction does not apply.
 func IsAllowedViewDashboard(cfg *config.Config, user *authpublic.AuthenticatedUser, dashboard *config.DashboardComponent) bool {
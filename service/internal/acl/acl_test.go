@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	config "github.com/OliveTin/OliveTin/internal/config"
 )
 
 func Test_hasGroupsMatch(t *testing.T) {
@@ -61,3 +62,67 @@ func Test_hasGroupsMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAllowedApprove(t *testing.T) {
+	cfg := config.DefaultConfig()
+	action := &config.Action{
+		Title:    "Reboot",
+		Approval: config.ApprovalConfig{Usergroups: []string{"admins"}, Count: 1},
+	}
+
+	admin := &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}
+	user := &authpublic.AuthenticatedUser{Username: "bob", UsergroupLine: "users"}
+	guest := &authpublic.AuthenticatedUser{Username: "guest", Provider: "system", UsergroupLine: "admins"}
+
+	if !IsAllowedApprove(cfg, admin, action) {
+		t.Errorf("expected a member of an approval usergroup to be allowed to approve")
+	}
+
+	if IsAllowedApprove(cfg, user, action) {
+		t.Errorf("expected a user outside the approval usergroups to be denied")
+	}
+
+	if IsAllowedApprove(cfg, guest, action) {
+		t.Errorf("expected guests to never be allowed to approve")
+	}
+
+	if IsAllowedApprove(cfg, admin, &config.Action{Title: "Ping"}) {
+		t.Errorf("expected actions without approval to have no approvers")
+	}
+}
+
+func TestRequireTotpForExec(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.AccessControlLists = []*config.AccessControlList{{
+		Name:               "admins",
+		MatchUsergroups:    []string{"admins"},
+		Permissions:        config.PermissionsList{View: true, Exec: true},
+		RequireTotpForExec: true,
+	}}
+
+	action := &config.Action{
+		Title: "Restart database",
+		Acls:  []string{"admins"},
+	}
+
+	user := &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}
+	user.BuildUserAcls(cfg)
+
+	if !cfg.DefaultPermissions.Exec {
+		t.Fatalf("expected the default config to allow exec")
+	}
+
+	if IsAllowedExec(cfg, user, action) {
+		t.Errorf("exec allowed without TOTP, from the default permissions")
+	}
+
+	if !IsAllowedView(cfg, user, action) {
+		t.Errorf("view should not need TOTP")
+	}
+
+	user.TotpVerified = true
+
+	if !IsAllowedExec(cfg, user, action) {
+		t.Errorf("exec not allowed with TOTP")
+	}
+}
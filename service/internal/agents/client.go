@@ -0,0 +1,209 @@
+package agents
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	agentv1 "github.com/OliveTin/OliveTin/gen/olivetin/agent/v1"
+	agentv1connect "github.com/OliveTin/OliveTin/gen/olivetin/agent/v1/agentv1connect"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	log "github.com/sirupsen/logrus"
+)
+
+// reconnectDelay is how long an agent waits before dialing the server again,
+// after its connection is lost.
+const reconnectDelay = 5 * time.Second
+
+// Client is the olivetin-agent side of the AgentService. It runs jobs sent by
+// the server as local processes.
+type Client struct {
+	Name     string
+	Hostname string
+	Version  string
+
+	token   string
+	service agentv1connect.AgentServiceClient
+
+	runningMu sync.Mutex
+	running   map[string]context.CancelFunc
+}
+
+func NewClient(httpClient *http.Client, serverURL string, token string, name string) *Client {
+	return &Client{
+		Name:    name,
+		token:   token,
+		service: agentv1connect.NewAgentServiceClient(httpClient, serverURL),
+		running: make(map[string]context.CancelFunc),
+	}
+}
+
+func authorizedRequest[T any](msg *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Authorization", "Bearer "+token)
+
+	return req
+}
+
+// Run keeps the agent connected to the server, reconnecting when the
+// connection is lost, until ctx is cancelled.
+func (c *Client) Run(ctx context.Context) {
+	for {
+		err := c.receiveJobs(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Agent lost connection to server, reconnecting in %v", reconnectDelay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (c *Client) receiveJobs(ctx context.Context) error {
+	stream, err := c.service.Connect(ctx, authorizedRequest(&agentv1.ConnectRequest{
+		Name:     c.Name,
+		Hostname: c.Hostname,
+		Version:  c.Version,
+	}, c.token))
+
+	if err != nil {
+		return err
+	}
+
+	defer stream.Close()
+
+	log.WithFields(log.Fields{
+		"name": c.Name,
+	}).Infof("Agent connected to server")
+
+	for stream.Receive() {
+		c.handleJob(ctx, stream.Msg())
+	}
+
+	if stream.Err() == nil {
+		return errors.New("server closed the connection")
+	}
+
+	return stream.Err()
+}
+
+func (c *Client) handleJob(ctx context.Context, job *agentv1.AgentJob) {
+	if job.Kill {
+		c.killJob(job.ExecutionTrackingId)
+		return
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, time.Duration(job.Timeout)*time.Second)
+
+	c.runningMu.Lock()
+	c.running[job.ExecutionTrackingId] = cancel
+	c.runningMu.Unlock()
+
+	go c.runJob(jobCtx, job)
+}
+
+func (c *Client) killJob(trackingID string) {
+	c.runningMu.Lock()
+	cancel, found := c.running[trackingID]
+	c.runningMu.Unlock()
+
+	log.WithFields(log.Fields{
+		"executionTrackingId": trackingID,
+		"found":               found,
+	}).Infof("Agent asked to kill job")
+
+	if found {
+		cancel()
+	}
+}
+
+func (c *Client) runJob(jobCtx context.Context, job *agentv1.AgentJob) {
+	defer c.forgetJob(job.ExecutionTrackingId)
+
+	log.WithFields(log.Fields{
+		"executionTrackingId": job.ExecutionTrackingId,
+	}).Infof("Agent running job")
+
+	cmd := executor.NewAgentJobCommand(jobCtx, agentJobFromPb(job))
+	cmd.Stdout = &outputSender{client: c, trackingID: job.ExecutionTrackingId, stream: executor.StreamStdout}
+	cmd.Stderr = &outputSender{client: c, trackingID: job.ExecutionTrackingId, stream: executor.StreamStderr}
+
+	err := cmd.Run()
+
+	finish := &agentv1.FinishJobRequest{
+		Name:                c.Name,
+		ExecutionTrackingId: job.ExecutionTrackingId,
+		ExitCode:            -1,
+		TimedOut:            errors.Is(jobCtx.Err(), context.DeadlineExceeded),
+	}
+
+	if cmd.ProcessState != nil {
+		finish.ExitCode = int32(cmd.ProcessState.ExitCode())
+	}
+
+	if err != nil {
+		finish.Error = err.Error()
+	}
+
+	if _, err := c.service.FinishJob(context.Background(), authorizedRequest(finish, c.token)); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Agent could not report finished job")
+	}
+}
+
+func (c *Client) forgetJob(trackingID string) {
+	c.runningMu.Lock()
+	cancel := c.running[trackingID]
+	delete(c.running, trackingID)
+	c.runningMu.Unlock()
+
+	cancel()
+}
+
+func agentJobFromPb(job *agentv1.AgentJob) *executor.AgentJob {
+	return &executor.AgentJob{
+		TrackingID: job.ExecutionTrackingId,
+		Shell:      job.Shell,
+		Exec:       job.Exec,
+		Env:        job.Env,
+		Timeout:    int(job.Timeout),
+	}
+}
+
+// outputSender relays output from one stream to the server as it is written.
+type outputSender struct {
+	client     *Client
+	trackingID string
+	stream     string
+}
+
+func (o *outputSender) Write(p []byte) (int, error) {
+	_, err := o.client.service.SendOutput(context.Background(), authorizedRequest(&agentv1.SendOutputRequest{
+		Name:                o.client.Name,
+		ExecutionTrackingId: o.trackingID,
+		Output:              string(p),
+		Stream:              o.stream,
+	}, o.client.token))
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Agent could not send output")
+	}
+
+	// Output that the server cannot receive is dropped, rather than failing
+	// the job.
+	return len(p), nil
+}
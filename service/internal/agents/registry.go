@@ -0,0 +1,417 @@
+package agents
+
+/*
+Agents are olivetin-agent processes running on other hosts. They dial in to
+the server and hold the Connect stream open, and the registry pushes jobs down
+that stream for actions that have runOn set. Output and results are reported
+back with unary calls, because the single HTTP frontend does not do HTTP/2, and
+so cannot support bidirectional streams.
+*/
+
+import (
+	ctx "context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	agentv1 "github.com/OliveTin/OliveTin/gen/olivetin/agent/v1"
+	agentv1connect "github.com/OliveTin/OliveTin/gen/olivetin/agent/v1/agentv1connect"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	log "github.com/sirupsen/logrus"
+)
+
+// agentJobQueueSize is how many jobs can be waiting to be streamed to a
+// single agent.
+const agentJobQueueSize = 16
+
+var (
+	errInvalidToken       = errors.New("invalid agent token")
+	errAgentDisconnected  = errors.New("agent disconnected before the job finished")
+	errAgentReplaced      = errors.New("another agent connected with the same name")
+	errAgentNameInUse     = errors.New("an agent with this name is already connected")
+	errJobNotFound        = errors.New("job not found for this agent")
+	errMissingAgentName   = errors.New("agents must connect with a name")
+	failedToDispatchAgent = executor.AgentResult{ExitCode: -1}
+)
+
+type Registry struct {
+	cfg *config.Config
+
+	mu     sync.Mutex
+	agents map[string]*connectedAgent
+	jobs   map[string]*pendingJob
+}
+
+type connectedAgent struct {
+	name string
+	jobs chan *agentv1.AgentJob
+	gone chan struct{}
+}
+
+type pendingJob struct {
+	agent   *connectedAgent
+	outcome chan jobOutcome
+
+	// stdout and stderr are guarded by outputMu, as agents may still be
+	// sending output after the executor has stopped waiting for the job.
+	outputMu sync.Mutex
+	stdout   io.Writer
+	stderr   io.Writer
+}
+
+type jobOutcome struct {
+	result executor.AgentResult
+	err    error
+}
+
+func NewRegistry(cfg *config.Config) *Registry {
+	return &Registry{
+		cfg:    cfg,
+		agents: make(map[string]*connectedAgent),
+		jobs:   make(map[string]*pendingJob),
+	}
+}
+
+var (
+	registriesMu sync.Mutex
+	registries   = map[*executor.Executor]*Registry{}
+)
+
+// RegisterExecutorDispatcher lets the executor send actions to agents, if
+// agents are enabled. Call this before background goroutines that may
+// execute actions.
+func RegisterExecutorDispatcher(ex *executor.Executor) {
+	if ex.Cfg.AgentsEnabled() {
+		ensureRegistry(ex)
+	}
+}
+
+func ensureRegistry(ex *executor.Executor) *Registry {
+	registriesMu.Lock()
+	defer registriesMu.Unlock()
+
+	if registry, ok := registries[ex]; ok {
+		return registry
+	}
+
+	registry := NewRegistry(ex.Cfg)
+	registries[ex] = registry
+	ex.SetAgentDispatcher(registry)
+
+	return registry
+}
+
+func GetNewHandler(ex *executor.Executor) (string, http.Handler) {
+	return agentv1connect.NewAgentServiceHandler(ensureRegistry(ex))
+}
+
+// expectedToken returns the token that the named agent must use, and whether
+// it is a token of that agent only, rather than the shared token.
+func (r *Registry) expectedToken(name string) (string, bool) {
+	if token, found := r.cfg.Agents.Tokens[name]; found {
+		return token, true
+	}
+
+	return r.cfg.Agents.Token, false
+}
+
+func (r *Registry) authorize(header http.Header, name string) error {
+	token := strings.TrimPrefix(header.Get("Authorization"), "Bearer ")
+	expected, _ := r.expectedToken(name)
+
+	if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		return connect.NewError(connect.CodeUnauthenticated, errInvalidToken)
+	}
+
+	return nil
+}
+
+// ConnectedAgents returns the names of the agents that are currently connected.
+func (r *Registry) ConnectedAgents() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ret := make([]string, 0, len(r.agents))
+
+	for name := range r.agents {
+		ret = append(ret, name)
+	}
+
+	return ret
+}
+
+func (r *Registry) Connect(ctx ctx.Context, req *connect.Request[agentv1.ConnectRequest], stream *connect.ServerStream[agentv1.AgentJob]) error {
+	if req.Msg.Name == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errMissingAgentName)
+	}
+
+	if err := r.authorize(req.Header(), req.Msg.Name); err != nil {
+		return err
+	}
+
+	agent, err := r.register(req.Msg)
+	if err != nil {
+		return err
+	}
+
+	defer r.unregister(agent)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-agent.gone:
+			return connect.NewError(connect.CodeAborted, errAgentReplaced)
+		case job := <-agent.jobs:
+			if err := stream.Send(job); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// register adds the agent. An agent with its own token replaces an older
+// connection with the same name, as only that agent has the token, and so
+// it is the same agent reconnecting. Agents that share a token could take over
+// each other's names, so they are rejected until the older connection ends.
+func (r *Registry) register(msg *agentv1.ConnectRequest) (*connectedAgent, error) {
+	agent := &connectedAgent{
+		name: msg.Name,
+		jobs: make(chan *agentv1.AgentJob, agentJobQueueSize),
+		gone: make(chan struct{}),
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, found := r.agents[msg.Name]; found {
+		if _, ownToken := r.expectedToken(msg.Name); !ownToken {
+			return nil, connect.NewError(connect.CodeAlreadyExists, errAgentNameInUse)
+		}
+
+		r.dropLocked(existing, errAgentReplaced)
+	}
+
+	r.agents[msg.Name] = agent
+
+	log.WithFields(log.Fields{
+		"agent":    msg.Name,
+		"hostname": msg.Hostname,
+		"version":  msg.Version,
+	}).Infof("Agent connected")
+
+	return agent, nil
+}
+
+func (r *Registry) unregister(agent *connectedAgent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.agents[agent.name] != agent {
+		return
+	}
+
+	delete(r.agents, agent.name)
+	r.dropLocked(agent, errAgentDisconnected)
+
+	log.WithFields(log.Fields{
+		"agent": agent.name,
+	}).Infof("Agent disconnected")
+}
+
+// dropLocked fails every job that the agent was running. The caller must hold
+// mu, and must have removed the agent from the agents map.
+func (r *Registry) dropLocked(agent *connectedAgent, err error) {
+	close(agent.gone)
+
+	for trackingID, job := range r.jobs {
+		if job.agent == agent {
+			r.finishLocked(trackingID, jobOutcome{result: failedToDispatchAgent, err: err})
+		}
+	}
+}
+
+func (r *Registry) finishLocked(trackingID string, outcome jobOutcome) {
+	job, found := r.jobs[trackingID]
+	if !found {
+		return
+	}
+
+	delete(r.jobs, trackingID)
+
+	job.outputMu.Lock()
+	job.stdout = io.Discard
+	job.stderr = io.Discard
+	job.outputMu.Unlock()
+
+	job.outcome <- outcome
+}
+
+// Dispatch sends a job to the named agent, and waits for the agent to report
+// that it has finished. Output is written as it arrives.
+func (r *Registry) Dispatch(ctx ctx.Context, agentName string, job *executor.AgentJob, stdout io.Writer, stderr io.Writer) (executor.AgentResult, error) {
+	pending, err := r.addJob(agentName, job.TrackingID, stdout, stderr)
+	if err != nil {
+		return failedToDispatchAgent, err
+	}
+
+	select {
+	case pending.agent.jobs <- agentJobToPb(job):
+	case <-pending.agent.gone:
+	case <-ctx.Done():
+	}
+
+	return r.awaitJob(ctx, job.TrackingID, pending)
+}
+
+func (r *Registry) addJob(agentName string, trackingID string, stdout io.Writer, stderr io.Writer) (*pendingJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	agent, found := r.agents[agentName]
+	if !found {
+		return nil, fmt.Errorf("agent %q is not connected", agentName)
+	}
+
+	pending := &pendingJob{
+		agent:   agent,
+		outcome: make(chan jobOutcome, 1),
+		stdout:  stdout,
+		stderr:  stderr,
+	}
+
+	r.jobs[trackingID] = pending
+
+	return pending, nil
+}
+
+func (r *Registry) awaitJob(ctx ctx.Context, trackingID string, pending *pendingJob) (executor.AgentResult, error) {
+	select {
+	case outcome := <-pending.outcome:
+		return outcome.result, outcome.err
+	case <-ctx.Done():
+	}
+
+	r.mu.Lock()
+	r.finishLocked(trackingID, jobOutcome{result: executor.AgentResult{ExitCode: -1, TimedOut: true}})
+	r.mu.Unlock()
+
+	r.sendKill(pending.agent, trackingID)
+
+	// The agent may have reported back just before the deadline, in which case
+	// its outcome wins.
+	outcome := <-pending.outcome
+
+	return outcome.result, outcome.err
+}
+
+// Kill asks the agent running a job to kill it.
+func (r *Registry) Kill(trackingID string) error {
+	r.mu.Lock()
+	pending, found := r.jobs[trackingID]
+	r.mu.Unlock()
+
+	if !found {
+		return errJobNotFound
+	}
+
+	r.sendKill(pending.agent, trackingID)
+
+	return nil
+}
+
+func (r *Registry) sendKill(agent *connectedAgent, trackingID string) {
+	select {
+	case agent.jobs <- &agentv1.AgentJob{ExecutionTrackingId: trackingID, Kill: true}:
+	case <-agent.gone:
+	}
+}
+
+// writerFor returns where output for the stream goes. Agents that do not
+// send a stream are treated as writing to stdout. The caller must hold
+// outputMu.
+func (job *pendingJob) writerFor(stream string) io.Writer {
+	if stream == executor.StreamStderr {
+		return job.stderr
+	}
+
+	return job.stdout
+}
+
+// findJob returns a job, as long as it is being run by the named agent.
+func (r *Registry) findJob(agentName string, trackingID string) (*pendingJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.findJobLocked(agentName, trackingID)
+}
+
+func (r *Registry) findJobLocked(agentName string, trackingID string) (*pendingJob, error) {
+	job, found := r.jobs[trackingID]
+	if !found || job.agent.name != agentName {
+		return nil, connect.NewError(connect.CodeNotFound, errJobNotFound)
+	}
+
+	return job, nil
+}
+
+func (r *Registry) SendOutput(ctx ctx.Context, req *connect.Request[agentv1.SendOutputRequest]) (*connect.Response[agentv1.SendOutputResponse], error) {
+	if err := r.authorize(req.Header(), req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	job, err := r.findJob(req.Msg.Name, req.Msg.ExecutionTrackingId)
+	if err != nil {
+		return nil, err
+	}
+
+	job.outputMu.Lock()
+	_, _ = job.writerFor(req.Msg.Stream).Write([]byte(req.Msg.Output))
+	job.outputMu.Unlock()
+
+	return connect.NewResponse(&agentv1.SendOutputResponse{}), nil
+}
+
+func (r *Registry) FinishJob(ctx ctx.Context, req *connect.Request[agentv1.FinishJobRequest]) (*connect.Response[agentv1.FinishJobResponse], error) {
+	if err := r.authorize(req.Header(), req.Msg.Name); err != nil {
+		return nil, err
+	}
+
+	outcome := jobOutcome{
+		result: executor.AgentResult{
+			ExitCode: req.Msg.ExitCode,
+			TimedOut: req.Msg.TimedOut,
+		},
+	}
+
+	if req.Msg.Error != "" {
+		outcome.err = errors.New(req.Msg.Error)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.findJobLocked(req.Msg.Name, req.Msg.ExecutionTrackingId); err != nil {
+		return nil, err
+	}
+
+	r.finishLocked(req.Msg.ExecutionTrackingId, outcome)
+
+	return connect.NewResponse(&agentv1.FinishJobResponse{}), nil
+}
+
+func agentJobToPb(job *executor.AgentJob) *agentv1.AgentJob {
+	return &agentv1.AgentJob{
+		ExecutionTrackingId: job.TrackingID,
+		Shell:               job.Shell,
+		Exec:                job.Exec,
+		Env:                 job.Env,
+		Timeout:             int32(job.Timeout),
+	}
+}
@@ -0,0 +1,214 @@
+package agents
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	agentv1 "github.com/OliveTin/OliveTin/gen/olivetin/agent/v1"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "s3cret"
+
+func agentTestingExecutor(t *testing.T, action *config.Action) (*executor.Executor, *config.Config) {
+	cfg := config.DefaultConfig()
+	cfg.Agents.Token = testToken
+	cfg.Actions = append(cfg.Actions, action)
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	return ex, cfg
+}
+
+func startAgent(t *testing.T, ex *executor.Executor, name string) *Registry {
+	_, handler := GetNewHandler(ex)
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	client := NewClient(ts.Client(), ts.URL, testToken, name)
+	go client.Run(ctx)
+
+	registry := ensureRegistry(ex)
+
+	require.Eventually(t, func() bool {
+		return len(registry.ConnectedAgents()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return registry
+}
+
+type outputChunkCollector struct {
+	chunks chan string
+}
+
+func (c *outputChunkCollector) OnExecutionStarted(*executor.InternalLogEntry)       {}
+func (c *outputChunkCollector) OnExecutionFinished(*executor.InternalLogEntry)      {}
+func (c *outputChunkCollector) OnActionMapRebuilt()                                 {}
+func (c *outputChunkCollector) OnApprovalRequested(*executor.PendingApproval)       {}
+func (c *outputChunkCollector) OnApprovalResolved(*executor.InternalLogEntry, bool) {}
+func (c *outputChunkCollector) OnOutputChunk(chunk executor.OutputChunk, executionTrackingId string) {
+	c.chunks <- chunk.Output
+}
+
+func execOnAgent(ex *executor.Executor, cfg *config.Config) (*executor.InternalLogEntry, string) {
+	wg, trackingID := ex.ExecRequest(&executor.ExecutionRequest{
+		Binding:           ex.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:               cfg,
+		AuthenticatedUser: &authpublic.AuthenticatedUser{Username: "alice"},
+	})
+
+	wg.Wait()
+
+	entry, _ := ex.GetLog(trackingID)
+
+	return entry, trackingID
+}
+
+func TestActionRunsOnAgent(t *testing.T) {
+	ex, cfg := agentTestingExecutor(t, &config.Action{
+		Title: "hello",
+		Shell: "echo hello from $OLIVETIN; exit 3",
+		RunOn: "web1",
+	})
+
+	startAgent(t, ex, "web1")
+
+	entry, _ := execOnAgent(ex, cfg)
+
+	assert.Equal(t, "exit status 3\n\nhello from 1\n", entry.Output)
+	assert.Equal(t, int32(3), entry.ExitCode)
+	assert.False(t, entry.TimedOut)
+}
+
+func TestActionOnAgentTimesOut(t *testing.T) {
+	ex, cfg := agentTestingExecutor(t, &config.Action{
+		Title:   "slow",
+		Shell:   "sleep 10",
+		RunOn:   "web1",
+		Timeout: 3,
+	})
+
+	startAgent(t, ex, "web1")
+
+	entry, _ := execOnAgent(ex, cfg)
+
+	assert.True(t, entry.TimedOut)
+	assert.NotEqual(t, int32(0), entry.ExitCode)
+}
+
+func TestKillActionOnAgent(t *testing.T) {
+	ex, cfg := agentTestingExecutor(t, &config.Action{
+		Title:   "slow",
+		Shell:   "echo started; sleep 10",
+		RunOn:   "web1",
+		Timeout: 30,
+	})
+
+	collector := &outputChunkCollector{chunks: make(chan string, 1)}
+	ex.AddListener(collector)
+
+	startAgent(t, ex, "web1")
+
+	wg, trackingID := ex.ExecRequest(&executor.ExecutionRequest{
+		Binding: ex.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+
+	select {
+	case chunk := <-collector.chunks:
+		assert.Equal(t, "started\n", chunk, "Output is streamed through OnOutputChunk while the job runs")
+	case <-time.After(5 * time.Second):
+		t.Fatal("no output was streamed from the agent")
+	}
+
+	entry, _ := ex.GetLog(trackingID)
+	require.NoError(t, ex.Kill(entry))
+
+	wg.Wait()
+
+	entry, _ = ex.GetLog(trackingID)
+	assert.True(t, entry.ExecutionFinished)
+	assert.False(t, entry.TimedOut)
+	assert.NotEqual(t, int32(0), entry.ExitCode)
+}
+
+func TestActionOnDisconnectedAgent(t *testing.T) {
+	ex, cfg := agentTestingExecutor(t, &config.Action{
+		Title: "hello",
+		Shell: "echo hello",
+		RunOn: "db1",
+	})
+
+	startAgent(t, ex, "web1")
+
+	entry, _ := execOnAgent(ex, cfg)
+
+	assert.Contains(t, entry.Output, `agent "db1" is not connected`)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+}
+
+func TestAgentRejectedWithWrongToken(t *testing.T) {
+	ex, _ := agentTestingExecutor(t, &config.Action{Title: "hello", Shell: "echo hello"})
+	registry := ensureRegistry(ex)
+
+	req := connect.NewRequest(&agentv1.SendOutputRequest{Name: "web1"})
+	req.Header().Set("Authorization", "Bearer wrong")
+
+	_, err := registry.SendOutput(context.Background(), req)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	ex.Cfg.Agents.Token = ""
+	req.Header().Set("Authorization", "Bearer ")
+
+	_, err = registry.SendOutput(context.Background(), req)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "An empty token never authenticates")
+}
+
+func TestAgentNameCannotBeTakenOverWithSharedToken(t *testing.T) {
+	ex, _ := agentTestingExecutor(t, &config.Action{Title: "hello", Shell: "echo hello"})
+	registry := startAgent(t, ex, "web1")
+
+	req := connect.NewRequest(&agentv1.ConnectRequest{Name: "web1"})
+	req.Header().Set("Authorization", "Bearer "+testToken)
+
+	err := registry.Connect(context.Background(), req, nil)
+	assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+	assert.Equal(t, []string{"web1"}, registry.ConnectedAgents())
+}
+
+func TestAgentWithOwnTokenReplacesOlderConnection(t *testing.T) {
+	ex, _ := agentTestingExecutor(t, &config.Action{Title: "hello", Shell: "echo hello"})
+	ex.Cfg.Agents.Tokens = map[string]string{"web1": "web1-s3cret"}
+	registry := ensureRegistry(ex)
+
+	req := connect.NewRequest(&agentv1.SendOutputRequest{Name: "web1"})
+	req.Header().Set("Authorization", "Bearer "+testToken)
+
+	_, err := registry.SendOutput(context.Background(), req)
+	assert.Equal(t, connect.CodeUnauthenticated, connect.CodeOf(err), "The shared token is not accepted for an agent with its own token")
+
+	first, err := registry.register(&agentv1.ConnectRequest{Name: "web1"})
+	require.NoError(t, err)
+
+	_, err = registry.register(&agentv1.ConnectRequest{Name: "web1"})
+	require.NoError(t, err)
+
+	select {
+	case <-first.gone:
+	default:
+		t.Fatal("the older connection was not dropped")
+	}
+}
@@ -22,13 +22,16 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	acl "github.com/OliveTin/OliveTin/internal/acl"
+	"github.com/OliveTin/OliveTin/internal/audit"
 	auth "github.com/OliveTin/OliveTin/internal/auth"
 	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/auth/ottotp"
 	config "github.com/OliveTin/OliveTin/internal/config"
 	entities "github.com/OliveTin/OliveTin/internal/entities"
 	executor "github.com/OliveTin/OliveTin/internal/executor"
 	installationinfo "github.com/OliveTin/OliveTin/internal/installationinfo"
 	"github.com/OliveTin/OliveTin/internal/tpl"
+	webhooks "github.com/OliveTin/OliveTin/internal/webhooks"
 	connectproto "go.akshayshah.org/connectproto"
 )
 
@@ -132,7 +135,7 @@ func (api *oliveTinAPI) KillAction(ctx ctx.Context, req *connect.Request[apiv1.K
 }
 
 func (api *oliveTinAPI) killActionByTrackingId(user *authpublic.AuthenticatedUser, action *config.Action, execReqLogEntry *executor.InternalLogEntry, ret *apiv1.KillActionResponse) {
-	if !acl.IsAllowedKill(api.cfg, user, action) {
+	if !acl.RequireKill(api.cfg, user, action) {
 		log.Warnf("Killing execution request not possible - user not allowed to kill this action: %v", execReqLogEntry.ExecutionTrackingID)
 		ret.Killed = false
 		return
@@ -147,6 +150,26 @@ func (api *oliveTinAPI) killActionByTrackingId(user *authpublic.AuthenticatedUse
 	} else {
 		ret.Killed = true
 	}
+
+	recordKill(user, action, execReqLogEntry.ExecutionTrackingID, err)
+}
+
+func recordKill(user *authpublic.AuthenticatedUser, action *config.Action, trackingID string, err error) {
+	e := audit.Event{
+		Type:       audit.TypeKill,
+		Outcome:    audit.OutcomeSuccess,
+		Username:   user.Username,
+		Provider:   user.Provider,
+		Action:     action.Title,
+		TrackingID: trackingID,
+	}
+
+	if err != nil {
+		e.Outcome = audit.OutcomeFailure
+		e.Detail = err.Error()
+	}
+
+	audit.Record(e)
 }
 
 func (api *oliveTinAPI) StartAction(ctx ctx.Context, req *connect.Request[apiv1.StartActionRequest]) (*connect.Response[apiv1.StartActionResponse], error) {
@@ -204,28 +227,48 @@ func (api *oliveTinAPI) applyLocalLoginResult(req *apiv1.LocalUserLoginRequest,
 	if match {
 		user := api.cfg.FindUserByUsername(req.Username)
 		if user != nil {
+			if api.requireTotpChallenge(user.Username, response) {
+				return
+			}
 			sid := uuid.NewString()
 			auth.RegisterUserSession(api.cfg, "local", sid, user.Username)
 			log.WithFields(log.Fields{"username": user.Username}).Info("LocalUserLogin: Session created and registered")
-			cookie := &http.Cookie{
-				Name:     "olivetin-sid-local",
-				Value:    sid,
-				MaxAge:   31556952,
-				HttpOnly: true,
-				Path:     "/",
-				Secure:   secure,
-				SameSite: http.SameSiteLaxMode,
-			}
-			response.Header().Set("Set-Cookie", cookie.String())
+			response.Header().Set("Set-Cookie", localSessionCookie(sid, secure).String())
 			log.WithFields(log.Fields{"username": user.Username}).Info("LocalUserLogin: User logged in successfully.")
+			recordLogin("local", audit.TypeLogin, audit.OutcomeSuccess, user.Username, "")
 		} else {
 			log.WithFields(log.Fields{"username": req.Username}).Warn("LocalUserLogin: Password matched but user lookup failed.")
+			recordLogin("local", audit.TypeLoginFailed, audit.OutcomeFailure, req.Username, "")
 		}
 	} else {
 		log.WithFields(log.Fields{"username": req.Username}).Warn("LocalUserLogin: User login failed.")
+		recordLogin("local", audit.TypeLoginFailed, audit.OutcomeFailure, req.Username, "")
+	}
+}
+
+// localSessionCookie is used by both local and LDAP users.
+func localSessionCookie(sid string, secure bool) *http.Cookie {
+	return &http.Cookie{
+		Name:     "olivetin-sid-local",
+		Value:    sid,
+		MaxAge:   31556952,
+		HttpOnly: true,
+		Path:     "/",
+		Secure:   secure,
+		SameSite: http.SameSiteLaxMode,
 	}
 }
 
+func recordLogin(provider string, eventType string, outcome string, username string, detail string) {
+	audit.Record(audit.Event{
+		Type:     eventType,
+		Outcome:  outcome,
+		Username: username,
+		Provider: provider,
+		Detail:   detail,
+	})
+}
+
 func (api *oliveTinAPI) localUserLoginEarlyReject(req *connect.Request[apiv1.LocalUserLoginRequest]) *connect.Response[apiv1.LocalUserLoginResponse] {
 	if !api.cfg.AuthLocalUsers.Enabled {
 		return connect.NewResponse(&apiv1.LocalUserLoginResponse{Success: false})
@@ -240,6 +283,10 @@ func (api *oliveTinAPI) localUserLoginEarlyReject(req *connect.Request[apiv1.Loc
 }
 
 func (api *oliveTinAPI) LocalUserLogin(ctx ctx.Context, req *connect.Request[apiv1.LocalUserLoginRequest]) (*connect.Response[apiv1.LocalUserLoginResponse], error) {
+	if other := api.loginWithoutLocalPassword(req); other != nil {
+		return other, nil
+	}
+
 	if early := api.localUserLoginEarlyReject(req); early != nil {
 		return early, nil
 	}
@@ -402,6 +449,9 @@ func (api *oliveTinAPI) internalLogEntryToPb(logEntry *executor.InternalLogEntry
 		DatetimeFinished:         logEntry.DatetimeFinished.Format("2006-01-02 15:04:05"),
 		DatetimeIndex:            logEntry.Index,
 		Output:                   logEntry.Output,
+		Stdout:                   logEntry.Stdout,
+		Stderr:                   logEntry.Stderr,
+		ResultJson:               resultToJson(logEntry.Result),
 		TimedOut:                 logEntry.TimedOut,
 		Blocked:                  logEntry.Blocked,
 		Queued:                   logEntry.Queued,
@@ -416,6 +466,12 @@ func (api *oliveTinAPI) internalLogEntryToPb(logEntry *executor.InternalLogEntry
 		DatetimeRateLimitExpires: calculateRateLimitExpires(api, logEntry),
 		Justification:            logEntry.Justification,
 		Arguments:                logEntryArgumentsToProto(logEntry.Arguments),
+		AwaitingApproval:         logEntry.AwaitingApproval,
+		ApprovedBy:               logEntry.ApprovedBy,
+		WorkflowTrackingId:       logEntry.WorkflowTrackingID,
+		WorkflowStep:             logEntry.WorkflowStep,
+		Attempt:                  int32(logEntry.Attempt),
+		Attempts:                 executionAttemptsToPb(logEntry.Attempts),
 	}
 
 	if !pble.ExecutionFinished && logEntry.Binding != nil && logEntry.Binding.Action != nil {
@@ -425,6 +481,40 @@ func (api *oliveTinAPI) internalLogEntryToPb(logEntry *executor.InternalLogEntry
 	return pble
 }
 
+func executionAttemptsToPb(attempts []executor.ExecutionAttempt) []*apiv1.ExecutionAttempt {
+	ret := make([]*apiv1.ExecutionAttempt, 0, len(attempts))
+
+	for _, attempt := range attempts {
+		ret = append(ret, &apiv1.ExecutionAttempt{
+			Attempt:          int32(attempt.Attempt),
+			DatetimeStarted:  attempt.DatetimeStarted.Format("2006-01-02 15:04:05"),
+			DatetimeFinished: attempt.DatetimeFinished.Format("2006-01-02 15:04:05"),
+			ExitCode:         attempt.ExitCode,
+			TimedOut:         attempt.TimedOut,
+			Output:           attempt.Output,
+		})
+	}
+
+	return ret
+}
+
+func resultToJson(result map[string]any) string {
+	if result == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Could not marshal execution result")
+
+		return ""
+	}
+
+	return string(data)
+}
+
 func getExecutionStatusByTrackingID(api *oliveTinAPI, executionTrackingId string) *executor.InternalLogEntry {
 	logEntry, ok := api.executor.GetLog(executionTrackingId)
 
@@ -465,8 +555,8 @@ func (api *oliveTinAPI) resolveExecutionStatusForView(msg *apiv1.ExecutionStatus
 	if ile == nil {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("execution not found for tracking ID %s or action ID %s", msg.ExecutionTrackingId, msg.ActionId))
 	}
-	if !isValidLogEntry(ile) || !api.isLogEntryAllowed(ile, user) {
-		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied to view this execution"))
+	if err := api.requireLogEntryAllowed(ile, user); err != nil {
+		return nil, err
 	}
 	return ile, nil
 }
@@ -523,14 +613,23 @@ func (api *oliveTinAPI) ExecutionStatus(ctx ctx.Context, req *connect.Request[ap
 func (api *oliveTinAPI) Logout(ctx ctx.Context, req *connect.Request[apiv1.LogoutRequest]) (*connect.Response[apiv1.LogoutResponse], error) {
 	user := auth.UserFromApiCall(ctx, req, api.cfg)
 
-	auth.RevokeSessionForProvider(api.cfg, user.Provider, user.SID)
+	redirectUrl := auth.RevokeSessionForProvider(api.cfg, user.Provider, user.SID)
 
 	log.WithFields(log.Fields{
 		"username": user.Username,
 		"provider": user.Provider,
 	}).Info("Logout: User logged out")
 
-	response := connect.NewResponse(&apiv1.LogoutResponse{})
+	audit.Record(audit.Event{
+		Type:     audit.TypeLogout,
+		Outcome:  audit.OutcomeSuccess,
+		Username: user.Username,
+		Provider: user.Provider,
+	})
+
+	response := connect.NewResponse(&apiv1.LogoutResponse{
+		RedirectUrl: redirectUrl,
+	})
 	secure := api.cookieSecure(req.Header())
 
 	// Clear the local authentication cookie by setting it to expire
@@ -678,6 +777,10 @@ func (api *oliveTinAPI) GetLogs(ctx ctx.Context, req *connect.Request[apiv1.GetL
 		return nil, err
 	}
 
+	if req.Msg.WorkflowTrackingId != "" {
+		return connect.NewResponse(api.getWorkflowLogs(req.Msg.WorkflowTrackingId, user)), nil
+	}
+
 	pageSize := resolveLogsPageSize(req.Msg.GetPageSize(), api.cfg.LogHistoryPageSize)
 	logEntries, paging, err := api.executor.GetLogTrackingIdsACL(api.cfg, user, req.Msg.StartOffset, pageSize, req.Msg.DateFilter, req.Msg.GetFilter())
 	if err != nil {
@@ -708,7 +811,7 @@ func (api *oliveTinAPI) isLogEntryAllowed(e *executor.InternalLogEntry, user *au
 }
 
 func (api *oliveTinAPI) requireLogEntryAllowed(entry *executor.InternalLogEntry, user *authpublic.AuthenticatedUser) error {
-	if api.isLogEntryAllowed(entry, user) {
+	if user != nil && isValidLogEntry(entry) && acl.RequireLogs(api.cfg, user, entry.Binding.Action) {
 		return nil
 	}
 	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied to view this execution"))
@@ -927,6 +1030,11 @@ func (api *oliveTinAPI) WhoAmI(ctx ctx.Context, req *connect.Request[apiv1.WhoAm
 		Acls:              user.Acls,
 	}
 
+	if api.totpUser(user) == nil {
+		res.TotpAvailable = true
+		res.TotpEnrolled = ottotp.IsEnrolled(api.cfg, user.Username)
+	}
+
 	return connect.NewResponse(res), nil
 }
 
@@ -1131,6 +1239,27 @@ func (api *oliveTinAPI) OnActionMapRebuilt() {
 	}
 }
 
+func (api *oliveTinAPI) OnEntityChanged(entityName string) {
+	toRemove := []*streamingClient{}
+
+	for _, client := range api.copyOfStreamingClients() {
+		msg := &apiv1.EventStreamResponse{
+			Event: &apiv1.EventStreamResponse_EntityChanged{
+				EntityChanged: &apiv1.EventEntityChanged{
+					EntityName: entityName,
+				},
+			},
+		}
+		if !api.trySendEventToClient(client, msg) {
+			toRemove = append(toRemove, client)
+		}
+	}
+
+	for _, client := range toRemove {
+		api.removeClient(client)
+	}
+}
+
 func (api *oliveTinAPI) OnExecutionStarted(ex *executor.InternalLogEntry) {
 	toRemove := []*streamingClient{}
 	for _, client := range api.copyOfStreamingClients() {
@@ -1197,13 +1326,35 @@ func (api *oliveTinAPI) GetDiagnostics(ctx ctx.Context, req *connect.Request[api
 	if !user.EffectivePolicy.ShowDiagnostics {
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("diagnostics are not available for your account"))
 	}
+	deliveries, pending := webhooks.GetDeliveryLog(api.executor)
 	res := &apiv1.GetDiagnosticsResponse{
-		SshFoundKey:    installationinfo.Runtime.SshFoundKey,
-		SshFoundConfig: installationinfo.Runtime.SshFoundConfig,
+		SshFoundKey:              installationinfo.Runtime.SshFoundKey,
+		SshFoundConfig:           installationinfo.Runtime.SshFoundConfig,
+		WebhookDeliveries:        webhookDeliveriesToPb(deliveries),
+		WebhookDeliveriesPending: int32(pending),
 	}
 	return connect.NewResponse(res), nil
 }
 
+func webhookDeliveriesToPb(deliveries []webhooks.DeliveryLogEntry) []*apiv1.WebhookDelivery {
+	ret := make([]*apiv1.WebhookDelivery, 0, len(deliveries))
+
+	for _, d := range deliveries {
+		ret = append(ret, &apiv1.WebhookDelivery{
+			Datetime:            d.Datetime.Format("2006-01-02 15:04:05"),
+			Webhook:             d.Webhook,
+			Event:               d.Event,
+			ExecutionTrackingId: d.TrackingID,
+			Attempt:             int32(d.Attempt),
+			Status:              d.Status,
+			Delivered:           d.Delivered,
+			WillRetry:           d.WillRetry,
+		})
+	}
+
+	return ret
+}
+
 func (api *oliveTinAPI) Init(ctx ctx.Context, req *connect.Request[apiv1.InitRequest]) (*connect.Response[apiv1.InitResponse], error) {
 	user := auth.UserFromApiCall(ctx, req, api.cfg)
 
@@ -1227,7 +1378,7 @@ func (api *oliveTinAPI) Init(ctx ctx.Context, req *connect.Request[apiv1.InitReq
 		DefaultIconForBack:        api.cfg.DefaultIconForBack,
 		EnableCustomJs:            api.cfg.EnableCustomJs,
 		AuthLoginUrl:              api.cfg.AuthLoginUrl,
-		AuthLocalLogin:            api.cfg.AuthLocalUsers.Enabled,
+		AuthLocalLogin:            api.cfg.AuthLocalUsers.Enabled || api.cfg.AuthLdap.Enabled,
 		OAuth2Providers:           buildPublicOAuth2ProvidersList(api.cfg),
 		AdditionalLinks:           buildAdditionalLinks(api.cfg.AdditionalNavigationLinks),
 		StyleMods:                 api.cfg.StyleMods,
@@ -1357,7 +1508,7 @@ func buildAdditionalLinks(links []*config.NavigationLink) []*apiv1.AdditionalLin
 	return additionalLinks
 }
 
-func (api *oliveTinAPI) OnOutputChunk(content []byte, executionTrackingId string) {
+func (api *oliveTinAPI) OnOutputChunk(chunk executor.OutputChunk, executionTrackingId string) {
 	entry := api.getValidLogEntryForStreaming(executionTrackingId)
 	if entry == nil {
 		return
@@ -1365,7 +1516,9 @@ func (api *oliveTinAPI) OnOutputChunk(content []byte, executionTrackingId string
 	msg := &apiv1.EventStreamResponse{
 		Event: &apiv1.EventStreamResponse_OutputChunk{
 			OutputChunk: &apiv1.EventOutputChunk{
-				Output:              string(content),
+				Output:              chunk.Output,
+				Stream:              chunk.Stream,
+				Datetime:            chunk.Datetime.Format("2006-01-02 15:04:05.000"),
 				ExecutionTrackingId: executionTrackingId,
 			},
 		},
@@ -1722,6 +1875,7 @@ func newServer(ex *executor.Executor) *oliveTinAPI {
 	}
 
 	ex.AddListener(server)
+	entities.AddEntityChangedListener(server.OnEntityChanged)
 	return server
 }
 
@@ -24,4 +24,12 @@ func applyActionExecTriggers(pb *apiv1.Action, cfg *config.Action) {
 			MatchQuery:   wh.MatchQuery,
 		})
 	}
+
+	for _, trigger := range cfg.ExecOnMqtt {
+		pb.ExecOnMqtt = append(pb.ExecOnMqtt, &apiv1.ActionMqttExecHint{
+			Broker:    trigger.Broker,
+			Topic:     trigger.Topic,
+			MatchPath: trigger.MatchPath,
+		})
+	}
 }
@@ -106,6 +106,7 @@ func buildEffectivePolicy(policy *config.ConfigurationPolicy) *apiv1.EffectivePo
 		ShowDiagnostics:   policy.ShowDiagnostics,
 		ShowLogList:       policy.ShowLogList,
 		ShowVersionNumber: policy.ShowVersionNumber,
+		ShowAuditLog:      policy.ShowAuditLog,
 	}
 
 	return ret
@@ -0,0 +1,128 @@
+package api
+
+import (
+	ctx "context"
+	"errors"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	acl "github.com/OliveTin/OliveTin/internal/acl"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func (api *oliveTinAPI) ListPendingApprovals(ctx ctx.Context, req *connect.Request[apiv1.ListPendingApprovalsRequest]) (*connect.Response[apiv1.ListPendingApprovalsResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.checkDashboardAccess(user); err != nil {
+		return nil, err
+	}
+
+	ret := &apiv1.ListPendingApprovalsResponse{}
+
+	for _, approval := range api.executor.GetPendingApprovalsACL(api.cfg, user) {
+		ret.Approvals = append(ret.Approvals, api.pendingApprovalToPb(approval, user))
+	}
+
+	return connect.NewResponse(ret), nil
+}
+
+func (api *oliveTinAPI) ApproveExecution(ctx ctx.Context, req *connect.Request[apiv1.ApproveExecutionRequest]) (*connect.Response[apiv1.ApproveExecutionResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	remaining, err := api.executor.Approve(user, req.Msg.ExecutionTrackingId)
+	if err != nil {
+		return nil, connectApprovalError(err)
+	}
+
+	return connect.NewResponse(&apiv1.ApproveExecutionResponse{
+		ExecutionTrackingId: req.Msg.ExecutionTrackingId,
+		ApprovalsRemaining:  int32(remaining),
+	}), nil
+}
+
+func (api *oliveTinAPI) RejectExecution(ctx ctx.Context, req *connect.Request[apiv1.RejectExecutionRequest]) (*connect.Response[apiv1.RejectExecutionResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.executor.Reject(user, req.Msg.ExecutionTrackingId); err != nil {
+		return nil, connectApprovalError(err)
+	}
+
+	return connect.NewResponse(&apiv1.RejectExecutionResponse{
+		ExecutionTrackingId: req.Msg.ExecutionTrackingId,
+	}), nil
+}
+
+func connectApprovalError(err error) error {
+	switch {
+	case errors.Is(err, executor.ErrApprovalNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, executor.ErrNotAnApprover):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+}
+
+func (api *oliveTinAPI) pendingApprovalToPb(approval *executor.PendingApproval, user *authpublic.AuthenticatedUser) *apiv1.PendingApproval {
+	return &apiv1.PendingApproval{
+		LogEntry:          api.internalLogEntryToPb(approval.LogEntry, user),
+		ApprovalsRequired: int32(approval.ApprovalsRequired),
+		DatetimeExpires:   approval.Expires.Format("2006-01-02 15:04:05"),
+		CanApprove:        acl.IsAllowedApprove(api.cfg, user, approval.LogEntry.Binding.Action),
+	}
+}
+
+// mayViewApprovalEvent lets approvers see approval events for actions whose
+// logs they could not otherwise view.
+func (api *oliveTinAPI) mayViewApprovalEvent(entry *executor.InternalLogEntry, user *authpublic.AuthenticatedUser) bool {
+	if user == nil || !isValidLogEntry(entry) {
+		return false
+	}
+
+	return acl.IsAllowedApprove(api.cfg, user, entry.Binding.Action) || api.isLogEntryAllowed(entry, user)
+}
+
+func (api *oliveTinAPI) OnApprovalRequested(approval *executor.PendingApproval) {
+	api.sendApprovalEvent(approval.LogEntry, func(user *authpublic.AuthenticatedUser) *apiv1.EventStreamResponse {
+		return &apiv1.EventStreamResponse{
+			Event: &apiv1.EventStreamResponse_ApprovalRequested{
+				ApprovalRequested: &apiv1.EventApprovalRequested{
+					Approval: api.pendingApprovalToPb(approval, user),
+				},
+			},
+		}
+	})
+}
+
+func (api *oliveTinAPI) OnApprovalResolved(entry *executor.InternalLogEntry, approved bool) {
+	api.sendApprovalEvent(entry, func(user *authpublic.AuthenticatedUser) *apiv1.EventStreamResponse {
+		return &apiv1.EventStreamResponse{
+			Event: &apiv1.EventStreamResponse_ApprovalResolved{
+				ApprovalResolved: &apiv1.EventApprovalResolved{
+					LogEntry: api.internalLogEntryToPb(entry, user),
+					Approved: approved,
+				},
+			},
+		}
+	})
+}
+
+func (api *oliveTinAPI) sendApprovalEvent(entry *executor.InternalLogEntry, buildMsg func(*authpublic.AuthenticatedUser) *apiv1.EventStreamResponse) {
+	toRemove := []*streamingClient{}
+
+	for _, client := range api.copyOfStreamingClients() {
+		if !api.mayViewApprovalEvent(entry, client.AuthenticatedUser) {
+			continue
+		}
+
+		if !api.trySendEventToClient(client, buildMsg(client.AuthenticatedUser)) {
+			toRemove = append(toRemove, client)
+		}
+	}
+
+	for _, client := range toRemove {
+		api.removeClient(client)
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildApprovalTestConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.AuthHttpHeaderUsername = "X-Ot-User"
+	cfg.AuthHttpHeaderUserGroup = "X-Ot-Group"
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		ID:       "reboot",
+		Title:    "Reboot",
+		Shell:    "echo rebooting",
+		Approval: config.ApprovalConfig{Usergroups: []string{"admins"}},
+	})
+	cfg.Sanitize()
+
+	return cfg
+}
+
+func approvalRequest[T any](msg *T, username string, usergroup string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("X-Ot-User", username)
+	req.Header().Set("X-Ot-Group", usergroup)
+
+	return req
+}
+
+func TestApproveExecutionViaApi(t *testing.T) {
+	cfg := buildApprovalTestConfig()
+	ts, client := getNewTestServerAndClient(cfg)
+	defer ts.Close()
+
+	started, err := client.StartAction(context.Background(), approvalRequest(&apiv1.StartActionRequest{BindingId: "reboot"}, "requester", "admins"))
+	require.NoError(t, err)
+
+	trackingID := started.Msg.ExecutionTrackingId
+
+	var approvals *apiv1.ListPendingApprovalsResponse
+	require.Eventually(t, func() bool {
+		resp, err := client.ListPendingApprovals(context.Background(), approvalRequest(&apiv1.ListPendingApprovalsRequest{}, "alice", "admins"))
+		if err != nil {
+			return false
+		}
+
+		approvals = resp.Msg
+		return len(approvals.Approvals) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	pending := approvals.Approvals[0]
+	assert.Equal(t, trackingID, pending.LogEntry.ExecutionTrackingId)
+	assert.True(t, pending.LogEntry.AwaitingApproval)
+	assert.True(t, pending.CanApprove)
+	assert.Equal(t, int32(1), pending.ApprovalsRequired)
+
+	_, err = client.ApproveExecution(context.Background(), approvalRequest(&apiv1.ApproveExecutionRequest{ExecutionTrackingId: trackingID}, "requester", "admins"))
+	assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err), "Requesters cannot approve their own executions")
+
+	_, err = client.ApproveExecution(context.Background(), approvalRequest(&apiv1.ApproveExecutionRequest{ExecutionTrackingId: trackingID}, "mallory", "users"))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	approved, err := client.ApproveExecution(context.Background(), approvalRequest(&apiv1.ApproveExecutionRequest{ExecutionTrackingId: trackingID}, "alice", "admins"))
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), approved.Msg.ApprovalsRemaining)
+
+	require.Eventually(t, func() bool {
+		status, err := client.ExecutionStatus(context.Background(), approvalRequest(&apiv1.ExecutionStatusRequest{ExecutionTrackingId: trackingID}, "alice", "admins"))
+		return err == nil && status.Msg.LogEntry.ExecutionFinished
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, err = client.RejectExecution(context.Background(), approvalRequest(&apiv1.RejectExecutionRequest{ExecutionTrackingId: trackingID}, "alice", "admins"))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err), "Executions that have been approved can no longer be rejected")
+}
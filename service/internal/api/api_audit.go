@@ -0,0 +1,110 @@
+package api
+
+import (
+	ctx "context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/audit"
+	"github.com/OliveTin/OliveTin/internal/auth"
+)
+
+const (
+	auditLogDefaultLimit = 100
+	auditLogMaxLimit     = 1000
+)
+
+func (api *oliveTinAPI) GetAuditLog(ctx ctx.Context, req *connect.Request[apiv1.GetAuditLogRequest]) (*connect.Response[apiv1.GetAuditLogResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if !user.EffectivePolicy.ShowAuditLog {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("the audit log is not available for your account"))
+	}
+
+	filter, err := auditFilterFromPb(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := queryAuditLog(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(auditQueryResultToPb(res)), nil
+}
+
+func queryAuditLog(filter audit.Filter) (*audit.QueryResult, error) {
+	l := audit.Current()
+
+	if l == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("the audit log is not enabled"))
+	}
+
+	res, err := l.Query(filter)
+	if errors.Is(err, audit.ErrNoFile) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return res, nil
+}
+
+func auditFilterFromPb(msg *apiv1.GetAuditLogRequest) (audit.Filter, error) {
+	filter := audit.Filter{
+		Type:     msg.Type,
+		Username: msg.Username,
+		Limit:    int(msg.Limit),
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = auditLogDefaultLimit
+	}
+
+	filter.Limit = min(filter.Limit, auditLogMaxLimit)
+
+	if msg.Since != "" {
+		since, err := time.Parse(time.RFC3339, msg.Since)
+		if err != nil {
+			return filter, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since must be RFC3339: %w", err))
+		}
+
+		filter.Since = since
+	}
+
+	return filter, nil
+}
+
+func auditQueryResultToPb(res *audit.QueryResult) *apiv1.GetAuditLogResponse {
+	ret := &apiv1.GetAuditLogResponse{
+		Events:     make([]*apiv1.AuditEvent, 0, len(res.Events)),
+		ChainValid: res.ChainValid,
+		ChainError: res.ChainError,
+	}
+
+	for _, e := range res.Events {
+		ret.Events = append(ret.Events, &apiv1.AuditEvent{
+			Schema:     int32(e.Schema),
+			Seq:        e.Seq,
+			Time:       e.Time.Format(time.RFC3339Nano),
+			Type:       e.Type,
+			Outcome:    e.Outcome,
+			Username:   e.Username,
+			Provider:   e.Provider,
+			Action:     e.Action,
+			TrackingId: e.TrackingID,
+			Permission: e.Permission,
+			Detail:     e.Detail,
+			PrevHash:   e.PrevHash,
+			Hash:       e.Hash,
+		})
+	}
+
+	return ret
+}
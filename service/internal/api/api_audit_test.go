@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/audit"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuditLogRecordsFailedLogin(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.AuthLocalUsers.Enabled = true
+	cfg.AuditLog.File = filepath.Join(t.TempDir(), "audit.jsonl")
+
+	require.NoError(t, audit.Open(cfg))
+	t.Cleanup(func() {
+		_ = audit.Open(config.DefaultConfig())
+	})
+
+	ts, client := getNewTestServerAndClient(cfg)
+	defer ts.Close()
+
+	_, err := client.LocalUserLogin(context.Background(), connect.NewRequest(&apiv1.LocalUserLoginRequest{
+		Username: "audited",
+		Password: "wrong",
+	}))
+	require.NoError(t, err)
+
+	req := &apiv1.GetAuditLogRequest{Username: "audited"}
+
+	_, err = client.GetAuditLog(context.Background(), connect.NewRequest(req))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+	cfg.DefaultPolicy.ShowAuditLog = true
+
+	res, err := client.GetAuditLog(context.Background(), connect.NewRequest(req))
+	require.NoError(t, err)
+
+	assert.True(t, res.Msg.ChainValid)
+	require.Len(t, res.Msg.Events, 1)
+	assert.Equal(t, audit.TypeLoginFailed, res.Msg.Events[0].Type)
+	assert.Equal(t, "local", res.Msg.Events[0].Provider)
+}
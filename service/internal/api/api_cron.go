@@ -0,0 +1,148 @@
+package api
+
+import (
+	ctx "context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	acl "github.com/OliveTin/OliveTin/internal/acl"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/oncron"
+)
+
+func (api *oliveTinAPI) cronScheduler() (*oncron.Scheduler, error) {
+	s := oncron.Get(api.executor)
+
+	if s == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("cron schedules are not available"))
+	}
+
+	return s, nil
+}
+
+func (api *oliveTinAPI) ListCronSchedules(ctx ctx.Context, req *connect.Request[apiv1.ListCronSchedulesRequest]) (*connect.Response[apiv1.ListCronSchedulesResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.checkDashboardAccess(user); err != nil {
+		return nil, err
+	}
+
+	ret := &apiv1.ListCronSchedulesResponse{
+		Schedules: make([]*apiv1.CronSchedule, 0),
+	}
+
+	if s := oncron.Get(api.executor); s != nil {
+		for _, job := range s.List() {
+			if acl.IsAllowedView(api.cfg, user, job.Action) {
+				ret.Schedules = append(ret.Schedules, api.cronJobToPb(job, user))
+			}
+		}
+	}
+
+	return connect.NewResponse(ret), nil
+}
+
+func (api *oliveTinAPI) PauseCronSchedule(ctx ctx.Context, req *connect.Request[apiv1.PauseCronScheduleRequest]) (*connect.Response[apiv1.PauseCronScheduleResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	s, err := api.checkCronSchedulePermission(req.Msg.Id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.Pause(req.Msg.Id)
+	if err != nil {
+		return nil, connectCronError(err)
+	}
+
+	return connect.NewResponse(&apiv1.PauseCronScheduleResponse{
+		Schedule: api.cronJobToPb(job, user),
+	}), nil
+}
+
+func (api *oliveTinAPI) ResumeCronSchedule(ctx ctx.Context, req *connect.Request[apiv1.ResumeCronScheduleRequest]) (*connect.Response[apiv1.ResumeCronScheduleResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	s, err := api.checkCronSchedulePermission(req.Msg.Id, user)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.Resume(req.Msg.Id)
+	if err != nil {
+		return nil, connectCronError(err)
+	}
+
+	return connect.NewResponse(&apiv1.ResumeCronScheduleResponse{
+		Schedule: api.cronJobToPb(job, user),
+	}), nil
+}
+
+// checkCronSchedulePermission allows users that may execute an action to
+// pause and resume its schedules, as that decides whether it runs.
+func (api *oliveTinAPI) checkCronSchedulePermission(id string, user *authpublic.AuthenticatedUser) (*oncron.Scheduler, error) {
+	s, err := api.cronScheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	job := s.Find(id)
+
+	if job == nil || !acl.IsAllowedView(api.cfg, user, job.Action) {
+		return nil, connect.NewError(connect.CodeNotFound, oncron.ErrNotFound)
+	}
+
+	if !acl.RequireExec(api.cfg, user, job.Action) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied to pause or resume this schedule"))
+	}
+
+	return s, nil
+}
+
+func connectCronError(err error) error {
+	if errors.Is(err, oncron.ErrNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func formatOptionalRFC3339(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func (api *oliveTinAPI) cronJobToPb(job *oncron.Job, user *authpublic.AuthenticatedUser) *apiv1.CronSchedule {
+	ret := &apiv1.CronSchedule{
+		Id:          job.ID,
+		ActionTitle: job.Action.Title,
+		ActionIcon:  job.Action.Icon,
+		CronLine:    job.CronLine,
+		NextRun:     formatOptionalRFC3339(job.NextRun),
+		PreviousRun: formatOptionalRFC3339(job.PreviousRun),
+		Paused:      job.Paused,
+		Error:       job.Error,
+		CanPause:    acl.IsAllowedExec(api.cfg, user, job.Action),
+	}
+
+	if binding := api.executor.FindBindingWithNoEntity(job.Action); binding != nil {
+		ret.BindingId = binding.ID
+	}
+
+	if job.LastTrackingID == "" || !acl.IsAllowedLogs(api.cfg, user, job.Action) {
+		return ret
+	}
+
+	if entry, ok := api.executor.GetLog(job.LastTrackingID); ok {
+		ret.LastExecution = api.internalLogEntryToPb(entry, user)
+	}
+
+	return ret
+}
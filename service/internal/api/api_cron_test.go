@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/oncron"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAndPauseCronSchedules(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = []*config.Action{
+		{Title: "Backup", Exec: []string{"true"}, ExecOnCron: []string{"0 3 * * *"}},
+		{Title: "Manual", Exec: []string{"true"}},
+	}
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+	oncron.Schedule(cfg, ex)
+
+	ts, client := getNewTestServerAndClientWithExecutor(cfg, ex)
+	defer ts.Close()
+
+	list, err := client.ListCronSchedules(context.Background(), connect.NewRequest(&apiv1.ListCronSchedulesRequest{}))
+	require.NoError(t, err)
+	require.Len(t, list.Msg.Schedules, 1)
+
+	schedule := list.Msg.Schedules[0]
+	assert.Equal(t, "Backup", schedule.ActionTitle)
+	assert.Equal(t, "0 3 * * *", schedule.CronLine)
+	assert.NotEmpty(t, schedule.NextRun)
+	assert.NotEmpty(t, schedule.BindingId)
+	assert.True(t, schedule.CanPause)
+
+	paused, err := client.PauseCronSchedule(context.Background(), connect.NewRequest(&apiv1.PauseCronScheduleRequest{Id: schedule.Id}))
+	require.NoError(t, err)
+	assert.True(t, paused.Msg.Schedule.Paused)
+	assert.Empty(t, paused.Msg.Schedule.NextRun)
+
+	resumed, err := client.ResumeCronSchedule(context.Background(), connect.NewRequest(&apiv1.ResumeCronScheduleRequest{Id: schedule.Id}))
+	require.NoError(t, err)
+	assert.False(t, resumed.Msg.Schedule.Paused)
+
+	_, err = client.PauseCronSchedule(context.Background(), connect.NewRequest(&apiv1.PauseCronScheduleRequest{Id: "missing"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+}
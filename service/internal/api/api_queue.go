@@ -32,6 +32,7 @@ func (api *oliveTinAPI) GetExecutionQueue(ctx ctx.Context, req *connect.Request[
 	return connect.NewResponse(&apiv1.GetExecutionQueueResponse{
 		Groups:      groups,
 		TotalActive: int32(len(active)),
+		Scheduled:   api.scheduledExecutionsForUser(user),
 	}), nil
 }
 
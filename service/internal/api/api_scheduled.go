@@ -0,0 +1,208 @@
+package api
+
+import (
+	ctx "context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	acl "github.com/OliveTin/OliveTin/internal/acl"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/scheduled"
+)
+
+func (api *oliveTinAPI) scheduler() (*scheduled.Scheduler, error) {
+	s := scheduled.Get(api.executor)
+
+	if s == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("scheduled executions are not available"))
+	}
+
+	return s, nil
+}
+
+// ScheduleAction checks the ACL now, and the executor checks it again when
+// the execution runs, in case the config has changed.
+func (api *oliveTinAPI) ScheduleAction(ctx ctx.Context, req *connect.Request[apiv1.ScheduleActionRequest]) (*connect.Response[apiv1.ScheduleActionResponse], error) {
+	s, err := api.scheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	binding, err := api.findBindingByIDOrNotFound(req.Msg.BindingId)
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if !acl.RequireExec(api.cfg, user, binding.Action) {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied to execute this action"))
+	}
+
+	sched, err := api.scheduleValidatedAction(s, binding, req.Msg, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&apiv1.ScheduleActionResponse{
+		ScheduledExecution: api.scheduledExecutionToPb(sched, user),
+	}), nil
+}
+
+func (api *oliveTinAPI) scheduleValidatedAction(s *scheduled.Scheduler, binding *executor.ActionBinding, msg *apiv1.ScheduleActionRequest, user *authpublic.AuthenticatedUser) (*scheduled.ScheduledExecution, error) {
+	at, err := time.Parse(time.RFC3339, msg.Datetime)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("datetime must be RFC3339: %w", err))
+	}
+
+	args := startActionArgumentsFromProto(msg.Arguments)
+	justification := resolveStartJustification(binding.Action, binding, msg.Justification, args)
+
+	if err := validateJustificationRequired(binding.Action, justification, user); err != nil {
+		return nil, connectInvalidJustification(err)
+	}
+
+	if err := validateScheduledArguments(binding.Action, args); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	sched, err := s.Schedule(binding, at, args, justification, user)
+	if errors.Is(err, scheduled.ErrInThePast) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return sched, err
+}
+
+// validateScheduledArguments checks the arguments that were given, so that
+// mistakes are found now rather than when the execution runs.
+func validateScheduledArguments(action *config.Action, args map[string]string) error {
+	for i := range action.Arguments {
+		arg := &action.Arguments[i]
+
+		if value, ok := args[arg.Name]; ok {
+			if err := executor.ValidateArgument(arg, value, action); err != nil {
+				return fmt.Errorf("argument %v: %w", arg.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (api *oliveTinAPI) ListScheduledExecutions(ctx ctx.Context, req *connect.Request[apiv1.ListScheduledExecutionsRequest]) (*connect.Response[apiv1.ListScheduledExecutionsResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.checkDashboardAccess(user); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&apiv1.ListScheduledExecutionsResponse{
+		ScheduledExecutions: api.scheduledExecutionsForUser(user),
+	}), nil
+}
+
+// scheduledExecutionsForUser returns the scheduled executions of actions
+// that the user may view the logs of, and those that the user scheduled.
+func (api *oliveTinAPI) scheduledExecutionsForUser(user *authpublic.AuthenticatedUser) []*apiv1.ScheduledExecution {
+	s := scheduled.Get(api.executor)
+
+	if s == nil {
+		return nil
+	}
+
+	ret := make([]*apiv1.ScheduledExecution, 0)
+
+	for _, sched := range s.List() {
+		if api.mayViewScheduled(sched, user) {
+			ret = append(ret, api.scheduledExecutionToPb(sched, user))
+		}
+	}
+
+	return ret
+}
+
+func (api *oliveTinAPI) CancelScheduledExecution(ctx ctx.Context, req *connect.Request[apiv1.CancelScheduledExecutionRequest]) (*connect.Response[apiv1.CancelScheduledExecutionResponse], error) {
+	s, err := api.scheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.checkCancelScheduled(s.Find(req.Msg.Id), user); err != nil {
+		return nil, err
+	}
+
+	if err := s.Cancel(req.Msg.Id); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	return connect.NewResponse(&apiv1.CancelScheduledExecutionResponse{}), nil
+}
+
+// checkCancelScheduled does not tell users about executions that they may not
+// view.
+func (api *oliveTinAPI) checkCancelScheduled(sched *scheduled.ScheduledExecution, user *authpublic.AuthenticatedUser) error {
+	if sched == nil || !api.mayViewScheduled(sched, user) {
+		return connect.NewError(connect.CodeNotFound, scheduled.ErrNotFound)
+	}
+
+	if !api.mayCancelScheduled(sched, user) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("permission denied to cancel this scheduled execution"))
+	}
+
+	return nil
+}
+
+func scheduledByUser(sched *scheduled.ScheduledExecution, user *authpublic.AuthenticatedUser) bool {
+	return !user.IsGuest() && sched.Username == user.Username && sched.Provider == user.Provider
+}
+
+func (api *oliveTinAPI) mayViewScheduled(sched *scheduled.ScheduledExecution, user *authpublic.AuthenticatedUser) bool {
+	if scheduledByUser(sched, user) {
+		return true
+	}
+
+	binding := api.executor.FindBindingByID(sched.BindingID)
+
+	return binding != nil && binding.Action != nil && acl.IsAllowedLogs(api.cfg, user, binding.Action)
+}
+
+// mayCancelScheduled allows users to cancel what they scheduled, and users
+// that may kill the action to cancel anything.
+func (api *oliveTinAPI) mayCancelScheduled(sched *scheduled.ScheduledExecution, user *authpublic.AuthenticatedUser) bool {
+	if scheduledByUser(sched, user) {
+		return true
+	}
+
+	binding := api.executor.FindBindingByID(sched.BindingID)
+
+	return binding != nil && binding.Action != nil && acl.IsAllowedKill(api.cfg, user, binding.Action)
+}
+
+func (api *oliveTinAPI) scheduledExecutionToPb(sched *scheduled.ScheduledExecution, user *authpublic.AuthenticatedUser) *apiv1.ScheduledExecution {
+	ret := &apiv1.ScheduledExecution{
+		Id:                sched.ID,
+		BindingId:         sched.BindingID,
+		ActionTitle:       sched.ActionTitle,
+		Datetime:          sched.Datetime.Format(time.RFC3339),
+		DatetimeScheduled: sched.DatetimeScheduled.Format(time.RFC3339),
+		Arguments:         logEntryArgumentsToProto(sched.Arguments),
+		Justification:     sched.Justification,
+		User:              sched.Username,
+		CanCancel:         api.mayCancelScheduled(sched, user),
+	}
+
+	if binding := api.executor.FindBindingByID(sched.BindingID); binding != nil && binding.Action != nil {
+		ret.ActionIcon = binding.Action.Icon
+	}
+
+	return ret
+}
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	apiv1connect "github.com/OliveTin/OliveTin/gen/olivetin/api/v1/apiv1connect"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/scheduled"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scheduleGreet(client apiv1connect.OliveTinApiServiceClient, bindingID string, at time.Time, name string) (*connect.Response[apiv1.ScheduleActionResponse], error) {
+	return client.ScheduleAction(context.Background(), connect.NewRequest(&apiv1.ScheduleActionRequest{
+		BindingId: bindingID,
+		Datetime:  at.Format(time.RFC3339),
+		Arguments: []*apiv1.StartActionArgument{{Name: "name", Value: name}},
+	}))
+}
+
+func TestScheduleListAndCancelExecution(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.Actions = []*config.Action{{
+		Title:     "Greet",
+		Exec:      []string{"echo", "{{ name }}"},
+		Arguments: []config.ActionArgument{{Name: "name", Type: "ascii_identifier"}},
+	}}
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+	scheduled.Register(ex)
+
+	ts, client := getNewTestServerAndClientWithExecutor(cfg, ex)
+	defer ts.Close()
+
+	bindingID := ex.FindBindingByActionTitle("Greet").ID
+
+	_, err := scheduleGreet(client, bindingID, time.Now().Add(-time.Hour), "olive")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	_, err = scheduleGreet(client, bindingID, time.Now().Add(time.Hour), "not valid!")
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	created, err := scheduleGreet(client, bindingID, time.Now().Add(time.Hour), "olive")
+	require.NoError(t, err)
+	assert.Equal(t, "Greet", created.Msg.ScheduledExecution.ActionTitle)
+
+	queue, err := client.GetExecutionQueue(context.Background(), connect.NewRequest(&apiv1.GetExecutionQueueRequest{}))
+	require.NoError(t, err)
+	require.Len(t, queue.Msg.Scheduled, 1)
+	assert.Equal(t, created.Msg.ScheduledExecution.Id, queue.Msg.Scheduled[0].Id)
+	assert.True(t, queue.Msg.Scheduled[0].CanCancel)
+
+	_, err = client.CancelScheduledExecution(context.Background(), connect.NewRequest(&apiv1.CancelScheduledExecutionRequest{Id: created.Msg.ScheduledExecution.Id}))
+	require.NoError(t, err)
+
+	_, err = client.CancelScheduledExecution(context.Background(), connect.NewRequest(&apiv1.CancelScheduledExecutionRequest{Id: created.Msg.ScheduledExecution.Id}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	list, err := client.ListScheduledExecutions(context.Background(), connect.NewRequest(&apiv1.ListScheduledExecutionsRequest{}))
+	require.NoError(t, err)
+	assert.Empty(t, list.Msg.ScheduledExecutions)
+}
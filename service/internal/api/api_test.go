@@ -73,6 +73,22 @@ func TestApplyActionExecTriggersIncludesWebhookHeaderAndQueryMatches(t *testing.
 	assert.Equal(t, cfg.ExecOnWebhook[0].MatchQuery, pb.ExecOnWebhooks[0].MatchQuery)
 }
 
+func TestApplyActionExecTriggersIncludesMqttTopics(t *testing.T) {
+	cfg := &config.Action{
+		ExecOnMqtt: []config.MqttTrigger{
+			{Broker: "home", Topic: "home/+/button", MatchPath: "$.action=open"},
+		},
+	}
+	pb := &apiv1.Action{}
+
+	applyActionExecTriggers(pb, cfg)
+
+	require.Len(t, pb.ExecOnMqtt, 1)
+	assert.Equal(t, "home", pb.ExecOnMqtt[0].Broker)
+	assert.Equal(t, "home/+/button", pb.ExecOnMqtt[0].Topic)
+	assert.Equal(t, "$.action=open", pb.ExecOnMqtt[0].MatchPath)
+}
+
 func TestGetActionsAndStart(t *testing.T) {
 	cfg := config.DefaultConfig()
 
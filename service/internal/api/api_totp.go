@@ -0,0 +1,187 @@
+package api
+
+import (
+	ctx "context"
+	"errors"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/audit"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/auth/ottotp"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// loginWithoutLocalPassword handles the TOTP step of a login, and LDAP users,
+// or returns nil when the local password needs to be checked.
+func (api *oliveTinAPI) loginWithoutLocalPassword(req *connect.Request[apiv1.LocalUserLoginRequest]) *connect.Response[apiv1.LocalUserLoginResponse] {
+	if req.Msg.TotpChallenge != "" {
+		return api.totpUserLogin(req)
+	}
+
+	if api.useLdapLogin(req.Msg.Username) {
+		return api.ldapUserLogin(req)
+	}
+
+	return nil
+}
+
+// requireTotpChallenge answers with a challenge, rather than a session, for
+// users who have enrolled TOTP. When TOTP has been disabled, for example as
+// its encryptionKey is missing, these users cannot log in at all.
+func (api *oliveTinAPI) requireTotpChallenge(username string, response *connect.Response[apiv1.LocalUserLoginResponse]) bool {
+	if !ottotp.HasEnrolment(username) {
+		return false
+	}
+
+	response.Msg.Success = false
+
+	if !api.cfg.AuthLocalUsers.Totp.Enabled {
+		log.WithFields(log.Fields{"username": username}).Error("LocalUserLogin: User has enrolled TOTP, but TOTP is not enabled, so the login is refused.")
+		recordLogin("local", audit.TypeLoginFailed, audit.OutcomeFailure, username, "totp: "+ottotp.ErrNotEnabled.Error())
+
+		return true
+	}
+
+	challenge, err := ottotp.NewChallenge(username)
+	if err != nil {
+		log.WithFields(log.Fields{"username": username}).Errorf("LocalUserLogin: could not create TOTP challenge: %v", err)
+		return true
+	}
+
+	response.Msg.TotpRequired = true
+	response.Msg.TotpChallenge = challenge
+
+	log.WithFields(log.Fields{"username": username}).Info("LocalUserLogin: Password correct, waiting for TOTP code.")
+
+	return true
+}
+
+// totpUserLogin is the second step of the login of a user who has enrolled
+// TOTP. The challenge is kept after a wrong code, until it has expired.
+func (api *oliveTinAPI) totpUserLogin(req *connect.Request[apiv1.LocalUserLoginRequest]) *connect.Response[apiv1.LocalUserLoginResponse] {
+	username, err := ottotp.VerifyChallenge(api.cfg, req.Msg.TotpChallenge, req.Msg.TotpCode)
+
+	if err == nil && (!api.cfg.AuthLocalUsers.Enabled || api.cfg.FindUserByUsername(username) == nil) {
+		err = ottotp.ErrNotEnrolled
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{"username": username}).Warnf("LocalUserLogin: TOTP login failed: %v", err)
+		recordLogin("local", audit.TypeLoginFailed, audit.OutcomeFailure, username, "totp: "+err.Error())
+
+		return connect.NewResponse(&apiv1.LocalUserLoginResponse{
+			Success:       false,
+			TotpRequired:  errors.Is(err, ottotp.ErrInvalidCode),
+			TotpChallenge: req.Msg.TotpChallenge,
+		})
+	}
+
+	sid := uuid.NewString()
+	auth.RegisterUserSessionWithTotp(api.cfg, "local", sid, username)
+
+	response := connect.NewResponse(&apiv1.LocalUserLoginResponse{Success: true})
+	response.Header().Set("Set-Cookie", localSessionCookie(sid, api.cookieSecure(req.Header())).String())
+
+	log.WithFields(log.Fields{"username": username}).Info("LocalUserLogin: User logged in successfully with TOTP.")
+	recordLogin("local", audit.TypeLogin, audit.OutcomeSuccess, username, "totp")
+
+	return response
+}
+
+// totpUser is the user for enrolment, who must have logged in with a local
+// password, as API keys and other providers do not use TOTP.
+func (api *oliveTinAPI) totpUser(user *authpublic.AuthenticatedUser) error {
+	if !api.cfg.AuthLocalUsers.Totp.Enabled {
+		return connect.NewError(connect.CodeFailedPrecondition, ottotp.ErrNotEnabled)
+	}
+
+	if user.Provider != "local" || user.SID == "" {
+		return connect.NewError(connect.CodePermissionDenied, errors.New("TOTP is only available to local users who logged in with a password"))
+	}
+
+	return nil
+}
+
+func totpError(err error) error {
+	switch {
+	case errors.Is(err, ottotp.ErrInvalidCode):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, ottotp.ErrAlreadyEnrolled):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, ottotp.ErrNotEnrolled), errors.Is(err, ottotp.ErrNotStarted), errors.Is(err, ottotp.ErrNotEnabled):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func (api *oliveTinAPI) BeginTotpEnrolment(ctx ctx.Context, req *connect.Request[apiv1.BeginTotpEnrolmentRequest]) (*connect.Response[apiv1.BeginTotpEnrolmentResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.totpUser(user); err != nil {
+		return nil, err
+	}
+
+	enrolment, err := ottotp.BeginEnrolment(api.cfg, user.Username)
+	if err != nil {
+		return nil, totpError(err)
+	}
+
+	return connect.NewResponse(&apiv1.BeginTotpEnrolmentResponse{
+		Secret:    enrolment.Secret,
+		Url:       enrolment.URL,
+		QrCodePng: enrolment.QRCode,
+	}), nil
+}
+
+// ConfirmTotpEnrolment also marks the current session as verified, as the
+// user has just entered a code.
+func (api *oliveTinAPI) ConfirmTotpEnrolment(ctx ctx.Context, req *connect.Request[apiv1.ConfirmTotpEnrolmentRequest]) (*connect.Response[apiv1.ConfirmTotpEnrolmentResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.totpUser(user); err != nil {
+		return nil, err
+	}
+
+	codes, err := ottotp.ConfirmEnrolment(api.cfg, user.Username, req.Msg.Code)
+	if err != nil {
+		return nil, totpError(err)
+	}
+
+	auth.MarkSessionTotpVerified(api.cfg, "local", user.SID)
+	recordTotpChange(audit.TypeTotpEnrolled, user)
+
+	return connect.NewResponse(&apiv1.ConfirmTotpEnrolmentResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+func (api *oliveTinAPI) DisableTotp(ctx ctx.Context, req *connect.Request[apiv1.DisableTotpRequest]) (*connect.Response[apiv1.DisableTotpResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.totpUser(user); err != nil {
+		return nil, err
+	}
+
+	if err := ottotp.Disable(api.cfg, user.Username, req.Msg.Code); err != nil {
+		return nil, totpError(err)
+	}
+
+	recordTotpChange(audit.TypeTotpDisabled, user)
+
+	return connect.NewResponse(&apiv1.DisableTotpResponse{}), nil
+}
+
+func recordTotpChange(eventType string, user *authpublic.AuthenticatedUser) {
+	log.WithFields(log.Fields{"username": user.Username}).Infof("TOTP %v", eventType)
+
+	audit.Record(audit.Event{
+		Type:     eventType,
+		Outcome:  audit.OutcomeSuccess,
+		Username: user.Username,
+		Provider: user.Provider,
+	})
+}
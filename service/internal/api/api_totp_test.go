@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/auth/ottotp"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func withCookie[T any](msg *T, cookie string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set("Cookie", cookie)
+
+	return req
+}
+
+func sessionCookie(t *testing.T, header http.Header) string {
+	c, err := http.ParseSetCookie(header.Get("Set-Cookie"))
+	require.NoError(t, err)
+
+	return c.Name + "=" + c.Value
+}
+
+func TestLocalUserLoginWithTotp(t *testing.T) {
+	hash, err := createHash("alice-secret")
+	require.NoError(t, err)
+
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.AuthLocalUsers.Enabled = true
+	cfg.AuthLocalUsers.Users = []*config.LocalUser{{Username: "alice", Password: hash}}
+	cfg.AuthLocalUsers.Totp = config.TotpConfig{Enabled: true, Issuer: "OliveTin", EncryptionKey: "test-key"}
+	ottotp.Load(cfg)
+
+	ts, client := getNewTestServerAndClient(cfg)
+	defer ts.Close()
+
+	ctx := context.Background()
+	passwordLogin := &apiv1.LocalUserLoginRequest{Username: "alice", Password: "alice-secret"}
+
+	login, err := client.LocalUserLogin(ctx, connect.NewRequest(passwordLogin))
+	require.NoError(t, err)
+	require.True(t, login.Msg.Success)
+	cookie := sessionCookie(t, login.Header())
+
+	whoami, err := client.WhoAmI(ctx, withCookie(&apiv1.WhoAmIRequest{}, cookie))
+	require.NoError(t, err)
+	assert.True(t, whoami.Msg.TotpAvailable)
+	assert.False(t, whoami.Msg.TotpEnrolled)
+
+	enrolment, err := client.BeginTotpEnrolment(ctx, withCookie(&apiv1.BeginTotpEnrolmentRequest{}, cookie))
+	require.NoError(t, err)
+
+	code, err := totp.GenerateCode(enrolment.Msg.Secret, time.Now())
+	require.NoError(t, err)
+
+	confirmed, err := client.ConfirmTotpEnrolment(ctx, withCookie(&apiv1.ConfirmTotpEnrolmentRequest{Code: code}, cookie))
+	require.NoError(t, err)
+	assert.NotEmpty(t, confirmed.Msg.RecoveryCodes)
+
+	challenged, err := client.LocalUserLogin(ctx, connect.NewRequest(passwordLogin))
+	require.NoError(t, err)
+	assert.False(t, challenged.Msg.Success)
+	assert.True(t, challenged.Msg.TotpRequired)
+	assert.Empty(t, challenged.Header().Get("Set-Cookie"))
+
+	wrong, err := client.LocalUserLogin(ctx, connect.NewRequest(&apiv1.LocalUserLoginRequest{
+		TotpChallenge: challenged.Msg.TotpChallenge,
+		TotpCode:      "not-a-code",
+	}))
+	require.NoError(t, err)
+	assert.False(t, wrong.Msg.Success)
+	assert.True(t, wrong.Msg.TotpRequired, "another code can be tried")
+
+	login, err = client.LocalUserLogin(ctx, connect.NewRequest(&apiv1.LocalUserLoginRequest{
+		TotpChallenge: challenged.Msg.TotpChallenge,
+		TotpCode:      confirmed.Msg.RecoveryCodes[0],
+	}))
+	require.NoError(t, err)
+	require.True(t, login.Msg.Success)
+
+	whoami, err = client.WhoAmI(ctx, withCookie(&apiv1.WhoAmIRequest{}, sessionCookie(t, login.Header())))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", whoami.Msg.AuthenticatedUser)
+	assert.True(t, whoami.Msg.TotpEnrolled)
+}
+
+func TestBeginTotpEnrolmentRequiresLocalSession(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.AuthLocalUsers.Totp = config.TotpConfig{Enabled: true, EncryptionKey: "test-key"}
+
+	ts, client := getNewTestServerAndClient(cfg)
+	defer ts.Close()
+
+	_, err := client.BeginTotpEnrolment(context.Background(), connect.NewRequest(&apiv1.BeginTotpEnrolmentRequest{}))
+	assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+}
+
+func TestEnrolledUserCannotLoginWhenTotpIsDisabled(t *testing.T) {
+	hash, err := createHash("alice-secret")
+	require.NoError(t, err)
+
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.AuthLocalUsers.Enabled = true
+	cfg.AuthLocalUsers.Users = []*config.LocalUser{{Username: "alice", Password: hash}}
+	cfg.AuthLocalUsers.Totp = config.TotpConfig{Enabled: true, Issuer: "OliveTin", EncryptionKey: "test-key"}
+	ottotp.Load(cfg)
+
+	enrolment, err := ottotp.BeginEnrolment(cfg, "alice")
+	require.NoError(t, err)
+
+	code, err := totp.GenerateCode(enrolment.Secret, time.Now())
+	require.NoError(t, err)
+
+	_, err = ottotp.ConfirmEnrolment(cfg, "alice", code)
+	require.NoError(t, err)
+
+	// As when the encryptionKey env var is missing on the next start.
+	cfg.AuthLocalUsers.Totp = config.TotpConfig{Enabled: true, EncryptionKey: "{{ .Env.OLIVETIN_TEST_UNSET_TOTP_KEY }}"}
+	cfg.Sanitize()
+	require.False(t, cfg.AuthLocalUsers.Totp.Enabled)
+	ottotp.Load(cfg)
+
+	ts, client := getNewTestServerAndClient(cfg)
+	defer ts.Close()
+
+	login, err := client.LocalUserLogin(context.Background(), connect.NewRequest(&apiv1.LocalUserLoginRequest{Username: "alice", Password: "alice-secret"}))
+	require.NoError(t, err)
+	assert.False(t, login.Msg.Success)
+	assert.False(t, login.Msg.TotpRequired)
+	assert.Empty(t, login.Header().Get("Set-Cookie"))
+}
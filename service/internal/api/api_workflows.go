@@ -0,0 +1,91 @@
+package api
+
+import (
+	ctx "context"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/workflows"
+)
+
+// StartWorkflow starts a run of a workflow. Every step is executed as the
+// user that started the run, so steps with actions that the user may not
+// execute are blocked, and fail.
+func (api *oliveTinAPI) StartWorkflow(ctx ctx.Context, req *connect.Request[apiv1.StartWorkflowRequest]) (*connect.Response[apiv1.StartWorkflowResponse], error) {
+	user := auth.UserFromApiCall(ctx, req, api.cfg)
+
+	if err := api.checkDashboardAccess(user); err != nil {
+		return nil, err
+	}
+
+	if api.cfg.FindWorkflow(req.Msg.WorkflowId) == nil {
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+
+	trackingID, err := workflows.Start(api.executor, req.Msg.WorkflowId, startActionArgumentsFromProto(req.Msg.Arguments), user)
+
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	return connect.NewResponse(&apiv1.StartWorkflowResponse{
+		WorkflowTrackingId: trackingID,
+	}), nil
+}
+
+// getWorkflowLogs returns every execution of a workflow run that the user may
+// view, without paging, as runs only have a few steps.
+func (api *oliveTinAPI) getWorkflowLogs(workflowTrackingID string, user *authpublic.AuthenticatedUser) *apiv1.GetLogsResponse {
+	entries := api.filterLogsByACL(api.executor.GetLogsByWorkflow(workflowTrackingID), user)
+
+	ret := &apiv1.GetLogsResponse{
+		PageSize:   int64(len(entries)),
+		TotalCount: int64(len(entries)),
+	}
+
+	for _, le := range entries {
+		ret.Logs = append(ret.Logs, api.internalLogEntryToPb(le, user))
+	}
+
+	if run, ok := workflows.GetRun(api.executor, workflowTrackingID); ok {
+		ret.WorkflowRun = workflowRunToPb(run)
+	}
+
+	return ret
+}
+
+func workflowRunToPb(run *workflows.Run) *apiv1.WorkflowRun {
+	ret := &apiv1.WorkflowRun{
+		WorkflowTrackingId: run.TrackingID,
+		WorkflowId:         run.WorkflowID,
+		Title:              run.Title,
+		User:               run.Username,
+		Status:             run.Status,
+		DatetimeStarted:    run.DatetimeStarted.Format("2006-01-02 15:04:05"),
+		DatetimeFinished:   formatOptionalDatetime(run.DatetimeFinished),
+	}
+
+	for _, step := range run.Steps {
+		ret.Steps = append(ret.Steps, &apiv1.WorkflowStepRun{
+			Id:                  step.ID,
+			ActionTitle:         step.ActionTitle,
+			Status:              step.Status,
+			ExecutionTrackingId: step.ExecutionTrackingID,
+			ExitCode:            step.ExitCode,
+			Error:               step.Error,
+		})
+	}
+
+	return ret
+}
+
+func formatOptionalDatetime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format("2006-01-02 15:04:05")
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLogsOfWorkflowRun(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = []*config.Action{
+		{Title: "Backup", Exec: []string{"echo", "backup"}},
+		{Title: "Prune", Exec: []string{"echo", "prune"}},
+		{Title: "Unrelated", Exec: []string{"echo", "unrelated"}},
+	}
+	cfg.Workflows = []*config.Workflow{{
+		ID: "nightly",
+		Steps: []config.WorkflowStep{
+			{Action: "Backup"},
+			{Action: "Prune", Needs: []string{"Backup"}},
+		},
+	}}
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	wg, _ := ex.ExecRequest(&executor.ExecutionRequest{
+		Binding: ex.FindBindingWithNoEntity(cfg.Actions[2]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	ts, client := getNewTestServerAndClientWithExecutor(cfg, ex)
+	defer ts.Close()
+
+	_, err := client.StartWorkflow(context.Background(), connect.NewRequest(&apiv1.StartWorkflowRequest{WorkflowId: "missing"}))
+	assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+	started, err := client.StartWorkflow(context.Background(), connect.NewRequest(&apiv1.StartWorkflowRequest{WorkflowId: "nightly"}))
+	require.NoError(t, err)
+
+	workflows.Wait(ex, started.Msg.WorkflowTrackingId)
+
+	resp, err := client.GetLogs(context.Background(), connect.NewRequest(&apiv1.GetLogsRequest{
+		WorkflowTrackingId: started.Msg.WorkflowTrackingId,
+	}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.Logs, 2)
+
+	for _, le := range resp.Msg.Logs {
+		assert.Equal(t, started.Msg.WorkflowTrackingId, le.WorkflowTrackingId)
+	}
+
+	require.NotNil(t, resp.Msg.WorkflowRun)
+	assert.Equal(t, workflows.StatusSucceeded, resp.Msg.WorkflowRun.Status)
+	require.Len(t, resp.Msg.WorkflowRun.Steps, 2)
+	assert.Equal(t, "Prune", resp.Msg.WorkflowRun.Steps[1].Id)
+}
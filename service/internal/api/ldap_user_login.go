@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/audit"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/auth/otldap"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// useLdapLogin checks users against LDAP when they are not local users, so
+// that local users still work when the directory is not available.
+func (api *oliveTinAPI) useLdapLogin(username string) bool {
+	if !api.cfg.AuthLdap.Enabled {
+		return false
+	}
+
+	return !api.cfg.AuthLocalUsers.Enabled || api.cfg.FindUserByUsername(username) == nil
+}
+
+func (api *oliveTinAPI) ldapUserLogin(req *connect.Request[apiv1.LocalUserLoginRequest]) *connect.Response[apiv1.LocalUserLoginResponse] {
+	user, err := otldap.Authenticate(api.cfg, req.Msg.Username, req.Msg.Password)
+
+	if err != nil {
+		logLdapLoginFailed(req.Msg.Username, err)
+		return connect.NewResponse(&apiv1.LocalUserLoginResponse{Success: false})
+	}
+
+	sid := uuid.NewString()
+	auth.RegisterUserSessionWithUsergroup(api.cfg, "ldap", sid, user.Username, user.Usergroup, int64(api.cfg.AuthLdap.SessionMaxAge))
+
+	response := connect.NewResponse(&apiv1.LocalUserLoginResponse{Success: true})
+	response.Header().Set("Set-Cookie", localSessionCookie(sid, api.cookieSecure(req.Header())).String())
+
+	log.WithFields(log.Fields{
+		"username":  user.Username,
+		"usergroup": user.Usergroup,
+	}).Info("LocalUserLogin: LDAP user logged in successfully.")
+
+	recordLogin("ldap", audit.TypeLogin, audit.OutcomeSuccess, user.Username, "")
+
+	return response
+}
+
+// logLdapLoginFailed only logs errors other than a wrong username or
+// password as errors, as they mean that the directory could not be used.
+func logLdapLoginFailed(username string, err error) {
+	fields := log.Fields{
+		"username": username,
+		"error":    err,
+	}
+
+	if errors.Is(err, otldap.ErrInvalidCredentials) {
+		log.WithFields(fields).Warn("LocalUserLogin: LDAP user login failed.")
+		recordLogin("ldap", audit.TypeLoginFailed, audit.OutcomeFailure, username, "")
+	} else {
+		log.WithFields(fields).Error("LocalUserLogin: LDAP error.")
+		recordLogin("ldap", audit.TypeLoginFailed, audit.OutcomeFailure, username, err.Error())
+	}
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	apiv1 "github.com/OliveTin/OliveTin/gen/olivetin/api/v1"
+	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/auth/otldap/otldaptest"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalUserLoginFallsBackToLdap(t *testing.T) {
+	server := otldaptest.NewServer(t, &otldaptest.Entry{
+		DN:       "uid=alice,ou=people,dc=example,dc=org",
+		Password: "alice-secret",
+		Attributes: map[string][]string{
+			"uid":      {"alice"},
+			"memberOf": {"cn=admins,ou=groups,dc=example,dc=org"},
+		},
+	})
+
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.AuthLdap.Enabled = true
+	cfg.AuthLdap.URL = server.URL
+	cfg.AuthLdap.BaseDN = "dc=example,dc=org"
+	cfg.AccessControlLists = []*config.AccessControlList{{
+		Name:            "admins",
+		MatchUsergroups: []string{"admins"},
+	}}
+
+	ts, client := getNewTestServerAndClient(cfg)
+	defer ts.Close()
+
+	failed, err := client.LocalUserLogin(context.Background(), connect.NewRequest(&apiv1.LocalUserLoginRequest{
+		Username: "alice",
+		Password: "wrong",
+	}))
+	require.NoError(t, err)
+	assert.False(t, failed.Msg.Success)
+
+	login, err := client.LocalUserLogin(context.Background(), connect.NewRequest(&apiv1.LocalUserLoginRequest{
+		Username: "alice",
+		Password: "alice-secret",
+	}))
+	require.NoError(t, err)
+	require.True(t, login.Msg.Success)
+
+	cookies, err := http.ParseSetCookie(login.Header().Get("Set-Cookie"))
+	require.NoError(t, err)
+
+	whoami := connect.NewRequest(&apiv1.WhoAmIRequest{})
+	whoami.Header().Set("Cookie", cookies.Name+"="+cookies.Value)
+
+	res, err := client.WhoAmI(context.Background(), whoami)
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice", res.Msg.AuthenticatedUser)
+	assert.Equal(t, "ldap", res.Msg.Provider)
+	assert.Equal(t, "admins", res.Msg.Usergroup)
+	assert.Contains(t, res.Msg.Acls, "admins")
+
+	session := auth.GetUserSession("ldap", cookies.Value)
+	require.NotNil(t, session)
+	assert.LessOrEqual(t, session.Expiry, time.Now().Unix()+int64(cfg.AuthLdap.SessionMaxAge), "LDAP groups are only trusted until the session expires")
+}
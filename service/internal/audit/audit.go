@@ -0,0 +1,388 @@
+// Package audit records security related events, such as logins, denied
+// permissions and kills, to an append only log. Each event includes the hash
+// of the event before it, so that changes to the log can be detected.
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// SchemaVersion is increased when the fields of Event change in a way that
+// readers of the log need to know about.
+const SchemaVersion = 1
+
+const (
+	TypeLogin             = "login"
+	TypeLoginFailed       = "login_failed"
+	TypeOAuth2Login       = "oauth2_login"
+	TypeOAuth2LoginFailed = "oauth2_login_failed"
+	TypeLogout            = "logout"
+	TypeAclDenied         = "acl_denied"
+	TypeKill              = "kill"
+	TypeConfigReload      = "config_reload"
+	TypeTotpEnrolled      = "totp_enrolled"
+	TypeTotpDisabled      = "totp_disabled"
+	TypeRecovered         = "audit_recovered"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+	OutcomeDenied  = "denied"
+)
+
+// ErrNoFile is returned when querying an audit log that is not written to a
+// file.
+var ErrNoFile = errors.New("the audit log is not written to a file")
+
+// Event is one line of the audit log.
+type Event struct {
+	Schema     int       `json:"schema"`
+	Seq        uint64    `json:"seq"`
+	Time       time.Time `json:"time"`
+	Type       string    `json:"type"`
+	Outcome    string    `json:"outcome"`
+	Username   string    `json:"username,omitempty"`
+	Provider   string    `json:"provider,omitempty"`
+	Action     string    `json:"action,omitempty"`
+	TrackingID string    `json:"trackingId,omitempty"`
+	Permission string    `json:"permission,omitempty"`
+	Detail     string    `json:"detail,omitempty"`
+	PrevHash   string    `json:"prevHash"`
+	Hash       string    `json:"hash"`
+}
+
+type syslogWriter interface {
+	Info(m string) error
+	Close() error
+}
+
+// Log appends events to a file and/or syslog.
+type Log struct {
+	mu       sync.Mutex
+	path     string
+	file     *os.File
+	syslog   syslogWriter
+	seq      uint64
+	lastHash string
+
+	// tornBytes is the length of a torn last line that was removed from the
+	// file when it was opened.
+	tornBytes int
+}
+
+var (
+	current   *Log
+	currentMu sync.Mutex
+)
+
+// Open starts recording events as set in the config. Events are not recorded
+// when neither a file nor syslog is set.
+func Open(cfg *config.Config) error {
+	l, err := newLog(cfg.AuditLog)
+	if err != nil {
+		return err
+	}
+
+	currentMu.Lock()
+	old := current
+	current = l
+	currentMu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+
+	return nil
+}
+
+// Record adds the event to the audit log, if it is open.
+func Record(e Event) {
+	currentMu.Lock()
+	l := current
+	currentMu.Unlock()
+
+	if l != nil {
+		l.Record(e)
+	}
+}
+
+// Current returns the open audit log, or nil.
+func Current() *Log {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+
+	return current
+}
+
+func newLog(cfg config.AuditLogConfig) (*Log, error) {
+	if cfg.File == "" && !cfg.Syslog {
+		return nil, nil
+	}
+
+	l := &Log{
+		path: cfg.File,
+	}
+
+	if err := l.openFile(); err != nil {
+		return nil, err
+	}
+
+	if err := l.openSyslog(cfg); err != nil {
+		l.Close()
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"file":   cfg.File,
+		"syslog": cfg.Syslog,
+		"seq":    l.seq,
+	}).Infof("Audit log opened")
+
+	l.recordRecovery()
+
+	return l, nil
+}
+
+// recordRecovery records that a torn last line was removed, so that the gap
+// is visible in the log itself.
+func (l *Log) recordRecovery() {
+	if l.tornBytes == 0 {
+		return
+	}
+
+	detail := fmt.Sprintf("removed a torn last line of %v bytes after event %v", l.tornBytes, l.seq)
+
+	log.WithFields(log.Fields{
+		"file": l.path,
+	}).Warnf("Audit log: %v", detail)
+
+	l.Record(Event{
+		Type:    TypeRecovered,
+		Outcome: OutcomeFailure,
+		Detail:  detail,
+	})
+}
+
+func (l *Log) openSyslog(cfg config.AuditLogConfig) error {
+	if !cfg.Syslog {
+		return nil
+	}
+
+	w, err := dialSyslog(cfg.SyslogNetwork, cfg.SyslogAddress)
+	if err != nil {
+		return fmt.Errorf("audit log syslog: %w", err)
+	}
+
+	l.syslog = w
+
+	return nil
+}
+
+// openFile continues the chain from the last event in the file.
+func (l *Log) openFile() error {
+	if l.path == "" {
+		return nil
+	}
+
+	if err := l.readTail(); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("audit log: %w", err)
+	}
+
+	l.file = f
+
+	return nil
+}
+
+func (l *Log) readTail() error {
+	f, err := os.OpenFile(l.path, os.O_RDWR, 0)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("audit log: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	return l.scanTail(f)
+}
+
+func (l *Log) continueFrom(e *Event) {
+	l.seq = e.Seq
+	l.lastHash = e.Hash
+}
+
+// scanTail reads every complete line of the file. Lines are only written
+// whole, so the last line can only be missing its newline when OliveTin
+// stopped while writing it.
+func (l *Log) scanTail(f *os.File) error {
+	reader := bufio.NewReader(f)
+	offset := int64(0)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+
+		if errors.Is(err, io.EOF) {
+			return l.recoverTail(f, offset, line)
+		}
+
+		if err != nil {
+			return fmt.Errorf("audit log: %w", err)
+		}
+
+		if lineErr := readEventLine(line, l.continueFrom); lineErr != nil {
+			return lineErr
+		}
+
+		offset += int64(len(line))
+	}
+}
+
+// recoverTail ends the last line with a newline when it is a whole event, and
+// otherwise truncates the file to the end of the line before it.
+func (l *Log) recoverTail(f *os.File, offset int64, line []byte) error {
+	if len(line) == 0 {
+		return nil
+	}
+
+	if readEventLine(line, l.continueFrom) == nil {
+		_, err := f.WriteAt([]byte("\n"), offset+int64(len(line)))
+		return wrapFileError(err)
+	}
+
+	l.tornBytes = len(line)
+
+	return wrapFileError(f.Truncate(offset))
+}
+
+func wrapFileError(err error) error {
+	if err != nil {
+		return fmt.Errorf("audit log: %w", err)
+	}
+
+	return nil
+}
+
+// Close stops writing to the file and syslog.
+func (l *Log) Close() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file != nil {
+		_ = l.file.Close()
+		l.file = nil
+	}
+
+	if l.syslog != nil {
+		_ = l.syslog.Close()
+		l.syslog = nil
+	}
+}
+
+// Record fills in the sequence number, time and hashes of the event, and
+// writes it.
+func (l *Log) Record(e Event) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	e.Schema = SchemaVersion
+	e.Seq = l.seq + 1
+	e.Time = time.Now().UTC()
+	e.PrevHash = l.lastHash
+	e.Hash = ""
+	e.Hash = hashEvent(&e)
+
+	line, err := json.Marshal(&e)
+	if err != nil {
+		log.Errorf("Could not encode audit event: %v", err)
+		return
+	}
+
+	l.seq = e.Seq
+	l.lastHash = e.Hash
+
+	l.write(line)
+}
+
+func (l *Log) write(line []byte) {
+	if l.file != nil {
+		if _, err := l.file.Write(append(line, '\n')); err != nil {
+			log.Errorf("Could not write to the audit log: %v", err)
+		}
+	}
+
+	if l.syslog != nil {
+		if err := l.syslog.Info(string(line)); err != nil {
+			log.Errorf("Could not write the audit log to syslog: %v", err)
+		}
+	}
+}
+
+// hashEvent hashes the event with an empty hash. As the event includes the
+// hash of the event before it, changing any event breaks the chain.
+func hashEvent(e *Event) string {
+	copied := *e
+	copied.Hash = ""
+
+	data, _ := json.Marshal(&copied)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func readEvents(r io.Reader, fn func(e *Event)) error {
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadBytes('\n')
+
+		if lineErr := readEventLine(line, fn); lineErr != nil {
+			return lineErr
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("audit log: %w", err)
+		}
+	}
+}
+
+func readEventLine(line []byte, fn func(e *Event)) error {
+	if len(bytes.TrimSpace(line)) == 0 {
+		return nil
+	}
+
+	e := &Event{}
+
+	if err := json.Unmarshal(line, e); err != nil {
+		return fmt.Errorf("audit log: invalid line: %w", err)
+	}
+
+	fn(e)
+
+	return nil
+}
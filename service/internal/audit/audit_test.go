@@ -0,0 +1,163 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLog(t *testing.T, path string) *Log {
+	l, err := newLog(config.AuditLogConfig{File: path})
+	require.NoError(t, err)
+
+	t.Cleanup(l.Close)
+
+	return l
+}
+
+func TestRecordChainsEvents(t *testing.T) {
+	l := newTestLog(t, filepath.Join(t.TempDir(), "audit.jsonl"))
+
+	l.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+	l.Record(Event{Type: TypeKill, Outcome: OutcomeSuccess, Username: "bob", TrackingID: "abc"})
+
+	res, err := l.Query(Filter{})
+	require.NoError(t, err)
+
+	assert.True(t, res.ChainValid)
+	require.Len(t, res.Events, 2)
+	assert.Equal(t, uint64(2), res.Events[0].Seq, "newest first")
+	assert.Equal(t, res.Events[1].Hash, res.Events[0].PrevHash)
+	assert.Equal(t, SchemaVersion, res.Events[0].Schema)
+}
+
+func TestQueryFilters(t *testing.T) {
+	l := newTestLog(t, filepath.Join(t.TempDir(), "audit.jsonl"))
+
+	l.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+	l.Record(Event{Type: TypeLoginFailed, Outcome: OutcomeFailure, Username: "alice"})
+	l.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "bob"})
+
+	res, err := l.Query(Filter{Type: TypeLogin})
+	require.NoError(t, err)
+	assert.Len(t, res.Events, 2)
+
+	res, err = l.Query(Filter{Username: "alice", Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Events, 1)
+	assert.Equal(t, TypeLoginFailed, res.Events[0].Type)
+}
+
+func TestReopenContinuesChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	first := newTestLog(t, path)
+	first.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+	first.Close()
+
+	second := newTestLog(t, path)
+	second.Record(Event{Type: TypeLogout, Outcome: OutcomeSuccess, Username: "alice"})
+
+	res, err := second.Query(Filter{})
+	require.NoError(t, err)
+
+	assert.True(t, res.ChainValid)
+	require.Len(t, res.Events, 2)
+	assert.Equal(t, uint64(2), res.Events[0].Seq)
+}
+
+func TestQueryDetectsTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l := newTestLog(t, path)
+
+	l.Record(Event{Type: TypeLoginFailed, Outcome: OutcomeFailure, Username: "mallory"})
+	l.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte(strings.Replace(string(data), "mallory", "alice", 1)), 0600))
+
+	res, err := l.Query(Filter{})
+	require.NoError(t, err)
+
+	assert.False(t, res.ChainValid)
+	assert.Contains(t, res.ChainError, "event 1")
+}
+
+func TestQueryDetectsRemovedEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	l := newTestLog(t, path)
+
+	l.Record(Event{Type: TypeLoginFailed, Outcome: OutcomeFailure, Username: "mallory"})
+	l.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.SplitAfter(string(data), "\n")
+	require.NoError(t, os.WriteFile(path, []byte(lines[1]), 0600))
+
+	res, err := l.Query(Filter{})
+	require.NoError(t, err)
+
+	assert.False(t, res.ChainValid)
+}
+
+func TestReopenRemovesTornLastLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	first := newTestLog(t, path)
+	first.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+	first.Close()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"schema":1,"seq":2,"ty`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	second := newTestLog(t, path)
+	second.Record(Event{Type: TypeLogout, Outcome: OutcomeSuccess, Username: "alice"})
+
+	res, err := second.Query(Filter{})
+	require.NoError(t, err)
+
+	assert.True(t, res.ChainValid)
+	require.Len(t, res.Events, 3)
+	assert.Equal(t, TypeRecovered, res.Events[1].Type)
+	assert.Contains(t, res.Events[1].Detail, "23 bytes")
+}
+
+func TestReopenKeepsLastEventWithoutNewline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	first := newTestLog(t, path)
+	first.Record(Event{Type: TypeLogin, Outcome: OutcomeSuccess, Username: "alice"})
+	first.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, []byte(strings.TrimSuffix(string(data), "\n")), 0600))
+
+	second := newTestLog(t, path)
+	second.Record(Event{Type: TypeLogout, Outcome: OutcomeSuccess, Username: "alice"})
+
+	res, err := second.Query(Filter{})
+	require.NoError(t, err)
+
+	assert.True(t, res.ChainValid)
+	require.Len(t, res.Events, 2)
+	assert.Equal(t, TypeLogout, res.Events[0].Type)
+}
+
+func TestDisabledWithoutFileOrSyslog(t *testing.T) {
+	l, err := newLog(config.AuditLogConfig{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, l)
+}
@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Filter selects events from the audit log. Empty fields match everything.
+type Filter struct {
+	Type     string
+	Username string
+	Since    time.Time
+	Limit    int
+}
+
+// QueryResult has the matching events, newest first, and whether the hash
+// chain of the whole log is intact.
+type QueryResult struct {
+	Events     []*Event
+	ChainValid bool
+	ChainError string
+}
+
+type chainVerifier struct {
+	seq      uint64
+	lastHash string
+	err      error
+}
+
+func (v *chainVerifier) check(e *Event) {
+	if v.err != nil {
+		return
+	}
+
+	switch {
+	case e.Seq != v.seq+1:
+		v.err = fmt.Errorf("event %v follows event %v", e.Seq, v.seq)
+	case e.PrevHash != v.lastHash:
+		v.err = fmt.Errorf("event %v does not follow the hash of event %v", e.Seq, v.seq)
+	case e.Hash != hashEvent(e):
+		v.err = fmt.Errorf("event %v does not match its hash", e.Seq)
+	}
+
+	v.seq = e.Seq
+	v.lastHash = e.Hash
+}
+
+func (f *Filter) matches(e *Event) bool {
+	if f.Type != "" && e.Type != f.Type {
+		return false
+	}
+
+	return f.matchesUsername(e) && (f.Since.IsZero() || !e.Time.Before(f.Since))
+}
+
+func (f *Filter) matchesUsername(e *Event) bool {
+	return f.Username == "" || e.Username == f.Username
+}
+
+// Query reads the audit log file, and verifies the hash chain while doing so.
+// Only the size of the file is read while holding the lock, so that events can
+// still be recorded while a large file is read. The file is only appended to,
+// so the events up to that size do not change.
+func (l *Log) Query(filter Filter) (*QueryResult, error) {
+	size, err := l.size()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		return nil, fmt.Errorf("audit log: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	verifier := &chainVerifier{}
+	matched := make([]*Event, 0)
+
+	err = readEvents(io.LimitReader(f, size), func(e *Event) {
+		verifier.check(e)
+
+		if filter.matches(e) {
+			matched = append(matched, e)
+		}
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newQueryResult(matched, filter.Limit, verifier.err), nil
+}
+
+// size is the length of the file after the last event that was written.
+func (l *Log) size() (int64, error) {
+	if l.path == "" {
+		return 0, ErrNoFile
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	info, err := os.Stat(l.path)
+	if err != nil {
+		return 0, fmt.Errorf("audit log: %w", err)
+	}
+
+	return info.Size(), nil
+}
+
+func newQueryResult(matched []*Event, limit int, chainErr error) *QueryResult {
+	ret := &QueryResult{
+		Events:     make([]*Event, 0, len(matched)),
+		ChainValid: chainErr == nil,
+	}
+
+	if chainErr != nil {
+		ret.ChainError = chainErr.Error()
+	}
+
+	for i := len(matched) - 1; i >= 0 && (limit <= 0 || len(ret.Events) < limit); i-- {
+		ret.Events = append(ret.Events, matched[i])
+	}
+
+	return ret
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+// +build !windows
+
+package audit
+
+import (
+	"log/syslog"
+)
+
+// dialSyslog uses the local syslog daemon when the network and address are
+// empty.
+func dialSyslog(network string, address string) (syslogWriter, error) {
+	return syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_AUTH, "olivetin")
+}
@@ -0,0 +1,12 @@
+//go:build windows
+// +build windows
+
+package audit
+
+import (
+	"errors"
+)
+
+func dialSyslog(network string, address string) (syslogWriter, error) {
+	return nil, errors.New("syslog is not supported on Windows")
+}
@@ -16,6 +16,14 @@ type AuthenticatedUser struct {
 	Provider string
 	SID      string
 
+	// TotpVerified is true when the user entered a TOTP code when they
+	// logged in.
+	TotpVerified bool
+
+	// Usergroups are the groups of the usergroup line, and the groups that
+	// include them. They are set by BuildUserAcls.
+	Usergroups []string
+
 	Acls []string
 
 	EffectivePolicy *config.ConfigurationPolicy
@@ -45,8 +53,18 @@ func (u *AuthenticatedUser) parseUsergroupLine(sep string) []string {
 	return ret
 }
 
+// effectiveUsergroups falls back to the usergroup line for users whose ACLs
+// have not been built.
+func (u *AuthenticatedUser) effectiveUsergroups(sep string) []string {
+	if u.Usergroups != nil {
+		return u.Usergroups
+	}
+
+	return u.parseUsergroupLine(sep)
+}
+
 func (u *AuthenticatedUser) MatchesUsergroupAcl(matchUsergroups []string, sep string) bool {
-	groupList := u.parseUsergroupLine(sep)
+	groupList := u.effectiveUsergroups(sep)
 
 	for _, group := range groupList {
 		if slices.Contains(matchUsergroups, group) {
@@ -59,6 +77,8 @@ func (u *AuthenticatedUser) MatchesUsergroupAcl(matchUsergroups []string, sep st
 }
 
 func (u *AuthenticatedUser) BuildUserAcls(cfg *config.Config) {
+	u.Usergroups = ExpandUsergroups(cfg, u.parseUsergroupLine(cfg.AuthHttpHeaderUserGroupSep))
+
 	for _, acl := range cfg.AccessControlLists {
 		if slices.Contains(acl.MatchUsernames, u.Username) {
 			u.Acls = append(u.Acls, acl.Name)
@@ -79,6 +99,7 @@ func getEffectivePolicy(cfg *config.Config, u *AuthenticatedUser) *config.Config
 		ShowDiagnostics:   cfg.DefaultPolicy.ShowDiagnostics,
 		ShowLogList:       cfg.DefaultPolicy.ShowLogList,
 		ShowVersionNumber: cfg.DefaultPolicy.ShowVersionNumber,
+		ShowAuditLog:      cfg.DefaultPolicy.ShowAuditLog,
 	}
 
 	for _, acl := range cfg.AccessControlLists {
@@ -103,5 +124,9 @@ func buildConfigurationPolicy(ret *config.ConfigurationPolicy, policy config.Con
 		ret.ShowVersionNumber = policy.ShowVersionNumber
 	}
 
+	if policy.ShowAuditLog {
+		ret.ShowAuditLog = policy.ShowAuditLog
+	}
+
 	return ret
 }
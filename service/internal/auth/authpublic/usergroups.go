@@ -0,0 +1,42 @@
+package authpublic
+
+import (
+	"slices"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+)
+
+// ExpandUsergroups adds the groups that include any of the groups, and the
+// groups that include those, so that a member of "oncall" is also a member of
+// "ops" when "ops" includes "oncall". Groups that include each other do not
+// loop.
+func ExpandUsergroups(cfg *config.Config, groups []string) []string {
+	ret := make([]string, 0, len(groups))
+	pending := slices.Clone(groups)
+
+	for len(pending) > 0 {
+		group := pending[0]
+		pending = pending[1:]
+
+		if slices.Contains(ret, group) {
+			continue
+		}
+
+		ret = append(ret, group)
+		pending = append(pending, groupsIncluding(cfg, group)...)
+	}
+
+	return ret
+}
+
+func groupsIncluding(cfg *config.Config, group string) []string {
+	ret := []string{}
+
+	for _, def := range cfg.Usergroups {
+		if slices.Contains(def.Includes, group) {
+			ret = append(ret, def.Name)
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,47 @@
+package authpublic
+
+import (
+	"testing"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func newNestedUsergroupsConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Usergroups = []*config.UsergroupDefinition{
+		{Name: "staff", Includes: []string{"ops", "developers"}},
+		{Name: "ops", Includes: []string{"oncall"}},
+		{Name: "oncall", Includes: []string{"staff"}},
+	}
+	cfg.AccessControlLists = []*config.AccessControlList{
+		{Name: "everyone", MatchUsergroups: []string{"staff"}},
+		{Name: "operators", MatchUsergroups: []string{"ops"}},
+	}
+
+	return cfg
+}
+
+func TestExpandUsergroups(t *testing.T) {
+	cfg := newNestedUsergroupsConfig()
+
+	assert.Equal(t, []string{"developers", "staff", "oncall", "ops"}, ExpandUsergroups(cfg, []string{"developers"}))
+	assert.Equal(t, []string{"visitors"}, ExpandUsergroups(cfg, []string{"visitors"}))
+	assert.Empty(t, ExpandUsergroups(cfg, nil))
+}
+
+func TestBuildUserAclsUsesNestedUsergroups(t *testing.T) {
+	cfg := newNestedUsergroupsConfig()
+	cfg.Usergroups = cfg.Usergroups[:2]
+
+	oncall := &AuthenticatedUser{Username: "alice", UsergroupLine: "oncall"}
+	oncall.BuildUserAcls(cfg)
+
+	assert.Equal(t, []string{"everyone", "operators"}, oncall.Acls)
+	assert.True(t, oncall.MatchesUsergroupAcl([]string{"staff"}, ""))
+
+	developer := &AuthenticatedUser{Username: "bob", UsergroupLine: "developers"}
+	developer.BuildUserAcls(cfg)
+
+	assert.Equal(t, []string{"everyone"}, developer.Acls)
+}
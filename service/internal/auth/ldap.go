@@ -0,0 +1,30 @@
+package auth
+
+import (
+	types "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	log "github.com/sirupsen/logrus"
+)
+
+// checkUserFromLdapSession uses the same cookie as local sessions, as LDAP
+// users log in with the local login form too. The usergroup is kept from when
+// the user logged in, until the session expires after authLdap.sessionMaxAge.
+func checkUserFromLdapSession(context *types.AuthCheckingContext, sid string) *types.AuthenticatedUser {
+	u := &types.AuthenticatedUser{}
+
+	sess := GetUserSession("ldap", sid)
+	if sess == nil {
+		log.WithFields(log.Fields{"sid": sid, "provider": "ldap"}).Warn("UserFromContext: stale LDAP session")
+		return u
+	}
+
+	if !context.Config.AuthLdap.Enabled {
+		log.WithFields(log.Fields{"username": sess.Username}).Warn("UserFromContext: LDAP session, but authLdap is not enabled")
+		return u
+	}
+
+	u.Username = sess.Username
+	u.UsergroupLine = sess.Usergroup
+	u.Provider = "ldap"
+	u.SID = sid
+	return u
+}
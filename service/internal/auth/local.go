@@ -31,8 +31,7 @@ func checkUserFromLocalSession(context *types.AuthCheckingContext) *types.Authen
 
 	sess := GetUserSession("local", sid)
 	if sess == nil {
-		log.WithFields(log.Fields{"sid": sid, "provider": "local"}).Warn("UserFromContext: stale local session")
-		return u
+		return checkUserFromLdapSession(context, sid)
 	}
 
 	cfgUser := context.Config.FindUserByUsername(sess.Username)
@@ -42,8 +41,9 @@ func checkUserFromLocalSession(context *types.AuthCheckingContext) *types.Authen
 	}
 
 	u.Username = cfgUser.Username
-	u.UsergroupLine = cfgUser.Usergroup
+	u.UsergroupLine = cfgUser.UsergroupLine(context.Config.AuthHttpHeaderUserGroupSep)
 	u.Provider = "local"
 	u.SID = sid
+	u.TotpVerified = sess.TotpVerified
 	return u
 }
@@ -98,12 +98,12 @@ func checkUserFromLocalBearerApiKey(context *types.AuthCheckingContext) *types.A
 
 	log.WithFields(log.Fields{
 		"username":  user.Username,
-		"usergroup": user.Usergroup,
+		"usergroup": user.UsergroupLine(context.Config.AuthHttpHeaderUserGroupSep),
 	}).Debugf("Local bearer API key: authenticated")
 
 	return &types.AuthenticatedUser{
 		Username:      user.Username,
-		UsergroupLine: user.Usergroup,
+		UsergroupLine: user.UsergroupLine(context.Config.AuthHttpHeaderUserGroupSep),
 		Provider:      "local",
 	}
 }
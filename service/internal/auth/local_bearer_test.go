@@ -104,3 +104,24 @@ func TestCheckUserFromLocalBearerApiKey_NoBearerPrefix(t *testing.T) {
 	ctx := &authpublic.AuthCheckingContext{Request: req, Config: cfg}
 	assert.Nil(t, checkUserFromLocalBearerApiKey(ctx))
 }
+
+func TestCheckUserFromLocalBearerApiKey_MultipleUsergroups(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.DefaultConfig()
+	cfg.AuthLocalUsers.Enabled = true
+	cfg.AuthLocalUsers.Users = []*config.LocalUser{{
+		Username:   "bot",
+		Usergroup:  "bots",
+		Usergroups: []string{"ops"},
+		ApiKey:     "secret-api-key",
+	}}
+
+	req := httptest.NewRequest("POST", "/", nil)
+	req.Header.Set("Authorization", "Bearer secret-api-key")
+
+	ctx := &authpublic.AuthCheckingContext{Request: req, Config: cfg}
+	user := checkUserFromLocalBearerApiKey(ctx)
+	require.NotNil(t, user)
+	assert.Equal(t, "bots ops", user.UsergroupLine)
+}
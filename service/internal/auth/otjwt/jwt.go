@@ -5,6 +5,7 @@ import (
 	"crypto/rsa"
 	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"sync"
@@ -100,6 +101,36 @@ func initJwks(cfg *config.Config) error {
 	return jwksInitErr
 }
 
+var (
+	remoteKeyfuncs   = make(map[string]keyfunc.Keyfunc)
+	remoteKeyfuncsMu sync.Mutex
+)
+
+// RemoteKeyfunc returns a keyfunc for the JWKS at the URL, which is fetched
+// with the client. Keyfuncs are shared between callers and refresh their keys
+// in the background, so there is one per URL.
+func RemoteKeyfunc(url string, client *http.Client) (jwt.Keyfunc, error) {
+	remoteKeyfuncsMu.Lock()
+	defer remoteKeyfuncsMu.Unlock()
+
+	if k, ok := remoteKeyfuncs[url]; ok {
+		return k.Keyfunc, nil
+	}
+
+	// The context ends the background refresh, so it must not be cancelled.
+	k, err := keyfunc.NewDefaultOverrideCtx(context.Background(), []string{url}, keyfunc.Override{
+		Client: client,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("JWKS %v: %w", url, err)
+	}
+
+	remoteKeyfuncs[url] = k
+
+	return k.Keyfunc, nil
+}
+
 func loadPublicKeyFromFile(keyPath string) error {
 	keyBytes, err := os.ReadFile(keyPath)
 	if err != nil {
@@ -227,14 +258,16 @@ func parseJwt(cfg *config.Config, token string) *authTypes.AuthenticatedUser {
 
 	user := &authTypes.AuthenticatedUser{
 		Username:      lookupClaimValueOrDefault(claims, cfg.AuthJwtClaimUsername, ""),
-		UsergroupLine: parseGroupClaim(cfg.AuthJwtClaimUserGroup, claims),
+		UsergroupLine: ParseGroupClaim(cfg.AuthJwtClaimUserGroup, claims),
 		Provider:      "jwt",
 	}
 
 	return user
 }
 
-func parseGroupClaim(groupClaim string, claims jwt.MapClaims) string {
+// ParseGroupClaim joins the values of a claim that is an array with spaces, so
+// that it may be used as a usergroup line.
+func ParseGroupClaim(groupClaim string, claims jwt.MapClaims) string {
 	usergroup := ""
 	if val, ok := claims[groupClaim]; ok {
 		if array, ok := val.([]interface{}); ok {
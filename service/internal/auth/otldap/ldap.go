@@ -0,0 +1,250 @@
+// Package otldap checks the passwords of users against an LDAP directory, and
+// maps the groups of the users onto usergroups.
+package otldap
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+	"unicode"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/go-ldap/ldap/v3"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrInvalidCredentials is returned when the user is not found, or the
+// password is wrong. Both are the same error, so that usernames cannot be
+// guessed.
+var ErrInvalidCredentials = errors.New("invalid username or password")
+
+// User is a user of the directory whose password was correct.
+type User struct {
+	Username  string
+	Usergroup string
+}
+
+// Authenticate finds the user with the user filter, and then binds as the
+// user to check the password.
+func Authenticate(cfg *config.Config, username string, password string) (*User, error) {
+	// An empty password would be an unauthenticated bind, which many
+	// directories allow.
+	if username == "" || password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := connect(&cfg.AuthLdap)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = conn.Close() }()
+
+	return bindAsUser(conn, cfg, username, password)
+}
+
+func bindAsUser(conn *ldap.Conn, cfg *config.Config, username string, password string) (*User, error) {
+	entry, err := findUser(conn, &cfg.AuthLdap, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		return nil, bindError(err)
+	}
+
+	return &User{
+		Username:  usernameOf(entry, &cfg.AuthLdap, username),
+		Usergroup: usergroupLine(entry.GetAttributeValues(cfg.AuthLdap.GroupAttribute), &cfg.AuthLdap, cfg.AuthHttpHeaderUserGroupSep),
+	}, nil
+}
+
+func connect(cfg *config.AuthLdapConfig) (*ldap.Conn, error) {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+
+	conn, err := ldap.DialURL(cfg.URL, ldap.DialWithDialer(&net.Dialer{Timeout: timeout}), ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to LDAP: %w", err)
+	}
+
+	conn.SetTimeout(timeout)
+
+	if err := startTLSAndBind(conn, cfg, tlsConfig); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// startTLSAndBind binds with the service account, if one is set, so that it
+// may search for users.
+func startTLSAndBind(conn *ldap.Conn, cfg *config.AuthLdapConfig, tlsConfig *tls.Config) error {
+	if cfg.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("LDAP StartTLS: %w", err)
+		}
+	}
+
+	if cfg.BindDN == "" {
+		return nil
+	}
+
+	if err := conn.Bind(cfg.BindDN, cfg.BindPassword); err != nil {
+		return fmt.Errorf("LDAP bind as %v: %w", cfg.BindDN, err)
+	}
+
+	return nil
+}
+
+func findUser(conn *ldap.Conn, cfg *config.AuthLdapConfig, username string) (*ldap.Entry, error) {
+	filter := strings.ReplaceAll(cfg.UserFilter, "{username}", ldap.EscapeFilter(username))
+
+	req := ldap.NewSearchRequest(
+		cfg.BaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		2,
+		cfg.Timeout,
+		false,
+		filter,
+		searchAttributes(cfg),
+		nil,
+	)
+
+	res, err := conn.Search(req)
+
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("LDAP user filter matches more than one entry for %v", username)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("LDAP search: %w", err)
+	}
+
+	if len(res.Entries) != 1 {
+		log.WithFields(log.Fields{
+			"username": username,
+			"entries":  len(res.Entries),
+		}).Debugf("LDAP user filter did not match one entry")
+
+		return nil, ErrInvalidCredentials
+	}
+
+	return res.Entries[0], nil
+}
+
+func searchAttributes(cfg *config.AuthLdapConfig) []string {
+	ret := []string{"dn"}
+
+	if cfg.GroupAttribute != "" {
+		ret = append(ret, cfg.GroupAttribute)
+	}
+
+	if cfg.UsernameAttribute != "" {
+		ret = append(ret, cfg.UsernameAttribute)
+	}
+
+	return ret
+}
+
+func bindError(err error) error {
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return ErrInvalidCredentials
+	}
+
+	return fmt.Errorf("LDAP bind as user: %w", err)
+}
+
+func usernameOf(entry *ldap.Entry, cfg *config.AuthLdapConfig, username string) string {
+	if cfg.UsernameAttribute != "" {
+		if value := entry.GetAttributeValue(cfg.UsernameAttribute); value != "" {
+			return value
+		}
+	}
+
+	return username
+}
+
+// usergroupLine joins the usergroups of the groups with the same separator
+// that is used to split usergroup lines when matching ACLs.
+func usergroupLine(groups []string, cfg *config.AuthLdapConfig, sep string) string {
+	usergroups := make([]string, 0, len(groups))
+
+	for _, group := range groups {
+		usergroup := usergroupOf(group, cfg.GroupMap)
+
+		if usergroup == "" {
+			continue
+		}
+
+		if splitsOnSeparator(usergroup, sep) {
+			log.WithFields(log.Fields{
+				"group":     group,
+				"usergroup": usergroup,
+			}).Warnf("LDAP group skipped, as its name would be split into several usergroups. Map it to another name in authLdap.groupMap")
+
+			continue
+		}
+
+		usergroups = append(usergroups, usergroup)
+	}
+
+	if sep == "" {
+		sep = " "
+	}
+
+	return strings.Join(usergroups, sep)
+}
+
+// splitsOnSeparator is true when splitting the usergroup line would not give
+// the usergroup back. Without a separator, lines are split on whitespace.
+func splitsOnSeparator(usergroup string, sep string) bool {
+	if sep == "" {
+		return strings.ContainsFunc(usergroup, unicode.IsSpace)
+	}
+
+	return strings.Contains(usergroup, sep)
+}
+
+// usergroupOf looks up the DN of the group, and then its common name, in the
+// group map. Groups that are not in the map use their common name.
+func usergroupOf(group string, groupMap map[string]string) string {
+	if usergroup, ok := lookupGroup(groupMap, group); ok {
+		return usergroup
+	}
+
+	name := commonName(group)
+
+	if usergroup, ok := lookupGroup(groupMap, name); ok {
+		return usergroup
+	}
+
+	return name
+}
+
+func lookupGroup(groupMap map[string]string, key string) (string, bool) {
+	for k, v := range groupMap {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+// commonName returns the value of the first part of a DN, or the value itself
+// when the group attribute does not hold DNs.
+func commonName(group string) string {
+	dn, err := ldap.ParseDN(group)
+
+	if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+		return group
+	}
+
+	return dn.RDNs[0].Attributes[0].Value
+}
@@ -0,0 +1,101 @@
+package otldap
+
+import (
+	"testing"
+
+	"github.com/OliveTin/OliveTin/internal/auth/otldap/otldaptest"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDirectory(t *testing.T) *config.Config {
+	server := otldaptest.NewServer(t,
+		&otldaptest.Entry{
+			DN:       "cn=olivetin,ou=services,dc=example,dc=org",
+			Password: "service-secret",
+		},
+		&otldaptest.Entry{
+			DN:       "uid=alice,ou=people,dc=example,dc=org",
+			Password: "alice-secret",
+			Attributes: map[string][]string{
+				"uid": {"alice"},
+				"memberOf": {
+					"cn=admins,ou=groups,dc=example,dc=org",
+					"cn=Domain Users,ou=groups,dc=example,dc=org",
+					"cn=printers,ou=groups,dc=example,dc=org",
+				},
+			},
+		},
+	)
+
+	cfg := config.DefaultConfig()
+	cfg.AuthLdap.Enabled = true
+	cfg.AuthLdap.URL = server.URL
+	cfg.AuthLdap.BaseDN = "dc=example,dc=org"
+	cfg.AuthLdap.BindDN = "cn=olivetin,ou=services,dc=example,dc=org"
+	cfg.AuthLdap.BindPassword = "service-secret"
+	cfg.AuthLdap.GroupMap = map[string]string{
+		"domain users": "users",
+		"cn=printers,ou=groups,dc=example,dc=org": "",
+	}
+
+	return cfg
+}
+
+func TestAuthenticateMapsGroups(t *testing.T) {
+	cfg := newTestDirectory(t)
+
+	user, err := Authenticate(cfg, "alice", "alice-secret")
+	require.NoError(t, err)
+
+	assert.Equal(t, "alice", user.Username)
+	assert.Equal(t, "admins users", user.Usergroup)
+}
+
+func TestAuthenticateRejectsWrongPassword(t *testing.T) {
+	cfg := newTestDirectory(t)
+
+	_, err := Authenticate(cfg, "alice", "wrong")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = Authenticate(cfg, "alice", "")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestAuthenticateRejectsUnknownUser(t *testing.T) {
+	cfg := newTestDirectory(t)
+
+	_, err := Authenticate(cfg, "bob", "alice-secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = Authenticate(cfg, "*", "alice-secret")
+	assert.ErrorIs(t, err, ErrInvalidCredentials, "the username is escaped in the filter")
+}
+
+func TestAuthenticateFailsWhenServiceBindFails(t *testing.T) {
+	cfg := newTestDirectory(t)
+	cfg.AuthLdap.BindPassword = "wrong"
+
+	_, err := Authenticate(cfg, "alice", "alice-secret")
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestUsergroupLineUsesSeparator(t *testing.T) {
+	groups := []string{"cn=admins,dc=example,dc=org", "operators"}
+
+	assert.Equal(t, "admins,operators", usergroupLine(groups, &config.AuthLdapConfig{}, ","))
+}
+
+func TestUsergroupLineSkipsGroupsThatWouldSplit(t *testing.T) {
+	groups := []string{"CN=Exchange Admins,OU=Groups,DC=example,DC=org", "cn=admins,dc=example,dc=org"}
+
+	assert.Equal(t, "admins", usergroupLine(groups, &config.AuthLdapConfig{}, ""))
+	assert.Equal(t, "Exchange Admins,admins", usergroupLine(groups, &config.AuthLdapConfig{}, ","))
+
+	mapped := &config.AuthLdapConfig{GroupMap: map[string]string{"Exchange Admins": "exchange-admins"}}
+
+	assert.Equal(t, "exchange-admins admins", usergroupLine(groups, mapped, ""))
+}
@@ -0,0 +1,184 @@
+// Package otldaptest is a minimal in-process LDAP server for tests. It only
+// supports simple binds and searches with equality filters.
+package otldaptest
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// Entry is a directory entry. Entries with a password may bind.
+type Entry struct {
+	DN         string
+	Password   string
+	Attributes map[string][]string
+}
+
+// Server serves the entries until the test ends.
+type Server struct {
+	URL     string
+	Entries []*Entry
+
+	listener net.Listener
+	wg       sync.WaitGroup
+}
+
+// NewServer starts a server on a random local port.
+func NewServer(t *testing.T, entries ...*Entry) *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	s := &Server{
+		URL:      "ldap://" + listener.Addr().String(),
+		Entries:  entries,
+		listener: listener,
+	}
+
+	s.wg.Add(1)
+	go s.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+
+		if !s.respond(conn, packet.Children[0].Value, packet.Children[1]) {
+			return
+		}
+	}
+}
+
+// respond returns false for operations that end the connection, such as an
+// unbind.
+func (s *Server) respond(conn net.Conn, messageID any, op *ber.Packet) bool {
+	switch op.Tag {
+	case ldap.ApplicationBindRequest:
+		s.write(conn, messageID, s.bind(op))
+	case ldap.ApplicationSearchRequest:
+		s.search(conn, messageID, op)
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) write(conn net.Conn, messageID any, op *ber.Packet) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(op)
+
+	_, _ = conn.Write(packet.Bytes())
+}
+
+func result(tag ber.Tag, code int) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+
+	return op
+}
+
+func (s *Server) bind(op *ber.Packet) *ber.Packet {
+	dn := op.Children[1].Data.String()
+	password := op.Children[2].Data.String()
+
+	for _, e := range s.Entries {
+		if strings.EqualFold(e.DN, dn) && e.Password != "" && e.Password == password {
+			return result(ldap.ApplicationBindResponse, ldap.LDAPResultSuccess)
+		}
+	}
+
+	return result(ldap.ApplicationBindResponse, ldap.LDAPResultInvalidCredentials)
+}
+
+func (s *Server) search(conn net.Conn, messageID any, op *ber.Packet) {
+	filter, err := ldap.DecompileFilter(op.Children[6])
+	if err != nil {
+		s.write(conn, messageID, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultOperationsError))
+		return
+	}
+
+	for _, e := range s.Entries {
+		if e.matches(filter) {
+			s.write(conn, messageID, e.toPacket())
+		}
+	}
+
+	s.write(conn, messageID, result(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+}
+
+// matches only supports filters like (uid=alice).
+func (e *Entry) matches(filter string) bool {
+	name, value, ok := strings.Cut(strings.Trim(filter, "()"), "=")
+
+	if !ok {
+		return false
+	}
+
+	for _, v := range e.Attributes[name] {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Entry) toPacket() *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.DN, "Object Name"))
+
+	attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+
+	for name, values := range e.Attributes {
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+
+		for _, v := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "Value"))
+		}
+
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+
+	op.AppendChild(attributes)
+
+	return op
+}
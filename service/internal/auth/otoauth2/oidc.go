@@ -0,0 +1,317 @@
+package otoauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/auth/otjwt"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+// discoveryDocument is the part of .well-known/openid-configuration that is
+// used.
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+	EndSessionEndpoint    string `json:"end_session_endpoint"`
+}
+
+// oidcProvider is a provider with an issuerUrl, after discovery.
+type oidcProvider struct {
+	issuer        string
+	endSessionURL string
+	keyfunc       jwt.Keyfunc
+}
+
+// idTokenSigningMethods excludes "none" and the HMAC methods, as ID tokens
+// must be signed with a key from the JWKS.
+var idTokenSigningMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+func isOIDC(providerConfig *config.OAuth2Provider) bool {
+	return providerConfig != nil && providerConfig.IssuerUrl != ""
+}
+
+func usesPkce(providerConfig *config.OAuth2Provider) bool {
+	return providerConfig != nil && (providerConfig.Pkce || isOIDC(providerConfig))
+}
+
+// completeOIDCProviderConfig sets the defaults of OIDC providers. The openid
+// scope is required for the provider to return an ID token.
+func completeOIDCProviderConfig(providerConfig *config.OAuth2Provider) {
+	if !isOIDC(providerConfig) {
+		return
+	}
+
+	if providerConfig.Scopes == nil {
+		providerConfig.Scopes = []string{"openid", "profile", "email"}
+	}
+
+	if !containsString(providerConfig.Scopes, "openid") {
+		providerConfig.Scopes = append([]string{"openid"}, providerConfig.Scopes...)
+	}
+
+	assignIfEmpty(&providerConfig.UsernameField, "preferred_username")
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func discoveryClient(providerConfig *config.OAuth2Provider) *http.Client {
+	return &http.Client{
+		Transport: getOAuth2HttpClient(providerConfig).Transport,
+		Timeout:   10 * time.Second,
+	}
+}
+
+func fetchDiscoveryDocument(client *http.Client, issuerUrl string) (*discoveryDocument, error) {
+	res, err := client.Get(strings.TrimSuffix(issuerUrl, "/") + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, fmt.Errorf("OIDC discovery: %w", err)
+	}
+
+	defer func() { _ = res.Body.Close() }()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OIDC discovery: unexpected status %v", res.StatusCode)
+	}
+
+	doc := &discoveryDocument{}
+
+	if err := json.NewDecoder(res.Body).Decode(doc); err != nil {
+		return nil, fmt.Errorf("OIDC discovery: %w", err)
+	}
+
+	return doc, validateDiscoveryDocument(doc, issuerUrl)
+}
+
+// validateDiscoveryDocument checks that the document is for the configured
+// issuer, as ID tokens are checked against the issuer in the document.
+func validateDiscoveryDocument(doc *discoveryDocument, issuerUrl string) error {
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(issuerUrl, "/") {
+		return fmt.Errorf("OIDC discovery: issuer %q does not match issuerUrl %q", doc.Issuer, issuerUrl)
+	}
+
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksURI == "" {
+		return errors.New("OIDC discovery: authorization_endpoint, token_endpoint and jwks_uri are required")
+	}
+
+	return nil
+}
+
+func discover(providerConfig *config.OAuth2Provider) (*oidcProvider, *discoveryDocument, error) {
+	client := discoveryClient(providerConfig)
+
+	doc, err := fetchDiscoveryDocument(client, providerConfig.IssuerUrl)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyfunc, err := otjwt.RemoteKeyfunc(doc.JwksURI, client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &oidcProvider{
+		issuer:        doc.Issuer,
+		endSessionURL: doc.EndSessionEndpoint,
+		keyfunc:       keyfunc,
+	}, doc, nil
+}
+
+// oidcProviderFor discovers the provider when it is first used, rather than
+// at startup, so that a provider that is down at startup can still be used
+// later.
+func (h *OAuth2Handler) oidcProviderFor(providerName string) (*oidcProvider, error) {
+	h.mu.RLock()
+	p, ok := h.discoveredProviders[providerName]
+	h.mu.RUnlock()
+
+	if ok {
+		return p, nil
+	}
+
+	p, doc, err := discover(h.cfg.AuthOAuth2Providers[providerName])
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"provider": providerName,
+		"issuer":   doc.Issuer,
+	}).Infof("OIDC provider discovered")
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Another login may have discovered the provider in the meantime, and
+	// may already be using its endpoints.
+	if existing, ok := h.discoveredProviders[providerName]; ok {
+		return existing, nil
+	}
+
+	h.discoveredProviders[providerName] = p
+	h.registeredProviders[providerName].Endpoint = oauth2.Endpoint{
+		AuthURL:  doc.AuthorizationEndpoint,
+		TokenURL: doc.TokenEndpoint,
+	}
+
+	return p, nil
+}
+
+// discoverIfOIDC does nothing for providers without an issuerUrl.
+func (h *OAuth2Handler) discoverIfOIDC(providerName string) error {
+	if !isOIDC(h.cfg.AuthOAuth2Providers[providerName]) {
+		return nil
+	}
+
+	_, err := h.oidcProviderFor(providerName)
+
+	return err
+}
+
+// authCodeOptions returns the PKCE verifier and nonce to keep with the state,
+// and the options that send them to the provider.
+func authCodeOptions(providerConfig *config.OAuth2Provider) (string, string, []oauth2.AuthCodeOption, error) {
+	verifier := ""
+	nonce := ""
+	opts := []oauth2.AuthCodeOption{}
+
+	if usesPkce(providerConfig) {
+		verifier = oauth2.GenerateVerifier()
+		opts = append(opts, oauth2.S256ChallengeOption(verifier))
+	}
+
+	if isOIDC(providerConfig) {
+		var err error
+
+		if nonce, err = randString(16); err != nil {
+			return "", "", nil, err
+		}
+
+		opts = append(opts, oauth2.SetAuthURLParam("nonce", nonce))
+	}
+
+	return verifier, nonce, opts, nil
+}
+
+// userInfoFromIDToken takes the user from the claims of the ID token, instead
+// of calling the userinfo endpoint.
+func (h *OAuth2Handler) userInfoFromIDToken(registeredState *oauth2State, providerConfig *config.OAuth2Provider, tok *oauth2.Token) (*UserInfo, error) {
+	rawIDToken, _ := tok.Extra("id_token").(string)
+
+	if rawIDToken == "" {
+		return nil, errors.New("no id_token in the token response")
+	}
+
+	p, err := h.oidcProviderFor(registeredState.providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := validateIDToken(p, providerConfig.ClientID, rawIDToken, registeredState.nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.cfg.InsecureAllowDumpOAuth2UserData {
+		log.Debugf("OIDC ID token claims: %+v", claims)
+	}
+
+	return &UserInfo{
+		Username:  claimString(claims, providerConfig.UsernameField),
+		Usergroup: otjwt.ParseGroupClaim(providerConfig.UserGroupField, claims),
+		idToken:   rawIDToken,
+	}, nil
+}
+
+func validateIDToken(p *oidcProvider, clientID string, rawIDToken string, nonce string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, p.keyfunc,
+		jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods(idTokenSigningMethods),
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	if claimString(claims, "nonce") != nonce {
+		return nil, errors.New("invalid ID token: nonce mismatch")
+	}
+
+	return claims, nil
+}
+
+func claimString(claims jwt.MapClaims, name string) string {
+	if name == "" {
+		return ""
+	}
+
+	value, _ := claims[name].(string)
+
+	return value
+}
+
+// endSessionURL is where the browser is sent to also log out of the
+// provider, or an empty string when the provider does not support
+// RP-initiated logout.
+func (h *OAuth2Handler) endSessionURL(state *oauth2State, p *oidcProvider) string {
+	if !supportsLogout(state, p) {
+		return ""
+	}
+
+	u, err := url.Parse(p.endSessionURL)
+	if err != nil {
+		log.Warnf("OIDC end_session_endpoint is not a URL: %v", err)
+		return ""
+	}
+
+	providerConfig := h.cfg.AuthOAuth2Providers[state.providerName]
+
+	q := u.Query()
+	q.Set("id_token_hint", state.idToken)
+	q.Set("client_id", providerConfig.ClientID)
+
+	if providerConfig.PostLogoutRedirectUrl != "" {
+		q.Set("post_logout_redirect_uri", providerConfig.PostLogoutRedirectUrl)
+	}
+
+	u.RawQuery = q.Encode()
+
+	return u.String()
+}
+
+func supportsLogout(state *oauth2State, p *oidcProvider) bool {
+	return p != nil && p.endSessionURL != "" && state.idToken != ""
+}
+
+func (h *OAuth2Handler) userInfoFromToken(ctx context.Context, registeredState *oauth2State, providerConfig *config.OAuth2Provider, tok *oauth2.Token, clientSettings *HttpClientSettings) (*UserInfo, error) {
+	if isOIDC(providerConfig) {
+		return h.userInfoFromIDToken(registeredState, providerConfig, tok)
+	}
+
+	userInfoClient := h.createUserInfoClient(ctx, registeredState.providerConfig, tok, clientSettings)
+
+	return getUserInfo(h.cfg, userInfoClient, providerConfig), nil
+}
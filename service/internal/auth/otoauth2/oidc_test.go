@@ -0,0 +1,205 @@
+package otoauth2
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOIDCProvider serves discovery, a JWKS and a token endpoint that returns
+// an ID token with the claims, and the nonce and code challenge of the last
+// login.
+type fakeOIDCProvider struct {
+	server        *httptest.Server
+	key           *rsa.PrivateKey
+	claims        jwt.MapClaims
+	codeChallenge string
+}
+
+func newFakeOIDCProvider(t *testing.T) *fakeOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	p := &fakeOIDCProvider{
+		key: key,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/jwks", p.jwks)
+	mux.HandleFunc("/token", p.token)
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	p.claims = jwt.MapClaims{
+		"iss":                p.server.URL,
+		"sub":                "1234",
+		"aud":                "olivetin",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"preferred_username": "alice",
+		"groups":             []string{"admins", "ops"},
+	}
+
+	return p
+}
+
+func (p *fakeOIDCProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"issuer":                 p.server.URL,
+		"authorization_endpoint": p.server.URL + "/authorize",
+		"token_endpoint":         p.server.URL + "/token",
+		"jwks_uri":               p.server.URL + "/jwks",
+		"end_session_endpoint":   p.server.URL + "/logout",
+	})
+}
+
+func (p *fakeOIDCProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "test",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *fakeOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	sum := sha256.Sum256([]byte(r.FormValue("code_verifier")))
+
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != p.codeChallenge {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, p.claims)
+	token.Header["kid"] = "test"
+
+	idToken, _ := token.SignedString(p.key)
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func newOIDCTestHandler(p *fakeOIDCProvider) *OAuth2Handler {
+	cfg := config.DefaultConfig()
+	cfg.AuthOAuth2Providers = map[string]*config.OAuth2Provider{
+		"corp": {
+			ClientID:              "olivetin",
+			ClientSecret:          "secret",
+			IssuerUrl:             p.server.URL,
+			UserGroupField:        "groups",
+			PostLogoutRedirectUrl: "https://olivetin.example.com/",
+		},
+	}
+
+	return NewOAuth2Handler(cfg)
+}
+
+// login follows the redirect to the provider, as the browser would, and
+// returns the response of the callback.
+func login(t *testing.T, h *OAuth2Handler, p *fakeOIDCProvider) (*httptest.ResponseRecorder, string) {
+	rec := httptest.NewRecorder()
+	h.HandleOAuthLogin(rec, httptest.NewRequest(http.MethodGet, "/oauth/login?provider=corp", nil))
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	authorizeURL, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+
+	q := authorizeURL.Query()
+	assert.Equal(t, p.server.URL+"/authorize", authorizeURL.Scheme+"://"+authorizeURL.Host+authorizeURL.Path)
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Contains(t, q.Get("scope"), "openid")
+
+	p.codeChallenge = q.Get("code_challenge")
+
+	if _, set := p.claims["nonce"]; !set {
+		p.claims["nonce"] = q.Get("nonce")
+	}
+
+	state := q.Get("state")
+
+	req := httptest.NewRequest(http.MethodGet, "/oauth/callback?code=abc&state="+url.QueryEscape(state), nil)
+	req.AddCookie(&http.Cookie{Name: "olivetin-sid-oauth", Value: state})
+
+	rec = httptest.NewRecorder()
+	h.HandleOAuthCallback(rec, req)
+
+	return rec, state
+}
+
+func TestOIDCLoginUsesIDTokenClaims(t *testing.T) {
+	p := newFakeOIDCProvider(t)
+	h := newOIDCTestHandler(p)
+
+	rec, state := login(t, h, p)
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	user, found := h.lookupOAuth2UserByState(state)
+	require.True(t, found)
+	assert.Equal(t, "alice", user.Username)
+	assert.Equal(t, "admins ops", user.UsergroupLine)
+
+	logoutURL, err := url.Parse(h.RevokeSession(state))
+	require.NoError(t, err)
+	assert.Equal(t, "/logout", logoutURL.Path)
+	assert.NotEmpty(t, logoutURL.Query().Get("id_token_hint"))
+	assert.Equal(t, "olivetin", logoutURL.Query().Get("client_id"))
+	assert.Equal(t, "https://olivetin.example.com/", logoutURL.Query().Get("post_logout_redirect_uri"))
+
+	_, found = h.lookupOAuth2UserByState(state)
+	assert.False(t, found)
+}
+
+func TestOIDCLoginRejectsWrongNonce(t *testing.T) {
+	p := newFakeOIDCProvider(t)
+	p.claims["nonce"] = "replayed"
+	h := newOIDCTestHandler(p)
+
+	rec, state := login(t, h, p)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	user, found := h.lookupOAuth2UserByState(state)
+	require.True(t, found)
+	assert.Empty(t, user.Username)
+}
+
+func TestOIDCLoginRejectsWrongAudience(t *testing.T) {
+	p := newFakeOIDCProvider(t)
+	p.claims["aud"] = "another-client"
+	h := newOIDCTestHandler(p)
+
+	rec, _ := login(t, h, p)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestRevokeSessionWithoutOIDCHasNoLogoutURL(t *testing.T) {
+	h := &OAuth2Handler{
+		cfg:                 config.DefaultConfig(),
+		registeredStates:    map[string]*oauth2State{"sid": {providerName: "github"}},
+		discoveredProviders: make(map[string]*oidcProvider),
+	}
+
+	assert.Empty(t, h.RevokeSession("sid"))
+	assert.Empty(t, h.RevokeSession("unknown"))
+}
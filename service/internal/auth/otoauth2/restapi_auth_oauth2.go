@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/OliveTin/OliveTin/internal/audit"
 	authTypes "github.com/OliveTin/OliveTin/internal/auth/authpublic"
 	config "github.com/OliveTin/OliveTin/internal/config"
 	log "github.com/sirupsen/logrus"
@@ -25,6 +27,7 @@ type OAuth2Handler struct {
 	mu                  sync.RWMutex
 	registeredStates    map[string]*oauth2State
 	registeredProviders map[string]*oauth2.Config
+	discoveredProviders map[string]*oidcProvider
 }
 
 func NewOAuth2Handler(cfg *config.Config) *OAuth2Handler {
@@ -34,9 +37,11 @@ func NewOAuth2Handler(cfg *config.Config) *OAuth2Handler {
 
 	h.registeredStates = make(map[string]*oauth2State)
 	h.registeredProviders = make(map[string]*oauth2.Config)
+	h.discoveredProviders = make(map[string]*oidcProvider)
 
 	for providerName, providerConfig := range cfg.AuthOAuth2Providers {
 		completeProviderConfig(providerName, providerConfig)
+		completeOIDCProviderConfig(providerConfig)
 
 		newConfig := &oauth2.Config{
 			ClientID:     providerConfig.ClientID,
@@ -63,6 +68,9 @@ type oauth2State struct {
 	Username       string
 	Usergroup      string
 	createdAt      time.Time
+	codeVerifier   string
+	nonce          string
+	idToken        string
 }
 
 const (
@@ -91,13 +99,15 @@ func completeProviderConfig(providerName string, providerConfig *config.OAuth2Pr
 		if providerConfig.Scopes == nil {
 			providerConfig.Scopes = dbConfig.Scopes
 		}
-	} else {
+	} else if providerConfig.IssuerUrl == "" {
 		log.Warnf("Provider not found in database: %v", providerName)
 	}
 }
 
 func (h *OAuth2Handler) getOAuth2Config(providerName string) (*oauth2.Config, error) {
+	h.mu.RLock()
 	config, ok := h.registeredProviders[providerName]
+	h.mu.RUnlock()
 
 	if !ok {
 		return nil, fmt.Errorf("provider not found in config: %v", providerName)
@@ -165,26 +175,53 @@ func (h *OAuth2Handler) HandleOAuthLogin(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	h.mu.Lock()
-	h.sweepExpiredOAuthStatesLocked(time.Now())
-	if len(h.registeredStates) >= oauthStateMaxEntries {
-		h.mu.Unlock()
-		http.Error(w, "OAuth login temporarily unavailable", http.StatusServiceUnavailable)
+	if err := h.discoverIfOIDC(providerName); err != nil {
+		log.Errorf("Failed to discover OIDC provider: %v %v", providerName, err)
+		http.Error(w, "OIDC provider discovery failed", http.StatusBadGateway)
 		return
 	}
-	h.registeredStates[state] = &oauth2State{
+
+	newState := &oauth2State{
 		providerConfig: provider,
 		providerName:   providerName,
 		Username:       "",
 		createdAt:      time.Now(),
 	}
-	h.mu.Unlock()
+
+	authCodeURL, err := h.registerState(state, newState)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
 
 	h.setOAuthCallbackCookie(w, r, "olivetin-sid-oauth", state)
 
 	log.Infof("OAuth2 state: %v mapped to provider %v (found: %v), now redirecting", state, providerName, provider != nil)
 
-	http.Redirect(w, r, provider.AuthCodeURL(state), http.StatusFound)
+	http.Redirect(w, r, authCodeURL, http.StatusFound)
+}
+
+// registerState keeps the state until the callback, and returns the URL to
+// send the browser to.
+func (h *OAuth2Handler) registerState(state string, newState *oauth2State) (string, error) {
+	verifier, nonce, opts, err := authCodeOptions(h.cfg.AuthOAuth2Providers[newState.providerName])
+	if err != nil {
+		return "", err
+	}
+
+	newState.codeVerifier = verifier
+	newState.nonce = nonce
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.sweepExpiredOAuthStatesLocked(time.Now())
+	if len(h.registeredStates) >= oauthStateMaxEntries {
+		return "", errors.New("OAuth login temporarily unavailable")
+	}
+	h.registeredStates[state] = newState
+
+	return newState.providerConfig.AuthCodeURL(state, opts...), nil
 }
 
 func (h *OAuth2Handler) validateStateMatch(queryState, cookieState string) bool {
@@ -196,6 +233,7 @@ func (h *OAuth2Handler) checkOAuthCallbackCookie(w http.ResponseWriter, r *http.
 	if err != nil {
 		log.Errorf("Failed to get state cookie: %v", err)
 		http.Error(w, "State not found", http.StatusBadRequest)
+		recordOAuth2Login(audit.TypeOAuth2LoginFailed, "", "", "state cookie not found")
 		return nil, "", false
 	}
 
@@ -207,6 +245,7 @@ func (h *OAuth2Handler) checkOAuthCallbackCookie(w http.ResponseWriter, r *http.
 		h.deleteOAuthStateLocked(state)
 		h.mu.Unlock()
 		http.Error(w, "State mismatch", http.StatusBadRequest)
+		recordOAuth2Login(audit.TypeOAuth2LoginFailed, "", "", "state mismatch")
 		return nil, state, false
 	}
 
@@ -219,6 +258,7 @@ func (h *OAuth2Handler) checkOAuthCallbackCookie(w http.ResponseWriter, r *http.
 		h.deleteOAuthStateLocked(state)
 		h.mu.Unlock()
 		http.Error(w, "State not found in server", http.StatusBadRequest)
+		recordOAuth2Login(audit.TypeOAuth2LoginFailed, "", "", "state not found in server")
 		return nil, state, false
 	}
 
@@ -263,7 +303,7 @@ func getOAuthCertBundle(providerConfig *config.OAuth2Provider) *x509.CertPool {
 	return caCertPool
 }
 
-func (h *OAuth2Handler) exchangeOAuthCode(ctx context.Context, providerConfig *oauth2.Config, code string, clientSettings *HttpClientSettings) (*oauth2.Token, error) {
+func (h *OAuth2Handler) exchangeOAuthCode(ctx context.Context, registeredState *oauth2State, code string, clientSettings *HttpClientSettings) (*oauth2.Token, error) {
 	exchangeClient := &http.Client{
 		Transport: clientSettings.Transport,
 		Timeout:   clientSettings.Timeout,
@@ -271,7 +311,13 @@ func (h *OAuth2Handler) exchangeOAuthCode(ctx context.Context, providerConfig *o
 
 	ctx = context.WithValue(ctx, oauth2.HTTPClient, exchangeClient)
 
-	return providerConfig.Exchange(ctx, code)
+	opts := []oauth2.AuthCodeOption{}
+
+	if registeredState.codeVerifier != "" {
+		opts = append(opts, oauth2.VerifierOption(registeredState.codeVerifier))
+	}
+
+	return registeredState.providerConfig.Exchange(ctx, code, opts...)
 }
 
 func (h *OAuth2Handler) createUserInfoClient(ctx context.Context, providerConfig *oauth2.Config, tok *oauth2.Token, clientSettings *HttpClientSettings) *http.Client {
@@ -315,27 +361,75 @@ func (h *OAuth2Handler) HandleOAuthCallback(w http.ResponseWriter, r *http.Reque
 	clientSettings := getOAuth2HttpClient(providerConfig)
 
 	ctx := context.Background()
-	tok, err := h.exchangeOAuthCode(ctx, registeredState.providerConfig, code, clientSettings)
+	tok, err := h.exchangeOAuthCode(ctx, registeredState, code, clientSettings)
 	if err != nil {
 		log.Errorf("Failed to exchange code: %v", err)
 		http.Error(w, "Failed to exchange code", http.StatusBadRequest)
+		recordOAuth2Login(audit.TypeOAuth2LoginFailed, registeredState.providerName, "", "failed to exchange code")
 		return
 	}
 
-	userInfoClient := h.createUserInfoClient(ctx, registeredState.providerConfig, tok, clientSettings)
-	userinfo := getUserInfo(h.cfg, userInfoClient, providerConfig)
+	userinfo, err := h.userInfoFromToken(ctx, registeredState, providerConfig, tok, clientSettings)
+	if err != nil {
+		log.Errorf("Failed to get user from the ID token: %v", err)
+		http.Error(w, "Invalid ID token", http.StatusBadRequest)
+		recordOAuth2Login(audit.TypeOAuth2LoginFailed, registeredState.providerName, "", err.Error())
+		return
+	}
 
 	h.mu.Lock()
 	h.registeredStates[state].Username = userinfo.Username
 	h.registeredStates[state].Usergroup = h.computeUsergroup(userinfo, providerConfig)
+	h.registeredStates[state].idToken = userinfo.idToken
 	h.mu.Unlock()
 
+	recordOAuth2UserInfo(registeredState.providerName, userinfo)
+
 	http.Redirect(w, r, "/", http.StatusFound)
 }
 
+// recordOAuth2UserInfo records a failed login when the provider did not
+// return a username, as the session is then only a guest.
+func recordOAuth2UserInfo(providerName string, userinfo *UserInfo) {
+	if userinfo.Username == "" {
+		recordOAuth2Login(audit.TypeOAuth2LoginFailed, providerName, "", "no username in user data")
+	} else {
+		recordOAuth2Login(audit.TypeOAuth2Login, providerName, userinfo.Username, "")
+	}
+}
+
+func recordOAuth2Login(eventType string, providerName string, username string, detail string) {
+	outcome := audit.OutcomeSuccess
+
+	if eventType == audit.TypeOAuth2LoginFailed {
+		outcome = audit.OutcomeFailure
+	}
+
+	audit.Record(audit.Event{
+		Type:     eventType,
+		Outcome:  outcome,
+		Username: username,
+		Provider: "oauth2",
+		Detail:   joinDetail(providerName, detail),
+	})
+}
+
+func joinDetail(providerName string, detail string) string {
+	if providerName == "" {
+		return detail
+	}
+
+	if detail == "" {
+		return "provider: " + providerName
+	}
+
+	return "provider: " + providerName + ", " + detail
+}
+
 type UserInfo struct {
 	Username  string
 	Usergroup string
+	idToken   string
 }
 
 //gocyclo:ignore
@@ -423,10 +517,23 @@ func (h *OAuth2Handler) lookupOAuth2UserByState(state string) (*authTypes.Authen
 	return user, true
 }
 
-func (h *OAuth2Handler) RevokeSession(sid string) {
+// RevokeSession deletes the session, and returns the URL to also log out of
+// the provider, if it supports RP-initiated logout.
+func (h *OAuth2Handler) RevokeSession(sid string) string {
 	h.mu.Lock()
-	defer h.mu.Unlock()
+	state, ok := h.registeredStates[sid]
 	delete(h.registeredStates, sid)
+	var discovered *oidcProvider
+	if ok {
+		discovered = h.discoveredProviders[state.providerName]
+	}
+	h.mu.Unlock()
+
+	if !ok {
+		return ""
+	}
+
+	return h.endSessionURL(state, discovered)
 }
 
 func (h *OAuth2Handler) CheckUserFromOAuth2Cookie(context *authTypes.AuthCheckingContext) *authTypes.AuthenticatedUser {
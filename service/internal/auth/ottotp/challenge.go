@@ -0,0 +1,169 @@
+package ottotp
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+const (
+	challengeMaxAge      = 5 * time.Minute
+	challengeMaxAttempts = 5
+
+	// A user with this many wrong codes in a row, across any number of
+	// challenges, is locked out for userLockout.
+	userMaxFailures = 10
+	userLockout     = 15 * time.Minute
+)
+
+// challenge is the half finished login of a user whose password was correct,
+// and who now needs to enter a code.
+type challenge struct {
+	username  string
+	createdAt time.Time
+	attempts  int
+}
+
+// userFailures counts the wrong codes of a user since their last login.
+type userFailures struct {
+	count       int
+	lockedUntil time.Time
+}
+
+func (f *userFailures) lockoutOver(now time.Time) bool {
+	return !f.lockedUntil.IsZero() && now.After(f.lockedUntil)
+}
+
+var (
+	challenges   = make(map[string]*challenge)
+	failures     = make(map[string]*userFailures)
+	challengesMu sync.Mutex
+)
+
+// NewChallenge is returned to the browser instead of a session, after the
+// password of an enrolled user was correct.
+func NewChallenge(username string) (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	id := hex.EncodeToString(b)
+
+	challengesMu.Lock()
+	defer challengesMu.Unlock()
+
+	sweepChallengesLocked(time.Now())
+
+	challenges[id] = &challenge{
+		username:  username,
+		createdAt: time.Now(),
+	}
+
+	return id, nil
+}
+
+func sweepChallengesLocked(now time.Time) {
+	for id, c := range challenges {
+		if now.Sub(c.createdAt) > challengeMaxAge {
+			delete(challenges, id)
+		}
+	}
+
+	for username, f := range failures {
+		if f.lockoutOver(now) {
+			delete(failures, username)
+		}
+	}
+}
+
+// VerifyChallenge returns the username of the challenge when the code is
+// correct. A challenge can only be used to log in once, and is dropped
+// after too many wrong codes, so that the password has to be entered again.
+// Attempts are counted before the code is checked, so that requests made at
+// the same time cannot get around the limits.
+func VerifyChallenge(cfg *config.Config, id string, code string) (string, error) {
+	c, err := beginAttempt(id, time.Now())
+	if err != nil {
+		if c == nil {
+			return "", err
+		}
+
+		return c.username, err
+	}
+
+	return c.username, finishAttempt(id, c, Verify(cfg, c.username, code))
+}
+
+func beginAttempt(id string, now time.Time) (*challenge, error) {
+	challengesMu.Lock()
+	defer challengesMu.Unlock()
+
+	c, ok := challenges[id]
+
+	if !ok || now.Sub(c.createdAt) > challengeMaxAge {
+		delete(challenges, id)
+		return nil, ErrExpired
+	}
+
+	if err := countUserAttemptLocked(c.username, now); err != nil {
+		delete(challenges, id)
+		return c, err
+	}
+
+	c.attempts++
+
+	if c.attempts > challengeMaxAttempts {
+		delete(challenges, id)
+		return c, ErrExpired
+	}
+
+	return c, nil
+}
+
+// countUserAttemptLocked counts the attempt as a failure until it succeeds,
+// and locks the user out once they have too many.
+func countUserAttemptLocked(username string, now time.Time) error {
+	f, ok := failures[username]
+
+	if !ok || f.lockoutOver(now) {
+		f = &userFailures{}
+		failures[username] = f
+	}
+
+	if now.Before(f.lockedUntil) {
+		return ErrLockedOut
+	}
+
+	if f.count >= userMaxFailures {
+		f.lockedUntil = now.Add(userLockout)
+		return ErrLockedOut
+	}
+
+	f.count++
+
+	return nil
+}
+
+func finishAttempt(id string, c *challenge, err error) error {
+	challengesMu.Lock()
+	defer challengesMu.Unlock()
+
+	if err == nil {
+		delete(challenges, id)
+		delete(failures, c.username)
+
+		return nil
+	}
+
+	if c.attempts >= challengeMaxAttempts {
+		delete(challenges, id)
+		return ErrExpired
+	}
+
+	return err
+}
@@ -0,0 +1,160 @@
+package ottotp
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// enrolment is what is stored for each enrolled user. The secret is
+// encrypted, and only the hashes of the recovery codes are kept.
+type enrolment struct {
+	Secret        string   `yaml:"secret"`
+	RecoveryCodes []string `yaml:"recoveryCodes"`
+	LastStep      int64    `yaml:"lastStep"`
+}
+
+type stateFile struct {
+	Users map[string]*enrolment `yaml:"users"`
+}
+
+var (
+	state   = newStateFile()
+	stateMu sync.Mutex
+)
+
+func newStateFile() *stateFile {
+	return &stateFile{
+		Users: make(map[string]*enrolment),
+	}
+}
+
+func statePath(cfg *config.Config) string {
+	return filepath.Join(cfg.GetDir(), "totp.yaml")
+}
+
+// Load reads the enrolments from totp.yaml, next to the config.
+func Load(cfg *config.Config) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	state = newStateFile()
+
+	data, err := os.ReadFile(statePath(cfg))
+
+	if errors.Is(err, os.ErrNotExist) {
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Warn("Failed to read totp.yaml")
+		return
+	}
+
+	if err := yaml.Unmarshal(data, state); err != nil {
+		log.WithError(err).Error("Failed to unmarshal totp.yaml")
+		state = newStateFile()
+	}
+
+	if state.Users == nil {
+		state.Users = make(map[string]*enrolment)
+	}
+
+	warnIfUnavailableLocked(cfg)
+}
+
+func warnIfUnavailableLocked(cfg *config.Config) {
+	if len(state.Users) > 0 && !cfg.AuthLocalUsers.Totp.Enabled {
+		log.Errorf("totp.yaml has %d enrolled users, but authLocalUsers.totp is not enabled or has no encryptionKey. These users cannot log in until it is fixed, or they are removed from totp.yaml", len(state.Users))
+	}
+}
+
+func saveLocked(cfg *config.Config) error {
+	out, err := yaml.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal totp state: %w", err)
+	}
+
+	if err := os.WriteFile(statePath(cfg), out, 0600); err != nil {
+		return fmt.Errorf("write totp.yaml: %w", err)
+	}
+
+	return nil
+}
+
+// IsEnrolled is true when the user needs a TOTP code to log in.
+func IsEnrolled(cfg *config.Config, username string) bool {
+	return cfg.AuthLocalUsers.Totp.Enabled && HasEnrolment(username)
+}
+
+// HasEnrolment is true when totp.yaml has an enrolment for the user, even
+// when TOTP is not enabled. Such users must not fall back to only a password.
+func HasEnrolment(username string) bool {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	_, ok := state.Users[username]
+
+	return ok
+}
+
+func newGCM(cfg *config.Config) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(cfg.AuthLocalUsers.Totp.EncryptionKey))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// encryptSecret uses the username as additional data, so that a secret
+// cannot be copied to another user in the state file.
+func encryptSecret(cfg *config.Config, username string, secret string) (string, error) {
+	gcm, err := newGCM(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), []byte(username))
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func decryptSecret(cfg *config.Config, username string, encrypted string) (string, error) {
+	gcm, err := newGCM(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", errors.New("invalid encrypted TOTP secret")
+	}
+
+	secret, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], []byte(username))
+	if err != nil {
+		return "", fmt.Errorf("decrypt TOTP secret, has the encryptionKey changed? %w", err)
+	}
+
+	return string(secret), nil
+}
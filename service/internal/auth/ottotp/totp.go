@@ -0,0 +1,285 @@
+// Package ottotp adds TOTP codes from an authenticator app as a second step
+// to the login of local users. Secrets are encrypted in totp.yaml, next to the
+// config, and only the hashes of recovery codes are kept.
+package ottotp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"image/png"
+	"strings"
+	"sync"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	period            = 30
+	recoveryCodeCount = 10
+	pendingMaxAge     = 15 * time.Minute
+)
+
+var (
+	ErrNotEnabled      = errors.New("TOTP is not enabled")
+	ErrNotEnrolled     = errors.New("TOTP is not enrolled")
+	ErrAlreadyEnrolled = errors.New("TOTP is already enrolled")
+	ErrNotStarted      = errors.New("TOTP enrolment was not started, or has expired")
+	ErrExpired         = errors.New("TOTP login has expired, enter the password again")
+	ErrInvalidCode     = errors.New("invalid TOTP code")
+	ErrLockedOut       = errors.New("too many invalid TOTP codes, try again later")
+)
+
+var validateOpts = totp.ValidateOpts{
+	Period:    period,
+	Digits:    otp.DigitsSix,
+	Algorithm: otp.AlgorithmSHA1,
+}
+
+// Enrolment is shown to the user to add to their authenticator app.
+type Enrolment struct {
+	Secret string
+	URL    string
+	QRCode []byte
+}
+
+type pendingEnrolment struct {
+	secret    string
+	createdAt time.Time
+}
+
+var (
+	pending   = make(map[string]*pendingEnrolment)
+	pendingMu sync.Mutex
+)
+
+// BeginEnrolment creates a secret for the user, which is only kept once the
+// user confirms it with a code from their app.
+func BeginEnrolment(cfg *config.Config, username string) (*Enrolment, error) {
+	if !cfg.AuthLocalUsers.Totp.Enabled {
+		return nil, ErrNotEnabled
+	}
+
+	if IsEnrolled(cfg, username) {
+		return nil, ErrAlreadyEnrolled
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      cfg.AuthLocalUsers.Totp.Issuer,
+		AccountName: username,
+		Period:      period,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	pendingMu.Lock()
+	pending[username] = &pendingEnrolment{
+		secret:    key.Secret(),
+		createdAt: time.Now(),
+	}
+	pendingMu.Unlock()
+
+	return &Enrolment{
+		Secret: key.Secret(),
+		URL:    key.URL(),
+		QRCode: qrCode(key),
+	}, nil
+}
+
+// qrCode is optional, as the secret can also be typed into the app.
+func qrCode(key *otp.Key) []byte {
+	img, err := key.Image(200, 200)
+	if err != nil {
+		return nil
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := png.Encode(buf, img); err != nil {
+		return nil
+	}
+
+	return buf.Bytes()
+}
+
+func takePendingSecret(username string) (string, bool) {
+	pendingMu.Lock()
+	defer pendingMu.Unlock()
+
+	p, ok := pending[username]
+
+	if !ok || time.Since(p.createdAt) > pendingMaxAge {
+		delete(pending, username)
+		return "", false
+	}
+
+	return p.secret, true
+}
+
+// ConfirmEnrolment stores the secret once the code matches, and returns the
+// recovery codes. They are only shown this once.
+func ConfirmEnrolment(cfg *config.Config, username string, code string) ([]string, error) {
+	secret, ok := takePendingSecret(username)
+	if !ok {
+		return nil, ErrNotStarted
+	}
+
+	step, ok := matchStep(secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidCode
+	}
+
+	encrypted, err := encryptSecret(cfg, username, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	pendingMu.Lock()
+	delete(pending, username)
+	pendingMu.Unlock()
+
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	state.Users[username] = &enrolment{
+		Secret:        encrypted,
+		RecoveryCodes: hashes,
+		LastStep:      step,
+	}
+
+	return codes, saveLocked(cfg)
+}
+
+// Verify accepts a code from the app, or an unused recovery code. Each
+// code from the app can only be used once.
+func Verify(cfg *config.Config, username string, code string) error {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	e, ok := state.Users[username]
+	if !ok {
+		return ErrNotEnrolled
+	}
+
+	if !useRecoveryCode(e, code) {
+		if err := useCode(cfg, username, e, code); err != nil {
+			return err
+		}
+	}
+
+	return saveLocked(cfg)
+}
+
+// useCode remembers the step of the code, so that it cannot be used again.
+func useCode(cfg *config.Config, username string, e *enrolment, code string) error {
+	secret, err := decryptSecret(cfg, username, e.Secret)
+	if err != nil {
+		return err
+	}
+
+	step, ok := matchStep(secret, code, time.Now())
+	if !ok || step <= e.LastStep {
+		return ErrInvalidCode
+	}
+
+	e.LastStep = step
+
+	return nil
+}
+
+// Disable removes the enrolment of the user, after checking a code.
+func Disable(cfg *config.Config, username string, code string) error {
+	if err := Verify(cfg, username, code); err != nil {
+		return err
+	}
+
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	delete(state.Users, username)
+
+	return saveLocked(cfg)
+}
+
+// matchStep allows codes from one step either side of now, for clocks that
+// are not quite in sync, and returns the step of the code.
+func matchStep(secret string, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	current := now.Unix() / period
+
+	for step := current - 1; step <= current+1; step++ {
+		expected, err := totp.GenerateCodeCustom(secret, time.Unix(step*period, 0), validateOpts)
+
+		if err == nil && subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// newRecoveryCode looks like "abcde-fghij", from 50 random bits.
+func newRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	raw := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))[:10]
+
+	return raw[:5] + "-" + raw[5:], nil
+}
+
+// hashRecoveryCode ignores case, spaces and dashes, as they are easy to get
+// wrong when typing a code.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func useRecoveryCode(e *enrolment, code string) bool {
+	hash := hashRecoveryCode(code)
+
+	for i, stored := range e.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(stored), []byte(hash)) == 1 {
+			e.RecoveryCodes = append(e.RecoveryCodes[:i], e.RecoveryCodes[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,217 @@
+package ottotp
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig(t *testing.T) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.AuthLocalUsers.Totp = config.TotpConfig{
+		Enabled:       true,
+		Issuer:        "OliveTin",
+		EncryptionKey: "test-key",
+	}
+
+	Load(cfg)
+
+	challengesMu.Lock()
+	clear(challenges)
+	clear(failures)
+	challengesMu.Unlock()
+
+	return cfg
+}
+
+// codeAt returns the code for a step after the current one, as the code for
+// the current step is used up when enrolling.
+func codeAt(t *testing.T, secret string, steps int) string {
+	code, err := totp.GenerateCode(secret, time.Now().Add(time.Duration(steps*period)*time.Second))
+	require.NoError(t, err)
+
+	return code
+}
+
+func enrol(t *testing.T, cfg *config.Config, username string) (string, []string) {
+	enrolment, err := BeginEnrolment(cfg, username)
+	require.NoError(t, err)
+	assert.NotEmpty(t, enrolment.QRCode)
+	assert.Contains(t, enrolment.URL, "otpauth://totp/OliveTin:"+username)
+
+	codes, err := ConfirmEnrolment(cfg, username, codeAt(t, enrolment.Secret, 0))
+	require.NoError(t, err)
+
+	return enrolment.Secret, codes
+}
+
+func TestEnrolAndVerify(t *testing.T) {
+	cfg := newTestConfig(t)
+
+	assert.False(t, IsEnrolled(cfg, "alice"))
+
+	secret, codes := enrol(t, cfg, "alice")
+
+	assert.True(t, IsEnrolled(cfg, "alice"))
+	assert.Len(t, codes, recoveryCodeCount)
+
+	assert.ErrorIs(t, Verify(cfg, "alice", codeAt(t, secret, 0)), ErrInvalidCode, "codes cannot be used twice")
+	assert.ErrorIs(t, Verify(cfg, "alice", "000000"), ErrInvalidCode)
+	assert.NoError(t, Verify(cfg, "alice", codeAt(t, secret, 1)))
+
+	_, err := BeginEnrolment(cfg, "alice")
+	assert.ErrorIs(t, err, ErrAlreadyEnrolled)
+}
+
+func TestConfirmEnrolmentRejectsWrongCode(t *testing.T) {
+	cfg := newTestConfig(t)
+
+	_, err := ConfirmEnrolment(cfg, "alice", "123456")
+	assert.ErrorIs(t, err, ErrNotStarted)
+
+	_, err = BeginEnrolment(cfg, "alice")
+	require.NoError(t, err)
+
+	_, err = ConfirmEnrolment(cfg, "alice", "not-a-code")
+	assert.ErrorIs(t, err, ErrInvalidCode)
+	assert.False(t, IsEnrolled(cfg, "alice"))
+}
+
+func TestRecoveryCodesAreUsedOnce(t *testing.T) {
+	cfg := newTestConfig(t)
+	_, codes := enrol(t, cfg, "alice")
+
+	assert.NoError(t, Verify(cfg, "alice", " "+codes[0]+" "))
+	assert.ErrorIs(t, Verify(cfg, "alice", codes[0]), ErrInvalidCode)
+	assert.NoError(t, Verify(cfg, "alice", "  "+codes[1][:5]+codes[1][6:]))
+}
+
+func TestStateIsEncryptedAndReloaded(t *testing.T) {
+	cfg := newTestConfig(t)
+	secret, codes := enrol(t, cfg, "alice")
+
+	data, err := os.ReadFile(filepath.Join(cfg.GetDir(), "totp.yaml"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), secret)
+	assert.NotContains(t, string(data), codes[0])
+
+	Load(cfg)
+	assert.True(t, IsEnrolled(cfg, "alice"))
+	assert.NoError(t, Verify(cfg, "alice", codeAt(t, secret, 1)))
+
+	cfg.AuthLocalUsers.Totp.EncryptionKey = "another-key"
+	assert.Error(t, Verify(cfg, "alice", codeAt(t, secret, 1)))
+}
+
+func TestDisable(t *testing.T) {
+	cfg := newTestConfig(t)
+	_, codes := enrol(t, cfg, "alice")
+
+	assert.ErrorIs(t, Disable(cfg, "alice", "000000"), ErrInvalidCode)
+	assert.NoError(t, Disable(cfg, "alice", codes[0]))
+	assert.False(t, IsEnrolled(cfg, "alice"))
+}
+
+func TestChallengeExpiresAfterTooManyWrongCodes(t *testing.T) {
+	cfg := newTestConfig(t)
+	secret, _ := enrol(t, cfg, "alice")
+
+	challenge, err := NewChallenge("alice")
+	require.NoError(t, err)
+
+	for i := 1; i < challengeMaxAttempts; i++ {
+		_, err = VerifyChallenge(cfg, challenge, "000000")
+		assert.ErrorIs(t, err, ErrInvalidCode)
+	}
+
+	_, err = VerifyChallenge(cfg, challenge, "000000")
+	assert.ErrorIs(t, err, ErrExpired)
+
+	_, err = VerifyChallenge(cfg, challenge, codeAt(t, secret, 1))
+	assert.ErrorIs(t, err, ErrExpired)
+
+	challenge, err = NewChallenge("alice")
+	require.NoError(t, err)
+
+	username, err := VerifyChallenge(cfg, challenge, codeAt(t, secret, 1))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", username)
+
+	_, err = VerifyChallenge(cfg, challenge, codeAt(t, secret, 1))
+	assert.ErrorIs(t, err, ErrExpired, "challenges are only used once")
+}
+
+func TestChallengeAttemptsAreCountedBeforeVerifying(t *testing.T) {
+	cfg := newTestConfig(t)
+	enrol(t, cfg, "bob")
+
+	challenge, err := NewChallenge("bob")
+	require.NoError(t, err)
+
+	// Holding the state lock stops codes being checked, so only the requests
+	// over the limit can finish.
+	stateMu.Lock()
+
+	var wg sync.WaitGroup
+	var rejected atomic.Int32
+
+	requests := challengeMaxAttempts * 4
+
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := VerifyChallenge(cfg, challenge, "000000")
+			assert.Error(t, err)
+			rejected.Add(1)
+		}()
+	}
+
+	assert.Eventually(t, func() bool {
+		return int(rejected.Load()) == requests-challengeMaxAttempts
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stateMu.Unlock()
+	wg.Wait()
+}
+
+func TestUserIsLockedOutAfterTooManyWrongCodes(t *testing.T) {
+	cfg := newTestConfig(t)
+	secret, _ := enrol(t, cfg, "carol")
+
+	for i := 0; i < userMaxFailures; i++ {
+		challenge, err := NewChallenge("carol")
+		require.NoError(t, err)
+
+		_, err = VerifyChallenge(cfg, challenge, "000000")
+		assert.ErrorIs(t, err, ErrInvalidCode, "new challenges do not reset the count")
+	}
+
+	challenge, err := NewChallenge("carol")
+	require.NoError(t, err)
+
+	_, err = VerifyChallenge(cfg, challenge, codeAt(t, secret, 1))
+	assert.ErrorIs(t, err, ErrLockedOut, "even a correct code is refused")
+
+	challengesMu.Lock()
+	failures["carol"].lockedUntil = time.Now().Add(-time.Second)
+	challengesMu.Unlock()
+
+	challenge, err = NewChallenge("carol")
+	require.NoError(t, err)
+
+	username, err := VerifyChallenge(cfg, challenge, codeAt(t, secret, 1))
+	require.NoError(t, err, "the lockout ends")
+	assert.Equal(t, "carol", username)
+}
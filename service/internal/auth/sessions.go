@@ -12,8 +12,10 @@ import (
 
 // Session management for user authentication
 type UserSession struct {
-	Username string
-	Expiry   int64
+	Username     string
+	Usergroup    string `yaml:"usergroup,omitempty"`
+	TotpVerified bool   `yaml:"totpVerified,omitempty"`
+	Expiry       int64
 }
 
 type SessionProvider struct {
@@ -27,9 +29,13 @@ type SessionStorage struct {
 var (
 	sessionStorage       *SessionStorage
 	sessionStorageMutex  sync.RWMutex
-	oauth2SessionRevoker func(sid string)
+	oauth2SessionRevoker func(sid string) string
 )
 
+// localSessionMaxAge is for users who are checked against the config on
+// every request, so a long session does not keep any stale permissions.
+const localSessionMaxAge = 31556952 // 1 year
+
 func init() {
 	sessionStorage = &SessionStorage{
 		Providers: make(map[string]*SessionProvider),
@@ -38,6 +44,31 @@ func init() {
 
 // RegisterUserSession registers a user session
 func RegisterUserSession(cfg *config.Config, provider string, sid string, username string) {
+	registerUserSession(cfg, provider, sid, &UserSession{
+		Username: username,
+	}, localSessionMaxAge)
+}
+
+// RegisterUserSessionWithUsergroup registers a session for a user that is not
+// in the config, such as an LDAP user, whose usergroup is kept with the session.
+// The usergroup is not checked again, so maxAge (in seconds) should be short.
+func RegisterUserSessionWithUsergroup(cfg *config.Config, provider string, sid string, username string, usergroup string, maxAge int64) {
+	registerUserSession(cfg, provider, sid, &UserSession{
+		Username:  username,
+		Usergroup: usergroup,
+	}, maxAge)
+}
+
+// RegisterUserSessionWithTotp registers a session for a local user who
+// entered a TOTP code after their password.
+func RegisterUserSessionWithTotp(cfg *config.Config, provider string, sid string, username string) {
+	registerUserSession(cfg, provider, sid, &UserSession{
+		Username:     username,
+		TotpVerified: true,
+	}, localSessionMaxAge)
+}
+
+func registerUserSession(cfg *config.Config, provider string, sid string, session *UserSession, maxAge int64) {
 	sessionStorageMutex.Lock()
 	defer sessionStorageMutex.Unlock()
 
@@ -51,31 +82,48 @@ func RegisterUserSession(cfg *config.Config, provider string, sid string, userna
 		sessionStorage.Providers = make(map[string]*SessionProvider)
 	}
 
-	sessionStorage.Providers[provider].Sessions[sid] = &UserSession{
-		Username: username,
-		Expiry:   time.Now().Unix() + 31556952, // 1 year
-	}
+	session.Expiry = time.Now().Unix() + maxAge
+	sessionStorage.Providers[provider].Sessions[sid] = session
 
 	saveUserSessions(cfg)
 }
 
+// MarkSessionTotpVerified is used when a user enrols TOTP, as entering the
+// first code proves that they have the app.
+func MarkSessionTotpVerified(cfg *config.Config, provider string, sid string) {
+	sessionStorageMutex.Lock()
+	defer sessionStorageMutex.Unlock()
+
+	if sessionStorage.Providers[provider] == nil {
+		return
+	}
+
+	if session := sessionStorage.Providers[provider].Sessions[sid]; session != nil {
+		session.TotpVerified = true
+		saveUserSessions(cfg)
+	}
+}
+
 // RegisterOAuth2SessionRevoker registers a callback to revoke OAuth2 sessions on logout.
 // OAuth2 uses its own session storage; the API calls this when provider is oauth2.
-func RegisterOAuth2SessionRevoker(fn func(sid string)) {
+// The callback returns the URL to log out of the provider, or an empty string.
+func RegisterOAuth2SessionRevoker(fn func(sid string) string) {
 	oauth2SessionRevoker = fn
 }
 
 // RevokeSessionForProvider invalidates the session for the given provider and SID (e.g. on logout).
 // Local auth uses shared SessionStorage; OAuth2 uses a separate storage and revoker.
-func RevokeSessionForProvider(cfg *config.Config, provider string, sid string) {
+// It returns the URL that the browser should visit to also log out of an
+// OpenID Connect provider, or an empty string.
+func RevokeSessionForProvider(cfg *config.Config, provider string, sid string) string {
 	if sid == "" {
-		return
+		return ""
 	}
 	if provider == "oauth2" && oauth2SessionRevoker != nil {
-		oauth2SessionRevoker(sid)
-		return
+		return oauth2SessionRevoker(sid)
 	}
 	RevokeUserSession(cfg, provider, sid)
+	return ""
 }
 
 // RevokeUserSession removes a session from storage so it can no longer be used (e.g. on logout).
@@ -13,32 +13,44 @@ const JustificationRequiredNoTemplate = " "
 // Action represents the core functionality of OliveTin - commands that show up
 // as buttons in the UI.
 type Action struct {
-	ID                     string           `koanf:"id"`
-	Title                  string           `koanf:"title"`
-	Icon                   string           `koanf:"icon"`
-	Shell                  string           `koanf:"shell"`
-	Exec                   []string         `koanf:"exec"`
-	ShellAfterCompleted    string           `koanf:"shellAfterCompleted"`
-	Timeout                int              `koanf:"timeout"`
-	Acls                   []string         `koanf:"acls"`
-	Entity                 string           `koanf:"entity"`
-	Hidden                 bool             `koanf:"hidden"`
-	ExecOnStartup          bool             `koanf:"execOnStartup"`
-	ExecOnCron             []string         `koanf:"execOnCron"`
-	ExecOnFileCreatedInDir []string         `koanf:"execOnFileCreatedInDir"`
-	ExecOnFileChangedInDir []string         `koanf:"execOnFileChangedInDir"`
-	ExecOnCalendarFile     string           `koanf:"execOnCalendarFile"`
-	ExecOnWebhook          []WebhookConfig  `koanf:"execOnWebhook"`
-	Triggers               []string         `koanf:"triggers"`
-	MaxConcurrent          int              `koanf:"maxConcurrent"`
-	MaxRate                []RateSpec       `koanf:"maxRate"`
-	Arguments              []ActionArgument `koanf:"arguments"`
-	OnClick                string           `koanf:"onclick"`
-	PopupOnStart           string           `koanf:"popupOnStart"`
-	SaveLogs               SaveLogsConfig   `koanf:"saveLogs"`
-	EnabledExpression      string           `koanf:"enabledExpression"`
-	Groups                 []string         `koanf:"groups"`
-	Justification          string           `koanf:"justification"`
+	ID                     string             `koanf:"id"`
+	Title                  string             `koanf:"title"`
+	Icon                   string             `koanf:"icon"`
+	Shell                  string             `koanf:"shell"`
+	Exec                   []string           `koanf:"exec"`
+	ShellAfterCompleted    string             `koanf:"shellAfterCompleted"`
+	Timeout                int                `koanf:"timeout"`
+	Acls                   []string           `koanf:"acls"`
+	Entity                 string             `koanf:"entity"`
+	Hidden                 bool               `koanf:"hidden"`
+	ExecOnStartup          bool               `koanf:"execOnStartup"`
+	ExecOnCron             []string           `koanf:"execOnCron"`
+	CronTimezone           string             `koanf:"cronTimezone"`
+	CronCatchUp            int                `koanf:"cronCatchUp"`
+	ExecOnFileCreatedInDir []string           `koanf:"execOnFileCreatedInDir"`
+	ExecOnFileChangedInDir []string           `koanf:"execOnFileChangedInDir"`
+	ExecOnCalendarFile     string             `koanf:"execOnCalendarFile"`
+	ExecOnWebhook          []WebhookConfig    `koanf:"execOnWebhook"`
+	ExecOnMqtt             []MqttTrigger      `koanf:"execOnMqtt"`
+	Triggers               []ActionTrigger    `koanf:"triggers"`
+	MaxConcurrent          int                `koanf:"maxConcurrent"`
+	MaxRate                []RateSpec         `koanf:"maxRate"`
+	Retry                  RetryConfig        `koanf:"retry"`
+	Arguments              []ActionArgument   `koanf:"arguments"`
+	OnClick                string             `koanf:"onclick"`
+	PopupOnStart           string             `koanf:"popupOnStart"`
+	SaveLogs               SaveLogsConfig     `koanf:"saveLogs"`
+	EnabledExpression      string             `koanf:"enabledExpression"`
+	Groups                 []string           `koanf:"groups"`
+	Justification          string             `koanf:"justification"`
+	Approval               ApprovalConfig     `koanf:"approval"`
+	RunOn                  string             `koanf:"runOn"`
+	Ssh                    SshConfig          `koanf:"ssh"`
+	Container              ContainerConfig    `koanf:"container"`
+	OutputFormat           string             `koanf:"outputFormat"`
+	UpdatesEntities        []string           `koanf:"updatesEntities"`
+	Notify                 []NotificationRule `koanf:"notify"`
+	PublishToMqtt          []MqttPublish      `koanf:"publishToMqtt"`
 }
 
 func (action *Action) RequiresJustification() bool {
@@ -57,6 +69,111 @@ func (action *Action) JustificationTemplateText() string {
 	return action.Justification
 }
 
+// Output formats that an action's stdout can be parsed as, into the Result of
+// the execution.
+const (
+	OutputFormatJSON     = "json"
+	OutputFormatYAML     = "yaml"
+	OutputFormatKeyValue = "keyvalue"
+)
+
+// ApprovalConfig requires other users to approve an execution before it runs.
+type ApprovalConfig struct {
+	Usergroups []string `koanf:"usergroups"`
+	Count      int      `koanf:"count"`
+	Expiry     string   `koanf:"expiry"`
+}
+
+func (action *Action) RequiresApproval() bool {
+	return action != nil && (action.Approval.Count > 0 || len(action.Approval.Usergroups) > 0)
+}
+
+// SshConfig runs an action's command on a remote host over SSH, instead of
+// locally. Host and User are templates, so they can be set from entity fields.
+type SshConfig struct {
+	Host           string `koanf:"host"`
+	User           string `koanf:"user"`
+	KeyFile        string `koanf:"keyFile"`
+	KnownHostsFile string `koanf:"knownHostsFile"`
+}
+
+func (action *Action) RunsOverSsh() bool {
+	return action != nil && action.Ssh.Host != ""
+}
+
+// ContainerConfig runs an action in a new container, instead of locally.
+// Mounts are in the same "host:container[:ro]" format as docker run -v, and
+// Env entries are "NAME=value".
+type ContainerConfig struct {
+	Image   string   `koanf:"image"`
+	Mounts  []string `koanf:"mounts"`
+	Env     []string `koanf:"env"`
+	Network string   `koanf:"network"`
+
+	// PullTimeout is how many seconds pulling a missing image may take. It is
+	// separate from the action's timeout, which starts once the container
+	// has been created.
+	PullTimeout int `koanf:"pullTimeout"`
+}
+
+func (action *Action) RunsInContainer() bool {
+	return action != nil && action.Container.Image != ""
+}
+
+// NotificationRule sends a notification to a channel when an execution of
+// the action finishes. Title and Message are templates of the log entry.
+type NotificationRule struct {
+	Channel string   `koanf:"channel"`
+	On      []string `koanf:"on"`
+	Title   string   `koanf:"title"`
+	Message string   `koanf:"message"`
+}
+
+// Events that notification rules can be sent on.
+const (
+	NotifyOnSuccess = "success"
+	NotifyOnFailure = "failure"
+	NotifyOnTimeout = "timeout"
+	NotifyOnBlocked = "blocked"
+)
+
+// Types of notification channel.
+const (
+	NotificationChannelEmail  = "email"
+	NotificationChannelSlack  = "slack"
+	NotificationChannelNtfy   = "ntfy"
+	NotificationChannelGotify = "gotify"
+)
+
+// NotificationsConfig defines the named channels that notifications can be
+// sent to.
+type NotificationsConfig struct {
+	Channels map[string]*NotificationChannel `koanf:"channels"`
+}
+
+// NotificationChannel is somewhere to send notifications. Url is the
+// webhook url for slack, the topic url for ntfy, and the server url for
+// gotify. Token is the access token for ntfy, or the application token for
+// gotify.
+type NotificationChannel struct {
+	Type     string     `koanf:"type"`
+	Url      string     `koanf:"url"`
+	Token    string     `koanf:"token"`
+	Priority int        `koanf:"priority"`
+	Smtp     SmtpConfig `koanf:"smtp"`
+}
+
+// SmtpConfig is used by email channels. Without a Username, mail is sent
+// without authentication.
+type SmtpConfig struct {
+	Host     string   `koanf:"host"`
+	Port     int      `koanf:"port"`
+	Username string   `koanf:"username"`
+	Password string   `koanf:"password"`
+	From     string   `koanf:"from"`
+	To       []string `koanf:"to"`
+}
+
 // ActionGroup defines shared limits and metadata for a set of actions.
 type ActionGroup struct {
 	MaxConcurrent int    `koanf:"maxConcurrent"`
@@ -64,6 +181,18 @@ type ActionGroup struct {
 	Icon          string `koanf:"icon"`
 }
 
+// ActionTrigger starts another action, by title, after this action has
+// finished. A trigger can also be written as just the title. When conditions
+// are set, the action is only started when all of them match.
+type ActionTrigger struct {
+	Action     string            `koanf:"action"`
+	OnExitCode []int32           `koanf:"onExitCode"`
+	OnSuccess  bool              `koanf:"onSuccess"`
+	OnFailure  bool              `koanf:"onFailure"`
+	If         string            `koanf:"if"`
+	Arguments  map[string]string `koanf:"arguments"`
+}
+
 // ActionArgument objects appear on Actions.
 type ActionArgument struct {
 	Name                  string                 `koanf:"name"`
@@ -90,6 +219,17 @@ type RateSpec struct {
 	Duration string `koanf:"duration"`
 }
 
+// RetryConfig runs an action again when it fails. Attempts includes the first
+// attempt, and Backoff is the wait before the first retry, which doubles for
+// each retry after that. Without RetryOnExitCodes, any exit code other than 0
+// is retried. Timeouts are only retried with RetryOnTimeout.
+type RetryConfig struct {
+	Attempts         int     `koanf:"attempts"`
+	Backoff          string  `koanf:"backoff"`
+	RetryOnExitCodes []int32 `koanf:"retryOnExitCodes"`
+	RetryOnTimeout   bool    `koanf:"retryOnTimeout"`
+}
+
 // WebhookConfig defines configuration for generic webhook triggers.
 type WebhookConfig struct {
 	Secret        string            `koanf:"secret"`        // Optional: secret for signature verification
@@ -103,13 +243,109 @@ type WebhookConfig struct {
 	Justification string            `koanf:"justification"` // Optional JSONPath to extract justification from webhook body
 }
 
+// MqttConfig defines the named MQTT brokers that actions can be triggered
+// from, and publish to.
+type MqttConfig struct {
+	Brokers map[string]*MqttBroker `koanf:"brokers"`
+}
+
+// MqttBroker is a connection to an MQTT broker. Url is like
+// tcp://localhost:1883, ssl://localhost:8883 or ws://localhost:8080/mqtt.
+type MqttBroker struct {
+	Url      string `koanf:"url"`
+	Username string `koanf:"username"`
+	Password string `koanf:"password"`
+	ClientId string `koanf:"clientId"`
+}
+
+// MqttTrigger starts an action when a message is received on a topic that
+// matches Topic, which can have + and # wildcards. MatchPath and Extract work
+// on JSON payloads in the same way as they do for webhooks.
+type MqttTrigger struct {
+	Broker    string            `koanf:"broker"`
+	Topic     string            `koanf:"topic"`
+	Qos       byte              `koanf:"qos"`
+	MatchPath string            `koanf:"matchPath"`
+	Extract   map[string]string `koanf:"extract"`
+}
+
+// MqttPublish publishes the result of each execution of the action to Topic,
+// as JSON.
+type MqttPublish struct {
+	Broker string `koanf:"broker"`
+	Topic  string `koanf:"topic"`
+	Qos    byte   `koanf:"qos"`
+	Retain bool   `koanf:"retain"`
+}
+
+// Workflow runs actions as a graph of steps. Each step starts once the steps
+// that it needs have finished, if its condition is met.
+type Workflow struct {
+	ID    string         `koanf:"id"`
+	Title string         `koanf:"title"`
+	Steps []WorkflowStep `koanf:"steps"`
+}
+
+// WorkflowStep runs the action with the title Action. Arguments are
+// templates, which can use the arguments of the workflow and the output of
+// the steps before it. ExitCodes are the exit codes that count as success,
+// and If is when the step runs, based on the steps that it needs.
+type WorkflowStep struct {
+	ID        string            `koanf:"id"`
+	Action    string            `koanf:"action"`
+	Needs     []string          `koanf:"needs"`
+	If        string            `koanf:"if"`
+	ExitCodes []int32           `koanf:"exitCodes"`
+	Arguments map[string]string `koanf:"arguments"`
+}
+
+// Conditions of workflow steps.
+const (
+	WorkflowStepIfSuccess = "success"
+	WorkflowStepIfFailure = "failure"
+	WorkflowStepIfAlways  = "always"
+)
+
+// OutgoingWebhookConfig posts execution events to Url as JSON. With a Secret,
+// the payload is signed with HMAC-SHA256 in AuthHeader, in the same way as
+// execOnWebhook verifies incoming webhooks.
+type OutgoingWebhookConfig struct {
+	Name        string   `koanf:"name"`
+	Url         string   `koanf:"url"`
+	Secret      string   `koanf:"secret"`
+	AuthHeader  string   `koanf:"authHeader"`
+	Events      []string `koanf:"events"`
+	MaxAttempts int      `koanf:"maxAttempts"`
+}
+
+// Events that outgoing webhooks can be sent on.
+const (
+	WebhookEventQueued   = "queued"
+	WebhookEventStarted  = "started"
+	WebhookEventFinished = "finished"
+	WebhookEventBlocked  = "blocked"
+)
+
 // Entity represents a "thing" that can have multiple actions associated with it.
 // for example, a media player with a start and stop action.
+//
+// Entities are loaded from one of File, Url or Command. Urls and commands are
+// loaded again every Interval seconds.
 type EntityFile struct {
-	File       string           `koanf:"file"`
-	Name       string           `koanf:"name"`
-	Icon       string           `koanf:"icon"`
-	Properties []EntityProperty `koanf:"properties"`
+	File       string            `koanf:"file"`
+	Url        string            `koanf:"url"`
+	Headers    map[string]string `koanf:"headers"`
+	Command    string            `koanf:"command"`
+	Interval   int               `koanf:"interval"`
+	Name       string            `koanf:"name"`
+	Icon       string            `koanf:"icon"`
+	Properties []EntityProperty  `koanf:"properties"`
+}
+
+// IsPolled is true for entities that are loaded from a url or command, rather
+// than a file that is watched for changes.
+func (ef *EntityFile) IsPolled() bool {
+	return ef.Url != "" || ef.Command != ""
 }
 
 // EntityProperty defines a column shown when listing entity instances in the UI.
@@ -134,6 +370,17 @@ type AccessControlList struct {
 	MatchUsernames   []string            `koanf:"matchUsernames"`
 	Permissions      PermissionsList     `koanf:"permissions"`
 	Policy           ConfigurationPolicy `koanf:"policy"`
+
+	// RequireTotpForExec only grants exec to users who entered a TOTP code
+	// when they logged in.
+	RequireTotpForExec bool `koanf:"requireTotpForExec"`
+}
+
+// UsergroupDefinition makes the members of the included groups also members of
+// this group, so that ACLs do not need to list every group.
+type UsergroupDefinition struct {
+	Name     string   `koanf:"name"`
+	Includes []string `koanf:"includes"`
 }
 
 // ConfigurationPolicy defines global settings which are overridden with an ACL.
@@ -141,6 +388,7 @@ type ConfigurationPolicy struct {
 	ShowDiagnostics   bool `koanf:"showDiagnostics"`
 	ShowLogList       bool `koanf:"showLogList"`
 	ShowVersionNumber bool `koanf:"showVersionNumber"`
+	ShowAuditLog      bool `koanf:"showAuditLog"`
 }
 
 type PrometheusConfig struct {
@@ -158,6 +406,15 @@ type SecurityConfig struct {
 	ForceSecureCookies          bool   `koanf:"forceSecureCookies"`
 }
 
+// AuditLogConfig sets where security related events are recorded. The audit
+// log is off unless a file or syslog is set.
+type AuditLogConfig struct {
+	File          string `koanf:"file"`
+	Syslog        bool   `koanf:"syslog"`
+	SyslogNetwork string `koanf:"syslogNetwork"`
+	SyslogAddress string `koanf:"syslogAddress"`
+}
+
 // Config is the global config used through the whole app.
 type Config struct {
 	UseSingleHTTPFrontend              bool                       `koanf:"useSingleHTTPFrontend"`
@@ -171,6 +428,9 @@ type Config struct {
 	LogLevel                           string                     `koanf:"logLevel"`
 	LogDebugOptions                    LogDebugOptions            `koanf:"logDebugOptions"`
 	LogHistoryPageSize                 int64                      `koanf:"logHistoryPageSize"`
+	LogHistoryDatabase                 string                     `koanf:"logHistoryDatabase"`
+	StateDirectory                     string                     `koanf:"stateDirectory"`
+	LogRetention                       LogRetentionConfig         `koanf:"logRetention"`
 	ActionGroups                       map[string]*ActionGroup    `koanf:"actionGroups"`
 	Actions                            []*Action                  `koanf:"actions"`
 	Entities                           []*EntityFile              `koanf:"entities"`
@@ -195,6 +455,7 @@ type Config struct {
 	AuthHttpHeaderUserGroup            string                     `koanf:"authHttpHeaderUserGroup"`
 	AuthHttpHeaderUserGroupSep         string                     `koanf:"authHttpHeaderUserGroupSep"`
 	AuthLocalUsers                     AuthLocalUsersConfig       `koanf:"authLocalUsers"`
+	AuthLdap                           AuthLdapConfig             `koanf:"authLdap"`
 	AuthLoginUrl                       string                     `koanf:"authLoginUrl"`
 	AuthRequireGuestsToLogin           bool                       `koanf:"authRequireGuestsToLogin"`
 	AuthOAuth2RedirectURL              string                     `koanf:"authOAuth2RedirectUrl"`
@@ -202,6 +463,7 @@ type Config struct {
 	DefaultPermissions                 PermissionsList            `koanf:"defaultPermissions"`
 	DefaultPolicy                      ConfigurationPolicy        `koanf:"defaultPolicy"`
 	AccessControlLists                 []*AccessControlList       `koanf:"accessControlLists"`
+	Usergroups                         []*UsergroupDefinition     `koanf:"usergroups"`
 	WebUIDir                           string                     `koanf:"webUIDir"`
 	CronSupportForSeconds              bool                       `koanf:"cronSupportForSeconds"`
 	SectionNavigationStyle             string                     `koanf:"sectionNavigationStyle"`
@@ -214,6 +476,7 @@ type Config struct {
 	InsecureAllowDumpJwtClaims         bool                       `koanf:"insecureAllowDumpJwtClaims"`
 	Prometheus                         PrometheusConfig           `koanf:"prometheus"`
 	Security                           SecurityConfig             `koanf:"security"`
+	AuditLog                           AuditLogConfig             `koanf:"auditLog"`
 	SaveLogs                           SaveLogsConfig             `koanf:"saveLogs"`
 	ServiceLogs                        ServiceLogsConfig          `koanf:"serviceLogs"`
 	DefaultIconForActions              string                     `koanf:"defaultIconForActions"`
@@ -225,6 +488,12 @@ type Config struct {
 	BannerMessage                      string                     `koanf:"bannerMessage"`
 	BannerCSS                          string                     `koanf:"bannerCss"`
 	Include                            string                     `koanf:"include"`
+	Agents                             AgentsConfig               `koanf:"agents"`
+	ContainerEngine                    ContainerEngineConfig      `koanf:"containerEngine"`
+	Notifications                      NotificationsConfig        `koanf:"notifications"`
+	OutgoingWebhooks                   []*OutgoingWebhookConfig   `koanf:"outgoingWebhooks"`
+	Mqtt                               MqttConfig                 `koanf:"mqtt"`
+	Workflows                          []*Workflow                `koanf:"workflows"`
 
 	sourceFiles []string
 }
@@ -232,31 +501,63 @@ type Config struct {
 type AuthLocalUsersConfig struct {
 	Enabled bool         `koanf:"enabled"`
 	Users   []*LocalUser `koanf:"users"`
+	Totp    TotpConfig   `koanf:"totp"`
+}
+
+// TotpConfig lets local users enrol an authenticator app, whose codes they
+// then enter after their password. The secrets are encrypted with the
+// encryption key.
+type TotpConfig struct {
+	Enabled       bool   `koanf:"enabled"`
+	Issuer        string `koanf:"issuer"`
+	EncryptionKey string `koanf:"encryptionKey"`
 }
 
 type LocalUser struct {
-	Username  string `koanf:"username"`
-	Usergroup string `koanf:"usergroup"`
-	Password  string `koanf:"password"`
-	ApiKey    string `koanf:"apiKey"`
+	Username   string   `koanf:"username"`
+	Usergroup  string   `koanf:"usergroup"`
+	Usergroups []string `koanf:"usergroups"`
+	Password   string   `koanf:"password"`
+	ApiKey     string   `koanf:"apiKey"`
+}
+
+// AuthLdapConfig checks the passwords of users that are not local users
+// against an LDAP directory, such as Active Directory.
+type AuthLdapConfig struct {
+	Enabled            bool              `koanf:"enabled"`
+	URL                string            `koanf:"url"`
+	StartTLS           bool              `koanf:"startTls"`
+	InsecureSkipVerify bool              `koanf:"insecureSkipVerify"`
+	BindDN             string            `koanf:"bindDn"`
+	BindPassword       string            `koanf:"bindPassword"`
+	BaseDN             string            `koanf:"baseDn"`
+	UserFilter         string            `koanf:"userFilter"`
+	UsernameAttribute  string            `koanf:"usernameAttribute"`
+	GroupAttribute     string            `koanf:"groupAttribute"`
+	GroupMap           map[string]string `koanf:"groupMap"`
+	Timeout            int               `koanf:"timeout"`
+	SessionMaxAge      int               `koanf:"sessionMaxAge"`
 }
 
 type OAuth2Provider struct {
-	Name               string   `koanf:"name"`
-	Title              string   `koanf:"title"`
-	ClientID           string   `koanf:"clientId"`
-	ClientSecret       string   `koanf:"clientSecret"`
-	Icon               string   `koanf:"icon"`
-	Scopes             []string `koanf:"scopes"`
-	AuthUrl            string   `koanf:"authUrl"`
-	TokenUrl           string   `koanf:"tokenUrl"`
-	WhoamiUrl          string   `koanf:"whoamiUrl"`
-	UsernameField      string   `koanf:"usernameField"`
-	UserGroupField     string   `koanf:"userGroupField"`
-	InsecureSkipVerify bool     `koanf:"insecureSkipVerify"`
-	CallbackTimeout    int      `koanf:"callbackTimeout"`
-	CertBundlePath     string   `koanf:"certBundlePath"`
-	AddToUsergroup     string   `koanf:"addToUsergroup"`
+	Name                  string   `koanf:"name"`
+	Title                 string   `koanf:"title"`
+	ClientID              string   `koanf:"clientId"`
+	ClientSecret          string   `koanf:"clientSecret"`
+	Icon                  string   `koanf:"icon"`
+	Scopes                []string `koanf:"scopes"`
+	AuthUrl               string   `koanf:"authUrl"`
+	TokenUrl              string   `koanf:"tokenUrl"`
+	WhoamiUrl             string   `koanf:"whoamiUrl"`
+	UsernameField         string   `koanf:"usernameField"`
+	UserGroupField        string   `koanf:"userGroupField"`
+	InsecureSkipVerify    bool     `koanf:"insecureSkipVerify"`
+	CallbackTimeout       int      `koanf:"callbackTimeout"`
+	CertBundlePath        string   `koanf:"certBundlePath"`
+	AddToUsergroup        string   `koanf:"addToUsergroup"`
+	IssuerUrl             string   `koanf:"issuerUrl"`
+	Pkce                  bool     `koanf:"pkce"`
+	PostLogoutRedirectUrl string   `koanf:"postLogoutRedirectUrl"`
 }
 
 type NavigationLink struct {
@@ -270,6 +571,34 @@ type SaveLogsConfig struct {
 	OutputDirectory  string `koanf:"outputDirectory"`
 }
 
+// LogRetentionConfig limits how many execution logs are kept, both in the
+// history and in the saveLogs directories. Zero values mean no limit.
+type LogRetentionConfig struct {
+	MaxAge            string `koanf:"maxAge"`
+	MaxCountPerAction int    `koanf:"maxCountPerAction"`
+	MaxTotalBytes     int64  `koanf:"maxTotalBytes"`
+}
+
+// AgentsConfig allows olivetin-agent processes on other hosts to connect, so
+// that actions with runOn set can be executed on them.
+type AgentsConfig struct {
+	Token string `koanf:"token"`
+
+	// Tokens are per agent tokens, by agent name. An agent with a name in
+	// Tokens can only connect with its own token, and not with Token.
+	Tokens map[string]string `koanf:"tokens"`
+}
+
+func (cfg *Config) AgentsEnabled() bool {
+	return cfg.Agents.Token != "" || len(cfg.Agents.Tokens) > 0
+}
+
+// ContainerEngineConfig is where to find the Docker Engine API, which Podman
+// also provides, for actions that run in containers.
+type ContainerEngineConfig struct {
+	Socket string `koanf:"socket"`
+}
+
 type ServiceLogsConfig struct {
 	Directory string `koanf:"directory"`
 }
@@ -340,6 +669,11 @@ func DefaultConfigWithBasePort(basePort int) *Config {
 	config.DefaultIconForBack = "&laquo;"
 	config.ThemeCacheDisabled = false
 	config.ServiceHostMode = ""
+	config.ContainerEngine.Socket = "/var/run/docker.sock"
+	config.AuthLdap.UserFilter = "(uid={username})"
+	config.AuthLdap.GroupAttribute = "memberOf"
+	config.AuthLdap.Timeout = 5
+	config.AuthLdap.SessionMaxAge = 3600
 
 	config.ListenAddressSingleHTTPFrontend = fmt.Sprintf("0.0.0.0:%d", basePort)
 	config.ListenAddressRestActions = fmt.Sprintf("localhost:%d", basePort+1)
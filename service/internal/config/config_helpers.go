@@ -1,5 +1,11 @@
 package config
 
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
 // FindAction will return a action if there is a match on Title
 func (cfg *Config) findAction(actionTitle string) *Action {
 	for _, action := range cfg.Actions {
@@ -53,6 +59,24 @@ func (cfg *Config) FindUserByUsername(searchUsername string) *LocalUser {
 	return nil
 }
 
+// UsergroupLine joins usergroup and usergroups with the separator that is used
+// to split usergroup lines, or a space when there is none.
+func (user *LocalUser) UsergroupLine(sep string) string {
+	if sep == "" {
+		sep = " "
+	}
+
+	groups := make([]string, 0, len(user.Usergroups)+1)
+
+	for _, group := range append([]string{user.Usergroup}, user.Usergroups...) {
+		if group != "" && !slices.Contains(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+
+	return strings.Join(groups, sep)
+}
+
 func (cfg *Config) SetDir(dir string) {
 	cfg.sourceFiles = append(cfg.sourceFiles, dir)
 }
@@ -63,3 +87,25 @@ func (cfg *Config) GetDir() string {
 	}
 	return cfg.sourceFiles[len(cfg.sourceFiles)-1]
 }
+
+// GetStateDir returns the directory for files that OliveTin writes often
+// while it runs, such as queues. It is a "state" directory inside the config
+// directory by default, so that writing to these files does not wake the
+// config file watcher, or get them read as included config files. Relative
+// paths are relative to the config directory. It is empty when there is no
+// config directory either.
+func (cfg *Config) GetStateDir() string {
+	if filepath.IsAbs(cfg.StateDirectory) {
+		return cfg.StateDirectory
+	}
+
+	if cfg.GetDir() == "" {
+		return ""
+	}
+
+	if cfg.StateDirectory == "" {
+		return filepath.Join(cfg.GetDir(), "state")
+	}
+
+	return filepath.Join(cfg.GetDir(), cfg.StateDirectory)
+}
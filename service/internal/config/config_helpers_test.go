@@ -1,6 +1,7 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,6 +54,20 @@ func TestSetDir(t *testing.T) {
 	assert.Equal(t, "test", c.GetDir(), "SetDir")
 }
 
+func TestGetStateDir(t *testing.T) {
+	c := DefaultConfig()
+	assert.Equal(t, "", c.GetStateDir(), "No state directory without a config directory")
+
+	c.SetDir("config")
+	assert.Equal(t, filepath.Join("config", "state"), c.GetStateDir())
+
+	c.StateDirectory = "queues"
+	assert.Equal(t, filepath.Join("config", "queues"), c.GetStateDir())
+
+	c.StateDirectory = t.TempDir()
+	assert.Equal(t, c.StateDirectory, c.GetStateDir())
+}
+
 func TestFindUserByUsername(t *testing.T) {
 	c := DefaultConfig()
 
@@ -89,3 +104,15 @@ func TestFindUserByUsername(t *testing.T) {
 	assert.Nil(t, c.FindUserByUsername("nonexistent"), "Find non-existent user should return nil")
 	assert.Nil(t, c.FindUserByUsername(""), "Find empty username should return nil")
 }
+
+func TestLocalUserUsergroupLine(t *testing.T) {
+	user := &LocalUser{
+		Username:   "alice",
+		Usergroup:  "staff",
+		Usergroups: []string{"oncall", "staff", "ops"},
+	}
+
+	assert.Equal(t, "staff oncall ops", user.UsergroupLine(""))
+	assert.Equal(t, "staff,oncall,ops", user.UsergroupLine(","))
+	assert.Equal(t, "", (&LocalUser{}).UsergroupLine(""))
+}
@@ -56,6 +56,7 @@ func unmarshalRoot(k *koanf.Koanf, cfg *Config) bool {
 			DecodeHook: mapstructure.ComposeDecodeHookFunc(
 				envDecodeHookFunc,
 				justificationDecodeHookFunc,
+				actionTriggerDecodeHookFunc,
 				mapstructure.StringToTimeDurationHookFunc(),
 				mapstructure.TextUnmarshallerHookFunc(),
 			),
@@ -272,6 +273,16 @@ func justificationDecodeHookFunc(from reflect.Type, to reflect.Type, data any) (
 	return "", nil
 }
 
+// actionTriggerDecodeHookFunc allows triggers to be written as just the title
+// of the action, as they were before triggers had conditions.
+func actionTriggerDecodeHookFunc(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if from.Kind() != reflect.String || to != reflect.TypeOf(ActionTrigger{}) {
+		return data, nil
+	}
+
+	return ActionTrigger{Action: data.(string)}, nil
+}
+
 func envDecodeHookFunc(from reflect.Type, to reflect.Type, data any) (any, error) {
 	log.Debugf("envDecodeHookFunc called: from=%v, to=%v, data=%v", from, to, data)
 	if from.Kind() != reflect.String {
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/OliveTin/OliveTin/internal/env"
+	"github.com/OliveTin/OliveTin/internal/logfilter"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 )
@@ -17,6 +19,8 @@ func (cfg *Config) Sanitize() {
 	cfg.sanitizeAuthRequireGuestsToLogin()
 	cfg.sanitizeLogHistoryPageSize()
 	cfg.sanitizeLocalUsers()
+	cfg.sanitizeUsergroups()
+	cfg.sanitizeLdap()
 	cfg.sanitizeSecurityHeaders()
 	cfg.sanitizeOnClickDefaults()
 
@@ -31,6 +35,10 @@ func (cfg *Config) Sanitize() {
 	cfg.sanitizeActionGroups()
 	cfg.sanitizeActionGroupReferences()
 	cfg.sanitizeEntities()
+	cfg.sanitizeNotifications()
+	cfg.sanitizeOutgoingWebhooks()
+	cfg.sanitizeMqtt()
+	cfg.sanitizeWorkflows()
 
 	if err := cfg.validateReservedActionArgumentNames(); err != nil {
 		log.Fatalf("%v", err)
@@ -228,6 +236,12 @@ func (action *Action) sanitize(cfg *Config) {
 	action.Icon = lookupHTMLIcon(action.Icon, cfg.DefaultIconForActions)
 	migrateActionOnClick(action)
 	action.sanitizeJustification()
+	action.sanitizeApproval()
+	action.sanitizeOutputFormat()
+	action.sanitizeTriggers()
+	action.sanitizeRetry()
+	action.sanitizeCron()
+	action.sanitizeContainer()
 	action.OnClick = sanitizeOnClick(action.OnClick, cfg)
 	action.PopupOnStart = action.OnClick
 
@@ -242,6 +256,16 @@ func (action *Action) sanitize(cfg *Config) {
 	}
 }
 
+// defaultContainerPullTimeout is long enough to pull most images over a slow
+// connection.
+const defaultContainerPullTimeout = 300
+
+func (action *Action) sanitizeContainer() {
+	if action.Container.PullTimeout < 1 {
+		action.Container.PullTimeout = defaultContainerPullTimeout
+	}
+}
+
 func dedupeStrings(values []string) []string {
 	seen := make(map[string]struct{}, len(values))
 	out := make([]string, 0, len(values))
@@ -299,6 +323,13 @@ func (cfg *Config) sanitizeEntities() {
 
 		entityFile.Icon = lookupHTMLIcon(entityFile.Icon, "")
 		sanitizeEntityProperties(entityFile)
+		sanitizeEntityInterval(entityFile)
+	}
+}
+
+func sanitizeEntityInterval(entityFile *EntityFile) {
+	if entityFile.IsPolled() && entityFile.Interval < 1 {
+		entityFile.Interval = 60
 	}
 }
 
@@ -356,6 +387,32 @@ func (cfg *Config) sanitizeLocalUsers() {
 	if err := validateUniqueLocalUserAPIKeys(cfg.AuthLocalUsers.Users); err != nil {
 		log.Fatalf("%v", err)
 	}
+
+	cfg.sanitizeTotp()
+}
+
+// sanitizeTotp disables TOTP without an encryption key, as the secrets would
+// otherwise be stored in plain text. A template that could not be expanded,
+// such as for a missing env var, is not a key either. Users who have already
+// enrolled cannot log in while TOTP is disabled.
+func (cfg *Config) sanitizeTotp() {
+	totp := &cfg.AuthLocalUsers.Totp
+
+	if !totp.Enabled {
+		return
+	}
+
+	totp.EncryptionKey = expandEnvTemplate(totp.EncryptionKey)
+
+	if totp.EncryptionKey == "" || strings.Contains(totp.EncryptionKey, "{{") {
+		log.Errorf("authLocalUsers.totp is enabled without an encryptionKey, so it has been disabled, and users who enrolled cannot log in")
+		totp.EncryptionKey = ""
+		totp.Enabled = false
+	}
+
+	if totp.Issuer == "" {
+		totp.Issuer = "OliveTin"
+	}
 }
 
 func expandLocalUserEnvTemplates(user *LocalUser) {
@@ -372,6 +429,43 @@ func expandLocalUserEnvTemplates(user *LocalUser) {
 	}
 }
 
+// sanitizeUsergroups drops usergroup definitions without a name, as no ACL
+// could match them.
+func (cfg *Config) sanitizeUsergroups() {
+	ret := make([]*UsergroupDefinition, 0, len(cfg.Usergroups))
+
+	for _, def := range cfg.Usergroups {
+		if def == nil || def.Name == "" {
+			log.Warnf("Ignoring a usergroup without a name")
+			continue
+		}
+
+		ret = append(ret, def)
+	}
+
+	cfg.Usergroups = ret
+}
+
+func (cfg *Config) sanitizeLdap() {
+	if !cfg.AuthLdap.Enabled {
+		return
+	}
+
+	cfg.AuthLdap.BindPassword = expandEnvTemplate(cfg.AuthLdap.BindPassword)
+
+	if cfg.AuthLdap.URL == "" || cfg.AuthLdap.BaseDN == "" {
+		log.Warnf("authLdap is enabled, but url or baseDn is not set, so it has been disabled")
+		cfg.AuthLdap.Enabled = false
+	}
+
+	if !strings.Contains(cfg.AuthLdap.UserFilter, "{username}") {
+		log.Warnf("authLdap.userFilter does not contain {username}, so every user would match the same entry")
+	}
+
+	cfg.AuthLdap.Timeout = max(cfg.AuthLdap.Timeout, 1)
+	cfg.AuthLdap.SessionMaxAge = max(cfg.AuthLdap.SessionMaxAge, 60)
+}
+
 // validateUniqueLocalUserAPIKeys returns an error when two local users share the same non-empty apiKey.
 func validateUniqueLocalUserAPIKeys(users []*LocalUser) error {
 	seen := make(map[string]string)
@@ -478,6 +572,216 @@ func (action *Action) sanitizeJustification() {
 	}
 }
 
+// sanitizeTriggers compiles trigger conditions, so that mistakes are logged
+// when the config is loaded rather than each time the action finishes.
+func (action *Action) sanitizeTriggers() {
+	for _, trigger := range action.Triggers {
+		if _, err := logfilter.Compile(trigger.If); err != nil {
+			log.WithFields(log.Fields{
+				"actionTitle":  action.Title,
+				"triggerTitle": trigger.Action,
+				"error":        err,
+			}).Errorf("Invalid trigger condition, the action will not be triggered")
+		}
+	}
+}
+
+const defaultRetryBackoff = "5s"
+
+func (action *Action) sanitizeRetry() {
+	if action.Retry.Attempts < 1 {
+		action.Retry.Attempts = 1
+	}
+
+	if action.Retry.Backoff == "" {
+		action.Retry.Backoff = defaultRetryBackoff
+	}
+
+	if _, err := time.ParseDuration(action.Retry.Backoff); err != nil {
+		log.WithFields(log.Fields{
+			"actionTitle": action.Title,
+			"backoff":     action.Retry.Backoff,
+		}).Warnf("Invalid retry backoff, using %v", defaultRetryBackoff)
+
+		action.Retry.Backoff = defaultRetryBackoff
+	}
+}
+
+func (action *Action) sanitizeCron() {
+	if action.CronCatchUp < 0 {
+		action.CronCatchUp = 0
+	}
+
+	if action.CronTimezone == "" {
+		return
+	}
+
+	if _, err := time.LoadLocation(action.CronTimezone); err != nil {
+		log.WithFields(log.Fields{
+			"actionTitle": action.Title,
+			"timezone":    action.CronTimezone,
+		}).Warnf("Invalid cron timezone, using the local timezone")
+
+		action.CronTimezone = ""
+	}
+}
+
+const defaultApprovalExpiry = "1h"
+
+func (action *Action) sanitizeApproval() {
+	if !action.RequiresApproval() {
+		return
+	}
+
+	if action.Approval.Count < 1 {
+		action.Approval.Count = 1
+	}
+
+	if action.Approval.Expiry == "" {
+		action.Approval.Expiry = defaultApprovalExpiry
+	}
+}
+
+func (action *Action) sanitizeOutputFormat() {
+	action.OutputFormat = strings.ToLower(action.OutputFormat)
+
+	switch action.OutputFormat {
+	case "", OutputFormatJSON, OutputFormatYAML, OutputFormatKeyValue:
+	default:
+		log.WithFields(log.Fields{
+			"actionTitle":  action.Title,
+			"outputFormat": action.OutputFormat,
+		}).Warnf("Unknown outputFormat, the output of this action will not be parsed")
+
+		action.OutputFormat = ""
+	}
+}
+
+func (cfg *Config) sanitizeNotifications() {
+	for name, channel := range cfg.Notifications.Channels {
+		if channel == nil {
+			delete(cfg.Notifications.Channels, name)
+			continue
+		}
+
+		channel.sanitize(name)
+	}
+
+	for _, action := range cfg.Actions {
+		for idx := range action.Notify {
+			cfg.sanitizeNotificationRule(action, &action.Notify[idx])
+		}
+	}
+}
+
+func (channel *NotificationChannel) sanitize(name string) {
+	channel.Type = strings.ToLower(channel.Type)
+
+	switch channel.Type {
+	case NotificationChannelEmail, NotificationChannelSlack, NotificationChannelNtfy, NotificationChannelGotify:
+	default:
+		log.WithFields(log.Fields{
+			"channel": name,
+			"type":    channel.Type,
+		}).Warnf("Unknown notification channel type, notifications will not be sent to this channel")
+	}
+
+	if channel.Smtp.Port == 0 {
+		channel.Smtp.Port = 25
+	}
+}
+
+// sanitizeNotificationRule defaults to notifying on every execution that ran,
+// which does not include blocked executions.
+func (cfg *Config) sanitizeNotificationRule(action *Action, rule *NotificationRule) {
+	if _, found := cfg.Notifications.Channels[rule.Channel]; !found {
+		log.WithFields(log.Fields{
+			"actionTitle": action.Title,
+			"channel":     rule.Channel,
+		}).Warnf("Action notifies an unknown notification channel")
+	}
+
+	if len(rule.On) == 0 {
+		rule.On = []string{NotifyOnSuccess, NotifyOnFailure, NotifyOnTimeout}
+	}
+
+	for idx, event := range rule.On {
+		rule.On[idx] = strings.ToLower(event)
+	}
+}
+
+func (cfg *Config) sanitizeOutgoingWebhooks() {
+	for idx, webhook := range cfg.OutgoingWebhooks {
+		if webhook.Name == "" {
+			webhook.Name = fmt.Sprintf("webhook-%d", idx)
+		}
+
+		if webhook.AuthHeader == "" {
+			webhook.AuthHeader = "X-Webhook-Signature"
+		}
+
+		if webhook.MaxAttempts < 1 {
+			webhook.MaxAttempts = 5
+		}
+
+		webhook.sanitizeEvents()
+	}
+}
+
+func (webhook *OutgoingWebhookConfig) sanitizeEvents() {
+	if len(webhook.Events) == 0 {
+		webhook.Events = []string{WebhookEventQueued, WebhookEventStarted, WebhookEventFinished, WebhookEventBlocked}
+	}
+
+	for i, event := range webhook.Events {
+		webhook.Events[i] = strings.ToLower(event)
+	}
+}
+
+func (cfg *Config) sanitizeMqtt() {
+	for name, broker := range cfg.Mqtt.Brokers {
+		if broker == nil {
+			delete(cfg.Mqtt.Brokers, name)
+		}
+	}
+
+	for _, action := range cfg.Actions {
+		cfg.sanitizeActionMqtt(action)
+	}
+}
+
+func (cfg *Config) sanitizeActionMqtt(action *Action) {
+	for idx := range action.ExecOnMqtt {
+		trigger := &action.ExecOnMqtt[idx]
+		trigger.Broker = cfg.sanitizeMqttBroker(action, trigger.Broker)
+		trigger.Qos = min(trigger.Qos, 2)
+	}
+
+	for idx := range action.PublishToMqtt {
+		publish := &action.PublishToMqtt[idx]
+		publish.Broker = cfg.sanitizeMqttBroker(action, publish.Broker)
+		publish.Qos = min(publish.Qos, 2)
+	}
+}
+
+// sanitizeMqttBroker defaults to the only broker, when there is just one.
+func (cfg *Config) sanitizeMqttBroker(action *Action, broker string) string {
+	if broker == "" && len(cfg.Mqtt.Brokers) == 1 {
+		for name := range cfg.Mqtt.Brokers {
+			return name
+		}
+	}
+
+	if _, found := cfg.Mqtt.Brokers[broker]; !found {
+		log.WithFields(log.Fields{
+			"actionTitle": action.Title,
+			"broker":      broker,
+		}).Warnf("Action uses an unknown MQTT broker")
+	}
+
+	return broker
+}
+
 func shouldMigrateDefaultOnClickFromPopup(onClick, popupOnStart string) bool {
 	if popupOnStart == "" {
 		return false
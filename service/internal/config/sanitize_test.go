@@ -293,3 +293,21 @@ func TestValidateChecklistChoiceValuesAllowsCommas(t *testing.T) {
 	err := c.validateChecklistChoiceValues()
 	require.NoError(t, err)
 }
+
+func TestSanitizeApprovalDefaults(t *testing.T) {
+	c := DefaultConfig()
+	c.Actions = append(c.Actions, &Action{
+		Title:    "Reboot",
+		Approval: ApprovalConfig{Usergroups: []string{"admins"}},
+	})
+	c.Actions = append(c.Actions, &Action{
+		Title: "Ping",
+	})
+
+	c.Sanitize()
+
+	assert.Equal(t, 1, c.Actions[0].Approval.Count)
+	assert.Equal(t, defaultApprovalExpiry, c.Actions[0].Approval.Expiry)
+	assert.False(t, c.Actions[1].RequiresApproval())
+	assert.Equal(t, "", c.Actions[1].Approval.Expiry)
+}
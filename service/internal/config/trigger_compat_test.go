@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggersCanBeTitlesOrObjects(t *testing.T) {
+	cfg := loadJustificationCompatConfig(t, `
+actions:
+  - title: Backup
+    shell: /opt/backup.sh
+    triggers:
+      - Update backups
+      - action: Clean up
+        onFailure: true
+        onExitCode: [1, 2]
+        if: Output contains "disk full"
+        arguments:
+          reason: "{{ output }}"
+`)
+
+	require.Len(t, cfg.Actions, 1)
+	require.Len(t, cfg.Actions[0].Triggers, 2)
+
+	assert.Equal(t, ActionTrigger{Action: "Update backups"}, cfg.Actions[0].Triggers[0])
+
+	cleanup := cfg.Actions[0].Triggers[1]
+	assert.Equal(t, "Clean up", cleanup.Action)
+	assert.True(t, cleanup.OnFailure)
+	assert.Equal(t, []int32{1, 2}, cleanup.OnExitCode)
+	assert.Equal(t, `Output contains "disk full"`, cleanup.If)
+	assert.Equal(t, map[string]string{"reason": "{{ output }}"}, cleanup.Arguments)
+}
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// FindWorkflow returns the workflow with the ID, or nil.
+func (cfg *Config) FindWorkflow(id string) *Workflow {
+	for _, workflow := range cfg.Workflows {
+		if workflow.ID == id {
+			return workflow
+		}
+	}
+
+	return nil
+}
+
+// FindStep returns the step with the ID, or nil.
+func (workflow *Workflow) FindStep(id string) *WorkflowStep {
+	for idx := range workflow.Steps {
+		if workflow.Steps[idx].ID == id {
+			return &workflow.Steps[idx]
+		}
+	}
+
+	return nil
+}
+
+func (cfg *Config) sanitizeWorkflows() {
+	for idx, workflow := range cfg.Workflows {
+		workflow.sanitize(idx)
+
+		if err := cfg.ValidateWorkflow(workflow); err != nil {
+			log.WithFields(log.Fields{
+				"workflow": workflow.ID,
+				"error":    err,
+			}).Errorf("Invalid workflow, it cannot be started")
+		}
+	}
+}
+
+func (workflow *Workflow) sanitize(idx int) {
+	if workflow.ID == "" {
+		workflow.ID = fmt.Sprintf("workflow-%d", idx)
+	}
+
+	if workflow.Title == "" {
+		workflow.Title = workflow.ID
+	}
+
+	for stepIdx := range workflow.Steps {
+		workflow.Steps[stepIdx].sanitize()
+	}
+}
+
+// sanitize defaults the ID of a step to the title of its action, so that
+// simple workflows can refer to steps by action title.
+func (step *WorkflowStep) sanitize() {
+	if step.ID == "" {
+		step.ID = step.Action
+	}
+
+	step.If = strings.ToLower(step.If)
+
+	if step.If == "" {
+		step.If = WorkflowStepIfSuccess
+	}
+
+	if len(step.ExitCodes) == 0 {
+		step.ExitCodes = []int32{0}
+	}
+}
+
+// ValidateWorkflow checks that every step runs an action that exists, and
+// that the steps form a graph without cycles.
+func (cfg *Config) ValidateWorkflow(workflow *Workflow) error {
+	if len(workflow.Steps) == 0 {
+		return fmt.Errorf("workflow has no steps")
+	}
+
+	for idx := range workflow.Steps {
+		if err := cfg.validateWorkflowStep(workflow, &workflow.Steps[idx]); err != nil {
+			return fmt.Errorf("step %q: %w", workflow.Steps[idx].ID, err)
+		}
+	}
+
+	return findWorkflowCycle(workflow)
+}
+
+func (cfg *Config) validateWorkflowStep(workflow *Workflow, step *WorkflowStep) error {
+	if cfg.findAction(step.Action) == nil {
+		return fmt.Errorf("unknown action %q", step.Action)
+	}
+
+	if workflow.FindStep(step.ID) != step {
+		return fmt.Errorf("step IDs must be unique")
+	}
+
+	if !slices.Contains([]string{WorkflowStepIfSuccess, WorkflowStepIfFailure, WorkflowStepIfAlways}, step.If) {
+		return fmt.Errorf("unknown condition %q", step.If)
+	}
+
+	return validateWorkflowNeeds(workflow, step)
+}
+
+func validateWorkflowNeeds(workflow *Workflow, step *WorkflowStep) error {
+	for _, need := range step.Needs {
+		if workflow.FindStep(need) == nil {
+			return fmt.Errorf("needs unknown step %q", need)
+		}
+	}
+
+	return nil
+}
+
+// findWorkflowCycle does a depth first search of the steps, where finding a
+// step that is still being visited means there is a cycle.
+func findWorkflowCycle(workflow *Workflow) error {
+	search := &cycleSearch{
+		workflow: workflow,
+		state:    make(map[string]int),
+	}
+
+	for _, step := range workflow.Steps {
+		if err := search.visit(step.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	stepVisiting = 1
+	stepVisited  = 2
+)
+
+type cycleSearch struct {
+	workflow *Workflow
+	state    map[string]int
+}
+
+func (search *cycleSearch) visit(id string) error {
+	switch search.state[id] {
+	case stepVisiting:
+		return fmt.Errorf("steps depend on each other in a cycle, which includes %q", id)
+	case stepVisited:
+		return nil
+	}
+
+	search.state[id] = stepVisiting
+
+	for _, need := range search.workflow.FindStep(id).Needs {
+		if err := search.visit(need); err != nil {
+			return err
+		}
+	}
+
+	search.state[id] = stepVisited
+
+	return nil
+}
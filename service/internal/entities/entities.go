@@ -59,6 +59,11 @@ func resolveEntitiesBaseDir(configDir string) string {
 }
 
 func watchAndLoadEntity(baseDir string, ef *config.EntityFile) {
+	if ef.IsPolled() {
+		go pollEntitySource(ef)
+		return
+	}
+
 	p := ef.File
 	if !filepath.IsAbs(p) {
 		p = filepath.Join(baseDir, p)
@@ -89,24 +94,40 @@ func loadEntityFileJson(filename string, entityname string) {
 		return
 	}
 
+	data, err := parseEntitiesJson(jfile)
+
+	if err != nil {
+		log.Errorf("%v", err)
+		return
+	}
+
+	updateSvFromFile(entityname, data)
+}
+
+// parseEntitiesJson parses either a JSON array of objects, or a stream of
+// objects, such as one object per line.
+func parseEntitiesJson(content []byte) ([]map[string]any, error) {
 	data := make([]map[string]any, 0)
 
-	decoder := json.NewDecoder(bytes.NewReader(jfile))
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("[")) {
+		err := json.Unmarshal(content, &data)
+
+		return data, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
 
 	for decoder.More() {
 		d := make(map[string]any)
 
-		err := decoder.Decode(&d)
-
-		if err != nil {
-			log.Errorf("%v", err)
-			return
+		if err := decoder.Decode(&d); err != nil {
+			return nil, err
 		}
 
 		data = append(data, d)
 	}
 
-	updateSvFromFile(entityname, data)
+	return data, nil
 }
 
 func loadEntityFileYaml(filename string, entityname string) {
@@ -141,9 +162,7 @@ func updateSvFromFile(entityname string, data []map[string]any) {
 		AddEntity(entityname, fmt.Sprintf("%d", i), mapp)
 	}
 
-	for _, l := range listeners {
-		l()
-	}
+	notifyListeners(entityname)
 }
 
 /*
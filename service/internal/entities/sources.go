@@ -0,0 +1,261 @@
+package entities
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"path"
+	"strings"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/shell"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+/*
+Entities can be loaded from a url or the output of a command, as well as from
+files. These are polled every interval, rather than watched, and the entities
+are only replaced when the content has changed, so that listeners are not
+notified on every poll.
+*/
+
+// entitySourceTimeout is how long a single poll of a url or command may take.
+const entitySourceTimeout = 30 * time.Second
+
+// maxEntitySourceSize stops a misbehaving url or command from using all of
+// the memory.
+const maxEntitySourceSize = 16 << 20
+
+var errEntitiesNotModified = errors.New("entities have not been modified")
+
+var errEntitySourceTooLarge = fmt.Errorf("output is larger than %v bytes", maxEntitySourceSize)
+
+type entitySource struct {
+	name  string
+	fetch func(ctx context.Context) ([]byte, bool, error)
+
+	// lastContent is the content of the last successful poll.
+	lastContent []byte
+}
+
+func newEntitySource(ef *config.EntityFile) *entitySource {
+	source := &entitySource{
+		name: ef.Name,
+	}
+
+	if ef.Url != "" {
+		source.fetch = newUrlFetcher(ef.Url, ef.Headers).fetch
+	} else {
+		source.fetch = commandFetcher(ef.Command)
+	}
+
+	return source
+}
+
+func pollEntitySource(ef *config.EntityFile) {
+	source := newEntitySource(ef)
+	interval := time.Duration(ef.Interval) * time.Second
+
+	for {
+		if err := source.load(); err != nil {
+			log.WithFields(log.Fields{
+				"name":    ef.Name,
+				"url":     ef.Url,
+				"command": ef.Command,
+				"error":   err,
+			}).Warnf("Could not load entities")
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// load polls the source once, and replaces its entities if they have changed.
+func (s *entitySource) load() error {
+	ctx, cancel := context.WithTimeout(context.Background(), entitySourceTimeout)
+	defer cancel()
+
+	content, isYaml, err := s.fetch(ctx)
+
+	switch {
+	case errors.Is(err, errEntitiesNotModified):
+		return nil
+	case err != nil:
+		return err
+	case bytes.Equal(content, s.lastContent):
+		return nil
+	}
+
+	data, err := parseEntities(content, isYaml)
+
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"name":  s.name,
+		"count": len(data),
+	}).Infof("Loaded entities")
+
+	s.lastContent = content
+	updateSvFromFile(s.name, data)
+
+	return nil
+}
+
+func parseEntities(content []byte, isYaml bool) ([]map[string]any, error) {
+	if !isYaml {
+		return parseEntitiesJson(content)
+	}
+
+	var data []map[string]any
+
+	err := yaml.Unmarshal(content, &data)
+
+	return data, err
+}
+
+// urlFetcher gets entities over HTTP, and uses the ETag of the last response
+// so that servers can respond with 304 Not Modified.
+type urlFetcher struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+	etag    string
+}
+
+func newUrlFetcher(url string, headers map[string]string) *urlFetcher {
+	return &urlFetcher{
+		url:     url,
+		headers: headers,
+		client:  &http.Client{},
+	}
+}
+
+func (f *urlFetcher) fetch(ctx context.Context) ([]byte, bool, error) {
+	req, err := f.newRequest(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer func() { _ = res.Body.Close() }()
+
+	content, err := readEntitiesResponse(res)
+	if err != nil {
+		return nil, false, err
+	}
+
+	f.etag = res.Header.Get("ETag")
+
+	return content, isYamlResponse(res), nil
+}
+
+func (f *urlFetcher) newRequest(ctx context.Context) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range f.headers {
+		req.Header.Set(name, value)
+	}
+
+	if f.etag != "" {
+		req.Header.Set("If-None-Match", f.etag)
+	}
+
+	return req, nil
+}
+
+func readEntitiesResponse(res *http.Response) ([]byte, error) {
+	switch res.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(io.LimitReader(res.Body, maxEntitySourceSize))
+	case http.StatusNotModified:
+		return nil, errEntitiesNotModified
+	default:
+		return nil, fmt.Errorf("unexpected response status: %v", res.Status)
+	}
+}
+
+// isYamlResponse checks the content type, and then the url path, as many
+// servers do not have a content type for YAML files.
+func isYamlResponse(res *http.Response) bool {
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+
+	if strings.Contains(mediaType, "yaml") {
+		return true
+	}
+
+	if strings.Contains(mediaType, "json") {
+		return false
+	}
+
+	return isYamlPath(res.Request.URL)
+}
+
+func isYamlPath(u *url.URL) bool {
+	ext := path.Ext(u.Path)
+
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// commandFetcher runs a command, and returns its stdout, which is parsed as
+// JSON. The command is killed if it writes more than maxEntitySourceSize.
+func commandFetcher(command string) func(ctx context.Context) ([]byte, bool, error) {
+	return func(ctx context.Context) ([]byte, bool, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		cmd := shell.Command(ctx, command)
+
+		stderr := &bytes.Buffer{}
+		cmd.Stderr = stderr
+
+		content, err := readCommandOutput(cmd, cancel)
+		if err != nil {
+			return nil, false, fmt.Errorf("%w: %v", err, strings.TrimSpace(stderr.String()))
+		}
+
+		return content, false, nil
+	}
+}
+
+func readCommandOutput(cmd *exec.Cmd, cancel context.CancelFunc) ([]byte, error) {
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(stdout, maxEntitySourceSize+1))
+
+	if len(content) > maxEntitySourceSize {
+		cancel()
+		_ = cmd.Wait()
+
+		return nil, errEntitySourceTooLarge
+	}
+
+	if waitErr := cmd.Wait(); waitErr != nil {
+		return nil, waitErr
+	}
+
+	return content, err
+}
@@ -0,0 +1,152 @@
+package entities
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func TestUrlEntitySourceUsesETag(t *testing.T) {
+	ClearEntitiesOfType("url_test")
+	defer ClearEntitiesOfType("url_test")
+
+	body := `[{"hostname": "web1"}, {"hostname": "web2"}]`
+	requests := 0
+	notModified := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	source := newEntitySource(&config.EntityFile{
+		Name:    "url_test",
+		Url:     srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	})
+
+	require.NoError(t, source.load())
+	assert.Len(t, GetEntityInstances("url_test"), 2)
+
+	ClearEntitiesOfType("url_test")
+
+	require.NoError(t, source.load())
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, notModified)
+	assert.Empty(t, GetEntityInstances("url_test"), "Entities are not replaced when the server responds with 304")
+}
+
+func TestUrlEntitySourceYaml(t *testing.T) {
+	ClearEntitiesOfType("url_yaml_test")
+	defer ClearEntitiesOfType("url_yaml_test")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("- name: one\n- name: two\n- name: three\n"))
+	}))
+	defer srv.Close()
+
+	source := newEntitySource(&config.EntityFile{
+		Name: "url_yaml_test",
+		Url:  srv.URL + "/servers.yaml",
+	})
+
+	require.NoError(t, source.load())
+
+	instances := GetEntityInstances("url_yaml_test")
+	require.Len(t, instances, 3)
+	assert.Equal(t, "two", instances["1"].Title)
+}
+
+func TestUrlEntitySourceErrorKeepsEntities(t *testing.T) {
+	ClearEntitiesOfType("url_error_test")
+	defer ClearEntitiesOfType("url_error_test")
+
+	AddEntity("url_error_test", "0", map[string]any{"name": "existing"})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	source := newEntitySource(&config.EntityFile{
+		Name: "url_error_test",
+		Url:  srv.URL,
+	})
+
+	assert.Error(t, source.load())
+	assert.Len(t, GetEntityInstances("url_error_test"), 1)
+}
+
+func TestCommandEntitySource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a unix shell")
+	}
+
+	ClearEntitiesOfType("command_test")
+	defer ClearEntitiesOfType("command_test")
+
+	notified := 0
+
+	AddEntityChangedListener(func(entityName string) {
+		if entityName == "command_test" {
+			notified++
+		}
+	})
+
+	source := newEntitySource(&config.EntityFile{
+		Name:    "command_test",
+		Command: `echo '{"name": "a"}'; echo '{"name": "b"}'`,
+	})
+
+	require.NoError(t, source.load())
+	require.NoError(t, source.load())
+
+	assert.Len(t, GetEntityInstances("command_test"), 2)
+	assert.Equal(t, 1, notified, "Listeners are only notified when the output changes")
+
+	failing := newEntitySource(&config.EntityFile{
+		Name:    "command_test",
+		Command: "echo oops >&2; exit 3",
+	})
+
+	err := failing.load()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "oops")
+}
+
+func TestCommandEntitySourceIsLimited(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a unix shell")
+	}
+
+	ClearEntitiesOfType("command_limit_test")
+	defer ClearEntitiesOfType("command_limit_test")
+
+	source := newEntitySource(&config.EntityFile{
+		Name:    "command_limit_test",
+		Command: "yes",
+	})
+
+	err := source.load()
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errEntitySourceTooLarge)
+	assert.Empty(t, GetEntityInstances("command_limit_test"))
+}
@@ -0,0 +1,166 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strconv"
+)
+
+// EntityUpdate is a change to a single entity, that is written by an action
+// rather than loaded from a file. The entity is found by Key, or otherwise by
+// the fields in Match, and Data is merged in to it. If no entity is found by
+// Match, a new one is added.
+type EntityUpdate struct {
+	Entity string         `json:"entity"`
+	Key    string         `json:"key"`
+	Match  map[string]any `json:"match"`
+	Data   map[string]any `json:"data"`
+}
+
+var (
+	changedListeners []func(entityName string)
+
+	errUpdateWithoutEntity = errors.New("entity update has no entity name")
+	errUpdateWithoutTarget = errors.New("entity update needs a key or match")
+)
+
+// AddEntityChangedListener is called with the name of an entity type, after
+// entities of that type have been loaded or updated.
+func AddEntityChangedListener(l func(entityName string)) {
+	changedListeners = append(changedListeners, l)
+}
+
+func notifyListeners(entityNames ...string) {
+	for _, l := range listeners {
+		l()
+	}
+
+	for _, entityName := range entityNames {
+		for _, l := range changedListeners {
+			l(entityName)
+		}
+	}
+}
+
+// UpdateEntities applies updates to the stored entities, and then notifies
+// listeners once. Invalid updates are skipped, and returned as errors.
+//
+// Updates only last until the entity file is next loaded, which replaces
+// every entity of that type.
+func UpdateEntities(updates []*EntityUpdate) error {
+	var errs []error
+
+	changed := make([]string, 0, len(updates))
+	seen := make(map[string]bool)
+
+	for _, update := range updates {
+		if err := applyUpdate(update); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if !seen[update.Entity] {
+			seen[update.Entity] = true
+			changed = append(changed, update.Entity)
+		}
+	}
+
+	if len(changed) > 0 {
+		notifyListeners(changed...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateUpdate(update *EntityUpdate) error {
+	switch {
+	case update.Entity == "":
+		return errUpdateWithoutEntity
+	case update.Key == "" && len(update.Match) == 0:
+		return fmt.Errorf("%w, for entity %q", errUpdateWithoutTarget, update.Entity)
+	default:
+		return nil
+	}
+}
+
+func applyUpdate(update *EntityUpdate) error {
+	if err := validateUpdate(update); err != nil {
+		return err
+	}
+
+	rwmutex.Lock()
+	defer rwmutex.Unlock()
+
+	if _, ok := entities[update.Entity]; !ok {
+		entities[update.Entity] = make(entityInstancesByKey, 0)
+	}
+
+	instances := entities[update.Entity]
+	key := update.Key
+
+	if key == "" {
+		key = findOrNewKeyLocked(instances, update.Match)
+	}
+
+	// Entities are replaced rather than modified, as readers hold on to them
+	// without the lock.
+	data := mergedEntityData(instances[key], update)
+
+	instances[key] = &Entity{
+		Data:      data,
+		UniqueKey: key,
+		Title:     findEntityTitle(data),
+	}
+
+	return nil
+}
+
+func mergedEntityData(existing *Entity, update *EntityUpdate) map[string]any {
+	data := make(map[string]any)
+
+	if existing != nil {
+		if existingData, ok := existing.Data.(map[string]any); ok {
+			maps.Copy(data, existingData)
+		}
+	} else {
+		maps.Copy(data, update.Match)
+	}
+
+	maps.Copy(data, update.Data)
+
+	return data
+}
+
+func findOrNewKeyLocked(instances entityInstancesByKey, match map[string]any) string {
+	for key, entity := range instances {
+		if entityMatches(entity, match) {
+			return key
+		}
+	}
+
+	// Entities loaded from files are keyed by their index, so new entities
+	// carry on from there.
+	for i := len(instances); ; i++ {
+		key := strconv.Itoa(i)
+
+		if _, exists := instances[key]; !exists {
+			return key
+		}
+	}
+}
+
+func entityMatches(entity *Entity, match map[string]any) bool {
+	data, ok := entity.Data.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	for field, want := range match {
+		if fmt.Sprint(data[field]) != fmt.Sprint(want) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateEntities(t *testing.T) {
+	ClearEntitiesOfType("update_test")
+	defer ClearEntitiesOfType("update_test")
+
+	AddEntity("update_test", "0", map[string]any{"hostname": "web1", "status": "unknown", "port": 80})
+	AddEntity("update_test", "1", map[string]any{"hostname": "web2", "status": "unknown"})
+
+	var changed []string
+
+	AddEntityChangedListener(func(entityName string) {
+		changed = append(changed, entityName)
+	})
+
+	err := UpdateEntities([]*EntityUpdate{
+		{Entity: "update_test", Key: "0", Data: map[string]any{"status": "up"}},
+		{Entity: "update_test", Match: map[string]any{"hostname": "web2"}, Data: map[string]any{"status": "down"}},
+		{Entity: "update_test", Match: map[string]any{"hostname": "web3"}, Data: map[string]any{"status": "up"}},
+	})
+	require.NoError(t, err)
+
+	instances := GetEntityInstances("update_test")
+	require.Len(t, instances, 3)
+
+	assert.Equal(t, map[string]any{"hostname": "web1", "status": "up", "port": 80}, instances["0"].Data, "Data is merged with the existing fields")
+	assert.Equal(t, "down", instances["1"].Data.(map[string]any)["status"], "Entities can be found by matching fields")
+	assert.Equal(t, map[string]any{"hostname": "web3", "status": "up"}, instances["2"].Data, "Unmatched entities are added, with the fields they were matched by")
+	assert.Equal(t, "web3", instances["2"].Title)
+
+	assert.Equal(t, []string{"update_test"}, changed, "Listeners are notified once per entity type")
+}
+
+func TestUpdateEntitiesRejectsUpdatesWithoutTarget(t *testing.T) {
+	ClearEntitiesOfType("update_test_invalid")
+	defer ClearEntitiesOfType("update_test_invalid")
+
+	err := UpdateEntities([]*EntityUpdate{
+		{Data: map[string]any{"status": "up"}},
+		{Entity: "update_test_invalid", Data: map[string]any{"status": "up"}},
+	})
+
+	assert.ErrorIs(t, err, errUpdateWithoutEntity)
+	assert.ErrorIs(t, err, errUpdateWithoutTarget)
+	assert.Empty(t, GetEntityInstances("update_test_invalid"))
+}
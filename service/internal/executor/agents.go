@@ -0,0 +1,132 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/shell"
+	"github.com/OliveTin/OliveTin/internal/tpl"
+	log "github.com/sirupsen/logrus"
+)
+
+// AgentJob is an execution that is sent to a remote agent, rather than being
+// run as a local process.
+type AgentJob struct {
+	TrackingID string
+	Shell      string
+	Exec       []string
+	Env        []string
+	Timeout    int
+}
+
+// AgentResult is what an agent reports when it has finished running a job.
+type AgentResult struct {
+	ExitCode int32
+	TimedOut bool
+}
+
+// AgentDispatcher sends jobs to agents that have connected to this server. It
+// is implemented by the agents package.
+type AgentDispatcher interface {
+	Dispatch(ctx context.Context, agentName string, job *AgentJob, stdout io.Writer, stderr io.Writer) (AgentResult, error)
+	Kill(trackingID string) error
+}
+
+// agentTimeoutGrace is how much longer than the action timeout the server
+// waits for an agent to report back, as agents enforce the timeout themselves.
+const agentTimeoutGrace = 10 * time.Second
+
+var errAgentsDisabled = errors.New("action has runOn set, but agents are not enabled")
+
+func (e *Executor) SetAgentDispatcher(dispatcher AgentDispatcher) {
+	e.agents = dispatcher
+}
+
+func stepExecOnAgent(req *ExecutionRequest) bool {
+	dispatcher := req.executor.agents
+
+	if dispatcher == nil {
+		return fail(req, errAgentsDisabled)
+	}
+
+	agentName := tpl.ParseTemplateOfActionBeforeExec(req.Binding.Action.RunOn, req.Binding.Entity)
+
+	log.WithFields(log.Fields{
+		"actionTitle": req.logEntry.ActionTitle,
+		"agent":       agentName,
+	}).Infof("Dispatching action to agent")
+
+	timeout := time.Duration(req.Binding.Action.Timeout) * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), timeout+agentTimeoutGrace)
+	defer cancel()
+
+	streamer := &OutputStreamer{Req: req}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = func() error {
+			return dispatcher.Kill(req.TrackingID)
+		}
+	})
+
+	markExecutionStarted(req)
+
+	result, err := dispatcher.Dispatch(ctx, agentName, newAgentJob(req), streamer, streamer.Stderr())
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = nil
+		entry.ExitCode = result.ExitCode
+		streamer.saveTo(entry)
+	})
+
+	appendErrorToStderr(req, err)
+
+	if result.TimedOut {
+		markTimedOut(req)
+	}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.DatetimeFinished = time.Now()
+	})
+
+	return true
+}
+
+func newAgentJob(req *ExecutionRequest) *AgentJob {
+	job := &AgentJob{
+		TrackingID: req.TrackingID,
+		Env:        argumentEnv(req.Arguments),
+		Timeout:    req.Binding.Action.Timeout,
+	}
+
+	if req.useDirectExec {
+		job.Exec = req.execArgs
+	} else {
+		job.Shell = req.finalParsedCommand
+	}
+
+	return job
+}
+
+// NewAgentJobCommand builds the command that an agent runs for a job, in the
+// same way that the server would have run it locally. Cancelling ctx kills the
+// whole process group, not just the shell.
+func NewAgentJobCommand(ctx context.Context, job *AgentJob) *exec.Cmd {
+	var cmd *exec.Cmd
+
+	if len(job.Exec) > 0 {
+		cmd = wrapCommandDirect(ctx, job.Exec)
+	} else {
+		cmd = shell.Command(ctx, job.Shell)
+	}
+
+	cmd.Env = append(os.Environ(), job.Env...)
+	cmd.Cancel = func() error {
+		return killProcess(cmd.Process)
+	}
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func TestRunOnWithoutAgentsEnabled(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title: "hello",
+		Shell: "echo hello",
+		RunOn: "web1",
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, errAgentsDisabled.Error(), entry.Output)
+	assert.ErrorIs(t, e.Kill(entry), errNothingToKill)
+}
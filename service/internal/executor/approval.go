@@ -0,0 +1,264 @@
+package executor
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	acl "github.com/OliveTin/OliveTin/internal/acl"
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	config "github.com/OliveTin/OliveTin/internal/config"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ErrApprovalNotFound = errors.New("execution is not awaiting approval")
+	ErrNotAnApprover    = errors.New("user is not allowed to approve this action")
+	ErrSelfApproval     = errors.New("executions cannot be approved by the user that requested them")
+	ErrAlreadyApproved  = errors.New("execution has already been approved by this user")
+)
+
+const fallbackApprovalExpiry = time.Hour
+
+// PendingApproval is an execution parked by stepApprovalCheck until enough
+// users have approved it, an approver rejects it, or it expires.
+type PendingApproval struct {
+	LogEntry          *InternalLogEntry
+	ApprovalsRequired int
+	Expires           time.Time
+
+	req      *ExecutionRequest
+	decision chan approvalDecision
+	timer    *time.Timer
+}
+
+type approvalDecision struct {
+	approved bool
+	output   string
+}
+
+func approvalExpiry(action *config.Action) time.Duration {
+	expiry, err := time.ParseDuration(action.Approval.Expiry)
+
+	if err != nil || expiry <= 0 {
+		log.Warnf("Could not parse approval expiry: %v", action.Approval.Expiry)
+
+		return fallbackApprovalExpiry
+	}
+
+	return expiry
+}
+
+func stepApprovalCheck(req *ExecutionRequest) bool {
+	if !req.Binding.Action.RequiresApproval() {
+		return true
+	}
+
+	return req.executor.awaitApproval(req)
+}
+
+func (e *Executor) awaitApproval(req *ExecutionRequest) bool {
+	approval := e.parkForApproval(req)
+
+	log.WithFields(log.Fields{
+		"actionTitle":       req.logEntry.ActionTitle,
+		"approvalsRequired": approval.ApprovalsRequired,
+		"expires":           approval.Expires,
+	}).Infof("Action awaiting approval")
+
+	notifyListenersApprovalRequested(req, approval)
+
+	decision := <-approval.decision
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.AwaitingApproval = false
+
+		if !decision.approved {
+			entry.Output = decision.output
+			entry.Blocked = true
+		}
+	})
+
+	e.storeLog(req.logEntry)
+
+	log.WithFields(log.Fields{
+		"actionTitle": req.logEntry.ActionTitle,
+		"approved":    decision.approved,
+	}).Infof("Action approval resolved")
+
+	notifyListenersApprovalResolved(req, decision.approved)
+
+	return decision.approved
+}
+
+func (e *Executor) parkForApproval(req *ExecutionRequest) *PendingApproval {
+	expiry := approvalExpiry(req.Binding.Action)
+
+	approval := &PendingApproval{
+		LogEntry:          req.logEntry,
+		ApprovalsRequired: req.Binding.Action.Approval.Count,
+		Expires:           time.Now().Add(expiry),
+		req:               req,
+		decision:          make(chan approvalDecision, 1),
+	}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.AwaitingApproval = true
+		entry.Output = "Awaiting approval"
+	})
+
+	e.storeLog(req.logEntry)
+
+	// The expiry timer is created with approvalsMu held, so that it cannot
+	// fire before the approval is registered.
+	e.approvalsMu.Lock()
+	defer e.approvalsMu.Unlock()
+
+	e.approvals[req.TrackingID] = approval
+
+	approval.timer = time.AfterFunc(expiry, func() {
+		e.resolveApproval(req.TrackingID, approvalDecision{
+			output: fmt.Sprintf("Approval expired after %v", expiry),
+		})
+	})
+
+	return approval
+}
+
+func (e *Executor) resolveApproval(trackingID string, decision approvalDecision) {
+	e.approvalsMu.Lock()
+	defer e.approvalsMu.Unlock()
+
+	e.resolveApprovalLocked(trackingID, decision)
+}
+
+func (e *Executor) resolveApprovalLocked(trackingID string, decision approvalDecision) {
+	approval, found := e.approvals[trackingID]
+	if !found {
+		return
+	}
+
+	delete(e.approvals, trackingID)
+
+	approval.timer.Stop()
+	approval.decision <- decision
+}
+
+// Approve records the user's approval of an execution that is awaiting
+// approval, and returns how many more approvals it needs before it runs.
+func (e *Executor) Approve(user *authpublic.AuthenticatedUser, trackingID string) (int, error) {
+	e.approvalsMu.Lock()
+	defer e.approvalsMu.Unlock()
+
+	approval, err := e.findApprovalLocked(user, trackingID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := checkNewApprover(approval, user); err != nil {
+		return 0, err
+	}
+
+	approval.req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.ApprovedBy = append(entry.ApprovedBy, user.Username)
+	})
+
+	remaining := max(0, approval.ApprovalsRequired-len(approval.LogEntry.ApprovedBy))
+
+	log.WithFields(log.Fields{
+		"actionTitle": approval.LogEntry.ActionTitle,
+		"approver":    user.Username,
+		"remaining":   remaining,
+	}).Infof("Action approved")
+
+	if remaining == 0 {
+		e.resolveApprovalLocked(trackingID, approvalDecision{approved: true})
+	}
+
+	return remaining, nil
+}
+
+// Reject stops an execution that is awaiting approval from running.
+func (e *Executor) Reject(user *authpublic.AuthenticatedUser, trackingID string) error {
+	e.approvalsMu.Lock()
+	defer e.approvalsMu.Unlock()
+
+	if _, err := e.findApprovalLocked(user, trackingID); err != nil {
+		return err
+	}
+
+	e.resolveApprovalLocked(trackingID, approvalDecision{
+		output: fmt.Sprintf("Rejected by %v", user.Username),
+	})
+
+	return nil
+}
+
+func (e *Executor) findApprovalLocked(user *authpublic.AuthenticatedUser, trackingID string) (*PendingApproval, error) {
+	approval, found := e.approvals[trackingID]
+	if !found {
+		return nil, ErrApprovalNotFound
+	}
+
+	if !acl.IsAllowedApprove(e.Cfg, user, approval.req.Binding.Action) {
+		return nil, ErrNotAnApprover
+	}
+
+	return approval, nil
+}
+
+// checkNewApprover enforces that approvals come from someone other than the
+// requester, and that each approver is only counted once. ApprovedBy is only
+// written with approvalsMu held, which the caller holds.
+func checkNewApprover(approval *PendingApproval, user *authpublic.AuthenticatedUser) error {
+	if user.Username == approval.LogEntry.Username {
+		return ErrSelfApproval
+	}
+
+	if slices.Contains(approval.LogEntry.ApprovedBy, user.Username) {
+		return ErrAlreadyApproved
+	}
+
+	return nil
+}
+
+// GetPendingApprovalsACL returns executions awaiting approval that the user may
+// either approve, or view the logs of.
+func (e *Executor) GetPendingApprovalsACL(cfg *config.Config, user *authpublic.AuthenticatedUser) []*PendingApproval {
+	e.approvalsMu.Lock()
+	defer e.approvalsMu.Unlock()
+
+	ret := make([]*PendingApproval, 0, len(e.approvals))
+
+	for _, approval := range e.approvals {
+		if isApprovalVisible(cfg, user, approval) {
+			ret = append(ret, approval)
+		}
+	}
+
+	slices.SortFunc(ret, func(a, b *PendingApproval) int {
+		return cmp.Compare(a.LogEntry.Index, b.LogEntry.Index)
+	})
+
+	return ret
+}
+
+func isApprovalVisible(cfg *config.Config, user *authpublic.AuthenticatedUser, approval *PendingApproval) bool {
+	action := approval.req.Binding.Action
+
+	return acl.IsAllowedApprove(cfg, user, action) || acl.IsAllowedLogs(cfg, user, action)
+}
+
+func notifyListenersApprovalRequested(req *ExecutionRequest, approval *PendingApproval) {
+	for _, listener := range req.executor.copyListeners() {
+		listener.OnApprovalRequested(approval)
+	}
+}
+
+func notifyListenersApprovalResolved(req *ExecutionRequest, approved bool) {
+	for _, listener := range req.executor.copyListeners() {
+		listener.OnApprovalResolved(req.logEntry, approved)
+	}
+}
@@ -0,0 +1,198 @@
+package executor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+type approvalCollector struct {
+	executionFinishedCollector
+	requested chan *PendingApproval
+}
+
+func (c *approvalCollector) OnExecutionFinished(_ *InternalLogEntry) {}
+
+func (c *approvalCollector) OnApprovalRequested(approval *PendingApproval) {
+	c.requested <- approval
+}
+
+func approvalTestingExecutor(t *testing.T, approval config.ApprovalConfig) (*Executor, *config.Config, *approvalCollector) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:    "reboot",
+		Shell:    "echo rebooting",
+		Approval: approval,
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	collector := &approvalCollector{requested: make(chan *PendingApproval, 1)}
+	e.AddListener(collector)
+
+	return e, cfg, collector
+}
+
+func execAwaitingApproval(t *testing.T, e *Executor, cfg *config.Config, collector *approvalCollector) (*sync.WaitGroup, string) {
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding:           e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:               cfg,
+		AuthenticatedUser: &authpublic.AuthenticatedUser{Username: "requester", UsergroupLine: "admins"},
+	})
+
+	select {
+	case approval := <-collector.requested:
+		require.Equal(t, trackingID, approval.LogEntry.ExecutionTrackingID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("execution was not parked for approval")
+	}
+
+	return wg, trackingID
+}
+
+func TestApprovalRunsAfterEnoughApprovals(t *testing.T) {
+	e, cfg, collector := approvalTestingExecutor(t, config.ApprovalConfig{Usergroups: []string{"admins"}, Count: 2})
+	wg, trackingID := execAwaitingApproval(t, e, cfg, collector)
+
+	requester := &authpublic.AuthenticatedUser{Username: "requester", UsergroupLine: "admins"}
+	alice := &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}
+	bob := &authpublic.AuthenticatedUser{Username: "bob", UsergroupLine: "admins"}
+	mallory := &authpublic.AuthenticatedUser{Username: "mallory", UsergroupLine: "users"}
+
+	_, err := e.Approve(requester, trackingID)
+	assert.ErrorIs(t, err, ErrSelfApproval)
+
+	_, err = e.Approve(mallory, trackingID)
+	assert.ErrorIs(t, err, ErrNotAnApprover)
+
+	remaining, err := e.Approve(alice, trackingID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, remaining)
+
+	_, err = e.Approve(alice, trackingID)
+	assert.ErrorIs(t, err, ErrAlreadyApproved)
+
+	snapshot, _ := e.SnapshotLog(trackingID)
+	assert.False(t, snapshot.ExecutionStarted, "Not started until every approval is in")
+
+	remaining, err = e.Approve(bob, trackingID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, remaining)
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.False(t, entry.AwaitingApproval)
+	assert.Equal(t, []string{"alice", "bob"}, entry.ApprovedBy)
+	assert.Equal(t, "rebooting\n", entry.Output)
+
+	_, err = e.Approve(bob, trackingID)
+	assert.ErrorIs(t, err, ErrApprovalNotFound)
+}
+
+func TestApprovalRejected(t *testing.T) {
+	e, cfg, collector := approvalTestingExecutor(t, config.ApprovalConfig{Usergroups: []string{"admins"}})
+	wg, trackingID := execAwaitingApproval(t, e, cfg, collector)
+
+	require.NoError(t, e.Reject(&authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}, trackingID))
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.True(t, entry.Blocked)
+	assert.False(t, entry.ExecutionStarted)
+	assert.Equal(t, "Rejected by alice", entry.Output)
+}
+
+func TestApprovalExpires(t *testing.T) {
+	e, cfg, collector := approvalTestingExecutor(t, config.ApprovalConfig{Count: 1, Expiry: "10ms"})
+	wg, trackingID := execAwaitingApproval(t, e, cfg, collector)
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.True(t, entry.Blocked)
+	assert.Equal(t, "Approval expired after 10ms", entry.Output)
+	assert.Empty(t, e.GetPendingApprovalsACL(cfg, &authpublic.AuthenticatedUser{Username: "alice"}))
+}
+
+func TestApprovalParksWhileActionIsRunning(t *testing.T) {
+	e, cfg, collector := approvalTestingExecutor(t, config.ApprovalConfig{Usergroups: []string{"admins"}, Count: 1})
+	cfg.Actions[0].MaxConcurrent = 1
+	cfg.Actions[0].Shell = "sleep 10"
+
+	alice := &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}
+
+	firstWg, first := execAwaitingApproval(t, e, cfg, collector)
+
+	_, err := e.Approve(alice, first)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		entry, _ := e.GetLog(first)
+		return entry.ExecutionStarted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	secondWg, second := execAwaitingApproval(t, e, cfg, collector)
+
+	_, err = e.Approve(alice, second)
+	require.NoError(t, err)
+	secondWg.Wait()
+
+	entry, _ := e.GetLog(second)
+	assert.True(t, entry.Blocked, "the concurrency limit is checked once approved")
+	assert.Equal(t, "Blocked from executing due to concurrency limit", entry.Output)
+
+	firstEntry, _ := e.GetLog(first)
+	require.NoError(t, e.Kill(firstEntry))
+	firstWg.Wait()
+}
+
+func TestGetPendingApprovalsACL(t *testing.T) {
+	e, cfg, collector := approvalTestingExecutor(t, config.ApprovalConfig{Usergroups: []string{"admins"}})
+	cfg.DefaultPermissions.Logs = false
+
+	wg, trackingID := execAwaitingApproval(t, e, cfg, collector)
+
+	approvals := e.GetPendingApprovalsACL(cfg, &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"})
+	require.Equal(t, 1, len(approvals))
+	assert.Equal(t, trackingID, approvals[0].LogEntry.ExecutionTrackingID)
+	assert.Equal(t, 1, approvals[0].ApprovalsRequired)
+
+	assert.Empty(t, e.GetPendingApprovalsACL(cfg, &authpublic.AuthenticatedUser{Username: "mallory", UsergroupLine: "users"}))
+
+	require.NoError(t, e.Reject(&authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}, trackingID))
+	wg.Wait()
+}
+
+func TestApprovalDoesNotHoldConcurrencySlot(t *testing.T) {
+	e, cfg, collector := approvalTestingExecutor(t, config.ApprovalConfig{Usergroups: []string{"admins"}, Count: 1})
+	cfg.Actions[0].MaxConcurrent = 1
+
+	firstWg, first := execAwaitingApproval(t, e, cfg, collector)
+	secondWg, second := execAwaitingApproval(t, e, cfg, collector)
+
+	alice := &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins"}
+
+	_, err := e.Approve(alice, first)
+	require.NoError(t, err)
+	firstWg.Wait()
+
+	_, err = e.Approve(alice, second)
+	require.NoError(t, err)
+	secondWg.Wait()
+
+	for _, trackingID := range []string{first, second} {
+		entry, _ := e.GetLog(trackingID)
+		assert.False(t, entry.Blocked, "a parked execution does not count against maxConcurrent")
+		assert.Equal(t, int32(0), entry.ExitCode)
+	}
+}
@@ -0,0 +1,497 @@
+package executor
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/tpl"
+	log "github.com/sirupsen/logrus"
+)
+
+/*
+Actions with a container image are run in a new container, using the Docker
+Engine API over its unix socket. Podman provides the same API, so it works too.
+The container is created, started, and then its logs are followed until it
+exits, after which it is always removed.
+*/
+
+// containerApiVersion is the oldest Docker Engine API version that has
+// everything used here, which Podman also supports.
+const containerApiVersion = "v1.41"
+
+// containerRemoveTimeout is how long to wait for the engine to remove a
+// container after the action has finished.
+const containerRemoveTimeout = 30 * time.Second
+
+// containerIdleConnTimeout is how long an unused connection to the engine is
+// kept open for the next execution.
+const containerIdleConnTimeout = 90 * time.Second
+
+var errContainerImageEmpty = errors.New("container image is empty after parsing the template")
+
+var errContainerKilledBeforeStart = errors.New("killed before the container was started")
+
+// containerEngineError is an error response from the engine API.
+type containerEngineError struct {
+	status  int
+	message string
+}
+
+func (e *containerEngineError) Error() string {
+	return fmt.Sprintf("container engine responded with %v: %v", e.status, e.message)
+}
+
+type containerEngine struct {
+	client *http.Client
+}
+
+// containerCreateRequest is the subset of the engine's container config that
+// actions can set.
+type containerCreateRequest struct {
+	Image      string
+	Cmd        []string `json:",omitempty"`
+	Env        []string
+	Labels     map[string]string
+	HostConfig containerHostConfig
+}
+
+type containerHostConfig struct {
+	Binds       []string `json:",omitempty"`
+	NetworkMode string   `json:",omitempty"`
+}
+
+type containerCreateResponse struct {
+	Id string
+}
+
+type containerWaitResponse struct {
+	StatusCode int
+	Error      *struct {
+		Message string
+	}
+}
+
+func newContainerEngine(socket string) *containerEngine {
+	socket = strings.TrimPrefix(socket, "unix://")
+	dialer := &net.Dialer{}
+
+	return &containerEngine{
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _ string, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", socket)
+				},
+				IdleConnTimeout: containerIdleConnTimeout,
+			},
+		},
+	}
+}
+
+// containerEngine returns the engine for the socket, so that executions share
+// its keep-alive connections rather than each leaving their own open.
+func (e *Executor) containerEngine(socket string) *containerEngine {
+	e.containerEnginesMu.Lock()
+	defer e.containerEnginesMu.Unlock()
+
+	engine, ok := e.containerEngines[socket]
+
+	if !ok {
+		engine = newContainerEngine(socket)
+		e.containerEngines[socket] = engine
+	}
+
+	return engine
+}
+
+func stepExecInContainer(req *ExecutionRequest) bool {
+	streamer := &OutputStreamer{Req: req}
+	engine := req.executor.containerEngine(req.Cfg.ContainerEngine.Socket)
+
+	id, err := createContainer(req, engine, streamer.Stderr())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(req.Binding.Action.Timeout)*time.Second)
+	defer cancel()
+
+	exitCode := int32(-1)
+
+	if err == nil {
+		defer engine.removeAfterRun(id)
+
+		exitCode, err = runInContainer(ctx, req, engine, id, streamer)
+	}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = nil
+		entry.ExitCode = exitCode
+		streamer.saveTo(entry)
+	})
+
+	appendErrorToStderr(req, err)
+
+	if ctx.Err() == context.DeadlineExceeded {
+		markTimedOut(req)
+	}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.DatetimeFinished = time.Now()
+	})
+
+	return true
+}
+
+// createContainer creates the container, pulling its image first if needed.
+// This has its own timeout, container.pullTimeout, so that a slow pull does
+// not use up the action's timeout. Killing the execution cancels the pull.
+func createContainer(req *ExecutionRequest, engine *containerEngine, progress io.Writer) (string, error) {
+	spec, err := newContainerCreateRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(req.Binding.Action.Container.PullTimeout)*time.Second)
+	defer cancel()
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = func() error {
+			cancel()
+			return nil
+		}
+	})
+
+	markExecutionStarted(req)
+
+	return engine.create(ctx, "olivetin-"+req.TrackingID, spec, progress)
+}
+
+func runInContainer(ctx context.Context, req *ExecutionRequest, engine *containerEngine, id string, streamer *OutputStreamer) (int32, error) {
+	killed := false
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		killed = entry.killRequested
+		entry.killer = func() error {
+			return engine.remove(context.Background(), id)
+		}
+	})
+
+	if killed {
+		return -1, errContainerKilledBeforeStart
+	}
+
+	if err := engine.do(ctx, http.MethodPost, "/containers/"+id+"/start", nil, nil); err != nil {
+		return -1, err
+	}
+
+	return engine.waitWithLogs(ctx, id, streamer, streamer.Stderr())
+}
+
+func newContainerCreateRequest(req *ExecutionRequest) (*containerCreateRequest, error) {
+	cfg := req.Binding.Action.Container
+
+	image := tpl.ParseTemplateOfActionBeforeExec(cfg.Image, req.Binding.Entity)
+	if image == "" {
+		return nil, errContainerImageEmpty
+	}
+
+	return &containerCreateRequest{
+		Image: image,
+		Cmd:   containerCmd(req),
+		Env:   append(argumentEnv(req.Arguments), cfg.Env...),
+		Labels: map[string]string{
+			"olivetin.executionTrackingId": req.TrackingID,
+			"olivetin.actionTitle":         req.Binding.Action.Title,
+		},
+		HostConfig: containerHostConfig{
+			Binds:       cfg.Mounts,
+			NetworkMode: cfg.Network,
+		},
+	}, nil
+}
+
+// containerCmd returns the command to run in the container, or nil to run the
+// image's default command when the action has neither shell nor exec.
+func containerCmd(req *ExecutionRequest) []string {
+	switch {
+	case req.useDirectExec:
+		return req.execArgs
+	case req.finalParsedCommand != "":
+		return []string{"sh", "-c", req.finalParsedCommand}
+	default:
+		return nil
+	}
+}
+
+// create creates the container, pulling the image first if the engine does
+// not have it yet, as docker run does. Pull progress is written to progress.
+func (e *containerEngine) create(ctx context.Context, name string, spec *containerCreateRequest, progress io.Writer) (string, error) {
+	path := "/containers/create?" + url.Values{"name": {name}}.Encode()
+
+	var created containerCreateResponse
+
+	err := e.do(ctx, http.MethodPost, path, spec, &created)
+
+	var engineErr *containerEngineError
+
+	if errors.As(err, &engineErr) && engineErr.status == http.StatusNotFound {
+		if err = e.pull(ctx, spec.Image, progress); err == nil {
+			err = e.do(ctx, http.MethodPost, path, spec, &created)
+		}
+	}
+
+	return created.Id, err
+}
+
+func (e *containerEngine) pull(ctx context.Context, image string, progress io.Writer) error {
+	log.WithFields(log.Fields{
+		"image": image,
+	}).Infof("Pulling container image")
+
+	query := url.Values{"fromImage": {image}}
+
+	// Without a tag, the engine would pull every tag of the image.
+	if !imageHasTag(image) {
+		query.Set("tag", "latest")
+	}
+
+	body, err := e.stream(ctx, http.MethodPost, "/images/create?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = body.Close() }()
+
+	_, _ = fmt.Fprintf(progress, "Pulling container image %v\n", image)
+
+	return readPullProgress(body, progress)
+}
+
+// containerPullProgress is one progress message of a pull. Messages with a
+// progress bar are sent many times a second while a layer downloads.
+type containerPullProgress struct {
+	Id       string `json:"id"`
+	Status   string `json:"status"`
+	Progress string `json:"progress"`
+	Error    string `json:"error"`
+}
+
+func (p *containerPullProgress) String() string {
+	if p.Id == "" {
+		return p.Status
+	}
+
+	return p.Id + ": " + p.Status
+}
+
+// writeTo writes a status change to w, leaving out the progress bars, or
+// returns the error that the pull failed with.
+func (p *containerPullProgress) writeTo(w io.Writer) error {
+	if p.Error != "" {
+		return fmt.Errorf("could not pull container image: %v", p.Error)
+	}
+
+	if p.Progress == "" {
+		_, _ = fmt.Fprintln(w, p.String())
+	}
+
+	return nil
+}
+
+// readPullProgress waits for a pull to finish, and writes its progress to w.
+// Pull failures are reported in the progress messages, after the engine has
+// already responded with 200.
+func readPullProgress(body io.Reader, w io.Writer) error {
+	decoder := json.NewDecoder(body)
+
+	for {
+		var progress containerPullProgress
+
+		err := decoder.Decode(&progress)
+
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if err := progress.writeTo(w); err != nil {
+			return err
+		}
+	}
+}
+
+func imageHasTag(image string) bool {
+	lastPart := image[strings.LastIndex(image, "/")+1:]
+
+	return strings.ContainsAny(lastPart, ":@")
+}
+
+// waitWithLogs follows the container's logs until it exits, and returns its
+// exit code.
+func (e *containerEngine) waitWithLogs(ctx context.Context, id string, stdout io.Writer, stderr io.Writer) (int32, error) {
+	logs, err := e.stream(ctx, http.MethodGet, "/containers/"+id+"/logs?follow=1&stdout=1&stderr=1", nil)
+	if err != nil {
+		return -1, err
+	}
+
+	copied := make(chan error, 1)
+
+	go func() {
+		copied <- demuxContainerLogs(logs, stdout, stderr)
+		_ = logs.Close()
+	}()
+
+	var result containerWaitResponse
+
+	err = e.do(ctx, http.MethodPost, "/containers/"+id+"/wait", nil, &result)
+
+	// The log stream ends when the container exits, or when ctx is done.
+	<-copied
+
+	switch {
+	case err != nil:
+		return -1, err
+	case result.Error != nil && result.Error.Message != "":
+		return int32(result.StatusCode), errors.New(result.Error.Message)
+	default:
+		return int32(result.StatusCode), nil
+	}
+}
+
+// demuxContainerLogs splits the engine's multiplexed log stream, where each
+// frame has an 8 byte header of the stream type and the frame length.
+func demuxContainerLogs(logs io.Reader, stdout io.Writer, stderr io.Writer) error {
+	header := make([]byte, 8)
+
+	for {
+		_, err := io.ReadFull(logs, header)
+
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if err := copyLogFrame(logs, header, stdout, stderr); err != nil {
+			return err
+		}
+	}
+}
+
+func copyLogFrame(logs io.Reader, header []byte, stdout io.Writer, stderr io.Writer) error {
+	dst := stdout
+
+	if header[0] == 2 {
+		dst = stderr
+	}
+
+	_, err := io.CopyN(dst, logs, int64(binary.BigEndian.Uint32(header[4:])))
+
+	return err
+}
+
+// remove force removes the container, which also kills it if it is running.
+func (e *containerEngine) remove(ctx context.Context, id string) error {
+	return e.do(ctx, http.MethodDelete, "/containers/"+id+"?force=1", nil, nil)
+}
+
+func (e *containerEngine) removeAfterRun(id string) {
+	ctx, cancel := context.WithTimeout(context.Background(), containerRemoveTimeout)
+	defer cancel()
+
+	err := e.remove(ctx, id)
+
+	var engineErr *containerEngineError
+
+	// A killed container has already been removed.
+	if err == nil || errors.As(err, &engineErr) && engineErr.status == http.StatusNotFound {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"containerId": id,
+		"error":       err,
+	}).Warnf("Could not remove container")
+}
+
+// do makes a request with an optional JSON body, and decodes the JSON
+// response into out, if out is not nil.
+func (e *containerEngine) do(ctx context.Context, method string, path string, in any, out any) error {
+	body, err := e.stream(ctx, method, path, in)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = body.Close() }()
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(body).Decode(out)
+}
+
+// stream makes a request and returns the response body, which the caller must
+// close. Error responses are returned as a containerEngineError.
+func (e *containerEngine) stream(ctx context.Context, method string, path string, in any) (io.ReadCloser, error) {
+	reqBody, err := jsonBody(in)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, "http://container-engine/"+containerApiVersion+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	res, err := e.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		defer func() { _ = res.Body.Close() }()
+
+		return nil, readContainerEngineError(res)
+	}
+
+	return res.Body, nil
+}
+
+func jsonBody(in any) (io.Reader, error) {
+	if in == nil {
+		return http.NoBody, nil
+	}
+
+	encoded, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(encoded), nil
+}
+
+func readContainerEngineError(res *http.Response) error {
+	var msg struct {
+		Message string `json:"message"`
+	}
+
+	_ = json.NewDecoder(res.Body).Decode(&msg)
+
+	return &containerEngineError{
+		status:  res.StatusCode,
+		message: msg.Message,
+	}
+}
@@ -0,0 +1,422 @@
+package executor
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+const fakeContainerId = "0123456789ab"
+
+// fakeContainerEngine serves just enough of the Docker Engine API, on a unix
+// socket, to run one container that writes the configured output.
+type fakeContainerEngine struct {
+	socket string
+
+	stdout      string
+	stderr      string
+	exitCode    int
+	keepRunning bool
+	pullBlocks  bool
+
+	mu      sync.Mutex
+	images  map[string]bool
+	pulled  []string
+	created *containerCreateRequest
+	started bool
+	removed []string
+	conns   int
+	exited  chan struct{}
+	exit    sync.Once
+}
+
+func startFakeContainerEngine(t *testing.T) *fakeContainerEngine {
+	// Unix socket paths have a short length limit, which t.TempDir() can exceed.
+	dir, err := os.MkdirTemp("", "olivetin")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	engine := &fakeContainerEngine{
+		socket: filepath.Join(dir, "docker.sock"),
+		images: map[string]bool{"alpine:latest": true},
+		exited: make(chan struct{}),
+	}
+
+	listener, err := net.Listen("unix", engine.socket)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1.41/containers/create", engine.handleCreate)
+	mux.HandleFunc("POST /v1.41/images/create", engine.handlePull)
+	mux.HandleFunc("POST /v1.41/containers/{id}/start", engine.handleStart)
+	mux.HandleFunc("GET /v1.41/containers/{id}/logs", engine.handleLogs)
+	mux.HandleFunc("POST /v1.41/containers/{id}/wait", engine.handleWait)
+	mux.HandleFunc("DELETE /v1.41/containers/{id}", engine.handleRemove)
+
+	srv := httptest.NewUnstartedServer(mux)
+	srv.Listener = listener
+	srv.Config.ConnState = engine.countConn
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	return engine
+}
+
+func (f *fakeContainerEngine) countConn(_ net.Conn, state http.ConnState) {
+	if state != http.StateNew {
+		return
+	}
+
+	f.mu.Lock()
+	f.conns++
+	f.mu.Unlock()
+}
+
+func (f *fakeContainerEngine) connCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.conns
+}
+
+func (f *fakeContainerEngine) handleCreate(w http.ResponseWriter, r *http.Request) {
+	spec := &containerCreateRequest{}
+	_ = json.NewDecoder(r.Body).Decode(spec)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	image := spec.Image
+	if !imageHasTag(image) {
+		image += ":latest"
+	}
+
+	if !f.images[image] {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "No such image: ` + spec.Image + `"}`))
+
+		return
+	}
+
+	f.created = spec
+
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(`{"Id": "` + fakeContainerId + `"}`))
+}
+
+func (f *fakeContainerEngine) handlePull(w http.ResponseWriter, r *http.Request) {
+	image := r.URL.Query().Get("fromImage") + ":" + r.URL.Query().Get("tag")
+
+	if f.pullBlocks {
+		<-r.Context().Done()
+		return
+	}
+
+	f.mu.Lock()
+	f.images[image] = true
+	f.pulled = append(f.pulled, image)
+	f.mu.Unlock()
+
+	_, _ = w.Write([]byte(`{"status": "Pulling from library/busybox", "id": "latest"}` + "\n" +
+		`{"status": "Downloading", "progress": "[=>   ]", "id": "abcd"}` + "\n" +
+		`{"status": "Pull complete", "id": "abcd"}` + "\n" +
+		`{"status": "Status: Downloaded newer image for busybox:latest"}` + "\n"))
+}
+
+func (f *fakeContainerEngine) handleStart(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.started = true
+	f.mu.Unlock()
+
+	if !f.keepRunning {
+		f.exit.Do(func() { close(f.exited) })
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeContainerEngine) handleLogs(w http.ResponseWriter, r *http.Request) {
+	writeLogFrame(w, 1, f.stdout)
+	writeLogFrame(w, 2, f.stderr)
+	w.(http.Flusher).Flush()
+
+	select {
+	case <-f.exited:
+	case <-r.Context().Done():
+	}
+}
+
+func writeLogFrame(w http.ResponseWriter, stream byte, payload string) {
+	if payload == "" {
+		return
+	}
+
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+
+	_, _ = w.Write(header)
+	_, _ = w.Write([]byte(payload))
+}
+
+func (f *fakeContainerEngine) handleWait(w http.ResponseWriter, r *http.Request) {
+	select {
+	case <-f.exited:
+	case <-r.Context().Done():
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(map[string]int{"StatusCode": f.exitCode})
+}
+
+func (f *fakeContainerEngine) handleRemove(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.removed = append(f.removed, r.PathValue("id"))
+
+	f.exit.Do(func() {
+		f.exitCode = 137
+		close(f.exited)
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func containerTestingExecutor(socket string, action *config.Action) (*Executor, *config.Config) {
+	cfg := config.DefaultConfig()
+	cfg.ContainerEngine.Socket = socket
+	cfg.Actions = append(cfg.Actions, action)
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	return e, cfg
+}
+
+func TestExecInContainer(t *testing.T) {
+	engine := startFakeContainerEngine(t)
+	engine.stdout = "hello from the container\n"
+	engine.stderr = "oops\n"
+	engine.exitCode = 3
+
+	e, cfg := containerTestingExecutor(engine.socket, &config.Action{
+		Title: "container",
+		Shell: "echo hello",
+		Container: config.ContainerConfig{
+			Image:   "alpine:latest",
+			Mounts:  []string{"/srv/data:/data:ro"},
+			Env:     []string{"GREETING=hello"},
+			Network: "none",
+		},
+	})
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, int32(3), entry.ExitCode)
+	assert.Equal(t, "hello from the container\noops\n", entry.Output)
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	require.NotNil(t, engine.created)
+	assert.Equal(t, []string{"sh", "-c", "echo hello"}, engine.created.Cmd)
+	assert.Contains(t, engine.created.Env, "OLIVETIN=1")
+	assert.Contains(t, engine.created.Env, "GREETING=hello")
+	assert.Equal(t, []string{"/srv/data:/data:ro"}, engine.created.HostConfig.Binds)
+	assert.Equal(t, "none", engine.created.HostConfig.NetworkMode)
+	assert.Equal(t, trackingID, engine.created.Labels["olivetin.executionTrackingId"])
+	assert.Equal(t, []string{fakeContainerId}, engine.removed, "The container is removed after it exits")
+}
+
+func TestExecInContainerReusesEngineConnections(t *testing.T) {
+	engine := startFakeContainerEngine(t)
+
+	e, cfg := containerTestingExecutor(engine.socket, &config.Action{
+		Title:     "container",
+		Shell:     "true",
+		Container: config.ContainerConfig{Image: "alpine:latest"},
+	})
+
+	exec := func() {
+		wg, _ := e.ExecRequest(&ExecutionRequest{
+			Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+			Cfg:     cfg,
+		})
+		wg.Wait()
+	}
+
+	exec()
+	afterFirst := engine.connCount()
+
+	exec()
+	exec()
+
+	assert.Equal(t, afterFirst, engine.connCount(), "Later executions use the idle connections of the first")
+}
+
+func TestExecInContainerPullsMissingImage(t *testing.T) {
+	engine := startFakeContainerEngine(t)
+	engine.stdout = "pulled\n"
+
+	e, cfg := containerTestingExecutor(engine.socket, &config.Action{
+		Title:     "container",
+		Exec:      []string{"echo", "pulled"},
+		Container: config.ContainerConfig{Image: "busybox"},
+	})
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, int32(0), entry.ExitCode)
+	assert.Equal(t, "Pulling container image busybox\nlatest: Pulling from library/busybox\nabcd: Pull complete\nStatus: Downloaded newer image for busybox:latest\n", entry.Stderr)
+	assert.Equal(t, "pulled\n", entry.Stdout)
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	assert.Equal(t, []string{"busybox:latest"}, engine.pulled)
+	assert.Equal(t, []string{"echo", "pulled"}, engine.created.Cmd)
+}
+
+func TestPullIsNotLimitedByActionTimeout(t *testing.T) {
+	engine := startFakeContainerEngine(t)
+	engine.pullBlocks = true
+
+	e, cfg := containerTestingExecutor(engine.socket, &config.Action{
+		Title:     "container",
+		Shell:     "echo hello",
+		Timeout:   3,
+		Container: config.ContainerConfig{Image: "busybox", PullTimeout: 5},
+	})
+
+	start := time.Now()
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.GreaterOrEqual(t, time.Since(start), 5*time.Second)
+	assert.False(t, entry.TimedOut, "The pull timing out is not the action timing out")
+	assert.Contains(t, entry.Output, "context deadline exceeded")
+}
+
+func TestKillExecInContainerWhilePulling(t *testing.T) {
+	engine := startFakeContainerEngine(t)
+	engine.pullBlocks = true
+
+	e, cfg := containerTestingExecutor(engine.socket, &config.Action{
+		Title:     "container",
+		Shell:     "echo hello",
+		Container: config.ContainerConfig{Image: "busybox"},
+	})
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+
+	require.Eventually(t, func() bool {
+		entry, _ := e.GetLog(trackingID)
+		return e.Kill(entry) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.True(t, entry.ExecutionFinished)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+	assert.Contains(t, entry.Output, "context canceled")
+}
+
+func TestKillExecInContainer(t *testing.T) {
+	engine := startFakeContainerEngine(t)
+	engine.keepRunning = true
+
+	e, cfg := containerTestingExecutor(engine.socket, &config.Action{
+		Title:     "container",
+		Shell:     "sleep 10",
+		Container: config.ContainerConfig{Image: "alpine:latest"},
+	})
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+
+	require.Eventually(t, func() bool {
+		engine.mu.Lock()
+		defer engine.mu.Unlock()
+
+		return engine.started
+	}, 5*time.Second, 10*time.Millisecond)
+
+	entry, _ := e.GetLog(trackingID)
+	require.NoError(t, e.Kill(entry))
+
+	wg.Wait()
+
+	entry, _ = e.GetLog(trackingID)
+	assert.True(t, entry.ExecutionFinished)
+	assert.False(t, entry.TimedOut)
+	assert.Equal(t, int32(137), entry.ExitCode)
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	assert.Contains(t, engine.removed, fakeContainerId)
+}
+
+func TestExecInContainerWithoutEngine(t *testing.T) {
+	e, cfg := containerTestingExecutor(filepath.Join(t.TempDir(), "missing.sock"), &config.Action{
+		Title:     "container",
+		Shell:     "echo hello",
+		Container: config.ContainerConfig{Image: "alpine:latest"},
+	})
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+	assert.Contains(t, entry.Output, "missing.sock")
+}
+
+func TestImageHasTag(t *testing.T) {
+	assert.True(t, imageHasTag("alpine:3.20"))
+	assert.True(t, imageHasTag("ghcr.io/olivetin/olivetin@sha256:abcd"))
+	assert.True(t, imageHasTag("localhost:5000/tools:v1"))
+	assert.False(t, imageHasTag("alpine"))
+	assert.False(t, imageHasTag("localhost:5000/tools"))
+}
@@ -0,0 +1,97 @@
+package executor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/OliveTin/OliveTin/internal/entities"
+	log "github.com/sirupsen/logrus"
+)
+
+// entityUpdatePrefix marks a line of stdout as an entity update, followed by
+// the update as JSON.
+const entityUpdatePrefix = "OliveTin::entity "
+
+// entityUpdateMaxLineLength is the longest line of stdout that is read for
+// entity updates. Longer lines stop the reading of updates, which is noted in
+// the output, as bufio.Scanner cannot skip them.
+const entityUpdateMaxLineLength = 1024 * 1024
+
+// stepUpdateEntities applies the entity updates that an action wrote to its
+// stdout. Actions may only update the entity types listed in their
+// updatesEntities, and updates that cannot be applied are noted in the output
+// without failing the execution.
+func stepUpdateEntities(req *ExecutionRequest) bool {
+	allowed := req.Binding.Action.UpdatesEntities
+
+	if len(allowed) == 0 {
+		return true
+	}
+
+	updates, problems := parseEntityUpdates(req.logEntry.Stdout, allowed)
+
+	if err := entities.UpdateEntities(updates); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	for _, problem := range problems {
+		log.WithFields(log.Fields{
+			"actionTitle": req.logEntry.ActionTitle,
+			"problem":     problem,
+		}).Warnf("Could not update entity")
+
+		req.mutateLogEntry(func(entry *InternalLogEntry) {
+			entry.Output += "OliveTin::entity - " + problem + "\n"
+		})
+	}
+
+	return true
+}
+
+func parseEntityUpdates(stdout string, allowed []string) ([]*entities.EntityUpdate, []string) {
+	var updates []*entities.EntityUpdate
+	var problems []string
+
+	scanner := bufio.NewScanner(strings.NewReader(stdout))
+	scanner.Buffer(nil, entityUpdateMaxLineLength)
+
+	for scanner.Scan() {
+		line, found := strings.CutPrefix(scanner.Text(), entityUpdatePrefix)
+
+		if !found {
+			continue
+		}
+
+		update, err := parseEntityUpdate(line, allowed)
+
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		updates = append(updates, update)
+	}
+
+	if err := scanner.Err(); err != nil {
+		problems = append(problems, fmt.Sprintf("could not read the rest of the output: %v", err))
+	}
+
+	return updates, problems
+}
+
+func parseEntityUpdate(line string, allowed []string) (*entities.EntityUpdate, error) {
+	update := &entities.EntityUpdate{}
+
+	if err := json.Unmarshal([]byte(line), update); err != nil {
+		return nil, fmt.Errorf("could not parse the update: %v", err)
+	}
+
+	if !slices.Contains(allowed, update.Entity) {
+		return nil, fmt.Errorf("the action is not allowed to update %q entities", update.Entity)
+	}
+
+	return update, nil
+}
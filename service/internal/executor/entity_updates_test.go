@@ -0,0 +1,66 @@
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/entities"
+)
+
+func TestActionOutputUpdatesEntities(t *testing.T) {
+	entities.ClearEntitiesOfType("exec_update_test")
+	defer entities.ClearEntitiesOfType("exec_update_test")
+
+	entities.AddEntity("exec_update_test", "0", map[string]any{"hostname": "web1", "status": "unknown"})
+
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title: "Check servers",
+		Shell: `echo checking; ` +
+			`echo 'OliveTin::entity {"entity": "exec_update_test", "match": {"hostname": "web1"}, "data": {"status": "up"}}'; ` +
+			`echo 'OliveTin::entity {"entity": "other", "key": "0", "data": {"status": "up"}}'`,
+		UpdatesEntities: []string{"exec_update_test"},
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	instances := entities.GetEntityInstances("exec_update_test")
+	require.Len(t, instances, 1)
+	assert.Equal(t, "up", instances["0"].Data.(map[string]any)["status"])
+
+	assert.Empty(t, entities.GetEntityInstances("other"), "Actions can only update the entity types they list")
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, int32(0), entry.ExitCode)
+	assert.Contains(t, entry.Output, `OliveTin::entity - the action is not allowed to update "other" entities`)
+}
+
+func TestParseEntityUpdatesNotesInvalidJson(t *testing.T) {
+	updates, problems := parseEntityUpdates("OliveTin::entity {\nplain output\n", []string{"server"})
+
+	assert.Empty(t, updates)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0], "could not parse the update")
+}
+
+func TestParseEntityUpdatesNotesTooLongLine(t *testing.T) {
+	stdout := "OliveTin::entity {\"entity\": \"server\"}\n" + strings.Repeat("x", entityUpdateMaxLineLength+1) + "\n"
+
+	updates, problems := parseEntityUpdates(stdout, []string{"server"})
+
+	assert.Len(t, updates, 1)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0], "token too long")
+}
@@ -7,6 +7,7 @@ import (
 	config "github.com/OliveTin/OliveTin/internal/config"
 	"github.com/OliveTin/OliveTin/internal/entities"
 	"github.com/OliveTin/OliveTin/internal/logfilter"
+	"github.com/OliveTin/OliveTin/internal/shell"
 	"github.com/OliveTin/OliveTin/internal/tpl"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
@@ -15,6 +16,7 @@ import (
 
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -49,9 +51,12 @@ type ActionBinding struct {
 // Executor represents a helper class for executing commands. It's main method
 // is ExecRequest
 type Executor struct {
-	logs                  map[string]*InternalLogEntry
-	logsTrackingIdsByDate []string
-	LogsByBindingId       map[string][]*InternalLogEntry
+	// history holds every log entry, finished or not. liveLogs additionally
+	// holds the entries that are still being executed by this process, as
+	// those are mutated in place and own their *os.Process.
+	history      HistoryStore
+	liveLogs     map[string]*InternalLogEntry
+	nextLogIndex int64
 
 	logmutex sync.RWMutex
 
@@ -67,6 +72,14 @@ type Executor struct {
 
 	groupQueue   []*queuedExecution
 	groupQueueMu sync.Mutex
+
+	approvals   map[string]*PendingApproval
+	approvalsMu sync.Mutex
+
+	containerEngines   map[string]*containerEngine
+	containerEnginesMu sync.Mutex
+
+	agents AgentDispatcher
 }
 
 // ExecutionRequest is a request to execute an action. It's passed to an
@@ -81,6 +94,11 @@ type ExecutionRequest struct {
 	TriggerDepth      int
 	Justification     string
 
+	// WorkflowTrackingID and WorkflowStep are set when the execution is a
+	// step of a workflow run.
+	WorkflowTrackingID string
+	WorkflowStep       string
+
 	logEntry                *InternalLogEntry
 	finalParsedCommand      string
 	execArgs                []string
@@ -109,6 +127,8 @@ type LogEntrySnapshot struct {
 	ExecutionFinished bool
 	ExitCode          int32
 	Output            string
+	Attempt           int
+	FailedAttempts    int
 }
 
 // SnapshotLog returns a copy of selected log entry fields under read lock.
@@ -116,7 +136,7 @@ func (e *Executor) SnapshotLog(trackingID string) (LogEntrySnapshot, bool) {
 	e.logmutex.RLock()
 	defer e.logmutex.RUnlock()
 
-	entry, found := e.logs[trackingID]
+	entry, found := e.getLogLocked(trackingID)
 	if !found {
 		return LogEntrySnapshot{}, false
 	}
@@ -128,6 +148,8 @@ func (e *Executor) SnapshotLog(trackingID string) (LogEntrySnapshot, bool) {
 		ExecutionFinished: entry.ExecutionFinished,
 		ExitCode:          entry.ExitCode,
 		Output:            entry.Output,
+		Attempt:           entry.Attempt,
+		FailedAttempts:    len(entry.Attempts),
 	}, true
 }
 
@@ -139,6 +161,8 @@ type InternalLogEntry struct {
 	DatetimeStarted     time.Time
 	DatetimeFinished    time.Time
 	Output              string
+	Stdout              string
+	Stderr              string
 	TimedOut            bool
 	Blocked             bool
 	Queued              bool
@@ -149,10 +173,19 @@ type InternalLogEntry struct {
 	ExecutionFinished   bool
 	ExecutionTrackingID string
 	Process             *os.Process
+	killer              func() error // Set instead of Process when the execution is not a local process.
 	Username            string
 	Index               int64
 	EntityPrefix        string
 	ActionConfigTitle   string // This is the title of the action as defined in the config, not the final parsed title.
+	AwaitingApproval    bool
+	ApprovedBy          []string
+
+	// Attempt is the number of the current attempt, for actions with a retry
+	// policy, and Attempts are the attempts before it.
+	Attempt       int
+	Attempts      []ExecutionAttempt
+	killRequested bool
 
 	/*
 		The following 3 properties are obviously on Action normally, but it's useful
@@ -163,6 +196,12 @@ type InternalLogEntry struct {
 	ActionIcon    string
 	Justification string
 	Arguments     map[string]string
+
+	// Result is the parsed stdout of actions that have an outputFormat.
+	Result map[string]any
+
+	WorkflowTrackingID string
+	WorkflowStep       string
 }
 
 // .Binding can be nil, so we need to handle that.
@@ -181,19 +220,25 @@ type executorStepFunc func(*ExecutionRequest) bool
 func DefaultExecutor(cfg *config.Config) *Executor {
 	e := Executor{}
 	e.Cfg = cfg
-	e.logs = make(map[string]*InternalLogEntry)
-	e.logsTrackingIdsByDate = make([]string, 0)
-	e.LogsByBindingId = make(map[string][]*InternalLogEntry)
+	e.history = newMemoryHistoryStore()
+	e.liveLogs = make(map[string]*InternalLogEntry)
 	e.MapActionBindings = make(map[string]*ActionBinding)
+	e.approvals = make(map[string]*PendingApproval)
+	e.containerEngines = make(map[string]*containerEngine)
 
+	// Approval comes before the concurrency and rate checks, so that they are
+	// checked when the action runs, and a parked execution holds no slot.
 	e.chainOfCommand = []executorStepFunc{
 		stepRequestAction,
+		stepACLCheck,
+		stepApprovalCheck,
 		stepConcurrencyCheck,
 		stepRateCheck,
-		stepACLCheck,
 		stepParseArgs,
 		stepLogStart,
-		stepExec,
+		stepExecWithRetry,
+		stepParseOutput,
+		stepUpdateEntities,
 		stepExecAfter,
 		stepLogFinish,
 		stepSaveLog,
@@ -206,8 +251,10 @@ func DefaultExecutor(cfg *config.Config) *Executor {
 type listener interface {
 	OnExecutionStarted(logEntry *InternalLogEntry)
 	OnExecutionFinished(logEntry *InternalLogEntry)
-	OnOutputChunk(o []byte, executionTrackingId string)
+	OnOutputChunk(chunk OutputChunk, executionTrackingId string)
 	OnActionMapRebuilt()
+	OnApprovalRequested(approval *PendingApproval)
+	OnApprovalResolved(logEntry *InternalLogEntry, approved bool)
 }
 
 func (e *Executor) AddListener(m listener) {
@@ -256,71 +303,74 @@ type PagingResult struct {
 }
 
 func (e *Executor) GetLogTrackingIds(startOffset int64, pageCount int64) ([]*InternalLogEntry, *PagingResult) {
-	pagingResult := &PagingResult{
-		CountRemaining: 0,
-		PageSize:       pageCount,
-		TotalCount:     0,
-		StartOffset:    startOffset,
-	}
-
 	e.logmutex.RLock()
+	defer e.logmutex.RUnlock()
 
-	totalLogCount := int64(len(e.logsTrackingIdsByDate))
-	pagingResult.TotalCount = totalLogCount
-
-	startIndex := getPagingStartIndex(startOffset, totalLogCount)
+	return e.pageLogsLocked(HistoryQuery{}, startOffset, pageCount)
+}
 
-	pageCount = min(totalLogCount, pageCount)
+func isValidLogEntryForACL(entry *InternalLogEntry) bool {
+	return entry != nil && entry.Binding != nil && entry.Binding.Action != nil
+}
 
-	endIndex := max(0, (startIndex-pageCount)+1)
+// pageLogsLocked counts the entries that match the query, and then only reads
+// the page from the history store, newest first. The caller must hold
+// logmutex.
+func (e *Executor) pageLogsLocked(q HistoryQuery, startOffset int64, pageCount int64) ([]*InternalLogEntry, *PagingResult) {
+	total := int64(e.history.Count(q))
+	paging := &PagingResult{PageSize: pageCount, TotalCount: total, StartOffset: startOffset}
 
-	log.WithFields(log.Fields{
-		"startOffset": startOffset,
-		"pageCount":   pageCount,
-		"total":       totalLogCount,
-		"startIndex":  startIndex,
-		"endIndex":    endIndex,
-	}).Tracef("GetLogTrackingIds")
-
-	trackingIds := make([]*InternalLogEntry, 0, pageCount)
-
-	if totalLogCount > 0 {
-		for i := startIndex; i >= endIndex; i-- {
-			trackingIds = append(trackingIds, e.logs[e.logsTrackingIdsByDate[i]])
-		}
+	if total == 0 || pageCount <= 0 {
+		return []*InternalLogEntry{}, paging
 	}
 
-	e.logmutex.RUnlock()
+	startIndex := getPagingStartIndex(startOffset, total)
+	endIndex := max(0, (startIndex-min(total, pageCount))+1)
+
+	q.NewestFirst = true
+	q.Offset = total - 1 - startIndex
+	q.Limit = startIndex - endIndex + 1
 
-	pagingResult.CountRemaining = endIndex
+	paging.CountRemaining = endIndex
 
-	return trackingIds, pagingResult
+	return e.queryLogsLocked(q), paging
 }
 
-func isValidLogEntryForACL(entry *InternalLogEntry) bool {
-	return entry != nil && entry.Binding != nil && entry.Binding.Action != nil
+// allowedLogBindingIDs lists the bindings whose logs the user may see, so that
+// the ACL can be part of the history query rather than be checked on every
+// stored entry. Logs of actions that are no longer in the config are not
+// listed, as there is no action to check the ACL of.
+func (e *Executor) allowedLogBindingIDs(cfg *config.Config, user *authpublic.AuthenticatedUser) []string {
+	e.MapActionBindingsLock.RLock()
+	defer e.MapActionBindingsLock.RUnlock()
+
+	ret := make([]string, 0, len(e.MapActionBindings))
+
+	for id, binding := range e.MapActionBindings {
+		if acl.IsAllowedLogs(cfg, user, binding.Action) {
+			ret = append(ret, id)
+		}
+	}
+
+	return ret
 }
 
-func isLogEntryAllowedByACL(cfg *config.Config, user *authpublic.AuthenticatedUser, entry *InternalLogEntry) bool {
-	return acl.IsAllowedLogs(cfg, user, entry.Binding.Action)
+// logsQueryACL is the history query for the logs that the user may see, on the
+// day of the date filter, if any.
+func (e *Executor) logsQueryACL(cfg *config.Config, user *authpublic.AuthenticatedUser, dateFilter string) HistoryQuery {
+	q := dateFilterQuery(parseDateFilter(dateFilter))
+	q.BindingIDs = e.allowedLogBindingIDs(cfg, user)
+
+	return q
 }
 
 func (e *Executor) filterLogsByACL(cfg *config.Config, user *authpublic.AuthenticatedUser, dateFilter string) []*InternalLogEntry {
+	q := e.logsQueryACL(cfg, user, dateFilter)
+
 	e.logmutex.RLock()
 	defer e.logmutex.RUnlock()
 
-	filtered := make([]*InternalLogEntry, 0, len(e.logsTrackingIdsByDate))
-	filterDate, hasDateFilter := parseDateFilter(dateFilter)
-
-	for _, trackingId := range e.logsTrackingIdsByDate {
-		entry := e.logs[trackingId]
-
-		if shouldIncludeLogEntry(cfg, user, entry, filterDate, hasDateFilter) {
-			filtered = append(filtered, entry)
-		}
-	}
-
-	return filtered
+	return e.queryLogsLocked(q)
 }
 
 // parseDateFilter parses the date filter string and returns filter information.
@@ -341,28 +391,18 @@ func parseDateFilter(dateFilter string) (filterDate time.Time, hasDateFilter boo
 	return parsedDate, true
 }
 
-// shouldIncludeLogEntry determines if a log entry should be included based on ACL and date filter.
-func shouldIncludeLogEntry(cfg *config.Config, user *authpublic.AuthenticatedUser, entry *InternalLogEntry, filterDate time.Time, hasDateFilter bool) bool {
-	if !isValidLogEntryForACL(entry) {
-		return false
-	}
-
-	if !isLogEntryAllowedByACL(cfg, user, entry) {
-		return false
+// dateFilterQuery narrows a history query to the UTC day of the date filter.
+func dateFilterQuery(filterDate time.Time, hasDateFilter bool) HistoryQuery {
+	if !hasDateFilter {
+		return HistoryQuery{}
 	}
 
-	return matchesDateFilter(entry, filterDate, hasDateFilter)
-}
+	dayStart := filterDate.UTC().Truncate(24 * time.Hour)
 
-// matchesDateFilter checks if the log entry matches the date filter.
-func matchesDateFilter(entry *InternalLogEntry, filterDate time.Time, hasDateFilter bool) bool {
-	if !hasDateFilter {
-		return true
+	return HistoryQuery{
+		StartedFrom:  dayStart,
+		StartedUntil: dayStart.Add(24 * time.Hour),
 	}
-
-	entryDate := entry.DatetimeStarted.UTC().Truncate(24 * time.Hour)
-	filterDateUTC := filterDate.UTC().Truncate(24 * time.Hour)
-	return entryDate.Equal(filterDateUTC)
 }
 
 // paginateFilteredLogs applies pagination to a filtered list of logs and returns
@@ -393,15 +433,33 @@ func paginateFilteredLogs(filtered []*InternalLogEntry, startOffset int64, pageC
 // paginated correctly based on the filtered set.
 // dateFilter is optional and should be in YYYY-MM-DD format. If empty, no date filtering is applied.
 // expressionFilter is an optional filter expression applied after ACL checks.
+//
+// The ACL and date filter are part of the history query, so only one page is
+// read from the store. Filter expressions can only be evaluated on entries in
+// memory, so with an expression every entry that passes the ACL and date
+// filter is read, to know how many match.
 func (e *Executor) GetLogTrackingIdsACL(cfg *config.Config, user *authpublic.AuthenticatedUser, startOffset int64, pageCount int64, dateFilter string, expressionFilter string) ([]*InternalLogEntry, *PagingResult, error) {
-	filtered := e.filterLogsByACL(cfg, user, dateFilter)
-
 	program, err := logfilter.Compile(expressionFilter)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	filtered, err = applyLogFilter(filtered, program)
+	q := e.logsQueryACL(cfg, user, dateFilter)
+
+	if program == nil {
+		e.logmutex.RLock()
+		defer e.logmutex.RUnlock()
+
+		logs, paging := e.pageLogsLocked(q, startOffset, pageCount)
+
+		return logs, paging, nil
+	}
+
+	e.logmutex.RLock()
+	candidates := e.queryLogsLocked(q)
+	e.logmutex.RUnlock()
+
+	filtered, err := applyLogFilter(candidates, program)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -410,28 +468,76 @@ func (e *Executor) GetLogTrackingIdsACL(cfg *config.Config, user *authpublic.Aut
 	return logs, paging, nil
 }
 
+var errNothingToKill = errors.New("execution has no process to kill")
+
+// Kill stops a running execution, either by killing its local process group,
+// or by asking whatever is running it elsewhere to stop it.
+func (e *Executor) Kill(entry *InternalLogEntry) error {
+	e.logmutex.Lock()
+	entry.killRequested = true
+	killer := entry.killer
+	process := entry.Process
+	e.logmutex.Unlock()
+
+	if killer != nil {
+		return killer()
+	}
+
+	if process == nil {
+		return errNothingToKill
+	}
+
+	return killProcess(process)
+}
+
 func (e *Executor) GetLog(trackingID string) (*InternalLogEntry, bool) {
 	e.logmutex.RLock()
 
-	entry, found := e.logs[trackingID]
+	entry, found := e.getLogLocked(trackingID)
 
 	e.logmutex.RUnlock()
 
 	return entry, found
 }
 
-func (e *Executor) GetLogsByBindingId(bindingId string) []*InternalLogEntry {
-	e.logmutex.RLock()
+// getLogLocked prefers the live entry, as the stored copy of a running
+// execution may be behind. The caller must hold logmutex.
+func (e *Executor) getLogLocked(trackingID string) (*InternalLogEntry, bool) {
+	if entry, found := e.liveLogs[trackingID]; found {
+		return entry, true
+	}
 
-	logs, found := e.LogsByBindingId[bindingId]
+	return e.history.Get(trackingID)
+}
 
-	e.logmutex.RUnlock()
+// queryLogsLocked queries the history store, swapping in live entries for any
+// execution that is still running. The caller must hold logmutex.
+func (e *Executor) queryLogsLocked(q HistoryQuery) []*InternalLogEntry {
+	entries := e.history.Query(q)
 
-	if !found {
-		return make([]*InternalLogEntry, 0)
+	for i, entry := range entries {
+		if live, found := e.liveLogs[entry.ExecutionTrackingID]; found {
+			entries[i] = live
+		}
 	}
 
-	return logs
+	return entries
+}
+
+func (e *Executor) GetLogsByBindingId(bindingId string) []*InternalLogEntry {
+	e.logmutex.RLock()
+	defer e.logmutex.RUnlock()
+
+	return e.queryLogsLocked(HistoryQuery{BindingID: bindingId})
+}
+
+// GetLogsByWorkflow returns the executions of the steps of a workflow run,
+// oldest first.
+func (e *Executor) GetLogsByWorkflow(workflowTrackingID string) []*InternalLogEntry {
+	e.logmutex.RLock()
+	defer e.logmutex.RUnlock()
+
+	return e.queryLogsLocked(HistoryQuery{WorkflowTrackingID: workflowTrackingID})
 }
 
 // shouldCountExecution checks if a log entry should be counted for rate limiting.
@@ -504,19 +610,31 @@ func calculateExpiryForRate(rate config.RateSpec, logs []*InternalLogEntry, now
 	return calculateExpiryTime(*oldestExecutionTime, duration, now)
 }
 
-// getLogsForBinding retrieves logs for a binding ID.
-func (e *Executor) getLogsForBinding(bindingId string) []*InternalLogEntry {
+// getLogsForBindingSince retrieves logs for a binding ID started since windowStart.
+func (e *Executor) getLogsForBindingSince(bindingId string, windowStart time.Time) []*InternalLogEntry {
 	e.logmutex.RLock()
-	logs, found := e.LogsByBindingId[bindingId]
+	logs := e.queryLogsLocked(HistoryQuery{BindingID: bindingId, StartedFrom: windowStart})
 	e.logmutex.RUnlock()
 
-	if !found || len(logs) == 0 {
+	if len(logs) == 0 {
 		return nil
 	}
 
 	return logs
 }
 
+// longestRateDuration returns the widest window of all rate limit rules, so
+// that a single query covers every rule.
+func longestRateDuration(rates []config.RateSpec) time.Duration {
+	var longest time.Duration
+
+	for _, rate := range rates {
+		longest = max(longest, parseDuration(rate))
+	}
+
+	return longest
+}
+
 // calculateMaxExpiryTimeFromRates calculates the maximum expiry time across all rate limit rules.
 func calculateMaxExpiryTimeFromRates(rates []config.RateSpec, logs []*InternalLogEntry, now time.Time) time.Time {
 	var maxExpiryTime time.Time
@@ -536,12 +654,14 @@ func (e *Executor) GetTimeUntilAvailable(binding *ActionBinding) int64 {
 		return 0
 	}
 
-	logs := e.getLogsForBinding(binding.ID)
+	now := time.Now()
+
+	logs := e.getLogsForBindingSince(binding.ID, now.Add(-longestRateDuration(binding.Action.MaxRate)))
 	if logs == nil {
 		return 0
 	}
 
-	maxExpiryTime := calculateMaxExpiryTimeFromRates(binding.Action.MaxRate, logs, time.Now())
+	maxExpiryTime := calculateMaxExpiryTimeFromRates(binding.Action.MaxRate, logs, now)
 
 	if maxExpiryTime.IsZero() {
 		return 0
@@ -554,19 +674,42 @@ func (e *Executor) SetLog(trackingID string, entry *InternalLogEntry) string {
 	e.logmutex.Lock()
 	defer e.logmutex.Unlock()
 
-	if _, found := e.logs[trackingID]; found || !isValidTrackingID(trackingID) {
+	if _, found := e.getLogLocked(trackingID); found || !isValidTrackingID(trackingID) {
 		trackingID = uuid.NewString()
-		entry.ExecutionTrackingID = trackingID
 	}
 
-	entry.Index = int64(len(e.logsTrackingIdsByDate))
+	entry.ExecutionTrackingID = trackingID
+	entry.Index = e.nextLogIndex
+	e.nextLogIndex++
+
+	if !entry.ExecutionFinished {
+		e.liveLogs[trackingID] = entry
+	}
 
-	e.logs[trackingID] = entry
-	e.logsTrackingIdsByDate = append(e.logsTrackingIdsByDate, trackingID)
+	e.history.Put(entry)
+	metricLogsRetained.Inc()
 
 	return trackingID
 }
 
+// storeLog writes the current state of an entry to the history store.
+func (e *Executor) storeLog(entry *InternalLogEntry) {
+	e.logmutex.Lock()
+	defer e.logmutex.Unlock()
+
+	e.history.Put(entry)
+}
+
+// archiveLog stores the final state of a finished entry, after which it is
+// only read back from the history store.
+func (e *Executor) archiveLog(entry *InternalLogEntry) {
+	e.logmutex.Lock()
+	defer e.logmutex.Unlock()
+
+	e.history.Put(entry)
+	delete(e.liveLogs, entry.ExecutionTrackingID)
+}
+
 // ExecRequest processes an ExecutionRequest
 func (e *Executor) ExecRequest(req *ExecutionRequest) (*sync.WaitGroup, string) {
 	e.initializeExecRequest(req)
@@ -605,6 +748,8 @@ func (e *Executor) initializeExecRequest(req *ExecutionRequest) {
 		ActionTitle:         "notfound",
 		ActionIcon:          "&#x1f4a9;",
 		Username:            req.AuthenticatedUser.Username,
+		WorkflowTrackingID:  req.WorkflowTrackingID,
+		WorkflowStep:        req.WorkflowStep,
 	}
 }
 
@@ -643,8 +788,11 @@ func (e *Executor) registerOrQueueRequest(req *ExecutionRequest, wg *sync.WaitGr
 	return e.queueRequestIfGroupLimited(req, wg)
 }
 
+// finishIfConcurrencyBlocked blocks an execution before it is logged as
+// started. Actions that need approval are parked first, and are checked by
+// stepConcurrencyCheck once they are approved.
 func (e *Executor) finishIfConcurrencyBlocked(req *ExecutionRequest) bool {
-	if actionNeedsGroupLimit(req) {
+	if actionNeedsGroupLimit(req) || req.Binding.Action.RequiresApproval() {
 		return false
 	}
 
@@ -697,6 +845,8 @@ func (e *Executor) finishExecChain(req *ExecutionRequest) {
 		entry.ExecutionFinished = true
 	})
 
+	e.archiveLog(req.logEntry)
+
 	recordExecutionMetrics(req.logEntry)
 
 	notifyListenersFinished(req)
@@ -707,10 +857,9 @@ func getConcurrentCount(req *ExecutionRequest) int {
 	concurrentCount := 0
 
 	req.executor.logmutex.RLock()
-	logs := req.executor.LogsByBindingId[req.Binding.ID]
 
-	for _, logEntry := range logs {
-		if !logEntry.ExecutionFinished && !logEntry.Queued {
+	for _, logEntry := range req.executor.liveLogs {
+		if isRunningForBinding(logEntry, req.Binding.ID) {
 			concurrentCount += 1
 		}
 	}
@@ -720,6 +869,10 @@ func getConcurrentCount(req *ExecutionRequest) int {
 	return concurrentCount
 }
 
+func isRunningForBinding(logEntry *InternalLogEntry, bindingId string) bool {
+	return logEntry.GetBindingId() == bindingId && !logEntry.ExecutionFinished && !logEntry.Queued && !logEntry.AwaitingApproval
+}
+
 func stepConcurrencyCheck(req *ExecutionRequest) bool {
 	if actionNeedsGroupLimit(req) {
 		return true
@@ -770,7 +923,7 @@ func rateExecutionMatchesScope(logEntry *InternalLogEntry, req *ExecutionRequest
 		return false
 	}
 
-	return !logEntry.Queued && logEntry.ExecutionTrackingID != req.TrackingID
+	return !logEntry.Queued && !logEntry.AwaitingApproval && logEntry.ExecutionTrackingID != req.TrackingID
 }
 
 func logEntryStartedInWindow(logEntry *InternalLogEntry, windowStart time.Time) bool {
@@ -798,7 +951,7 @@ func getExecutionsCount(rate config.RateSpec, req *ExecutionRequest) int {
 	then := time.Now().Add(-duration)
 
 	req.executor.logmutex.RLock()
-	logs := req.executor.LogsByBindingId[req.Binding.ID]
+	logs := req.executor.queryLogsLocked(HistoryQuery{BindingID: req.Binding.ID, StartedFrom: then})
 	executions := countRateExecutions(logs, req, entityPrefixForRequest(req), then)
 	req.executor.logmutex.RUnlock()
 
@@ -829,7 +982,7 @@ func stepRateCheck(req *ExecutionRequest) bool {
 }
 
 func stepACLCheck(req *ExecutionRequest) bool {
-	canExec := acl.IsAllowedExec(req.Cfg, req.AuthenticatedUser, req.Binding.Action)
+	canExec := acl.RequireExec(req.Cfg, req.AuthenticatedUser, req.Binding.Action)
 
 	if !canExec {
 		req.mutateLogEntry(func(entry *InternalLogEntry) {
@@ -1040,13 +1193,7 @@ func stepRequestActionPopulateLogEntry(req *ExecutionRequest) {
 }
 
 func stepRequestActionRegisterLog(req *ExecutionRequest) {
-	req.executor.logmutex.Lock()
-	defer req.executor.logmutex.Unlock()
-
-	if _, containsKey := req.executor.LogsByBindingId[req.Binding.ID]; !containsKey {
-		req.executor.LogsByBindingId[req.Binding.ID] = make([]*InternalLogEntry, 0)
-	}
-	req.executor.LogsByBindingId[req.Binding.ID] = append(req.executor.LogsByBindingId[req.Binding.ID], req.logEntry)
+	req.executor.storeLog(req.logEntry)
 }
 
 func stepLogStart(req *ExecutionRequest) bool {
@@ -1092,28 +1239,18 @@ func appendErrorToStderr(req *ExecutionRequest, err error) {
 
 	req.mutateLogEntry(func(entry *InternalLogEntry) {
 		entry.Output = err.Error() + "\n\n" + entry.Output
+		entry.Stderr = err.Error() + "\n\n" + entry.Stderr
 	})
 }
 
-type OutputStreamer struct {
-	Req    *ExecutionRequest
-	output bytes.Buffer
-}
-
-func (ost *OutputStreamer) Write(o []byte) (n int, err error) {
-	for _, listener := range ost.Req.executor.copyListeners() {
-		listener.OnOutputChunk(o, ost.Req.TrackingID)
-	}
-
-	return ost.output.Write(o)
-}
-
-func (ost *OutputStreamer) String() string {
-	return ost.output.String()
+func buildEnv(args map[string]string) []string {
+	return append(os.Environ(), argumentEnv(args)...)
 }
 
-func buildEnv(args map[string]string) []string {
-	ret := append(os.Environ(), "OLIVETIN=1")
+// argumentEnv is the environment that OliveTin adds for an execution, on top
+// of whatever environment the command is run in.
+func argumentEnv(args map[string]string) []string {
+	ret := []string{"OLIVETIN=1"}
 
 	for k, v := range args {
 		varName := fmt.Sprintf("%v", strings.TrimSpace(strings.ToUpper(k)))
@@ -1137,6 +1274,19 @@ func commandExitCode(cmd *exec.Cmd) int {
 }
 
 func stepExec(req *ExecutionRequest) bool {
+	switch {
+	case req.Binding.Action.RunOn != "":
+		return stepExecOnAgent(req)
+	case req.Binding.Action.RunsOverSsh():
+		return stepExecOverSsh(req)
+	case req.Binding.Action.RunsInContainer():
+		return stepExecInContainer(req)
+	default:
+		return stepExecLocal(req)
+	}
+}
+
+func stepExecLocal(req *ExecutionRequest) bool {
 	ctx, cancel := newTimeoutContext(context.Background(), time.Duration(req.Binding.Action.Timeout)*time.Second, req.executor)
 	defer cancel()
 	streamer := &OutputStreamer{Req: req}
@@ -1157,21 +1307,14 @@ func stepExec(req *ExecutionRequest) bool {
 	waiterr := cmd.Wait()
 	req.mutateLogEntry(func(entry *InternalLogEntry) {
 		entry.ExitCode = int32(commandExitCode(cmd))
-		entry.Output = streamer.String()
+		streamer.saveTo(entry)
 	})
 
 	appendErrorToStderr(req, runerr)
 	appendErrorToStderr(req, waiterr)
 
 	if ctx.Err() == context.DeadlineExceeded {
-		log.WithFields(log.Fields{
-			"actionTitle": req.logEntry.ActionTitle,
-		}).Warnf("Action timed out")
-
-		req.mutateLogEntry(func(entry *InternalLogEntry) {
-			entry.TimedOut = true
-			entry.Output += "OliveTin::timeout - this action timed out after " + fmt.Sprintf("%v", req.Binding.Action.Timeout) + " seconds. If you need more time for this action, set a longer timeout. See https://docs.olivetin.app/action_customization/timeouts.html for more help."
-		})
+		markTimedOut(req)
 	}
 
 	req.mutateLogEntry(func(entry *InternalLogEntry) {
@@ -1181,18 +1324,33 @@ func stepExec(req *ExecutionRequest) bool {
 	return true
 }
 
+func markTimedOut(req *ExecutionRequest) {
+	log.WithFields(log.Fields{
+		"actionTitle": req.logEntry.ActionTitle,
+	}).Warnf("Action timed out")
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.TimedOut = true
+		entry.Output += "OliveTin::timeout - this action timed out after " + fmt.Sprintf("%v", req.Binding.Action.Timeout) + " seconds. If you need more time for this action, set a longer timeout. See https://docs.olivetin.app/action_customization/timeouts.html for more help."
+	})
+}
+
 func buildCommand(ctx context.Context, req *ExecutionRequest) *exec.Cmd {
 	if req.useDirectExec {
 		return wrapCommandDirect(ctx, req.execArgs)
 	}
-	return wrapCommandInShell(ctx, req.finalParsedCommand)
+	return shell.Command(ctx, req.finalParsedCommand)
 }
 
 func prepareCommand(cmd *exec.Cmd, streamer *OutputStreamer, req *ExecutionRequest) {
 	cmd.Stdout = streamer
-	cmd.Stderr = streamer
+	cmd.Stderr = streamer.Stderr()
 	cmd.Env = buildEnv(req.Arguments)
 
+	markExecutionStarted(req)
+}
+
+func markExecutionStarted(req *ExecutionRequest) {
 	started := false
 	req.mutateLogEntry(func(entry *InternalLogEntry) {
 		if entry.ExecutionStarted {
@@ -1221,7 +1379,7 @@ func stepExecAfter(req *ExecutionRequest) bool {
 		return true
 	}
 
-	cmd.Env = buildEnv(args)
+	cmd.Env = append(buildEnv(args), resultEnv(req.logEntry.Result)...)
 
 	runerr := cmd.Start()
 	ctx.setProcess(cmd.Process)
@@ -1235,6 +1393,8 @@ func stepExecAfter(req *ExecutionRequest) bool {
 		entry.Output += "OliveTin::shellAfterCompleted stderr\n"
 		entry.Output += stderr.String()
 		entry.Output += "OliveTin::shellAfterCompleted errors and summary\n"
+		entry.Stdout += stdout.String()
+		entry.Stderr += stderr.String()
 	})
 
 	appendErrorToStderr(req, runerr)
@@ -1320,7 +1480,7 @@ func buildShellAfterCommand(ctx context.Context, req *ExecutionRequest, stdout,
 		return nil, nil, err
 	}
 
-	cmd := wrapCommandInShell(ctx, finalParsedCommand)
+	cmd := shell.Command(ctx, finalParsedCommand)
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
 
@@ -1366,32 +1526,47 @@ func stepTrigger(req *ExecutionRequest) bool {
 }
 
 func triggerLoop(req *ExecutionRequest) {
-	for _, triggerTitle := range req.Binding.Action.Triggers {
-		binding := req.executor.findBindingByActionTitle(triggerTitle, "")
+	for _, trigger := range req.Binding.Action.Triggers {
+		if !triggerConditionsMatch(trigger, req.logEntry) {
+			continue
+		}
+
+		binding := req.executor.findBindingByActionTitle(trigger.Action, "")
 		if binding == nil {
 			log.WithFields(log.Fields{
-				"triggerTitle": triggerTitle,
+				"triggerTitle": trigger.Action,
 				"fromAction":   req.logEntry.ActionTitle,
 			}).Warnf("Trigger references unknown action title; skipping")
 			continue
 		}
-		trigger := &ExecutionRequest{
+
+		args, err := triggerArguments(trigger, req, binding.Action)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"triggerTitle": trigger.Action,
+				"fromAction":   req.logEntry.ActionTitle,
+				"error":        err,
+			}).Warnf("Trigger arguments could not be parsed; skipping")
+			continue
+		}
+
+		triggered := &ExecutionRequest{
 			Binding:           binding,
 			TrackingID:        uuid.NewString(),
 			Tags:              []string{"trigger"},
 			AuthenticatedUser: req.AuthenticatedUser,
-			Arguments:         req.Arguments,
+			Arguments:         args,
 			Cfg:               req.Cfg,
 			TriggerDepth:      req.TriggerDepth + 1,
 			Justification:     fmt.Sprintf("Triggered by action: %s", req.logEntry.ActionTitle),
 		}
 
-		req.executor.ExecRequest(trigger)
+		req.executor.ExecRequest(triggered)
 	}
 }
 
 func stepSaveLog(req *ExecutionRequest) bool {
-	filename := fmt.Sprintf("%v.%v.%v", req.logEntry.ActionTitle, req.logEntry.DatetimeStarted.Unix(), req.logEntry.ExecutionTrackingID)
+	filename := savedLogFilename(req.logEntry)
 
 	saveLogResults(req, filename)
 	saveLogOutput(req, filename)
@@ -1399,6 +1574,10 @@ func stepSaveLog(req *ExecutionRequest) bool {
 	return true
 }
 
+func savedLogFilename(entry *InternalLogEntry) string {
+	return fmt.Sprintf("%v.%v.%v", entry.ActionTitle, entry.DatetimeStarted.Unix(), entry.ExecutionTrackingID)
+}
+
 func firstNonEmpty(one, two string) string {
 	if one != "" {
 		return one
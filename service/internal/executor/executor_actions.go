@@ -35,6 +35,12 @@ func (e *Executor) FindBindingWithNoEntity(action *config.Action) *ActionBinding
 	return nil
 }
 
+// FindBindingByActionTitle returns the binding of the action with the title,
+// that is not bound to an entity.
+func (e *Executor) FindBindingByActionTitle(actionTitle string) *ActionBinding {
+	return e.findBindingByActionTitle(actionTitle, "")
+}
+
 type RebuildActionMapRequest struct {
 	Cfg              *config.Config
 	dashboardTargets *dashboardTargetIndex
@@ -94,6 +100,8 @@ func (e *Executor) RebuildActionMap() {
 
 	e.MapActionBindingsLock.Unlock()
 
+	e.rebindHistory()
+
 	for _, l := range e.copyListeners() {
 		l.OnActionMapRebuilt()
 	}
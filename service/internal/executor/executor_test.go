@@ -699,7 +699,7 @@ func TestTriggerExecutesTriggeredAction(t *testing.T) {
 	triggerAction := &config.Action{
 		Title:    "Simple action that triggers another action",
 		Shell:    "echo 'Hi'",
-		Triggers: []string{"Hello world"},
+		Triggers: []config.ActionTrigger{{Action: "Hello world"}},
 	}
 	cfg.Actions = append(cfg.Actions, helloAction, triggerAction)
 	cfg.Sanitize()
@@ -736,7 +736,7 @@ func TestTriggerUnknownActionTitleSkipsWithoutPanic(t *testing.T) {
 	triggerAction := &config.Action{
 		Title:    "Action with bad trigger",
 		Shell:    "echo 'ok'",
-		Triggers: []string{"Nonexistent action"},
+		Triggers: []config.ActionTrigger{{Action: "Nonexistent action"}},
 	}
 	cfg.Actions = append(cfg.Actions, triggerAction)
 	cfg.Sanitize()
@@ -777,6 +777,10 @@ func (c *executionFinishedCollector) OnExecutionFinished(entry *InternalLogEntry
 	c.ch <- entry.ActionTitle
 }
 
-func (c *executionFinishedCollector) OnOutputChunk(_ []byte, _ string) {}
+func (c *executionFinishedCollector) OnOutputChunk(_ OutputChunk, _ string) {}
 
 func (c *executionFinishedCollector) OnActionMapRebuilt() {}
+
+func (c *executionFinishedCollector) OnApprovalRequested(_ *PendingApproval) {}
+
+func (c *executionFinishedCollector) OnApprovalResolved(_ *InternalLogEntry, _ bool) {}
@@ -5,22 +5,14 @@ package executor
 
 import (
 	"context"
+	"os"
 	"os/exec"
 	"syscall"
 )
 
-func (e *Executor) Kill(execReq *InternalLogEntry) error {
+func killProcess(process *os.Process) error {
 	// A negative PID means to kill the whole process group. This is *nix specific behavior.
-	return syscall.Kill(-execReq.Process.Pid, syscall.SIGKILL)
-}
-
-func wrapCommandInShell(ctx context.Context, finalParsedCommand string) *exec.Cmd {
-	cmd := exec.CommandContext(ctx, "sh", "-c", finalParsedCommand)
-
-	// This is to ensure that the process group is killed when the parent process is killed.
-	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-
-	return cmd
+	return syscall.Kill(-process.Pid, syscall.SIGKILL)
 }
 
 func wrapCommandDirect(ctx context.Context, execArgs []string) *exec.Cmd {
@@ -75,7 +75,7 @@ func (e *Executor) countActiveInGroup(groupName string) int {
 func (e *Executor) countActiveInGroupLocked(groupName string) int {
 	count := 0
 
-	for _, logEntry := range e.logs {
+	for _, logEntry := range e.liveLogs {
 		if logEntryIsActiveInGroup(logEntry, groupName) {
 			count++
 		}
@@ -87,7 +87,7 @@ func (e *Executor) countActiveInGroupLocked(groupName string) int {
 func (e *Executor) countQueuedInGroupLocked(groupName string) int {
 	count := 0
 
-	for _, logEntry := range e.logs {
+	for _, logEntry := range e.liveLogs {
 		if queuedLogEntryInGroup(logEntry, groupName) {
 			count++
 		}
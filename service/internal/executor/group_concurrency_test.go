@@ -407,10 +407,14 @@ func (c *executionStartedCollector) OnExecutionStarted(entry *InternalLogEntry)
 
 func (c *executionStartedCollector) OnExecutionFinished(_ *InternalLogEntry) {}
 
-func (c *executionStartedCollector) OnOutputChunk(_ []byte, _ string) {}
+func (c *executionStartedCollector) OnOutputChunk(_ OutputChunk, _ string) {}
 
 func (c *executionStartedCollector) OnActionMapRebuilt() {}
 
+func (c *executionStartedCollector) OnApprovalRequested(_ *PendingApproval) {}
+
+func (c *executionStartedCollector) OnApprovalResolved(_ *InternalLogEntry, _ bool) {}
+
 func assertWaitGroupPending(t *testing.T, wg *sync.WaitGroup) {
 	t.Helper()
 
@@ -0,0 +1,382 @@
+package executor
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	// Registers the pure Go "sqlite" database/sql driver.
+	_ "modernc.org/sqlite"
+)
+
+const sqliteHistorySchema = `
+CREATE TABLE IF NOT EXISTS executions (
+	tracking_id TEXT PRIMARY KEY,
+	idx INTEGER NOT NULL,
+	binding_id TEXT NOT NULL DEFAULT '',
+	datetime_started INTEGER NOT NULL,
+	entry TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS executions_by_idx ON executions (idx);
+CREATE INDEX IF NOT EXISTS executions_by_started ON executions (datetime_started);
+CREATE INDEX IF NOT EXISTS executions_by_binding ON executions (binding_id, datetime_started);
+`
+
+// bindingResolver finds the current ActionBinding for an entry read back from
+// disk, as bindings are rebuilt on every config reload.
+type bindingResolver func(bindingID string, entry *InternalLogEntry) *ActionBinding
+
+// sqliteHistoryStore keeps execution history in a SQLite database, so that it
+// survives restarts and is queried by index rather than by walking every entry.
+type sqliteHistoryStore struct {
+	db       *sql.DB
+	bindings bindingResolver
+}
+
+func openSqliteHistoryStore(filename string, bindings bindingResolver) (*sqliteHistoryStore, error) {
+	db, err := sql.Open("sqlite", filename+"?_pragma=journal_mode(WAL)&_pragma=busy_timeout(5000)")
+	if err != nil {
+		return nil, err
+	}
+
+	// All access is already serialized by Executor.logmutex.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteHistorySchema); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	store := &sqliteHistoryStore{
+		db:       db,
+		bindings: bindings,
+	}
+
+	store.finishAbandonedEntries()
+
+	return store, nil
+}
+
+// finishAbandonedEntries marks entries that were still running when the
+// previous process stopped as finished, as nothing will ever finish them now.
+func (s *sqliteHistoryStore) finishAbandonedEntries() {
+	res, err := s.db.Exec(`UPDATE executions SET entry = json_set(entry, '$.ExecutionFinished', json('true'), '$.AwaitingApproval', json('false')) WHERE json_extract(entry, '$.ExecutionFinished') = 0`)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to mark abandoned executions as finished")
+		return
+	}
+
+	if count, _ := res.RowsAffected(); count > 0 {
+		log.WithFields(log.Fields{
+			"count": count,
+		}).Infof("Marked executions left running by a previous process as finished")
+	}
+}
+
+func bindingIdOfEntry(entry *InternalLogEntry) string {
+	if entry.Binding == nil {
+		return ""
+	}
+
+	return entry.Binding.ID
+}
+
+func (s *sqliteHistoryStore) Put(entry *InternalLogEntry) {
+	stored := *entry
+	stored.Binding = nil
+	stored.Process = nil
+
+	data, err := json.Marshal(&stored)
+	if err == nil {
+		_, err = s.db.Exec(`INSERT INTO executions (tracking_id, idx, binding_id, datetime_started, entry) VALUES (?, ?, ?, ?, ?)
+			ON CONFLICT (tracking_id) DO UPDATE SET binding_id = excluded.binding_id, datetime_started = excluded.datetime_started, entry = excluded.entry`,
+			entry.ExecutionTrackingID, entry.Index, bindingIdOfEntry(entry), entry.DatetimeStarted.UnixNano(), string(data))
+	}
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"trackingId": entry.ExecutionTrackingID,
+			"error":      err,
+		}).Warnf("Failed to save execution to history database")
+	}
+}
+
+func (s *sqliteHistoryStore) Get(trackingID string) (*InternalLogEntry, bool) {
+	entries := s.selectEntries("SELECT binding_id, entry FROM executions WHERE tracking_id = ?", trackingID)
+
+	if len(entries) == 0 {
+		return nil, false
+	}
+
+	return entries[0], true
+}
+
+func (s *sqliteHistoryStore) Query(q HistoryQuery) []*InternalLogEntry {
+	where, args := sqliteHistoryWhere(q)
+	order, pageArgs := sqliteHistoryPage(q)
+
+	return s.selectEntries("SELECT binding_id, entry FROM executions"+where+order, append(args, pageArgs...)...)
+}
+
+// Rebind updates the binding_id column, one stored binding at a time, as the
+// entries themselves do not include their binding.
+func (s *sqliteHistoryStore) Rebind(bindings *currentBindings) {
+	for _, stored := range s.storedBindings() {
+		binding, found := bindings.rebound(stored.bindingID, stored.actionConfigTitle, stored.entityPrefix)
+
+		if found {
+			s.rebindStored(stored, binding.ID)
+		}
+	}
+}
+
+type sqliteStoredBinding struct {
+	bindingID         string
+	actionConfigTitle string
+	entityPrefix      string
+}
+
+const sqliteStoredBindingColumns = `COALESCE(json_extract(entry, '$.ActionConfigTitle'), ''), COALESCE(json_extract(entry, '$.EntityPrefix'), '')`
+
+func (s *sqliteHistoryStore) storedBindings() []sqliteStoredBinding {
+	ret := make([]sqliteStoredBinding, 0)
+
+	rows, err := s.db.Query(`SELECT DISTINCT binding_id, ` + sqliteStoredBindingColumns + ` FROM executions WHERE json_extract(entry, '$.ExecutionFinished') = 1`)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to query history database")
+		return ret
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var stored sqliteStoredBinding
+
+		if err := rows.Scan(&stored.bindingID, &stored.actionConfigTitle, &stored.entityPrefix); err == nil {
+			ret = append(ret, stored)
+		}
+	}
+
+	return ret
+}
+
+func (s *sqliteHistoryStore) rebindStored(stored sqliteStoredBinding, bindingID string) {
+	_, err := s.db.Exec(`UPDATE executions SET binding_id = ? WHERE binding_id = ? AND (`+sqliteStoredBindingColumns+`) = (?, ?) AND json_extract(entry, '$.ExecutionFinished') = 1`,
+		bindingID, stored.bindingID, stored.actionConfigTitle, stored.entityPrefix)
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"bindingId": bindingID,
+			"error":     err,
+		}).Warnf("Failed to update bindings in history database")
+	}
+}
+
+// sqliteHistoryPage uses LIMIT -1 for no limit, as SQLite only accepts an
+// OFFSET after a LIMIT.
+func sqliteHistoryPage(q HistoryQuery) (string, []any) {
+	if !q.NewestFirst {
+		return " ORDER BY idx", nil
+	}
+
+	limit := q.Limit
+
+	if limit <= 0 {
+		limit = -1
+	}
+
+	return " ORDER BY idx DESC LIMIT ? OFFSET ?", []any{limit, q.Offset}
+}
+
+func sqliteHistoryWhere(q HistoryQuery) (string, []any) {
+	w := &sqliteWhere{}
+
+	w.add(q.BindingID != "", "binding_id = ?", q.BindingID)
+	w.add(q.WorkflowTrackingID != "", "json_extract(entry, '$.WorkflowTrackingID') = ?", q.WorkflowTrackingID)
+	w.add(!q.StartedFrom.IsZero(), "datetime_started >= ?", q.StartedFrom.UnixNano())
+	w.add(!q.StartedUntil.IsZero(), "datetime_started < ?", q.StartedUntil.UnixNano())
+	w.addIn(q.BindingIDs != nil, "binding_id", q.BindingIDs)
+
+	if len(w.clauses) == 0 {
+		return "", w.args
+	}
+
+	return " WHERE " + strings.Join(w.clauses, " AND "), w.args
+}
+
+type sqliteWhere struct {
+	clauses []string
+	args    []any
+}
+
+func (w *sqliteWhere) add(enabled bool, clause string, arg any) {
+	if enabled {
+		w.clauses = append(w.clauses, clause)
+		w.args = append(w.args, arg)
+	}
+}
+
+// addIn matches none of the rows for an empty list.
+func (w *sqliteWhere) addIn(enabled bool, column string, values []string) {
+	if !enabled {
+		return
+	}
+
+	if len(values) == 0 {
+		w.clauses = append(w.clauses, "0")
+		return
+	}
+
+	w.clauses = append(w.clauses, column+" IN (?"+strings.Repeat(", ?", len(values)-1)+")")
+
+	for _, value := range values {
+		w.args = append(w.args, value)
+	}
+}
+
+func (s *sqliteHistoryStore) selectEntries(query string, args ...any) []*InternalLogEntry {
+	ret := make([]*InternalLogEntry, 0)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to query history database")
+		return ret
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		if entry := s.scanEntry(rows); entry != nil {
+			ret = append(ret, entry)
+		}
+	}
+
+	return ret
+}
+
+func (s *sqliteHistoryStore) scanEntry(rows *sql.Rows) *InternalLogEntry {
+	var bindingID string
+	var data string
+
+	if err := rows.Scan(&bindingID, &data); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to read execution from history database")
+		return nil
+	}
+
+	entry := &InternalLogEntry{}
+
+	if err := json.Unmarshal([]byte(data), entry); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to unmarshal execution from history database")
+		return nil
+	}
+
+	entry.Binding = s.bindings(bindingID, entry)
+
+	return entry
+}
+
+func (s *sqliteHistoryStore) NextIndex() int64 {
+	var next int64
+
+	if err := s.db.QueryRow("SELECT COALESCE(MAX(idx) + 1, 0) FROM executions").Scan(&next); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to read next index from history database")
+	}
+
+	return next
+}
+
+func (s *sqliteHistoryStore) Count(q HistoryQuery) int {
+	var count int
+
+	where, args := sqliteHistoryWhere(q)
+
+	if err := s.db.QueryRow("SELECT COUNT(*) FROM executions"+where, args...).Scan(&count); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to count entries in history database")
+	}
+
+	return count
+}
+
+func (s *sqliteHistoryStore) Prune(policy RetentionPolicy, now time.Time) []*InternalLogEntry {
+	removed := make([]*InternalLogEntry, 0)
+
+	for _, trackingID := range s.expiredTrackingIds(newRetentionCounter(policy, now)) {
+		if entry, found := s.Get(trackingID); found {
+			removed = append(removed, entry)
+		}
+
+		s.delete(trackingID)
+	}
+
+	return removed
+}
+
+// expiredTrackingIds only reads the columns that retention needs, so that a
+// sweep does not have to unmarshal every stored entry.
+func (s *sqliteHistoryStore) expiredTrackingIds(counter *retentionCounter) []string {
+	ret := make([]string, 0)
+
+	rows, err := s.db.Query(`SELECT tracking_id, binding_id, datetime_started, COALESCE(length(json_extract(entry, '$.Output')), 0), json_extract(entry, '$.ExecutionFinished')
+		FROM executions ORDER BY idx DESC`)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to query history database")
+		return ret
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		if trackingID, expired := scanRetentionRow(rows, counter); expired {
+			ret = append(ret, trackingID)
+		}
+	}
+
+	return ret
+}
+
+func scanRetentionRow(rows *sql.Rows, counter *retentionCounter) (string, bool) {
+	var trackingID, bindingID string
+	var started, outputBytes int64
+	var finished bool
+
+	if err := rows.Scan(&trackingID, &bindingID, &started, &outputBytes, &finished); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Warnf("Failed to read execution from history database")
+		return "", false
+	}
+
+	return trackingID, counter.expired(bindingID, time.Unix(0, started), outputBytes) && finished
+}
+
+func (s *sqliteHistoryStore) delete(trackingID string) {
+	if _, err := s.db.Exec("DELETE FROM executions WHERE tracking_id = ?", trackingID); err != nil {
+		log.WithFields(log.Fields{
+			"trackingId": trackingID,
+			"error":      err,
+		}).Warnf("Failed to delete execution from history database")
+	}
+}
+
+func (s *sqliteHistoryStore) Close() error {
+	return s.db.Close()
+}
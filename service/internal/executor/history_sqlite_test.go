@@ -0,0 +1,211 @@
+package executor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func sqliteTestingExecutor(t *testing.T, cfg *config.Config) *Executor {
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	require.NoError(t, e.OpenHistoryStore())
+
+	t.Cleanup(func() {
+		_ = e.history.Close()
+	})
+
+	return e
+}
+
+func sqliteTestingConfig(t *testing.T) *config.Config {
+	return sqliteTestingConfigWithDatabase(filepath.Join(t.TempDir(), "history.db"))
+}
+
+// sqliteTestingConfigWithDatabase builds the config again, as a restart does,
+// which gives actions without an id a new binding ID.
+func sqliteTestingConfigWithDatabase(database string) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.LogHistoryDatabase = database
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title: "blat",
+		Shell: "echo blat",
+		MaxRate: []config.RateSpec{
+			{Limit: 2, Duration: "1h"},
+		},
+	})
+	cfg.Sanitize()
+
+	return cfg
+}
+
+func TestSqliteHistorySurvivesRestart(t *testing.T) {
+	cfg := sqliteTestingConfig(t)
+
+	first := sqliteTestingExecutor(t, cfg)
+	execNewReqAndWait(first, "blat", cfg)
+	execNewReqAndWait(first, "blat", cfg)
+	require.NoError(t, first.history.Close())
+
+	cfg = sqliteTestingConfigWithDatabase(cfg.LogHistoryDatabase)
+	second := sqliteTestingExecutor(t, cfg)
+	binding := second.FindBindingWithNoEntity(cfg.Actions[0])
+	require.NotEqual(t, first.FindBindingWithNoEntity(first.Cfg.Actions[0]).ID, binding.ID, "The action has a new binding ID after the restart")
+
+	logs, paging := second.GetLogTrackingIds(0, 10)
+	assert.Equal(t, 2, len(logs))
+	assert.Equal(t, int64(2), paging.TotalCount)
+
+	byBinding := second.GetLogsByBindingId(binding.ID)
+	require.Equal(t, 2, len(byBinding))
+	assert.Equal(t, binding, byBinding[0].Binding, "Stored entries are reattached to the current binding")
+	assert.Equal(t, "blat\n", byBinding[1].Output)
+	assert.True(t, byBinding[1].ExecutionFinished)
+
+	entry, found := second.GetLog(byBinding[0].ExecutionTrackingID)
+	assert.True(t, found)
+	assert.Equal(t, int64(0), entry.Index)
+
+	assert.NotZero(t, second.GetTimeUntilAvailable(binding), "Rate limit window includes executions from before the restart")
+
+	execNewReqAndWait(second, "blat", cfg)
+
+	byBinding = second.GetLogsByBindingId(binding.ID)
+	require.Equal(t, 3, len(byBinding))
+	assert.True(t, byBinding[2].Blocked, "Third execution within the hour is blocked")
+	assert.Equal(t, int64(2), byBinding[2].Index)
+}
+
+func TestSqliteHistoryFinishesAbandonedEntries(t *testing.T) {
+	cfg := sqliteTestingConfig(t)
+
+	first := sqliteTestingExecutor(t, cfg)
+	trackingID := first.SetLog("", &InternalLogEntry{
+		DatetimeStarted:  time.Now(),
+		ExecutionStarted: true,
+	})
+	require.NoError(t, first.history.Close())
+
+	second := sqliteTestingExecutor(t, cfg)
+
+	entry, found := second.GetLog(trackingID)
+	require.True(t, found)
+	assert.True(t, entry.ExecutionFinished)
+	assert.Empty(t, second.GetActiveExecutionsACL(cfg, auth.UserGuest(cfg)))
+}
+
+func TestSqliteHistoryDateFilter(t *testing.T) {
+	cfg := sqliteTestingConfig(t)
+	e := sqliteTestingExecutor(t, cfg)
+	binding := e.FindBindingWithNoEntity(cfg.Actions[0])
+
+	yesterday := time.Now().UTC().Add(-24 * time.Hour)
+
+	e.SetLog("", &InternalLogEntry{Binding: binding, DatetimeStarted: yesterday, ExecutionFinished: true})
+	e.SetLog("", &InternalLogEntry{Binding: binding, DatetimeStarted: time.Now(), ExecutionFinished: true})
+
+	logs := e.filterLogsByACL(cfg, auth.UserGuest(cfg), yesterday.Format("2006-01-02"))
+
+	require.Equal(t, 1, len(logs))
+	assert.True(t, logs[0].DatetimeStarted.Equal(yesterday))
+}
+
+func TestHistoryPagesWithACL(t *testing.T) {
+	stores := map[string]func(t *testing.T, cfg *config.Config) *Executor{
+		"memory": func(t *testing.T, cfg *config.Config) *Executor {
+			e := DefaultExecutor(cfg)
+			e.RebuildActionMap()
+			return e
+		},
+		"sqlite": sqliteTestingExecutor,
+	}
+
+	for name, newExecutor := range stores {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.DefaultConfig()
+			cfg.LogHistoryDatabase = filepath.Join(t.TempDir(), "history.db")
+			cfg.DefaultPermissions.Logs = false
+			cfg.AccessControlLists = []*config.AccessControlList{{
+				Name:            "everyone",
+				MatchUsergroups: []string{"guest"},
+				Permissions:     config.PermissionsList{Logs: true},
+			}}
+			cfg.Actions = append(cfg.Actions,
+				&config.Action{Title: "visible", Shell: "echo visible", Acls: []string{"everyone"}},
+				&config.Action{Title: "hidden", Shell: "echo hidden"},
+			)
+			cfg.Sanitize()
+
+			e := newExecutor(t, cfg)
+			visible := e.FindBindingWithNoEntity(cfg.Actions[0])
+			hidden := e.FindBindingWithNoEntity(cfg.Actions[1])
+			start := time.Now()
+
+			for i := range 5 {
+				e.SetLog("", &InternalLogEntry{Binding: visible, DatetimeStarted: start.Add(time.Duration(i) * time.Second), ExecutionFinished: true})
+				e.SetLog("", &InternalLogEntry{Binding: hidden, DatetimeStarted: start.Add(time.Duration(i) * time.Second), ExecutionFinished: true})
+			}
+
+			guest := auth.UserGuest(cfg)
+
+			logs, paging, err := e.GetLogTrackingIdsACL(cfg, guest, 0, 2, "", "")
+			require.NoError(t, err)
+			require.Equal(t, 2, len(logs))
+			assert.Equal(t, int64(5), paging.TotalCount)
+			assert.Equal(t, int64(3), paging.CountRemaining)
+			assert.Equal(t, int64(8), logs[0].Index, "Newest first")
+			assert.Equal(t, visible.ID, logs[1].Binding.ID)
+
+			logs, paging, err = e.GetLogTrackingIdsACL(cfg, guest, 4, 2, "", "")
+			require.NoError(t, err)
+			require.Equal(t, 1, len(logs))
+			assert.Equal(t, int64(0), logs[0].Index)
+			assert.Zero(t, paging.CountRemaining)
+
+			logs, paging = e.GetLogTrackingIds(0, 3)
+			assert.Equal(t, 3, len(logs))
+			assert.Equal(t, int64(10), paging.TotalCount, "Without an ACL, every entry is listed")
+		})
+	}
+}
+
+func TestHistoryFollowsBindingsAfterConfigReload(t *testing.T) {
+	stores := map[string]func(t *testing.T, cfg *config.Config) *Executor{
+		"memory": func(t *testing.T, cfg *config.Config) *Executor {
+			e := DefaultExecutor(cfg)
+			e.RebuildActionMap()
+			return e
+		},
+		"sqlite": sqliteTestingExecutor,
+	}
+
+	for name, newExecutor := range stores {
+		t.Run(name, func(t *testing.T) {
+			cfg := sqliteTestingConfig(t)
+			e := newExecutor(t, cfg)
+
+			execNewReqAndWait(e, "blat", cfg)
+			oldID := e.FindBindingWithNoEntity(cfg.Actions[0]).ID
+
+			// A reload sanitizes the actions again, which gives actions
+			// without an id a new one.
+			cfg.Actions[0].ID = ""
+			cfg.Sanitize()
+			e.RebuildActionMap()
+
+			binding := e.FindBindingWithNoEntity(cfg.Actions[0])
+			require.NotEqual(t, oldID, binding.ID)
+
+			logs := e.GetLogsByBindingId(binding.ID)
+			require.Len(t, logs, 1)
+			assert.Equal(t, binding, logs[0].Binding)
+		})
+	}
+}
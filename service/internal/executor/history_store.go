@@ -0,0 +1,340 @@
+package executor
+
+import (
+	"path/filepath"
+	"slices"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// HistoryStore holds execution log entries and answers the queries that back
+// log pages, action logs and rate limiting. The Executor always calls these
+// methods with logmutex held, so implementations do not need their own lock.
+type HistoryStore interface {
+	// Put inserts an entry, or updates it if the tracking ID is already stored.
+	Put(entry *InternalLogEntry)
+	Get(trackingID string) (*InternalLogEntry, bool)
+	// Query returns matching entries, oldest first, unless q.NewestFirst.
+	Query(q HistoryQuery) []*InternalLogEntry
+	NextIndex() int64
+	// Count returns how many entries match, ignoring Offset and Limit.
+	Count(q HistoryQuery) int
+	// Prune removes finished entries outside the policy, and returns them.
+	Prune(policy RetentionPolicy, now time.Time) []*InternalLogEntry
+	// Rebind moves finished entries whose binding no longer exists on to the
+	// current binding of the same action.
+	Rebind(bindings *currentBindings)
+	Close() error
+}
+
+// HistoryQuery narrows the entries returned by a HistoryStore. Zero values
+// match everything.
+type HistoryQuery struct {
+	BindingID          string
+	WorkflowTrackingID string
+	StartedFrom        time.Time
+	StartedUntil       time.Time
+
+	// BindingIDs, when not nil, only matches entries of these bindings.
+	BindingIDs []string
+
+	// NewestFirst returns the newest entries first, skipping Offset entries
+	// and returning at most Limit of them. A Limit of zero returns them all.
+	NewestFirst bool
+	Offset      int64
+	Limit       int64
+}
+
+func (q HistoryQuery) matches(entry *InternalLogEntry) bool {
+	if q.WorkflowTrackingID != "" && entry.WorkflowTrackingID != q.WorkflowTrackingID {
+		return false
+	}
+
+	if q.BindingIDs != nil && !slices.Contains(q.BindingIDs, entry.GetBindingId()) {
+		return false
+	}
+
+	return q.matchesStarted(entry)
+}
+
+// page orders and slices matching entries, which are oldest first.
+func (q HistoryQuery) page(entries []*InternalLogEntry) []*InternalLogEntry {
+	if !q.NewestFirst {
+		return entries
+	}
+
+	slices.Reverse(entries)
+
+	start := min(q.Offset, int64(len(entries)))
+	end := int64(len(entries))
+
+	if q.Limit > 0 {
+		end = min(start+q.Limit, end)
+	}
+
+	return entries[start:end]
+}
+
+func (q HistoryQuery) matchesStarted(entry *InternalLogEntry) bool {
+	if !q.StartedFrom.IsZero() && entry.DatetimeStarted.Before(q.StartedFrom) {
+		return false
+	}
+
+	return q.StartedUntil.IsZero() || entry.DatetimeStarted.Before(q.StartedUntil)
+}
+
+// memoryHistoryStore is the default HistoryStore. Entries are held by pointer,
+// so updates made while an execution is running are visible without a Put.
+type memoryHistoryStore struct {
+	entries   map[string]*InternalLogEntry
+	order     []string
+	byBinding map[string][]*InternalLogEntry
+}
+
+func newMemoryHistoryStore() *memoryHistoryStore {
+	return &memoryHistoryStore{
+		entries:   make(map[string]*InternalLogEntry),
+		order:     make([]string, 0),
+		byBinding: make(map[string][]*InternalLogEntry),
+	}
+}
+
+func (s *memoryHistoryStore) Put(entry *InternalLogEntry) {
+	if _, found := s.entries[entry.ExecutionTrackingID]; found {
+		return
+	}
+
+	s.entries[entry.ExecutionTrackingID] = entry
+	s.order = append(s.order, entry.ExecutionTrackingID)
+
+	if entry.Binding != nil {
+		s.byBinding[entry.Binding.ID] = append(s.byBinding[entry.Binding.ID], entry)
+	}
+}
+
+func (s *memoryHistoryStore) Get(trackingID string) (*InternalLogEntry, bool) {
+	entry, found := s.entries[trackingID]
+
+	return entry, found
+}
+
+func (s *memoryHistoryStore) Query(q HistoryQuery) []*InternalLogEntry {
+	return q.page(s.matching(q))
+}
+
+func (s *memoryHistoryStore) matching(q HistoryQuery) []*InternalLogEntry {
+	ret := make([]*InternalLogEntry, 0)
+
+	for _, entry := range s.candidates(q) {
+		if q.matches(entry) {
+			ret = append(ret, entry)
+		}
+	}
+
+	return ret
+}
+
+func (s *memoryHistoryStore) candidates(q HistoryQuery) []*InternalLogEntry {
+	if q.BindingID != "" {
+		return s.byBinding[q.BindingID]
+	}
+
+	all := make([]*InternalLogEntry, 0, len(s.order))
+
+	for _, trackingID := range s.order {
+		all = append(all, s.entries[trackingID])
+	}
+
+	return all
+}
+
+func (s *memoryHistoryStore) NextIndex() int64 {
+	if len(s.order) == 0 {
+		return 0
+	}
+
+	return s.entries[s.order[len(s.order)-1]].Index + 1
+}
+
+func (s *memoryHistoryStore) Count(q HistoryQuery) int {
+	return len(s.matching(q))
+}
+
+func (s *memoryHistoryStore) Prune(policy RetentionPolicy, now time.Time) []*InternalLogEntry {
+	counter := newRetentionCounter(policy, now)
+	removed := make([]*InternalLogEntry, 0)
+
+	for i := len(s.order) - 1; i >= 0; i-- {
+		entry := s.entries[s.order[i]]
+
+		if counter.expired(entry.GetBindingId(), entry.DatetimeStarted, int64(len(entry.Output))) && entry.ExecutionFinished {
+			removed = append(removed, entry)
+		}
+	}
+
+	for _, entry := range removed {
+		delete(s.entries, entry.ExecutionTrackingID)
+	}
+
+	s.reindex()
+
+	return removed
+}
+
+// reindex rebuilds order and byBinding after entries have been deleted.
+func (s *memoryHistoryStore) reindex() {
+	order := s.order
+
+	s.order = make([]string, 0, len(s.entries))
+	s.byBinding = make(map[string][]*InternalLogEntry)
+
+	for _, trackingID := range order {
+		if entry, found := s.entries[trackingID]; found {
+			delete(s.entries, trackingID)
+			s.Put(entry)
+		}
+	}
+}
+
+func (s *memoryHistoryStore) Rebind(bindings *currentBindings) {
+	for _, entry := range s.entries {
+		if !entry.ExecutionFinished {
+			continue
+		}
+
+		if binding, found := bindings.rebound(entry.GetBindingId(), entry.ActionConfigTitle, entry.EntityPrefix); found {
+			entry.Binding = binding
+		}
+	}
+
+	s.reindex()
+}
+
+func (s *memoryHistoryStore) Close() error {
+	return nil
+}
+
+// currentBindings indexes the bindings of the action map, so that stored
+// entries can be moved on to them. Actions without an id get a new random id
+// each time the config is loaded, so after a restart or a config reload the
+// binding IDs of stored entries no longer exist, and entries are matched by
+// the title of the action and the entity instead, as LoadLogsFromDisk does.
+type currentBindings struct {
+	ids     map[string]bool
+	byTitle map[string]*ActionBinding
+}
+
+func bindingTitleKey(actionConfigTitle string, entityPrefix string) string {
+	return actionConfigTitle + "\x00" + entityPrefix
+}
+
+// rebound returns the binding that an entry with a binding ID that no longer
+// exists belongs to now, if any.
+func (c *currentBindings) rebound(bindingID string, actionConfigTitle string, entityPrefix string) (*ActionBinding, bool) {
+	if c.ids[bindingID] || actionConfigTitle == "" {
+		return nil, false
+	}
+
+	binding, found := c.byTitle[bindingTitleKey(actionConfigTitle, entityPrefix)]
+
+	return binding, found
+}
+
+func (e *Executor) snapshotBindings() *currentBindings {
+	e.MapActionBindingsLock.RLock()
+	defer e.MapActionBindingsLock.RUnlock()
+
+	ret := &currentBindings{
+		ids:     make(map[string]bool, len(e.MapActionBindings)),
+		byTitle: make(map[string]*ActionBinding, len(e.MapActionBindings)),
+	}
+
+	for id, binding := range e.MapActionBindings {
+		ret.ids[id] = true
+		ret.byTitle[bindingTitleKey(binding.Action.Title, bindingEntityPrefix(binding))] = binding
+	}
+
+	return ret
+}
+
+func bindingEntityPrefix(binding *ActionBinding) string {
+	if binding.Entity == nil {
+		return ""
+	}
+
+	return binding.Entity.UniqueKey
+}
+
+// rebindHistory moves stored entries on to the current bindings, so that they
+// are still found by binding, for the logs of an action and its rate limits,
+// after a restart or a config reload.
+func (e *Executor) rebindHistory() {
+	bindings := e.snapshotBindings()
+
+	e.logmutex.Lock()
+	defer e.logmutex.Unlock()
+
+	e.history.Rebind(bindings)
+}
+
+// OpenHistoryStore moves the executor onto the history database configured in
+// logHistoryDatabase, if any. It should be called before LoadLogsFromDisk and
+// before any actions are executed.
+func (e *Executor) OpenHistoryStore() error {
+	filename := e.historyDatabasePath()
+	if filename == "" {
+		return nil
+	}
+
+	store, err := openSqliteHistoryStore(filename, e.resolveStoredBinding)
+	if err != nil {
+		return err
+	}
+
+	e.logmutex.Lock()
+	e.history = store
+	e.nextLogIndex = store.NextIndex()
+	e.logmutex.Unlock()
+
+	e.rebindHistory()
+
+	log.WithFields(log.Fields{
+		"file": filename,
+	}).Info("Opened history database")
+
+	return nil
+}
+
+func (e *Executor) historyDatabasePath() string {
+	filename := e.Cfg.LogHistoryDatabase
+
+	if filename == "" || filepath.IsAbs(filename) {
+		return filename
+	}
+
+	return filepath.Join(e.Cfg.GetDir(), filename)
+}
+
+func (e *Executor) historyDatabaseHasLogs() bool {
+	e.logmutex.RLock()
+	defer e.logmutex.RUnlock()
+
+	return e.Cfg.LogHistoryDatabase != "" && e.nextLogIndex > 0
+}
+
+// resolveStoredBinding is used by stores that read entries back from disk.
+// Stored binding IDs are kept up to date by rebindHistory, but entries that
+// are still running when the config is reloaded fall back to the same title
+// lookup as LoadLogsFromDisk.
+func (e *Executor) resolveStoredBinding(bindingID string, entry *InternalLogEntry) *ActionBinding {
+	if binding := e.FindBindingByID(bindingID); binding != nil {
+		return binding
+	}
+
+	if entry.ActionConfigTitle == "" {
+		return nil
+	}
+
+	return e.findBindingByActionTitle(entry.ActionConfigTitle, entry.EntityPrefix)
+}
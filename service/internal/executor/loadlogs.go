@@ -11,10 +11,11 @@ import (
 )
 
 // LoadLogsFromDisk loads persisted logs from YAML files on disk and restores them to the executor.
-// This should be called during startup if saveLogs is configured.
+// This should be called during startup if saveLogs is configured. When a history database is
+// used, the YAML files are only imported while the database is still empty.
 func (e *Executor) LoadLogsFromDisk() {
 	resultsDir := e.Cfg.SaveLogs.ResultsDirectory
-	if resultsDir == "" {
+	if resultsDir == "" || e.historyDatabaseHasLogs() {
 		return
 	}
 
@@ -157,7 +158,7 @@ func (e *Executor) restoreLogsToExecutor(loadedLogs []*InternalLogEntry, skipped
 	defer e.logmutex.Unlock()
 
 	for _, logEntry := range loadedLogs {
-		if _, exists := e.logs[logEntry.ExecutionTrackingID]; exists {
+		if _, exists := e.getLogLocked(logEntry.ExecutionTrackingID); exists {
 			log.WithFields(log.Fields{
 				"trackingId": logEntry.ExecutionTrackingID,
 			}).Debug("Log entry already exists, skipping")
@@ -165,25 +166,15 @@ func (e *Executor) restoreLogsToExecutor(loadedLogs []*InternalLogEntry, skipped
 			continue
 		}
 
-		logEntry.Index = int64(len(e.logsTrackingIdsByDate))
-		e.logs[logEntry.ExecutionTrackingID] = logEntry
-		e.logsTrackingIdsByDate = append(e.logsTrackingIdsByDate, logEntry.ExecutionTrackingID)
-
-		if logEntry.Binding != nil {
-			e.addLogToBindingMap(logEntry)
-		}
+		logEntry.Index = e.nextLogIndex
+		e.nextLogIndex++
+		e.history.Put(logEntry)
+		metricLogsRetained.Inc()
 	}
 
 	return skippedCount
 }
 
-func (e *Executor) addLogToBindingMap(logEntry *InternalLogEntry) {
-	if _, containsKey := e.LogsByBindingId[logEntry.Binding.ID]; !containsKey {
-		e.LogsByBindingId[logEntry.Binding.ID] = make([]*InternalLogEntry, 0)
-	}
-	e.LogsByBindingId[logEntry.Binding.ID] = append(e.LogsByBindingId[logEntry.Binding.ID], logEntry)
-}
-
 func (e *Executor) findBindingByActionTitle(actionConfigTitle string, entityPrefix string) *ActionBinding {
 	e.MapActionBindingsLock.RLock()
 	defer e.MapActionBindingsLock.RUnlock()
@@ -18,6 +18,9 @@ func filterRecordFromEntry(entry *InternalLogEntry) logfilter.Record {
 		Running:  !entry.ExecutionFinished,
 		ExitCode: entry.ExitCode,
 		Output:   entry.Output,
+		Stdout:   entry.Stdout,
+		Stderr:   entry.Stderr,
+		Result:   entry.Result,
 	}
 }
 
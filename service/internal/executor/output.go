@@ -0,0 +1,96 @@
+package executor
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"time"
+)
+
+// The streams that output can be written to.
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+)
+
+// OutputChunk is a single write of output by a command, as it was written. It
+// is only sent to listeners, and not kept on the log entry, which already has
+// the same output in Output, Stdout and Stderr.
+type OutputChunk struct {
+	Stream   string
+	Datetime time.Time
+	Output   string
+}
+
+// OutputStreamer collects the output of an execution, and passes it on to
+// listeners as it is written. Writing to the streamer itself writes to stdout,
+// and Stderr() returns a writer for stderr. Writes to both can come from
+// different goroutines.
+type OutputStreamer struct {
+	Req *ExecutionRequest
+
+	mu     sync.Mutex
+	output bytes.Buffer
+	stdout bytes.Buffer
+	stderr bytes.Buffer
+}
+
+type stderrWriter struct {
+	ost *OutputStreamer
+}
+
+func (w stderrWriter) Write(o []byte) (int, error) {
+	return w.ost.write(StreamStderr, o)
+}
+
+func (ost *OutputStreamer) Write(o []byte) (int, error) {
+	return ost.write(StreamStdout, o)
+}
+
+func (ost *OutputStreamer) Stderr() io.Writer {
+	return stderrWriter{ost: ost}
+}
+
+func (ost *OutputStreamer) write(stream string, o []byte) (int, error) {
+	chunk := OutputChunk{
+		Stream:   stream,
+		Datetime: time.Now(),
+		Output:   string(o),
+	}
+
+	// Listeners are called with the lock held, so that they see chunks in the
+	// same order as they are stored.
+	ost.mu.Lock()
+	defer ost.mu.Unlock()
+
+	for _, listener := range ost.Req.executor.copyListeners() {
+		listener.OnOutputChunk(chunk, ost.Req.TrackingID)
+	}
+
+	if stream == StreamStderr {
+		ost.stderr.Write(o)
+	} else {
+		ost.stdout.Write(o)
+	}
+
+	return ost.output.Write(o)
+}
+
+// String returns stdout and stderr, interleaved in the order they were written.
+func (ost *OutputStreamer) String() string {
+	ost.mu.Lock()
+	defer ost.mu.Unlock()
+
+	return ost.output.String()
+}
+
+// saveTo copies the collected output to the log entry, once the command has
+// finished.
+func (ost *OutputStreamer) saveTo(entry *InternalLogEntry) {
+	ost.mu.Lock()
+	defer ost.mu.Unlock()
+
+	entry.Output = ost.output.String()
+	entry.Stdout = ost.stdout.String()
+	entry.Stderr = ost.stderr.String()
+}
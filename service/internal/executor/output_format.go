@@ -0,0 +1,116 @@
+package executor
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// stepParseOutput parses the stdout of actions that have an outputFormat into
+// the Result of the execution. Output that cannot be parsed does not fail the
+// execution, but is noted in the output.
+func stepParseOutput(req *ExecutionRequest) bool {
+	format := req.Binding.Action.OutputFormat
+
+	if format == "" {
+		return true
+	}
+
+	result, err := parseOutput(format, req.logEntry.Stdout)
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"actionTitle":  req.logEntry.ActionTitle,
+			"outputFormat": format,
+			"error":        err,
+		}).Warnf("Could not parse action output")
+
+		req.mutateLogEntry(func(entry *InternalLogEntry) {
+			entry.Output += fmt.Sprintf("OliveTin::outputFormat - could not parse the output as %v: %v\n", format, err)
+		})
+
+		return true
+	}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.Result = result
+	})
+
+	return true
+}
+
+func parseOutput(format string, output string) (map[string]any, error) {
+	result := make(map[string]any)
+
+	var err error
+
+	switch format {
+	case config.OutputFormatJSON:
+		err = json.Unmarshal([]byte(output), &result)
+	case config.OutputFormatYAML:
+		err = yaml.Unmarshal([]byte(output), &result)
+	case config.OutputFormatKeyValue:
+		err = parseKeyValueOutput(output, result)
+	default:
+		err = fmt.Errorf("unknown output format %q", format)
+	}
+
+	return result, err
+}
+
+var resultEnvNameUnsafe = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// resultEnv passes the top level keys of the result to shellAfterCompleted as
+// OT_RESULT_<KEY> variables, as the output of a command must never be parsed
+// into shell text. Values that are not strings are passed as JSON.
+func resultEnv(result map[string]any) []string {
+	ret := make([]string, 0, len(result))
+
+	for key, value := range result {
+		name := "OT_RESULT_" + resultEnvNameUnsafe.ReplaceAllString(strings.ToUpper(key), "_")
+
+		ret = append(ret, name+"="+resultEnvValue(value))
+	}
+
+	return ret
+}
+
+func resultEnvValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	out, err := json.Marshal(value)
+
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(out)
+}
+
+// parseKeyValueOutput parses lines of key=value. Blank lines, comments and
+// lines without an = are skipped.
+func parseKeyValueOutput(output string, result map[string]any) error {
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if key, value, found := strings.Cut(line, "="); found {
+			result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	return scanner.Err()
+}
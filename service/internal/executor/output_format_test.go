@@ -0,0 +1,209 @@
+package executor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func TestParseOutput(t *testing.T) {
+	cases := []struct {
+		format string
+		output string
+		want   map[string]any
+	}{
+		{config.OutputFormatJSON, `{"version": "1.2.3", "healthy": true, "replicas": 3}`, map[string]any{"version": "1.2.3", "healthy": true, "replicas": float64(3)}},
+		{config.OutputFormatYAML, "version: 1.2.3\nhosts:\n  - web1\n", map[string]any{"version": "1.2.3", "hosts": []any{"web1"}}},
+		{config.OutputFormatKeyValue, "# status\nversion = 1.2.3\n\nnot a pair\nurl=http://example.com/?a=b\n", map[string]any{"version": "1.2.3", "url": "http://example.com/?a=b"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.format, func(t *testing.T) {
+			result, err := parseOutput(tc.format, tc.output)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, result)
+		})
+	}
+}
+
+func TestParseOutputRejectsInvalidJson(t *testing.T) {
+	_, err := parseOutput(config.OutputFormatJSON, `["not", "an", "object"]`)
+	assert.Error(t, err)
+}
+
+type finishedOutputCollector struct {
+	executionFinishedCollector
+	outputs chan string
+}
+
+func (c *finishedOutputCollector) OnExecutionFinished(entry *InternalLogEntry) {
+	c.outputs <- entry.ActionTitle + ": " + entry.Output
+}
+
+func TestResultIsUsableInShellAfterCompletedAndTriggers(t *testing.T) {
+	cfg := config.DefaultConfig()
+	e := DefaultExecutor(cfg)
+
+	deployAction := &config.Action{
+		Title:     "Deploy",
+		Shell:     "echo deployed {{ version }}",
+		Arguments: []config.ActionArgument{{Name: "version", Type: "ascii_identifier"}},
+	}
+	checkAction := &config.Action{
+		Title:               "Check version",
+		Shell:               `echo '{"version": "1.2.3", "replicas": 3}'; echo 'not json' >&2`,
+		OutputFormat:        "JSON",
+		ShellAfterCompleted: `echo after "$OT_RESULT_VERSION" "$OT_RESULT_REPLICAS"`,
+		Triggers: []config.ActionTrigger{{
+			Action:    "Deploy",
+			Arguments: map[string]string{"version": "{{ .Result.version }}"},
+		}},
+	}
+	cfg.Actions = append(cfg.Actions, deployAction, checkAction)
+	cfg.Sanitize()
+	e.RebuildActionMap()
+
+	collector := &finishedOutputCollector{outputs: make(chan string, 4)}
+	e.AddListener(collector)
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		AuthenticatedUser: auth.UserFromSystem(cfg, "testuser"),
+		Cfg:               cfg,
+		Binding:           e.FindBindingWithNoEntity(checkAction),
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, map[string]any{"version": "1.2.3", "replicas": float64(3)}, entry.Result, "Only stdout is parsed")
+	assert.Contains(t, entry.Output, "OliveTin::shellAfterCompleted stdout\nafter 1.2.3 3\n")
+
+	outputs := waitForOutputs(t, collector, 2)
+
+	assert.Contains(t, outputs, "Deploy: deployed 1.2.3\n", "Triggered actions can use the result in their arguments")
+}
+
+func waitForOutputs(t *testing.T, collector *finishedOutputCollector, count int) []string {
+	t.Helper()
+
+	var outputs []string
+
+	for len(outputs) < count {
+		select {
+		case output := <-collector.outputs:
+			outputs = append(outputs, output)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for executions; got %v", outputs)
+		}
+	}
+
+	return outputs
+}
+
+func TestResultIsNeverParsedIntoShell(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	e := DefaultExecutor(cfg)
+
+	marker := filepath.Join(cfg.GetDir(), "injected")
+	payload := `{"version": "$(touch ` + marker + `)"}`
+
+	deployAction := &config.Action{
+		Title:     "Deploy",
+		Shell:     "echo deployed {{ version }}",
+		Arguments: []config.ActionArgument{{Name: "version", Type: "ascii_identifier"}},
+	}
+	checkAction := &config.Action{
+		Title:               "Check version",
+		Shell:               "echo '" + payload + "'",
+		OutputFormat:        config.OutputFormatJSON,
+		ShellAfterCompleted: `echo after "$OT_RESULT_VERSION"`,
+		Triggers: []config.ActionTrigger{{
+			Action:    "Deploy",
+			Arguments: map[string]string{"version": "{{ .Result.version }}"},
+		}},
+	}
+	cfg.Actions = append(cfg.Actions, deployAction, checkAction)
+	cfg.Sanitize()
+	e.RebuildActionMap()
+
+	collector := &finishedOutputCollector{outputs: make(chan string, 4)}
+	e.AddListener(collector)
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		AuthenticatedUser: auth.UserFromSystem(cfg, "testuser"),
+		Cfg:               cfg,
+		Binding:           e.FindBindingWithNoEntity(checkAction),
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Contains(t, entry.Output, "after $(touch "+marker+")\n", "The result is passed as a variable, not as shell text")
+
+	outputs := waitForOutputs(t, collector, 2)
+
+	assert.NotContains(t, outputs, "Deploy: deployed \n")
+	assert.NoFileExists(t, marker)
+}
+
+func TestResultIsNotAvailableInShell(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:               "Check version",
+		Shell:               `echo '{"version": "1.2.3"}'`,
+		OutputFormat:        config.OutputFormatJSON,
+		ShellAfterCompleted: "echo after {{ .Result.version }}",
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		AuthenticatedUser: auth.UserFromSystem(cfg, "testuser"),
+		Cfg:               cfg,
+		Binding:           e.FindBindingWithNoEntity(cfg.Actions[0]),
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.NotContains(t, entry.Output, "after 1.2.3")
+}
+
+func TestTriggerArgumentsMustBeDeclared(t *testing.T) {
+	target := &config.Action{Title: "Deploy"}
+	trigger := config.ActionTrigger{Action: "Deploy", Arguments: map[string]string{"version": "{{ .Result.version }}"}}
+
+	_, err := triggerArguments(trigger, &ExecutionRequest{logEntry: &InternalLogEntry{}}, target)
+
+	assert.ErrorContains(t, err, "argument version is not an argument of Deploy")
+}
+
+func TestUnparsableOutputIsNoted(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:        "Broken",
+		Shell:        "echo '{'",
+		OutputFormat: config.OutputFormatJSON,
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Nil(t, entry.Result)
+	assert.Equal(t, int32(0), entry.ExitCode, "Unparsable output does not fail the execution")
+	assert.Contains(t, entry.Output, "OliveTin::outputFormat - could not parse the output as json")
+}
@@ -0,0 +1,85 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+type streamedChunkCollector struct {
+	executionFinishedCollector
+	chunks []OutputChunk
+}
+
+func (c *streamedChunkCollector) OnOutputChunk(chunk OutputChunk, _ string) {
+	c.chunks = append(c.chunks, chunk)
+}
+
+func TestOutputStreamerSeparatesStreams(t *testing.T) {
+	e, _ := testingExecutor()
+	collector := &streamedChunkCollector{}
+	e.AddListener(collector)
+
+	streamer := &OutputStreamer{Req: &ExecutionRequest{executor: e, TrackingID: "abc"}}
+
+	_, _ = streamer.Write([]byte("one\n"))
+	_, _ = streamer.Stderr().Write([]byte("two\n"))
+	_, _ = streamer.Write([]byte("three\n"))
+
+	entry := &InternalLogEntry{}
+	streamer.saveTo(entry)
+
+	assert.Equal(t, "one\ntwo\nthree\n", entry.Output, "Output keeps both streams interleaved")
+	assert.Equal(t, "one\nthree\n", entry.Stdout)
+	assert.Equal(t, "two\n", entry.Stderr)
+
+	require.Len(t, collector.chunks, 3, "Listeners see each chunk, in the order they were written")
+	assert.Equal(t, StreamStderr, collector.chunks[1].Stream)
+	assert.Equal(t, "two\n", collector.chunks[1].Output)
+	assert.False(t, collector.chunks[1].Datetime.IsZero())
+	assert.False(t, collector.chunks[2].Datetime.Before(collector.chunks[1].Datetime))
+}
+
+func TestSavedLogResultsHaveSeparateStreams(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.DefaultConfig()
+	cfg.SaveLogs.ResultsDirectory = dir
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title: "streams",
+		Shell: "echo out; echo err >&2",
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, "out\n", entry.Stdout)
+	assert.Equal(t, "err\n", entry.Stderr)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	saved := &InternalLogEntry{}
+	require.NoError(t, yaml.Unmarshal(data, saved))
+	assert.Equal(t, "out\n", saved.Stdout)
+	assert.Equal(t, "err\n", saved.Stderr)
+	assert.NotContains(t, string(data), "chunks", "Chunks are only streamed, as the output is already saved")
+}
@@ -30,6 +30,11 @@ var (
 		Buckets: []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60, 120, 300, 600},
 	})
 
+	metricLogsRetained = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "olivetin_logs_retained",
+		Help: "The number of execution logs currently retained in the history.",
+	})
+
 	executionResultLabels = []string{
 		executionResultSuccess,
 		executionResultFailed,
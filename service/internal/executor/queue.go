@@ -1,6 +1,9 @@
 package executor
 
 import (
+	"cmp"
+	"slices"
+
 	acl "github.com/OliveTin/OliveTin/internal/acl"
 	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
 	config "github.com/OliveTin/OliveTin/internal/config"
@@ -25,12 +28,15 @@ func (e *Executor) GetActiveExecutionsACL(cfg *config.Config, user *authpublic.A
 
 	active := make([]*InternalLogEntry, 0)
 
-	for _, trackingID := range e.logsTrackingIdsByDate {
-		entry := e.logs[trackingID]
+	for _, entry := range e.liveLogs {
 		if isQueueEntryVisible(cfg, user, entry) {
 			active = append(active, entry)
 		}
 	}
 
+	slices.SortFunc(active, func(a, b *InternalLogEntry) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
 	return active
 }
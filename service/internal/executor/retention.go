@@ -0,0 +1,268 @@
+package executor
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const retentionSweepInterval = 5 * time.Minute
+
+// RetentionPolicy is the parsed form of config.LogRetentionConfig.
+type RetentionPolicy struct {
+	MaxAge            time.Duration
+	MaxCountPerAction int
+	MaxTotalBytes     int64
+}
+
+func retentionPolicyFromConfig(cfg config.LogRetentionConfig) RetentionPolicy {
+	policy := RetentionPolicy{
+		MaxCountPerAction: cfg.MaxCountPerAction,
+		MaxTotalBytes:     cfg.MaxTotalBytes,
+	}
+
+	if cfg.MaxAge != "" {
+		maxAge, err := time.ParseDuration(cfg.MaxAge)
+		if err != nil {
+			log.Warnf("Could not parse logRetention.maxAge: %v", cfg.MaxAge)
+		}
+
+		policy.MaxAge = maxAge
+	}
+
+	return policy
+}
+
+func (p RetentionPolicy) enabled() bool {
+	return p.MaxAge > 0 || p.MaxCountPerAction > 0 || p.MaxTotalBytes > 0
+}
+
+// retentionCounter is fed entries newest first, and decides which of them are
+// outside the policy given everything newer that has already been kept.
+type retentionCounter struct {
+	policy         RetentionPolicy
+	oldestKept     time.Time
+	countByBinding map[string]int
+	totalBytes     int64
+}
+
+func newRetentionCounter(policy RetentionPolicy, now time.Time) *retentionCounter {
+	return &retentionCounter{
+		policy:         policy,
+		oldestKept:     now.Add(-policy.MaxAge),
+		countByBinding: make(map[string]int),
+	}
+}
+
+func (c *retentionCounter) expired(bindingID string, started time.Time, outputBytes int64) bool {
+	c.countByBinding[bindingID]++
+	c.totalBytes += outputBytes
+
+	return c.tooOld(started) || c.tooMany(bindingID) || c.tooBig()
+}
+
+func (c *retentionCounter) tooOld(started time.Time) bool {
+	return c.policy.MaxAge > 0 && started.Before(c.oldestKept)
+}
+
+func (c *retentionCounter) tooMany(bindingID string) bool {
+	return c.policy.MaxCountPerAction > 0 && c.countByBinding[bindingID] > c.policy.MaxCountPerAction
+}
+
+func (c *retentionCounter) tooBig() bool {
+	return c.policy.MaxTotalBytes > 0 && c.totalBytes > c.policy.MaxTotalBytes
+}
+
+// StartRetentionSweeper applies logRetention straight away, and then
+// periodically, re-reading the policy each time so that config reloads apply.
+func (e *Executor) StartRetentionSweeper() {
+	e.SweepLogs()
+
+	for range time.Tick(retentionSweepInterval) {
+		e.SweepLogs()
+	}
+}
+
+// SweepLogs removes finished logs that fall outside logRetention from the
+// history, along with their saved results and output files.
+func (e *Executor) SweepLogs() {
+	policy := retentionPolicyFromConfig(e.Cfg.LogRetention)
+
+	removed, retained := e.pruneHistory(policy)
+
+	metricLogsRetained.Set(float64(retained))
+
+	for _, entry := range removed {
+		e.removeSavedLogFiles(entry)
+	}
+
+	orphaned := e.removeOrphanedSavedLogFiles(policy, time.Now())
+
+	if len(removed) == 0 && orphaned == 0 {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"removed":  len(removed),
+		"retained": retained,
+		"orphaned": orphaned,
+	}).Info("Removed logs outside of the retention policy")
+}
+
+func (e *Executor) pruneHistory(policy RetentionPolicy) ([]*InternalLogEntry, int) {
+	e.logmutex.Lock()
+	defer e.logmutex.Unlock()
+
+	if !policy.enabled() {
+		return nil, e.history.Count(HistoryQuery{})
+	}
+
+	return e.history.Prune(policy, time.Now()), e.history.Count(HistoryQuery{})
+}
+
+func (e *Executor) removeSavedLogFiles(entry *InternalLogEntry) {
+	filename := savedLogFilename(entry)
+
+	removeSavedLogFile(e.savedLogDirectory(entry, func(s config.SaveLogsConfig) string { return s.ResultsDirectory }), filename+".yaml")
+	removeSavedLogFile(e.savedLogDirectory(entry, func(s config.SaveLogsConfig) string { return s.OutputDirectory }), filename+".log")
+}
+
+func (e *Executor) savedLogDirectory(entry *InternalLogEntry, dir func(config.SaveLogsConfig) string) string {
+	if e.hasValidBinding(entry) {
+		return firstNonEmpty(dir(entry.Binding.Action.SaveLogs), dir(e.Cfg.SaveLogs))
+	}
+
+	return dir(e.Cfg.SaveLogs)
+}
+
+func removeSavedLogFile(dir string, filename string) {
+	if dir == "" {
+		return
+	}
+
+	err := os.Remove(path.Join(dir, filename))
+
+	if err != nil && !os.IsNotExist(err) {
+		log.Warnf("%v", err)
+	}
+}
+
+// removeOrphanedSavedLogFiles removes saved results and output files that are
+// older than logRetention.maxAge, and have no entry in the history, such as
+// those of entries that were removed before a restart, or that were never
+// imported in to a history database. It returns how many files were removed.
+func (e *Executor) removeOrphanedSavedLogFiles(policy RetentionPolicy, now time.Time) int {
+	if policy.MaxAge <= 0 {
+		return 0
+	}
+
+	removed := 0
+	oldestKept := now.Add(-policy.MaxAge)
+
+	for _, dir := range e.savedLogDirectories() {
+		for _, name := range e.orphanedSavedLogFiles(dir, oldestKept) {
+			removeSavedLogFile(dir, name)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// savedLogDirectories returns every directory that logs may be saved in, from
+// the config and from each action.
+func (e *Executor) savedLogDirectories() []string {
+	configs := []config.SaveLogsConfig{e.Cfg.SaveLogs}
+
+	for _, action := range e.Cfg.Actions {
+		configs = append(configs, action.SaveLogs)
+	}
+
+	dirs := make([]string, 0)
+
+	for _, saveLogs := range configs {
+		dirs = append(dirs, saveLogs.ResultsDirectory, saveLogs.OutputDirectory)
+	}
+
+	return dedupeNonEmpty(dirs)
+}
+
+func dedupeNonEmpty(values []string) []string {
+	seen := make(map[string]bool)
+	ret := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if value != "" && !seen[value] {
+			seen[value] = true
+			ret = append(ret, value)
+		}
+	}
+
+	return ret
+}
+
+func (e *Executor) orphanedSavedLogFiles(dir string, oldestKept time.Time) []string {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	ret := make([]string, 0)
+
+	for _, file := range files {
+		if e.isOrphanedSavedLogFile(file.Name(), oldestKept) {
+			ret = append(ret, file.Name())
+		}
+	}
+
+	return ret
+}
+
+func (e *Executor) isOrphanedSavedLogFile(name string, oldestKept time.Time) bool {
+	trackingID, started, ok := parseSavedLogFilename(name)
+	if !ok || !started.Before(oldestKept) {
+		return false
+	}
+
+	_, found := e.GetLog(trackingID)
+
+	return !found
+}
+
+// parseSavedLogFilename reads the tracking ID and start time from the name
+// of a file written by saveLogResults or saveLogOutput, which is made by
+// savedLogFilename. Action titles may contain dots, so it is read from the
+// end.
+func parseSavedLogFilename(name string) (string, time.Time, bool) {
+	ext := filepath.Ext(name)
+
+	if ext != ".yaml" && ext != ".log" {
+		return "", time.Time{}, false
+	}
+
+	rest, trackingID, found := cutLast(strings.TrimSuffix(name, ext), ".")
+	if !found || trackingID == "" {
+		return "", time.Time{}, false
+	}
+
+	_, unix, _ := cutLast(rest, ".")
+
+	started, err := strconv.ParseInt(unix, 10, 64)
+
+	return trackingID, time.Unix(started, 0), err == nil
+}
+
+func cutLast(s string, sep string) (string, string, bool) {
+	idx := strings.LastIndex(s, sep)
+	if idx < 0 {
+		return "", s, false
+	}
+
+	return s[:idx], s[idx+len(sep):], true
+}
@@ -0,0 +1,193 @@
+package executor
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func retentionTestingExecutor(t *testing.T, cfg *config.Config) (*Executor, *ActionBinding, *ActionBinding) {
+	cfg.Actions = append(cfg.Actions, &config.Action{Title: "first", Shell: "echo first"})
+	cfg.Actions = append(cfg.Actions, &config.Action{Title: "second", Shell: "echo second"})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	require.NoError(t, e.OpenHistoryStore())
+
+	t.Cleanup(func() {
+		_ = e.history.Close()
+	})
+
+	return e, e.FindBindingWithNoEntity(cfg.Actions[0]), e.FindBindingWithNoEntity(cfg.Actions[1])
+}
+
+func addFinishedLog(e *Executor, binding *ActionBinding, started time.Time, output string) string {
+	return e.SetLog("", &InternalLogEntry{
+		Binding:           binding,
+		ActionTitle:       binding.Action.Title,
+		DatetimeStarted:   started,
+		Output:            output,
+		ExecutionStarted:  true,
+		ExecutionFinished: true,
+	})
+}
+
+func remainingOutputs(e *Executor) []string {
+	logs, _ := e.GetLogTrackingIds(0, 100)
+	ret := make([]string, 0, len(logs))
+
+	for _, entry := range logs {
+		ret = append(ret, entry.Output)
+	}
+
+	return ret
+}
+
+func retentionConfigs(t *testing.T) map[string]*config.Config {
+	sqlite := config.DefaultConfig()
+	sqlite.LogHistoryDatabase = filepath.Join(t.TempDir(), "history.db")
+
+	return map[string]*config.Config{
+		"memory": config.DefaultConfig(),
+		"sqlite": sqlite,
+	}
+}
+
+func TestSweepLogsMaxAge(t *testing.T) {
+	for name, cfg := range retentionConfigs(t) {
+		t.Run(name, func(t *testing.T) {
+			e, first, _ := retentionTestingExecutor(t, cfg)
+			cfg.LogRetention.MaxAge = "1h"
+
+			addFinishedLog(e, first, time.Now().Add(-2*time.Hour), "old")
+			addFinishedLog(e, first, time.Now(), "new")
+
+			e.SweepLogs()
+
+			assert.Equal(t, []string{"new"}, remainingOutputs(e))
+		})
+	}
+}
+
+func TestSweepLogsMaxCountPerAction(t *testing.T) {
+	for name, cfg := range retentionConfigs(t) {
+		t.Run(name, func(t *testing.T) {
+			e, first, second := retentionTestingExecutor(t, cfg)
+			cfg.LogRetention.MaxCountPerAction = 2
+
+			addFinishedLog(e, first, time.Now(), "first 1")
+			addFinishedLog(e, second, time.Now(), "second 1")
+			addFinishedLog(e, first, time.Now(), "first 2")
+			addFinishedLog(e, first, time.Now(), "first 3")
+
+			e.SweepLogs()
+
+			assert.Equal(t, []string{"first 3", "first 2", "second 1"}, remainingOutputs(e))
+			assert.Equal(t, 2, len(e.GetLogsByBindingId(first.ID)))
+		})
+	}
+}
+
+func TestSweepLogsMaxTotalBytes(t *testing.T) {
+	for name, cfg := range retentionConfigs(t) {
+		t.Run(name, func(t *testing.T) {
+			e, first, second := retentionTestingExecutor(t, cfg)
+			cfg.LogRetention.MaxTotalBytes = 10
+
+			addFinishedLog(e, first, time.Now(), "aaaa")
+			addFinishedLog(e, second, time.Now(), "bbbb")
+			addFinishedLog(e, first, time.Now(), "cccc")
+
+			e.SweepLogs()
+
+			assert.Equal(t, []string{"cccc", "bbbb"}, remainingOutputs(e))
+		})
+	}
+}
+
+func TestSweepLogsKeepsRunningExecutions(t *testing.T) {
+	cfg := config.DefaultConfig()
+	e, first, _ := retentionTestingExecutor(t, cfg)
+	cfg.LogRetention.MaxAge = "1h"
+
+	trackingID := e.SetLog("", &InternalLogEntry{
+		Binding:          first,
+		DatetimeStarted:  time.Now().Add(-2 * time.Hour),
+		ExecutionStarted: true,
+	})
+
+	e.SweepLogs()
+
+	_, found := e.GetLog(trackingID)
+	assert.True(t, found, "Unfinished executions are never removed")
+}
+
+func TestSweepLogsRemovesSavedFiles(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SaveLogs.ResultsDirectory = t.TempDir()
+	cfg.SaveLogs.OutputDirectory = t.TempDir()
+
+	e, _, _ := retentionTestingExecutor(t, cfg)
+	cfg.LogRetention.MaxCountPerAction = 1
+
+	execNewReqAndWait(e, "first", cfg)
+	execNewReqAndWait(e, "first", cfg)
+
+	results, _ := os.ReadDir(cfg.SaveLogs.ResultsDirectory)
+	require.Equal(t, 2, len(results))
+
+	e.SweepLogs()
+
+	logs, _ := e.GetLogTrackingIds(0, 10)
+	require.Equal(t, 1, len(logs))
+
+	for _, dir := range []string{cfg.SaveLogs.ResultsDirectory, cfg.SaveLogs.OutputDirectory} {
+		files, _ := os.ReadDir(dir)
+
+		require.Equal(t, 1, len(files))
+		assert.True(t, strings.Contains(files[0].Name(), logs[0].ExecutionTrackingID), "The newest saved log is kept")
+	}
+}
+
+func TestSweepLogsRemovesOldOrphanedSavedFiles(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SaveLogs.ResultsDirectory = t.TempDir()
+	cfg.SaveLogs.OutputDirectory = t.TempDir()
+
+	e, first, _ := retentionTestingExecutor(t, cfg)
+	cfg.LogRetention.MaxAge = "24h"
+
+	now := time.Now()
+	old := strconv.FormatInt(now.Add(-48*time.Hour).Unix(), 10)
+	recent := strconv.FormatInt(now.Add(-time.Hour).Unix(), 10)
+	kept := addFinishedLog(e, first, now, "kept")
+
+	files := map[string]string{
+		"orphan.v1.2." + old + ".gone.yaml":   cfg.SaveLogs.ResultsDirectory,
+		"orphan.v1.2." + old + ".gone.log":    cfg.SaveLogs.OutputDirectory,
+		"orphan." + recent + ".recent.yaml":   cfg.SaveLogs.ResultsDirectory,
+		"first." + old + "." + kept + ".yaml": cfg.SaveLogs.ResultsDirectory,
+		"notes." + old + ".txt":               cfg.SaveLogs.ResultsDirectory,
+	}
+
+	for name, dir := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte{}, 0600))
+	}
+
+	e.SweepLogs()
+
+	for name, dir := range files {
+		_, err := os.Stat(filepath.Join(dir, name))
+		assert.Equal(t, !strings.Contains(name, ".gone."), err == nil, name)
+	}
+}
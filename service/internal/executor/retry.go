@@ -0,0 +1,152 @@
+package executor
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const maxRetryBackoff = 10 * time.Minute
+
+// ExecutionAttempt is an attempt of an execution that was retried.
+type ExecutionAttempt struct {
+	Attempt          int
+	DatetimeStarted  time.Time
+	DatetimeFinished time.Time
+	ExitCode         int32
+	TimedOut         bool
+	Output           string
+}
+
+// stepExecWithRetry runs the action again while the retry policy allows it.
+// Every attempt uses the same log entry and tracking ID, so the execution
+// keeps its slot for maxConcurrent while it waits, and counts once for
+// maxRate.
+func stepExecWithRetry(req *ExecutionRequest) bool {
+	for attempt := 1; ; attempt++ {
+		started := time.Now()
+
+		req.mutateLogEntry(func(entry *InternalLogEntry) {
+			entry.Attempt = attempt
+		})
+
+		if !stepExec(req) {
+			return false
+		}
+
+		if !shouldRetry(req, attempt) || !waitToRetry(req, attempt, started) {
+			return true
+		}
+	}
+}
+
+func shouldRetry(req *ExecutionRequest, attempt int) bool {
+	policy := req.Binding.Action.Retry
+
+	if attempt >= policy.Attempts {
+		return false
+	}
+
+	req.executor.logmutex.RLock()
+	defer req.executor.logmutex.RUnlock()
+
+	return !req.logEntry.killRequested && attemptFailedRetryably(policy, req.logEntry)
+}
+
+func attemptFailedRetryably(policy config.RetryConfig, entry *InternalLogEntry) bool {
+	if entry.TimedOut {
+		return policy.RetryOnTimeout
+	}
+
+	if len(policy.RetryOnExitCodes) > 0 {
+		return slices.Contains(policy.RetryOnExitCodes, entry.ExitCode)
+	}
+
+	return entry.ExitCode != 0
+}
+
+// retryBackoff doubles the backoff for each retry.
+func retryBackoff(policy config.RetryConfig, attempt int) time.Duration {
+	backoff, _ := time.ParseDuration(policy.Backoff)
+
+	for i := 1; i < attempt && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxRetryBackoff)
+}
+
+// waitToRetry records the attempt that failed, and waits for the backoff. It
+// returns false when the execution was killed while waiting.
+func waitToRetry(req *ExecutionRequest, attempt int, started time.Time) bool {
+	backoff := retryBackoff(req.Binding.Action.Retry, attempt)
+
+	log.WithFields(log.Fields{
+		"actionTitle": req.logEntry.ActionTitle,
+		"attempt":     attempt,
+		"exit":        req.logEntry.ExitCode,
+		"timedOut":    req.logEntry.TimedOut,
+		"backoff":     backoff,
+	}).Warnf("Action failed, retrying")
+
+	cancelled := make(chan struct{})
+	cancel := sync.OnceFunc(func() { close(cancelled) })
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		recordAttempt(entry, attempt, started)
+		entry.killer = func() error {
+			cancel()
+			return nil
+		}
+	})
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-cancelled:
+	}
+
+	return startNextAttempt(req, attempt)
+}
+
+func recordAttempt(entry *InternalLogEntry, attempt int, started time.Time) {
+	entry.Attempts = append(entry.Attempts, ExecutionAttempt{
+		Attempt:          attempt,
+		DatetimeStarted:  started,
+		DatetimeFinished: time.Now(),
+		ExitCode:         entry.ExitCode,
+		TimedOut:         entry.TimedOut,
+		Output:           entry.Output,
+	})
+}
+
+// startNextAttempt clears the result of the last attempt, unless the
+// execution was killed while it waited.
+func startNextAttempt(req *ExecutionRequest, attempt int) bool {
+	retry := false
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = nil
+
+		if entry.killRequested {
+			entry.Output += fmt.Sprintf("OliveTin::retry - killed before attempt %v.", attempt+1)
+			return
+		}
+
+		retry = true
+		entry.ExitCode = 0
+		entry.TimedOut = false
+		entry.Output = ""
+		entry.Stdout = ""
+		entry.Stderr = ""
+		entry.Process = nil
+	})
+
+	return retry
+}
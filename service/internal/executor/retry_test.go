@@ -0,0 +1,113 @@
+package executor
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func newRetryTestExecutor(action *config.Action) (*Executor, *config.Config) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, action)
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	return e, cfg
+}
+
+func execRetryTestAction(e *Executor, cfg *config.Config) (func(), string) {
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		AuthenticatedUser: auth.UserFromSystem(cfg, "testuser"),
+		Cfg:               cfg,
+		Binding:           e.FindBindingWithNoEntity(cfg.Actions[0]),
+	})
+
+	return wg.Wait, trackingID
+}
+
+func TestRetryUntilSuccess(t *testing.T) {
+	counter := filepath.Join(t.TempDir(), "attempts")
+
+	e, cfg := newRetryTestExecutor(&config.Action{
+		Title: "Flaky",
+		Shell: "n=$(cat " + counter + " 2>/dev/null || echo 0); n=$((n+1)); echo $n > " + counter + "; echo attempt $n; [ $n -ge 3 ]",
+		Retry: config.RetryConfig{Attempts: 5, Backoff: "1ms"},
+	})
+
+	wait, trackingID := execRetryTestAction(e, cfg)
+	wait()
+
+	entry, ok := e.GetLog(trackingID)
+	require.True(t, ok)
+
+	assert.Equal(t, int32(0), entry.ExitCode)
+	assert.Equal(t, 3, entry.Attempt)
+	assert.Equal(t, "attempt 3\n", entry.Output)
+	require.Len(t, entry.Attempts, 2)
+	assert.Equal(t, int32(1), entry.Attempts[0].ExitCode)
+	assert.Contains(t, entry.Attempts[1].Output, "attempt 2\n")
+
+	assert.Len(t, e.GetLogsByBindingId(entry.GetBindingId()), 1, "Retries are part of the same execution")
+}
+
+func TestRetryOnlyOnConfiguredExitCodes(t *testing.T) {
+	e, cfg := newRetryTestExecutor(&config.Action{
+		Title: "Fails",
+		Shell: "exit 3",
+		Retry: config.RetryConfig{Attempts: 3, Backoff: "1ms", RetryOnExitCodes: []int32{2}},
+	})
+
+	wait, trackingID := execRetryTestAction(e, cfg)
+	wait()
+
+	entry, _ := e.GetLog(trackingID)
+
+	assert.Equal(t, int32(3), entry.ExitCode)
+	assert.Equal(t, 1, entry.Attempt)
+	assert.Empty(t, entry.Attempts)
+}
+
+func TestKillWhileWaitingToRetry(t *testing.T) {
+	e, cfg := newRetryTestExecutor(&config.Action{
+		Title: "Fails",
+		Shell: "exit 1",
+		Retry: config.RetryConfig{Attempts: 3, Backoff: "1h"},
+	})
+
+	wait, trackingID := execRetryTestAction(e, cfg)
+
+	assert.Eventually(t, func() bool {
+		snapshot, _ := e.SnapshotLog(trackingID)
+		return snapshot.FailedAttempts == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	waitSecond, secondID := execRetryTestAction(e, cfg)
+	waitSecond()
+
+	second, _ := e.GetLog(secondID)
+	assert.True(t, second.Blocked, "The execution keeps its slot for maxConcurrent while it waits to retry")
+
+	entry, _ := e.GetLog(trackingID)
+	require.NoError(t, e.Kill(entry))
+	wait()
+
+	assert.Equal(t, 1, entry.Attempt)
+	assert.Contains(t, entry.Output, "killed before attempt 2")
+}
+
+func TestRetryBackoffDoubles(t *testing.T) {
+	policy := config.RetryConfig{Backoff: "2s"}
+
+	assert.Equal(t, 2*time.Second, retryBackoff(policy, 1))
+	assert.Equal(t, 4*time.Second, retryBackoff(policy, 2))
+	assert.Equal(t, 8*time.Second, retryBackoff(policy, 3))
+	assert.Equal(t, maxRetryBackoff, retryBackoff(policy, 20))
+}
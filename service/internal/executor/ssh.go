@@ -0,0 +1,314 @@
+package executor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/tpl"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const defaultSshPort = "22"
+
+var errSshHostEmpty = errors.New("ssh host is empty after parsing the template")
+
+// sshTarget is an action's ssh config, with templates parsed and defaults
+// filled in.
+type sshTarget struct {
+	addr           string
+	user           string
+	keyFile        string
+	knownHostsFile string
+}
+
+func stepExecOverSsh(req *ExecutionRequest) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(req.Binding.Action.Timeout)*time.Second)
+	defer cancel()
+
+	streamer := &OutputStreamer{Req: req}
+
+	exitCode, err := runOverSsh(ctx, req, streamer)
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = nil
+		entry.ExitCode = exitCode
+		streamer.saveTo(entry)
+	})
+
+	appendErrorToStderr(req, err)
+
+	if ctx.Err() == context.DeadlineExceeded {
+		markTimedOut(req)
+	}
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.DatetimeFinished = time.Now()
+	})
+
+	return true
+}
+
+func runOverSsh(ctx context.Context, req *ExecutionRequest, streamer *OutputStreamer) (int32, error) {
+	target, err := resolveSshTarget(req)
+	if err != nil {
+		return -1, err
+	}
+
+	client, err := dialSshKillable(ctx, req, target)
+	if err != nil {
+		return -1, err
+	}
+
+	defer func() { _ = client.Close() }()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return -1, err
+	}
+
+	defer func() { _ = session.Close() }()
+
+	session.Stdout = streamer
+	session.Stderr = streamer.Stderr()
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = func() error {
+			return killSshSession(session, client)
+		}
+	})
+
+	markExecutionStarted(req)
+
+	if err := session.Start(sshRemoteCommand(req)); err != nil {
+		return -1, err
+	}
+
+	return waitForSsh(ctx, session, client)
+}
+
+func waitForSsh(ctx context.Context, session *ssh.Session, client *ssh.Client) (int32, error) {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- session.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		return sshExitCode(err)
+	case <-ctx.Done():
+		_ = killSshSession(session, client)
+		<-done
+
+		return -1, nil
+	}
+}
+
+// killSshSession asks the server to kill the command, which not all servers
+// support, and then closes the connection, which makes sshd hang up on it.
+func killSshSession(session *ssh.Session, client *ssh.Client) error {
+	_ = session.Signal(ssh.SIGKILL)
+
+	return client.Close()
+}
+
+func sshExitCode(err error) (int32, error) {
+	var exitErr *ssh.ExitError
+
+	switch {
+	case err == nil:
+		return 0, nil
+	case errors.As(err, &exitErr):
+		return int32(exitErr.ExitStatus()), err
+	default:
+		return -1, err
+	}
+}
+
+func resolveSshTarget(req *ExecutionRequest) (*sshTarget, error) {
+	cfg := req.Binding.Action.Ssh
+
+	host := tpl.ParseTemplateOfActionBeforeExec(cfg.Host, req.Binding.Entity)
+	if host == "" {
+		return nil, errSshHostEmpty
+	}
+
+	target := &sshTarget{
+		addr:           sshAddr(host),
+		user:           tpl.ParseTemplateOfActionBeforeExec(cfg.User, req.Binding.Entity),
+		keyFile:        sshFile(cfg.KeyFile, "id_rsa"),
+		knownHostsFile: sshFile(cfg.KnownHostsFile, "known_hosts"),
+	}
+
+	if target.user == "" {
+		target.user = currentUsername()
+	}
+
+	return target, nil
+}
+
+func sshAddr(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+
+	return net.JoinHostPort(host, defaultSshPort)
+}
+
+// sshFile returns the configured path, or otherwise looks for the file in
+// the same places as olivetin-setup-easy-ssh and the ssh client do.
+func sshFile(configured string, name string) string {
+	if configured != "" {
+		return expandHome(configured)
+	}
+
+	containerPath := filepath.Join("/config/ssh", name)
+
+	if _, err := os.Stat(containerPath); err == nil {
+		return containerPath
+	}
+
+	return expandHome(filepath.Join("~", ".ssh", name))
+}
+
+func expandHome(path string) string {
+	home, err := os.UserHomeDir()
+
+	if err != nil || !strings.HasPrefix(path, "~") {
+		return path
+	}
+
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+func currentUsername() string {
+	current, err := user.Current()
+	if err != nil {
+		return ""
+	}
+
+	return current.Username
+}
+
+// dialSshKillable lets the execution be killed while it is still connecting,
+// as a server that accepts the connection may never finish the handshake.
+func dialSshKillable(ctx context.Context, req *ExecutionRequest, target *sshTarget) (*ssh.Client, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	req.mutateLogEntry(func(entry *InternalLogEntry) {
+		entry.killer = func() error {
+			cancel()
+			return nil
+		}
+	})
+
+	return dialSsh(ctx, target)
+}
+
+func dialSsh(ctx context.Context, target *sshTarget) (*ssh.Client, error) {
+	clientConfig, err := sshClientConfig(target)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"addr": target.addr,
+		"user": target.user,
+	}).Debugf("Connecting to SSH server")
+
+	dialer := &net.Dialer{}
+
+	conn, err := dialer.DialContext(ctx, "tcp", target.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return sshHandshake(ctx, conn, target.addr, clientConfig)
+}
+
+// sshHandshake closes the connection when ctx is done, as the handshake has no
+// timeout of its own.
+func sshHandshake(ctx context.Context, conn net.Conn, addr string, clientConfig *ssh.ClientConfig) (*ssh.Client, error) {
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, clientConfig)
+
+	if !stop() {
+		if err == nil {
+			_ = sshConn.Close()
+		}
+
+		return nil, fmt.Errorf("ssh handshake with %v: %w", addr, ctx.Err())
+	}
+
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return ssh.NewClient(sshConn, chans, reqs), nil
+}
+
+func sshClientConfig(target *sshTarget) (*ssh.ClientConfig, error) {
+	key, err := os.ReadFile(target.keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ssh key file: %w", err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse ssh key file %v: %w", target.keyFile, err)
+	}
+
+	hostKeyCallback, err := knownhosts.New(target.knownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not read ssh known hosts file: %w", err)
+	}
+
+	return &ssh.ClientConfig{
+		User:            target.user,
+		Auth:            []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: hostKeyCallback,
+	}, nil
+}
+
+// sshRemoteCommand builds the command line that is sent to the server. The
+// environment is exported as part of the command, as most servers do not
+// accept environment variables from clients.
+func sshRemoteCommand(req *ExecutionRequest) string {
+	var cmd strings.Builder
+
+	for _, variable := range argumentEnv(req.Arguments) {
+		name, value, _ := strings.Cut(variable, "=")
+		cmd.WriteString("export " + name + "=" + shellQuote(value) + "; ")
+	}
+
+	if !req.useDirectExec {
+		cmd.WriteString(req.finalParsedCommand)
+		return cmd.String()
+	}
+
+	quoted := make([]string, len(req.execArgs))
+
+	for i, arg := range req.execArgs {
+		quoted[i] = shellQuote(arg)
+	}
+
+	cmd.WriteString(strings.Join(quoted, " "))
+
+	return cmd.String()
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
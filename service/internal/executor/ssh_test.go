@@ -0,0 +1,336 @@
+package executor
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/entities"
+)
+
+// testSshServer runs exec requests as local commands, which is just enough of
+// an SSH server to test against.
+type testSshServer struct {
+	addr           string
+	keyFile        string
+	knownHostsFile string
+	commands       chan string
+}
+
+func startTestSshServer(t *testing.T) *testSshServer {
+	dir := t.TempDir()
+
+	_, hostPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPriv)
+	require.NoError(t, err)
+
+	clientPub, clientPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	authorizedKey, err := ssh.NewPublicKey(clientPub)
+	require.NoError(t, err)
+
+	pemBlock, err := ssh.MarshalPrivateKey(clientPriv, "")
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	srv := &testSshServer{
+		addr:           listener.Addr().String(),
+		keyFile:        filepath.Join(dir, "id_ed25519"),
+		knownHostsFile: filepath.Join(dir, "known_hosts"),
+		commands:       make(chan string, 10),
+	}
+
+	require.NoError(t, os.WriteFile(srv.keyFile, pem.EncodeToMemory(pemBlock), 0600))
+	require.NoError(t, os.WriteFile(srv.knownHostsFile, []byte(knownhosts.Line([]string{knownhosts.Normalize(srv.addr)}, hostSigner.PublicKey())+"\n"), 0600))
+
+	serverConfig := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) == string(authorizedKey.Marshal()) {
+				return nil, nil
+			}
+
+			return nil, assert.AnError
+		},
+	}
+	serverConfig.AddHostKey(hostSigner)
+
+	go srv.serve(listener, serverConfig)
+
+	return srv
+}
+
+func (srv *testSshServer) serve(listener net.Listener, serverConfig *ssh.ServerConfig) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go srv.handleConn(conn, serverConfig)
+	}
+}
+
+func (srv *testSshServer) handleConn(conn net.Conn, serverConfig *ssh.ServerConfig) {
+	_, chans, reqs, err := ssh.NewServerConn(conn, serverConfig)
+	if err != nil {
+		return
+	}
+
+	go ssh.DiscardRequests(reqs)
+
+	for newChannel := range chans {
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+
+		go srv.handleSession(channel, requests)
+	}
+}
+
+func (srv *testSshServer) handleSession(channel ssh.Channel, requests <-chan *ssh.Request) {
+	defer func() { _ = channel.Close() }()
+
+	var cmd *exec.Cmd
+
+	for req := range requests {
+		switch req.Type {
+		case "exec":
+			cmd = srv.startCommand(channel, req)
+		case "signal":
+			if cmd != nil {
+				_ = cmd.Process.Kill()
+			}
+		default:
+			_ = req.Reply(false, nil)
+		}
+	}
+}
+
+func (srv *testSshServer) startCommand(channel ssh.Channel, req *ssh.Request) *exec.Cmd {
+	command := string(req.Payload[4:])
+	srv.commands <- command
+
+	cmd := exec.Command("sh", "-c", command)
+	cmd.Stdout = channel
+	cmd.Stderr = channel.Stderr()
+
+	if err := cmd.Start(); err != nil {
+		_ = req.Reply(false, nil)
+		return nil
+	}
+
+	_ = req.Reply(true, nil)
+
+	go func() {
+		_ = cmd.Wait()
+
+		status := make([]byte, 4)
+		binary.BigEndian.PutUint32(status, uint32(cmd.ProcessState.ExitCode()))
+		_, _ = channel.SendRequest("exit-status", false, status)
+		_ = channel.Close()
+	}()
+
+	return cmd
+}
+
+func sshTestingExecutor(srv *testSshServer, shell string) (*Executor, *config.Config) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title: "remote",
+		Shell: shell,
+		Ssh: config.SshConfig{
+			Host:           srv.addr,
+			User:           "olivetin",
+			KeyFile:        srv.keyFile,
+			KnownHostsFile: srv.knownHostsFile,
+		},
+	})
+	cfg.Sanitize()
+
+	e := DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	return e, cfg
+}
+
+func TestExecOverSsh(t *testing.T) {
+	srv := startTestSshServer(t)
+	e, cfg := sshTestingExecutor(srv, "echo hello $OLIVETIN; echo oops >&2; exit 3")
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, int32(3), entry.ExitCode)
+	assert.Contains(t, entry.Output, "hello 1\n")
+	assert.Contains(t, entry.Output, "oops\n")
+	command := <-srv.commands
+	assert.True(t, strings.HasPrefix(command, "export OLIVETIN='1'; "), "Environment is exported before the command")
+	assert.Contains(t, command, "export OT_USERNAME='guest'; ")
+	assert.True(t, strings.HasSuffix(command, "; echo hello $OLIVETIN; echo oops >&2; exit 3"))
+}
+
+func TestKillExecOverSsh(t *testing.T) {
+	srv := startTestSshServer(t)
+	e, cfg := sshTestingExecutor(srv, "sleep 10")
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+
+	<-srv.commands
+
+	require.Eventually(t, func() bool {
+		entry, _ := e.GetLog(trackingID)
+		return e.Kill(entry) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.True(t, entry.ExecutionFinished)
+	assert.False(t, entry.TimedOut)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+}
+
+// startStalledSshServer accepts connections but never answers, like a server
+// that hangs during the handshake.
+func startStalledSshServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			t.Cleanup(func() { _ = conn.Close() })
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func TestExecOverSshHandshakeTimesOut(t *testing.T) {
+	srv := startTestSshServer(t)
+	srv.addr = startStalledSshServer(t)
+
+	e, cfg := sshTestingExecutor(srv, "echo hello")
+	cfg.Actions[0].Timeout = 1
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.True(t, entry.TimedOut)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+}
+
+func TestKillExecOverSshDuringHandshake(t *testing.T) {
+	srv := startTestSshServer(t)
+	srv.addr = startStalledSshServer(t)
+
+	e, cfg := sshTestingExecutor(srv, "echo hello")
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+
+	require.Eventually(t, func() bool {
+		entry, _ := e.GetLog(trackingID)
+		return e.Kill(entry) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.True(t, entry.ExecutionFinished)
+	assert.False(t, entry.TimedOut)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+}
+
+func TestExecOverSshUnknownHostKey(t *testing.T) {
+	srv := startTestSshServer(t)
+	require.NoError(t, os.WriteFile(srv.knownHostsFile, []byte{}, 0600))
+
+	e, cfg := sshTestingExecutor(srv, "echo hello")
+
+	wg, trackingID := e.ExecRequest(&ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	entry, _ := e.GetLog(trackingID)
+	assert.Equal(t, int32(-1), entry.ExitCode)
+	assert.Contains(t, entry.Output, "knownhosts: key is unknown")
+}
+
+func TestResolveSshTargetFromEntity(t *testing.T) {
+	req := &ExecutionRequest{
+		Binding: &ActionBinding{
+			Action: &config.Action{
+				Ssh: config.SshConfig{
+					Host: "{{ server.hostname }}",
+					User: "{{ server.user }}",
+				},
+			},
+			Entity: &entities.Entity{
+				Data: map[string]any{
+					"hostname": "web1.example.com",
+					"user":     "deploy",
+				},
+			},
+		},
+	}
+
+	target, err := resolveSshTarget(req)
+	require.NoError(t, err)
+	assert.Equal(t, "web1.example.com:22", target.addr)
+	assert.Equal(t, "deploy", target.user)
+
+	req.Binding.Entity.Data = map[string]any{"hostname": "[::1]:2222"}
+
+	target, err = resolveSshTarget(req)
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:2222", target.addr)
+}
+
+func TestSshRemoteCommandQuotesExecArgs(t *testing.T) {
+	req := &ExecutionRequest{
+		useDirectExec: true,
+		execArgs:      []string{"echo", "it's", "$HOME"},
+	}
+
+	assert.Equal(t, `export OLIVETIN='1'; 'echo' 'it'\''s' '$HOME'`, sshRemoteCommand(req))
+}
@@ -0,0 +1,109 @@
+package executor
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/logfilter"
+	"github.com/OliveTin/OliveTin/internal/tpl"
+	log "github.com/sirupsen/logrus"
+)
+
+// triggerConditionsMatch checks every condition that is set on the trigger
+// against the finished execution.
+func triggerConditionsMatch(trigger config.ActionTrigger, entry *InternalLogEntry) bool {
+	return triggerOutcomeMatches(trigger, executionSucceeded(entry)) &&
+		triggerExitCodeMatches(trigger, entry.ExitCode) &&
+		triggerExpressionMatches(trigger, entry)
+}
+
+func triggerOutcomeMatches(trigger config.ActionTrigger, succeeded bool) bool {
+	if succeeded {
+		return !trigger.OnFailure
+	}
+
+	return !trigger.OnSuccess
+}
+
+func triggerExitCodeMatches(trigger config.ActionTrigger, exitCode int32) bool {
+	return len(trigger.OnExitCode) == 0 || slices.Contains(trigger.OnExitCode, exitCode)
+}
+
+func executionSucceeded(entry *InternalLogEntry) bool {
+	return !entry.Blocked && !entry.TimedOut && entry.ExitCode == 0
+}
+
+func triggerExpressionMatches(trigger config.ActionTrigger, entry *InternalLogEntry) bool {
+	program, err := logfilter.Compile(trigger.If)
+
+	if err == nil {
+		var matched bool
+
+		matched, err = logfilter.Matches(program, filterRecordFromEntry(entry))
+
+		if err == nil {
+			return matched
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"triggerTitle": trigger.Action,
+		"fromAction":   entry.ActionTitle,
+		"error":        err,
+	}).Warnf("Trigger condition could not be evaluated; skipping")
+
+	return false
+}
+
+// triggerArguments passes on the arguments of the execution, with the
+// arguments of the trigger parsed over them. The templates can use the output
+// and exit code like shellAfterCompleted, and the result of the execution.
+// Only arguments that the triggered action declares can be set, so that every
+// value is type checked before it reaches a command.
+func triggerArguments(trigger config.ActionTrigger, req *ExecutionRequest, target *config.Action) (map[string]string, error) {
+	if len(trigger.Arguments) == 0 {
+		return req.Arguments, nil
+	}
+
+	if err := checkTriggerArgumentsDeclared(trigger, target); err != nil {
+		return nil, err
+	}
+
+	templateArgs := copyArguments(req.Arguments)
+	templateArgs["output"] = req.logEntry.Output
+	templateArgs["exitCode"] = fmt.Sprintf("%v", req.logEntry.ExitCode)
+
+	args := copyArguments(req.Arguments)
+
+	for name, source := range trigger.Arguments {
+		value, err := tpl.ParseTemplateWithActionResult(source, req.Binding.Entity, templateArgs, req.logEntry.Result)
+
+		if err != nil {
+			return nil, fmt.Errorf("argument %v: %w", name, err)
+		}
+
+		args[name] = value
+	}
+
+	return args, nil
+}
+
+func checkTriggerArgumentsDeclared(trigger config.ActionTrigger, target *config.Action) error {
+	for name := range trigger.Arguments {
+		if target.FindArg(name) == nil {
+			return fmt.Errorf("argument %v is not an argument of %v", name, target.Title)
+		}
+	}
+
+	return nil
+}
+
+func copyArguments(args map[string]string) map[string]string {
+	ret := make(map[string]string, len(args)+2)
+
+	maps.Copy(ret, args)
+
+	return ret
+}
@@ -0,0 +1,119 @@
+package executor
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+func runAndCollectTriggered(t *testing.T, cfg *config.Config, e *Executor, action *config.Action, expected int) []string {
+	collector := &finishedOutputCollector{outputs: make(chan string, 8)}
+	e.AddListener(collector)
+
+	wg, _ := e.ExecRequest(&ExecutionRequest{
+		AuthenticatedUser: auth.UserFromSystem(cfg, "testuser"),
+		Cfg:               cfg,
+		Binding:           e.FindBindingWithNoEntity(action),
+	})
+	wg.Wait()
+
+	outputs := collectOutputs(t, collector, expected)
+	sort.Strings(outputs)
+
+	return outputs
+}
+
+func collectOutputs(t *testing.T, collector *finishedOutputCollector, expected int) []string {
+	var outputs []string
+
+	for len(outputs) < expected {
+		select {
+		case output := <-collector.outputs:
+			outputs = append(outputs, output)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for executions; got %v", outputs)
+		}
+	}
+
+	return append(outputs, unexpectedOutputs(collector)...)
+}
+
+// unexpectedOutputs waits a little for executions that should not have been
+// triggered.
+func unexpectedOutputs(collector *finishedOutputCollector) []string {
+	select {
+	case output := <-collector.outputs:
+		return []string{output}
+	case <-time.After(200 * time.Millisecond):
+		return nil
+	}
+}
+
+func TestTriggersWithConditions(t *testing.T) {
+	cfg := config.DefaultConfig()
+	e := DefaultExecutor(cfg)
+
+	backup := &config.Action{
+		Title: "Backup",
+		Shell: "echo 'disk full'; exit 2",
+		Triggers: []config.ActionTrigger{
+			{Action: "Notify", OnSuccess: true},
+			{Action: "Clean up", OnFailure: true, Arguments: map[string]string{"reason": "{{ exitCode }}"}},
+			{Action: "Page", OnExitCode: []int32{1}},
+			{Action: "Report", If: `Output contains "disk full"`},
+		},
+	}
+
+	cfg.Actions = append(cfg.Actions,
+		backup,
+		&config.Action{Title: "Notify", Shell: "echo notify"},
+		&config.Action{
+			Title:     "Clean up",
+			Shell:     "echo cleaning up after {{ reason }}",
+			Arguments: []config.ActionArgument{{Name: "reason", Type: "int"}},
+		},
+		&config.Action{Title: "Page", Shell: "echo page"},
+		&config.Action{Title: "Report", Shell: "echo report"},
+	)
+	cfg.Sanitize()
+	e.RebuildActionMap()
+
+	outputs := runAndCollectTriggered(t, cfg, e, backup, 3)
+
+	assert.Equal(t, []string{
+		"Backup: exit status 2\n\ndisk full\n",
+		"Clean up: cleaning up after 2\n",
+		"Report: report\n",
+	}, outputs)
+}
+
+func TestTriggerOnSuccess(t *testing.T) {
+	cfg := config.DefaultConfig()
+	e := DefaultExecutor(cfg)
+
+	backup := &config.Action{
+		Title: "Backup",
+		Shell: "echo done",
+		Triggers: []config.ActionTrigger{
+			{Action: "Notify", OnSuccess: true},
+			{Action: "Clean up", OnFailure: true},
+		},
+	}
+
+	cfg.Actions = append(cfg.Actions,
+		backup,
+		&config.Action{Title: "Notify", Shell: "echo notify"},
+		&config.Action{Title: "Clean up", Shell: "echo cleanup"},
+	)
+	cfg.Sanitize()
+	e.RebuildActionMap()
+
+	outputs := runAndCollectTriggered(t, cfg, e, backup, 2)
+
+	assert.Equal(t, []string{"Backup: done\n", "Notify: notify\n"}, outputs)
+}
@@ -1,6 +1,8 @@
 package filehelper
 
 import (
+	"context"
+
 	"github.com/fsnotify/fsnotify"
 	log "github.com/sirupsen/logrus"
 	"path/filepath"
@@ -37,7 +39,13 @@ type watchContext struct {
 }
 
 func WatchDirectoryCreate(fullpath string, callback func(filename string)) {
-	watchPath(&watchContext{
+	WatchDirectoryCreateContext(context.Background(), fullpath, callback)
+}
+
+// WatchDirectoryCreateContext is like WatchDirectoryCreate, but stops
+// watching when the context is done.
+func WatchDirectoryCreateContext(ctx context.Context, fullpath string, callback func(filename string)) {
+	watchPath(ctx, &watchContext{
 		filedir:         fullpath,
 		filename:        "",
 		callback:        callback,
@@ -46,7 +54,13 @@ func WatchDirectoryCreate(fullpath string, callback func(filename string)) {
 }
 
 func WatchDirectoryWrite(fullpath string, callback func(filename string)) {
-	watchPath(&watchContext{
+	WatchDirectoryWriteContext(context.Background(), fullpath, callback)
+}
+
+// WatchDirectoryWriteContext is like WatchDirectoryWrite, but stops watching
+// when the context is done.
+func WatchDirectoryWriteContext(ctx context.Context, fullpath string, callback func(filename string)) {
+	watchPath(ctx, &watchContext{
 		filedir:         fullpath,
 		filename:        "",
 		callback:        callback,
@@ -55,10 +69,16 @@ func WatchDirectoryWrite(fullpath string, callback func(filename string)) {
 }
 
 func WatchFileWrite(fullpath string, callback func(filename string)) {
+	WatchFileWriteContext(context.Background(), fullpath, callback)
+}
+
+// WatchFileWriteContext is like WatchFileWrite, but stops watching when the
+// context is done.
+func WatchFileWriteContext(ctx context.Context, fullpath string, callback func(filename string)) {
 	filename := filepath.Base(fullpath)
 	filedir := filepath.Dir(fullpath)
 
-	watchPath(&watchContext{
+	watchPath(ctx, &watchContext{
 		filedir:         filedir,
 		filename:        filename,
 		callback:        callback,
@@ -66,7 +86,9 @@ func WatchFileWrite(fullpath string, callback func(filename string)) {
 	})
 }
 
-func watchPath(ctx *watchContext) {
+// watchPath blocks until the context is done, and then closes the watcher,
+// which also ends the goroutine that processes its events.
+func watchPath(ctx context.Context, wctx *watchContext) {
 	watcher, err := fsnotify.NewWatcher()
 
 	if err != nil {
@@ -80,36 +102,33 @@ func watchPath(ctx *watchContext) {
 		}
 	}()
 
-	done := make(chan bool)
-
 	go func() {
-		for {
-			processEvent(ctx, watcher)
+		for processEvent(wctx, watcher) {
 		}
 	}()
 
-	err = watcher.Add(ctx.filedir)
+	err = watcher.Add(wctx.filedir)
 
 	if err != nil {
 		log.Errorf("Could not create watcher: %v", err)
 	}
 
-	<-done
+	<-ctx.Done()
 }
 
-func processEvent(ctx *watchContext, watcher *fsnotify.Watcher) {
+// processEvent returns false once the watcher has been closed.
+func processEvent(ctx *watchContext, watcher *fsnotify.Watcher) bool {
 	select {
 	case event, ok := <-watcher.Events:
 		ctx.event = &event
 
-		if !consumeEvent(ok, ctx) {
-			return
+		return consumeEvent(ok, ctx)
+	case err, ok := <-watcher.Errors:
+		if ok {
+			log.Errorf("Error in fsnotify: %v", err)
 		}
 
-		break
-	case err := <-watcher.Errors:
-		log.Errorf("Error in fsnotify: %v", err)
-		return
+		return ok
 	}
 }
 
@@ -157,13 +176,18 @@ func processDebounce(ctx *watchContext) {
 	if logEntry.callbackComplete || logEntry.callbackWrapper == nil {
 		log.Debugf("fsnotify event callback queued within debounce delay: %v", ctx.filename)
 
+		// The event is read now, as the watcher replaces it with the next one.
+		eventName := ctx.event.Name
+
 		logEntry.callbackComplete = false
 		logEntry.callbackWrapper = time.AfterFunc(debounceDelay, func() {
 			log.Debugf("fsnotify event callback being fired: %v", ctx.filename)
 
-			ctx.callback(ctx.event.Name)
+			ctx.callback(eventName)
 
+			debounceWriteLogMutex.Lock()
 			logEntry.callbackComplete = true
+			debounceWriteLogMutex.Unlock()
 		})
 	} else {
 		log.Debugf("fsnotify event suppressed because it's within the debounce delay: %v", ctx.filename)
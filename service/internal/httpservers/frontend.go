@@ -15,6 +15,7 @@ import (
 	"path"
 	"strings"
 
+	"github.com/OliveTin/OliveTin/internal/agents"
 	"github.com/OliveTin/OliveTin/internal/api"
 	"github.com/OliveTin/OliveTin/internal/auth"
 	"github.com/OliveTin/OliveTin/internal/auth/otoauth2"
@@ -122,6 +123,14 @@ func StartFrontendMux(cfg *config.Config, ex *executor.Executor) {
 		apiHandler.ServeHTTP(w, r)
 	}))
 
+	if cfg.AgentsEnabled() {
+		agentPath, agentHandler := agents.GetNewHandler(ex)
+
+		log.Infof("Agent path is %s", agentPath)
+
+		mux.Handle(agentPath, agentHandler)
+	}
+
 	oauth2handler := otoauth2.NewOAuth2Handler(cfg)
 	auth.AddAuthChainFunction(oauth2handler.CheckUserFromOAuth2Cookie)
 	auth.RegisterOAuth2SessionRevoker(oauth2handler.RevokeSession)
@@ -13,8 +13,8 @@ import (
 const maxFilterLength = 512
 
 var (
-	comparePattern  = regexp.MustCompile(`(?i)\b(Status|Action|User|ExitCode|Blocked|TimedOut|Running)\s*(==|!=)\s*("[^"]*"|\S+)`)
-	containsPattern = regexp.MustCompile(`(?i)\b(Status|Action|User|Output)\s+contains\s+("[^"]*"|\S+)`)
+	comparePattern  = regexp.MustCompile(`(?i)\b(Status|Action|User|ExitCode|Blocked|TimedOut|Running|Result\.[\w.]+)\s*(==|!=)\s*("[^"]*"|\S+)`)
+	containsPattern = regexp.MustCompile(`(?i)\b(Status|Action|User|Output|Stdout|Stderr)\s+contains\s+("[^"]*"|\S+)`)
 
 	fieldNameByLower = map[string]string{
 		"status":   "Status",
@@ -25,6 +25,8 @@ var (
 		"timedout": "TimedOut",
 		"running":  "Running",
 		"output":   "Output",
+		"stdout":   "Stdout",
+		"stderr":   "Stderr",
 	}
 )
 
@@ -180,6 +182,12 @@ func replaceComparisons(expression string) string {
 }
 
 func normalizeFieldName(field string) string {
+	// Result keys come from action output, so only the Result prefix is
+	// normalized, and the keys are left as they are.
+	if len(field) > len("Result.") && strings.EqualFold(field[:len("Result.")], "Result.") {
+		return "Result." + field[len("Result."):]
+	}
+
 	if canonical, ok := fieldNameByLower[strings.ToLower(field)]; ok {
 		return canonical
 	}
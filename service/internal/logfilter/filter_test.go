@@ -32,6 +32,23 @@ func TestCompileContainsAndBooleanWords(t *testing.T) {
 	assert.False(t, mustMatch(t, program, Record{Status: "Blocked", Action: "Nightly backup"}))
 }
 
+func TestCompileStderrContains(t *testing.T) {
+	program, err := Compile(`Stderr contains "permission denied" and stdout contains done`)
+	require.NoError(t, err)
+
+	assert.True(t, mustMatch(t, program, Record{Stdout: "done\n", Stderr: "rm: Permission denied\n"}))
+	assert.False(t, mustMatch(t, program, Record{Stdout: "permission denied\n", Stderr: "done\n"}))
+}
+
+func TestCompileResultComparisons(t *testing.T) {
+	program, err := Compile(`result.version == 1.2.3 and Result.replicas != 0`)
+	require.NoError(t, err)
+
+	assert.True(t, mustMatch(t, program, Record{Result: map[string]any{"version": "1.2.3", "replicas": float64(3)}}))
+	assert.False(t, mustMatch(t, program, Record{Result: map[string]any{"version": "1.2.4", "replicas": float64(3)}}))
+	assert.False(t, mustMatch(t, program, Record{}), "Logs without a result do not match")
+}
+
 func TestCompileNormalizesFieldNamesAndValueTypes(t *testing.T) {
 	cases := []struct {
 		expression string
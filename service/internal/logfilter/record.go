@@ -11,6 +11,9 @@ type Record struct {
 	Running  bool
 	ExitCode int32
 	Output   string
+	Stdout   string
+	Stderr   string
+	Result   map[string]any
 }
 
 // StatusLabel matches the status text shown in the web UI.
@@ -0,0 +1,214 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+)
+
+// notificationTimeout is how long sending a single notification may take.
+const notificationTimeout = 30 * time.Second
+
+func (n *Notifier) send(channel *config.NotificationChannel, msg *notification) error {
+	ctx, cancel := context.WithTimeout(context.Background(), notificationTimeout)
+	defer cancel()
+
+	switch channel.Type {
+	case config.NotificationChannelEmail:
+		return sendEmail(ctx, &channel.Smtp, msg)
+	case config.NotificationChannelSlack:
+		return n.sendSlack(ctx, channel, msg)
+	case config.NotificationChannelNtfy:
+		return n.sendNtfy(ctx, channel, msg)
+	case config.NotificationChannelGotify:
+		return n.sendGotify(ctx, channel, msg)
+	default:
+		return fmt.Errorf("unknown notification channel type %q", channel.Type)
+	}
+}
+
+// sendSlack posts to an incoming webhook. Mattermost, Rocket.Chat and
+// Discord (with /slack on the end of the url) accept the same payload.
+func (n *Notifier) sendSlack(ctx context.Context, channel *config.NotificationChannel, msg *notification) error {
+	return n.postJson(ctx, channel.Url, map[string]any{
+		"text": "*" + msg.title + "*\n" + msg.message,
+	}, nil)
+}
+
+func (n *Notifier) sendNtfy(ctx context.Context, channel *config.NotificationChannel, msg *notification) error {
+	headers := map[string]string{
+		"Title": msg.title,
+	}
+
+	if channel.Priority > 0 {
+		headers["Priority"] = strconv.Itoa(channel.Priority)
+	}
+
+	if channel.Token != "" {
+		headers["Authorization"] = "Bearer " + channel.Token
+	}
+
+	return n.post(ctx, channel.Url, "text/plain; charset=utf-8", []byte(msg.message), headers)
+}
+
+func (n *Notifier) sendGotify(ctx context.Context, channel *config.NotificationChannel, msg *notification) error {
+	body := map[string]any{
+		"title":   msg.title,
+		"message": msg.message,
+	}
+
+	if channel.Priority > 0 {
+		body["priority"] = channel.Priority
+	}
+
+	return n.postJson(ctx, strings.TrimSuffix(channel.Url, "/")+"/message", body, map[string]string{
+		"X-Gotify-Key": channel.Token,
+	})
+}
+
+func (n *Notifier) postJson(ctx context.Context, url string, body any, headers map[string]string) error {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	return n.post(ctx, url, "application/json", encoded, headers)
+}
+
+func (n *Notifier) post(ctx context.Context, url string, contentType string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	_ = res.Body.Close()
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status: %v", res.Status)
+	}
+
+	return nil
+}
+
+// sendEmail does the same as smtp.SendMail, but with a timeout, as that has
+// none.
+func sendEmail(ctx context.Context, cfg *config.SmtpConfig, msg *notification) error {
+	client, err := dialSmtp(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = client.Close() }()
+
+	if err := startSmtpSession(client, cfg); err != nil {
+		return err
+	}
+
+	if err := writeEmail(client, cfg, msg); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func dialSmtp(ctx context.Context, cfg *config.SmtpConfig) (*smtp.Client, error) {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)))
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+	}
+
+	return client, err
+}
+
+func startSmtpSession(client *smtp.Client, cfg *config.SmtpConfig) error {
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: cfg.Host}); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Username == "" {
+		return nil
+	}
+
+	return client.Auth(smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host))
+}
+
+func writeEmail(client *smtp.Client, cfg *config.SmtpConfig, msg *notification) error {
+	if err := setEnvelope(client, cfg); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(formatEmail(cfg, msg)); err != nil {
+		return err
+	}
+
+	return w.Close()
+}
+
+func setEnvelope(client *smtp.Client, cfg *config.SmtpConfig) error {
+	if err := client.Mail(cfg.From); err != nil {
+		return err
+	}
+
+	for _, to := range cfg.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func formatEmail(cfg *config.SmtpConfig, msg *notification) []byte {
+	subject := strings.NewReplacer("\r", " ", "\n", " ").Replace(msg.title)
+
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %v\r\n", cfg.From)
+	fmt.Fprintf(&b, "To: %v\r\n", strings.Join(cfg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %v\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Date: %v\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(msg.message)
+
+	return b.Bytes()
+}
@@ -0,0 +1,195 @@
+package notifications
+
+/*
+Notifications are sent to channels, such as email or chat webhooks, when
+executions of actions with notify rules finish. The notifier is an executor
+listener, and the messages are rendered straight away, but sent in the
+background, so that a slow channel does not hold up the executor.
+*/
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/tpl"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTitle   = "{{ .ActionTitle }} - {{ .Event }}"
+	defaultMessage = "{{ .ActionTitle }} finished with exit code {{ .ExitCode }}.\n\n{{ .Output }}"
+
+	// maxMessageLength keeps messages within what chat services accept, as
+	// the output of an action can be very long.
+	maxMessageLength = 4000
+)
+
+// messageContext is what notification titles and messages are templated
+// with. The fields are named the same as on the log entry.
+type messageContext struct {
+	Event               string
+	ActionTitle         string
+	ExitCode            int32
+	Output              string
+	Stdout              string
+	Stderr              string
+	Username            string
+	ExecutionTrackingID string
+	Arguments           map[string]string
+	Result              map[string]any
+	Justification       string
+	DatetimeStarted     time.Time
+	DatetimeFinished    time.Time
+}
+
+type notification struct {
+	title   string
+	message string
+}
+
+// Notifier is an executor listener that sends notifications when executions
+// finish.
+type Notifier struct {
+	cfg    *config.Config
+	client *http.Client
+
+	// sending is used to wait for notifications that are being sent.
+	sending sync.WaitGroup
+}
+
+func NewNotifier(cfg *config.Config) *Notifier {
+	return &Notifier{
+		cfg: cfg,
+		client: &http.Client{
+			Timeout: notificationTimeout,
+		},
+	}
+}
+
+func RegisterExecutorListener(ex *executor.Executor) {
+	ex.AddListener(NewNotifier(ex.Cfg))
+}
+
+func (n *Notifier) OnExecutionFinished(entry *executor.InternalLogEntry) {
+	if entry.Binding == nil || entry.Binding.Action == nil {
+		return
+	}
+
+	event := eventOf(entry)
+
+	for _, rule := range entry.Binding.Action.Notify {
+		if slices.Contains(rule.On, event) {
+			n.notify(rule, newMessageContext(event, entry))
+		}
+	}
+}
+
+func eventOf(entry *executor.InternalLogEntry) string {
+	switch {
+	case entry.Blocked:
+		return config.NotifyOnBlocked
+	case entry.TimedOut:
+		return config.NotifyOnTimeout
+	case entry.ExitCode == 0:
+		return config.NotifyOnSuccess
+	default:
+		return config.NotifyOnFailure
+	}
+}
+
+func newMessageContext(event string, entry *executor.InternalLogEntry) *messageContext {
+	return &messageContext{
+		Event:               event,
+		ActionTitle:         entry.ActionTitle,
+		ExitCode:            entry.ExitCode,
+		Output:              entry.Output,
+		Stdout:              entry.Stdout,
+		Stderr:              entry.Stderr,
+		Username:            entry.Username,
+		ExecutionTrackingID: entry.ExecutionTrackingID,
+		Arguments:           entry.Arguments,
+		Result:              entry.Result,
+		Justification:       entry.Justification,
+		DatetimeStarted:     entry.DatetimeStarted,
+		DatetimeFinished:    entry.DatetimeFinished,
+	}
+}
+
+func (n *Notifier) notify(rule config.NotificationRule, msgCtx *messageContext) {
+	logFields := log.Fields{
+		"actionTitle": msgCtx.ActionTitle,
+		"channel":     rule.Channel,
+		"event":       msgCtx.Event,
+	}
+
+	channel, found := n.cfg.Notifications.Channels[rule.Channel]
+
+	if !found {
+		log.WithFields(logFields).Warnf("Could not send notification to unknown channel")
+		return
+	}
+
+	msg, err := render(rule, msgCtx)
+
+	if err != nil {
+		log.WithFields(logFields).WithError(err).Warnf("Could not render notification")
+		return
+	}
+
+	n.sending.Add(1)
+
+	go func() {
+		defer n.sending.Done()
+
+		if err := n.send(channel, msg); err != nil {
+			log.WithFields(logFields).WithError(err).Warnf("Could not send notification")
+			return
+		}
+
+		log.WithFields(logFields).Debugf("Sent notification")
+	}()
+}
+
+func render(rule config.NotificationRule, msgCtx *messageContext) (*notification, error) {
+	title, err := tpl.ParseTemplateWithData(orDefault(rule.Title, defaultTitle), msgCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := tpl.ParseTemplateWithData(orDefault(rule.Message, defaultMessage), msgCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(message) > maxMessageLength {
+		message = strings.ToValidUTF8(message[:maxMessageLength], "") + "..."
+	}
+
+	return &notification{
+		title:   title,
+		message: message,
+	}, nil
+}
+
+func orDefault(value string, def string) string {
+	if value == "" {
+		return def
+	}
+
+	return value
+}
+
+func (n *Notifier) OnExecutionStarted(_ *executor.InternalLogEntry) {}
+
+func (n *Notifier) OnOutputChunk(_ executor.OutputChunk, _ string) {}
+
+func (n *Notifier) OnActionMapRebuilt() {}
+
+func (n *Notifier) OnApprovalRequested(_ *executor.PendingApproval) {}
+
+func (n *Notifier) OnApprovalResolved(_ *executor.InternalLogEntry, _ bool) {}
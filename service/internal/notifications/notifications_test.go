@@ -0,0 +1,222 @@
+package notifications
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+type receivedRequest struct {
+	path    string
+	headers http.Header
+	body    string
+}
+
+type fakeReceiver struct {
+	mu       sync.Mutex
+	requests []receivedRequest
+}
+
+func (f *fakeReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	f.requests = append(f.requests, receivedRequest{r.URL.Path, r.Header, string(body)})
+	f.mu.Unlock()
+}
+
+func runAction(t *testing.T, cfg *config.Config, action *config.Action) *Notifier {
+	cfg.Actions = append(cfg.Actions, action)
+	cfg.Sanitize()
+
+	e := executor.DefaultExecutor(cfg)
+	e.RebuildActionMap()
+
+	notifier := NewNotifier(cfg)
+	e.AddListener(notifier)
+
+	wg, _ := e.ExecRequest(&executor.ExecutionRequest{
+		Binding: e.FindBindingWithNoEntity(action),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	notifier.sending.Wait()
+
+	return notifier
+}
+
+func TestNotifiesChannelsOnMatchingEvents(t *testing.T) {
+	receiver := &fakeReceiver{}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.Notifications.Channels = map[string]*config.NotificationChannel{
+		"chat":  {Type: "Slack", Url: srv.URL + "/slack"},
+		"phone": {Type: "ntfy", Url: srv.URL + "/ntfy/backups", Token: "tk", Priority: 4},
+		"push":  {Type: "gotify", Url: srv.URL + "/gotify/", Token: "app-token"},
+	}
+
+	runAction(t, cfg, &config.Action{
+		Title: "Backup",
+		Shell: "echo disk full; exit 2",
+		Notify: []config.NotificationRule{
+			{Channel: "chat", On: []string{"failure"}, Message: "{{ .ActionTitle }} failed ({{ .ExitCode }}): {{ .Stdout }}"},
+			{Channel: "phone", On: []string{"Failure", "timeout"}},
+			{Channel: "push"},
+			{Channel: "chat", On: []string{"success"}, Message: "should not be sent"},
+		},
+	})
+
+	require.Len(t, receiver.requests, 3)
+
+	byPath := make(map[string]receivedRequest)
+
+	for _, req := range receiver.requests {
+		byPath[req.path] = req
+	}
+
+	slack := map[string]string{}
+	require.NoError(t, json.Unmarshal([]byte(byPath["/slack"].body), &slack))
+	assert.Equal(t, "*Backup - failure*\nBackup failed (2): disk full\n", slack["text"])
+
+	ntfy := byPath["/ntfy/backups"]
+	assert.Equal(t, "Backup - failure", ntfy.headers.Get("Title"))
+	assert.Equal(t, "4", ntfy.headers.Get("Priority"))
+	assert.Equal(t, "Bearer tk", ntfy.headers.Get("Authorization"))
+	assert.True(t, strings.HasPrefix(ntfy.body, "Backup finished with exit code 2.\n\n"), ntfy.body)
+	assert.Contains(t, ntfy.body, "disk full\n")
+
+	gotify := byPath["/gotify/message"]
+	assert.Equal(t, "app-token", gotify.headers.Get("X-Gotify-Key"))
+	assert.Contains(t, gotify.body, `"title":"Backup - failure"`)
+}
+
+func TestEventOf(t *testing.T) {
+	assert.Equal(t, config.NotifyOnSuccess, eventOf(&executor.InternalLogEntry{}))
+	assert.Equal(t, config.NotifyOnFailure, eventOf(&executor.InternalLogEntry{ExitCode: -1}))
+	assert.Equal(t, config.NotifyOnTimeout, eventOf(&executor.InternalLogEntry{ExitCode: -1, TimedOut: true}))
+	assert.Equal(t, config.NotifyOnBlocked, eventOf(&executor.InternalLogEntry{Blocked: true}))
+}
+
+// fakeSmtpServer accepts a single message, without TLS or authentication.
+func fakeSmtpServer(t *testing.T) (string, int, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	messages := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		defer func() { _ = conn.Close() }()
+
+		messages <- serveSmtp(conn)
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+
+	return addr.IP.String(), addr.Port, messages
+}
+
+func serveSmtp(conn net.Conn) string {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost")
+
+	var transcript strings.Builder
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return transcript.String()
+		}
+
+		transcript.WriteString(line)
+
+		if done := handleSmtpCommand(line, r, reply, &transcript); done {
+			return transcript.String()
+		}
+	}
+}
+
+func handleSmtpCommand(line string, r *bufio.Reader, reply func(string), transcript *strings.Builder) bool {
+	switch strings.ToUpper(strings.Fields(line + " x")[0]) {
+	case "EHLO":
+		reply("250 localhost")
+	case "DATA":
+		reply("354 go ahead")
+		transcript.WriteString(readSmtpData(r))
+		reply("250 queued")
+	case "QUIT":
+		reply("221 bye")
+		return true
+	default:
+		reply("250 ok")
+	}
+
+	return false
+}
+
+func readSmtpData(r *bufio.Reader) string {
+	var data strings.Builder
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil || line == ".\r\n" {
+			return data.String()
+		}
+
+		data.WriteString(line)
+	}
+}
+
+func TestEmailChannel(t *testing.T) {
+	host, port, messages := fakeSmtpServer(t)
+
+	cfg := config.DefaultConfig()
+	cfg.Notifications.Channels = map[string]*config.NotificationChannel{
+		"ops": {
+			Type: "email",
+			Smtp: config.SmtpConfig{
+				Host: host,
+				Port: port,
+				From: "olivetin@example.com",
+				To:   []string{"ops@example.com", "oncall@example.com"},
+			},
+		},
+	}
+
+	runAction(t, cfg, &config.Action{
+		Title:  "Deploy",
+		Shell:  "echo deployed",
+		Notify: []config.NotificationRule{{Channel: "ops", Title: "{{ .ActionTitle }} ok"}},
+	})
+
+	transcript := <-messages
+
+	assert.Contains(t, transcript, "MAIL FROM:<olivetin@example.com>")
+	assert.Contains(t, transcript, "RCPT TO:<oncall@example.com>")
+	assert.Contains(t, transcript, "To: ops@example.com, oncall@example.com\r\n")
+	assert.Contains(t, transcript, "Subject: Deploy ok\r\n")
+	assert.Contains(t, transcript, "\r\n\r\nDeploy finished with exit code 0.\r\n\r\ndeployed\r\n")
+}
@@ -28,20 +28,115 @@ var (
 	scheduleMapMutex sync.RWMutex
 )
 
+// calendarWatch is a running watcher of the calendar file of an action.
+type calendarWatch struct {
+	cancel context.CancelFunc
+	action *config.Action
+}
+
+var (
+	watches      = make(map[string]*calendarWatch)
+	watchesMutex sync.Mutex
+)
+
+// Schedule watches the calendar files of the config, and reconciles the
+// watchers with the config whenever it is reloaded.
 func Schedule(cfg *config.Config, ex *executor.Executor) {
-	for _, action := range cfg.Actions {
-		captured := action
+	reconcile(cfg, ex)
+
+	config.AddListener(func() {
+		reconcile(cfg, ex)
+	})
+}
+
+func watchKey(action *config.Action) string {
+	return action.ID + "\n" + action.ExecOnCalendarFile
+}
+
+func desiredWatches(cfg *config.Config) map[string]*config.Action {
+	ret := make(map[string]*config.Action)
 
+	for _, action := range cfg.Actions {
 		if action.ExecOnCalendarFile != "" {
-			x := func(filename string) {
-				parseCalendarFile(captured, cfg, ex, filename)
-			}
+			ret[watchKey(action)] = action
+		}
+	}
 
-			go filehelper.WatchFileWrite(action.ExecOnCalendarFile, x)
+	return ret
+}
+
+// reconcile stops the watchers that are no longer in the config before
+// starting new ones, as an action that changes its calendar file keeps its
+// timers under the same action ID.
+func reconcile(cfg *config.Config, ex *executor.Executor) {
+	desired := desiredWatches(cfg)
+
+	watchesMutex.Lock()
+	defer watchesMutex.Unlock()
 
-			x(action.ExecOnCalendarFile)
+	removed := 0
+
+	for key, w := range watches {
+		if _, ok := desired[key]; !ok {
+			stopWatch(key, w)
+			removed++
 		}
 	}
+
+	added := 0
+
+	for key, action := range desired {
+		if w, ok := watches[key]; ok {
+			w.action = action
+		} else {
+			startWatch(cfg, ex, key, action)
+			added++
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"added":   added,
+		"removed": removed,
+		"total":   len(watches),
+	}).Infof("Reconciled calendar file watchers with config")
+
+	parseWatchedCalendarFilesLocked(cfg, ex)
+}
+
+// parseWatchedCalendarFilesLocked parses kept actions again as well, so that
+// their timers execute the action from the reloaded config.
+func parseWatchedCalendarFilesLocked(cfg *config.Config, ex *executor.Executor) {
+	for _, w := range watches {
+		parseCalendarFile(w.action, cfg, ex, w.action.ExecOnCalendarFile)
+	}
+}
+
+func startWatch(cfg *config.Config, ex *executor.Executor, key string, action *config.Action) {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &calendarWatch{cancel: cancel, action: action}
+	watches[key] = w
+
+	go filehelper.WatchFileWriteContext(ctx, action.ExecOnCalendarFile, func(filename string) {
+		parseCalendarFile(w.currentAction(), cfg, ex, filename)
+	})
+}
+
+func stopWatch(key string, w *calendarWatch) {
+	log.WithFields(log.Fields{
+		"actionTitle": w.action.Title,
+		"filename":    w.action.ExecOnCalendarFile,
+	}).Infof("No longer watching calendar file")
+
+	w.cancel()
+	clearExistingTimers(w.action)
+	delete(watches, key)
+}
+
+func (w *calendarWatch) currentAction() *config.Action {
+	watchesMutex.Lock()
+	defer watchesMutex.Unlock()
+
+	return w.action
 }
 
 func clearExistingTimers(action *config.Action) {
@@ -0,0 +1,52 @@
+package oncalendarfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func timerCount(action *config.Action) int {
+	scheduleMapMutex.RLock()
+	defer scheduleMapMutex.RUnlock()
+
+	return len(scheduleMap[action.ID].timers)
+}
+
+func TestCalendarWatchersAreReconciledWithConfig(t *testing.T) {
+	calendar := filepath.Join(t.TempDir(), "calendar.yaml")
+	instant := time.Now().Add(time.Hour).Format(time.RFC3339)
+	require.NoError(t, os.WriteFile(calendar, []byte("- "+instant+"\n"), 0600))
+
+	action := &config.Action{
+		Title:              "Start server",
+		Exec:               []string{"true"},
+		ExecOnCalendarFile: calendar,
+	}
+
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, action)
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	reconcile(cfg, ex)
+
+	require.Eventually(t, func() bool {
+		return timerCount(action) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cfg.Actions = cfg.Actions[:len(cfg.Actions)-1]
+	reconcile(cfg, ex)
+
+	assert.Empty(t, watches)
+	assert.Equal(t, 0, timerCount(action))
+}
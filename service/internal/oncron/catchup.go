@@ -0,0 +1,114 @@
+package oncron
+
+import (
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/robfig/cron/v3"
+	log "github.com/sirupsen/logrus"
+)
+
+const cronUsername = "cron"
+
+// catchUp runs the actions with cronCatchUp set, for the cron lines that were
+// missed while OliveTin was not running. It only runs at startup, going by
+// the last execution of the action by cron in the history. The history moves
+// stored entries on to the new binding of the action when it is opened, so
+// they are found by binding even when the action has no id.
+func (s *Scheduler) catchUp() {
+	for _, action := range s.cfg.Actions {
+		if action.CronCatchUp > 0 && len(action.ExecOnCron) > 0 {
+			go s.catchUpAction(action, time.Now())
+		}
+	}
+}
+
+func (s *Scheduler) catchUpAction(action *config.Action, now time.Time) {
+	binding := s.ex.FindBindingWithNoEntity(action)
+
+	if binding == nil {
+		return
+	}
+
+	last := lastCronRun(s.ex, binding)
+
+	if last.IsZero() {
+		log.WithFields(log.Fields{
+			"action": action.Title,
+		}).Infof("Not catching up cron, as there is no earlier run by cron in the history")
+
+		return
+	}
+
+	missed := countMissedRuns(s.cfg, action, last, now)
+
+	if missed == 0 {
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"action":  action.Title,
+		"lastRun": last,
+		"runs":    missed,
+	}).Infof("Catching up missed cron runs")
+
+	for range missed {
+		s.runCatchUp(binding)
+	}
+}
+
+// runCatchUp waits for each run to finish, so that catching up several runs
+// is not blocked by the concurrency limit of the action.
+func (s *Scheduler) runCatchUp(binding *executor.ActionBinding) {
+	wg, _ := s.ex.ExecRequest(&executor.ExecutionRequest{
+		Binding:           binding,
+		Cfg:               s.cfg,
+		Tags:              []string{"catch-up"},
+		AuthenticatedUser: auth.UserFromSystem(s.cfg, cronUsername),
+	})
+
+	wg.Wait()
+}
+
+func lastCronRun(ex *executor.Executor, binding *executor.ActionBinding) time.Time {
+	var last time.Time
+
+	for _, entry := range ex.GetLogsByBindingId(binding.ID) {
+		if entry.Username == cronUsername && entry.DatetimeStarted.After(last) {
+			last = entry.DatetimeStarted
+		}
+	}
+
+	return last
+}
+
+// countMissedRuns counts the runs of all the cron lines of the action
+// between the last run and now, up to cronCatchUp.
+func countMissedRuns(cfg *config.Config, action *config.Action, last time.Time, now time.Time) int {
+	parser := newParser(cfg)
+	missed := 0
+
+	for _, line := range action.ExecOnCron {
+		schedule, err := parser.Parse(cronLine(action, line))
+
+		if err != nil {
+			continue
+		}
+
+		missed += countRunsBetween(schedule, last, now, action.CronCatchUp-missed)
+	}
+
+	return missed
+}
+
+func countRunsBetween(schedule cron.Schedule, from time.Time, until time.Time, limit int) int {
+	count := 0
+
+	for next := schedule.Next(from); !next.IsZero() && next.Before(until) && count < limit; next = schedule.Next(next) {
+		count++
+	}
+
+	return count
+}
@@ -0,0 +1,135 @@
+package oncron
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func TestCronLinesUseTheTimezoneOfTheAction(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions,
+		&config.Action{Title: "Tokyo", Exec: []string{"true"}, ExecOnCron: []string{"0 9 * * *"}, CronTimezone: "Asia/Tokyo"},
+		&config.Action{Title: "Explicit", Exec: []string{"true"}, ExecOnCron: []string{"TZ=UTC 0 9 * * *"}, CronTimezone: "Asia/Tokyo"},
+	)
+	cfg.Sanitize()
+
+	s := newScheduler(cfg, executor.DefaultExecutor(cfg))
+	s.Rebuild()
+	defer s.cron.Stop()
+
+	jobs := s.List()
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "CRON_TZ=Asia/Tokyo 0 9 * * *", jobs[0].CronLine)
+	assert.Equal(t, "TZ=UTC 0 9 * * *", jobs[1].CronLine)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	assert.Equal(t, 9, jobs[0].NextRun.In(tokyo).Hour())
+	assert.Equal(t, 9, jobs[1].NextRun.UTC().Hour())
+}
+
+func TestCountMissedRunsIsLimitedByCatchUp(t *testing.T) {
+	cfg := config.DefaultConfig()
+	action := &config.Action{ExecOnCron: []string{"0 * * * *"}, CronCatchUp: 3}
+
+	last := time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC)
+	now := time.Date(2026, 1, 1, 5, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, 3, countMissedRuns(cfg, action, last, now))
+
+	action.CronCatchUp = 10
+	assert.Equal(t, 5, countMissedRuns(cfg, action, last, now))
+	assert.Equal(t, 0, countMissedRuns(cfg, action, now, now))
+}
+
+func catchUpTestingConfig(database string) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.LogHistoryDatabase = database
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:       "Backup",
+		Exec:        []string{"true"},
+		ExecOnCron:  []string{"0 * * * *"},
+		CronCatchUp: 2,
+	})
+	cfg.Sanitize()
+
+	return cfg
+}
+
+func countCaughtUp(ex *executor.Executor, binding *executor.ActionBinding) int {
+	caughtUp := 0
+
+	for _, entry := range ex.GetLogsByBindingId(binding.ID) {
+		if len(entry.Tags) > 0 && entry.Tags[0] == "catch-up" {
+			caughtUp++
+		}
+	}
+
+	return caughtUp
+}
+
+func TestMissedRunsAreCaughtUpAfterRestart(t *testing.T) {
+	database := filepath.Join(t.TempDir(), "history.db")
+
+	cfg := catchUpTestingConfig(database)
+	before := executor.DefaultExecutor(cfg)
+	before.RebuildActionMap()
+	require.NoError(t, before.OpenHistoryStore())
+
+	execOnCronBefore(before, cfg, time.Now().Add(-5*time.Hour))
+
+	// The config is loaded again on restart, which gives the action a new
+	// binding ID, as it has no id.
+	cfg = catchUpTestingConfig(database)
+	after := executor.DefaultExecutor(cfg)
+	after.RebuildActionMap()
+	require.NoError(t, after.OpenHistoryStore())
+
+	binding := after.FindBindingWithNoEntity(cfg.Actions[len(cfg.Actions)-1])
+
+	s := newScheduler(cfg, after)
+	s.catchUpAction(cfg.Actions[len(cfg.Actions)-1], time.Now())
+
+	assert.Equal(t, 2, countCaughtUp(after, binding))
+}
+
+func execOnCronBefore(ex *executor.Executor, cfg *config.Config, started time.Time) {
+	ex.SetLog("", &executor.InternalLogEntry{
+		Binding:           ex.FindBindingWithNoEntity(cfg.Actions[len(cfg.Actions)-1]),
+		ActionTitle:       "Backup",
+		ActionConfigTitle: "Backup",
+		Username:          cronUsername,
+		DatetimeStarted:   started,
+		ExecutionStarted:  true,
+		ExecutionFinished: true,
+	})
+}
+
+func TestMissedRunsAreCaughtUp(t *testing.T) {
+	cfg := catchUpTestingConfig("")
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	binding := ex.FindBindingWithNoEntity(cfg.Actions[len(cfg.Actions)-1])
+	ex.SetLog("", &executor.InternalLogEntry{
+		Binding:           binding,
+		ActionTitle:       "Backup",
+		Username:          cronUsername,
+		DatetimeStarted:   time.Now().Add(-5 * time.Hour),
+		ExecutionStarted:  true,
+		ExecutionFinished: true,
+	})
+
+	s := newScheduler(cfg, ex)
+	s.catchUpAction(cfg.Actions[len(cfg.Actions)-1], time.Now())
+
+	assert.Equal(t, 2, countCaughtUp(ex, binding))
+}
@@ -1,6 +1,13 @@
 package oncron
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/OliveTin/OliveTin/internal/auth"
 	"github.com/OliveTin/OliveTin/internal/config"
 	"github.com/OliveTin/OliveTin/internal/executor"
@@ -8,47 +15,362 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// ErrNotFound is returned when pausing or resuming a job that does not exist.
+var ErrNotFound = errors.New("cron schedule not found")
+
+// Job is a copy of the state of one cron line of an action.
+type Job struct {
+	ID             string
+	Action         *config.Action
+	CronLine       string
+	Paused         bool
+	Error          string
+	NextRun        time.Time
+	PreviousRun    time.Time
+	LastTrackingID string
+}
+
+type job struct {
+	id       string
+	action   *config.Action
+	cronline string
+	entryID  cron.EntryID
+	err      error
+}
+
+type lastRun struct {
+	datetime   time.Time
+	trackingID string
+}
+
+// Scheduler runs the actions of an executor on their cron lines. Paused jobs
+// and the last runs are kept by job ID, so they survive config reloads.
+type Scheduler struct {
+	cfg *config.Config
+	ex  *executor.Executor
+
+	mu          sync.Mutex
+	cron        *cron.Cron
+	withSeconds bool
+	jobs        []*job
+	paused      map[string]bool
+	lastRuns    map[string]lastRun
+}
+
+var (
+	schedulers   = make(map[*executor.Executor]*Scheduler)
+	schedulersMu sync.Mutex
+)
+
+// Schedule starts the cron jobs of the config, and rebuilds them whenever
+// the config is reloaded.
 func Schedule(cfg *config.Config, ex *executor.Executor) {
-	var scheduler *cron.Cron
+	s := newScheduler(cfg, ex)
+
+	schedulersMu.Lock()
+	schedulers[ex] = s
+	schedulersMu.Unlock()
 
+	s.Rebuild()
+	s.catchUp()
+
+	config.AddListener(s.Rebuild)
+}
+
+// Get returns the scheduler of the executor, or nil if cron jobs were not
+// scheduled.
+func Get(ex *executor.Executor) *Scheduler {
+	schedulersMu.Lock()
+	defer schedulersMu.Unlock()
+
+	return schedulers[ex]
+}
+
+func newScheduler(cfg *config.Config, ex *executor.Executor) *Scheduler {
+	return &Scheduler{
+		cfg:      cfg,
+		ex:       ex,
+		paused:   make(map[string]bool),
+		lastRuns: make(map[string]lastRun),
+	}
+}
+
+func newParser(cfg *config.Config) cron.Parser {
 	if cfg.CronSupportForSeconds {
-		scheduler = cron.New(cron.WithSeconds())
-	} else {
-		scheduler = cron.New()
+		return cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	}
+
+	return cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+}
+
+func newCron(cfg *config.Config) *cron.Cron {
+	return cron.New(cron.WithParser(newParser(cfg)))
+}
+
+// cronLine adds the timezone of the action to the cron line, unless the line
+// already sets one.
+func cronLine(action *config.Action, cronline string) string {
+	if action.CronTimezone == "" || strings.HasPrefix(cronline, "CRON_TZ=") || strings.HasPrefix(cronline, "TZ=") {
+		return cronline
+	}
+
+	return "CRON_TZ=" + action.CronTimezone + " " + cronline
+}
+
+func jobID(action *config.Action, cronline string) string {
+	sum := sha256.Sum256([]byte(action.ID + "\n" + cronline))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// Rebuild reconciles the cron jobs with the current config. Jobs that are
+// still in the config are kept, so that their next run is not affected.
+func (s *Scheduler) Rebuild() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cron == nil || s.withSeconds != s.cfg.CronSupportForSeconds {
+		s.restartCronLocked()
+	}
+
+	existing := make(map[string]*job, len(s.jobs))
+
+	for _, j := range s.jobs {
+		existing[j.id] = j
+	}
+
+	jobs, added := s.reconcileJobsLocked(existing)
+
+	for _, j := range existing {
+		s.removeJobLocked(j)
 	}
 
-	for _, action := range cfg.Actions {
+	s.jobs = jobs
+
+	log.WithFields(log.Fields{
+		"added":   added,
+		"removed": len(existing),
+		"total":   len(jobs),
+	}).Infof("Reconciled cron jobs with config")
+}
+
+// reconcileJobsLocked returns the jobs of the config, and how many of them
+// were added. The jobs left in existing are no longer in the config.
+func (s *Scheduler) reconcileJobsLocked(existing map[string]*job) ([]*job, int) {
+	jobs := make([]*job, 0, len(existing))
+	added := 0
+
+	for _, action := range s.cfg.Actions {
 		for _, cronline := range action.ExecOnCron {
-			scheduleAction(cfg, scheduler, cronline, ex, action)
+			j, kept := s.reconcileJobLocked(existing, action, cronLine(action, cronline))
+			jobs = append(jobs, j)
+
+			if !kept {
+				added++
+			}
 		}
 	}
 
-	scheduler.Start()
+	return jobs, added
+}
+
+// restartCronLocked starts again with no jobs, as the parser of cron cannot
+// be changed while it is running.
+func (s *Scheduler) restartCronLocked() {
+	if s.cron != nil {
+		s.cron.Stop()
+	}
+
+	s.withSeconds = s.cfg.CronSupportForSeconds
+	s.cron = newCron(s.cfg)
+	s.jobs = nil
+	s.cron.Start()
 }
 
-func scheduleAction(cfg *config.Config, scheduler *cron.Cron, cronline string, ex *executor.Executor, action *config.Action) {
+// reconcileJobLocked removes the job from existing when it is kept.
+func (s *Scheduler) reconcileJobLocked(existing map[string]*job, action *config.Action, cronline string) (*job, bool) {
+	id := jobID(action, cronline)
+
+	if j, ok := existing[id]; ok {
+		delete(existing, id)
+		j.action = action
+
+		return j, true
+	}
+
+	j := &job{
+		id:       id,
+		action:   action,
+		cronline: cronline,
+	}
+
+	if s.paused[j.id] {
+		log.WithFields(log.Fields{
+			"action":   action.Title,
+			"cronline": cronline,
+		}).Infof("Not scheduling paused Action for cron")
+	} else {
+		s.startJobLocked(j)
+	}
+
+	return j, false
+}
+
+func (s *Scheduler) removeJobLocked(j *job) {
 	log.WithFields(log.Fields{
-		"action":   action.Title,
-		"cronline": cronline,
-	}).Infof("Scheduling Action for cron")
+		"action":   j.action.Title,
+		"cronline": j.cronline,
+	}).Infof("Removing Action from cron")
 
-	_, err := scheduler.AddFunc(cronline, func() {
-		req := &executor.ExecutionRequest{
-			Binding:           ex.FindBindingWithNoEntity(action),
-			Cfg:               cfg,
-			Tags:              []string{},
-			AuthenticatedUser: auth.UserFromSystem(cfg, "cron"),
-		}
+	s.cron.Remove(j.entryID)
+}
+
+func (s *Scheduler) startJobLocked(j *job) {
+	log.WithFields(log.Fields{
+		"action":   j.action.Title,
+		"cronline": j.cronline,
+	}).Infof("Scheduling Action for cron")
 
-		ex.ExecRequest(req)
+	j.entryID, j.err = s.cron.AddFunc(j.cronline, func() {
+		s.run(j)
 	})
 
-	if err != nil {
+	if j.err != nil {
 		log.WithFields(log.Fields{
-			"action":    action.Title,
-			"cronError": err,
+			"action":    j.action.Title,
+			"cronError": j.err,
 		}).Errorf("CRON schedule error")
-		return
+	}
+}
+
+func (s *Scheduler) run(j *job) {
+	s.mu.Lock()
+	action := j.action
+	s.mu.Unlock()
+
+	req := &executor.ExecutionRequest{
+		Binding:           s.ex.FindBindingWithNoEntity(action),
+		Cfg:               s.cfg,
+		Tags:              []string{},
+		AuthenticatedUser: auth.UserFromSystem(s.cfg, cronUsername),
+	}
+
+	_, trackingID := s.ex.ExecRequest(req)
+
+	s.mu.Lock()
+	s.lastRuns[j.id] = lastRun{
+		datetime:   time.Now(),
+		trackingID: trackingID,
+	}
+	s.mu.Unlock()
+}
+
+// List returns the jobs in the order of the config.
+func (s *Scheduler) List() []*Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := make([]*Job, 0, len(s.jobs))
+
+	for _, j := range s.jobs {
+		ret = append(ret, s.snapshotLocked(j))
+	}
+
+	return ret
+}
+
+// Find returns the job with the ID, or nil.
+func (s *Scheduler) Find(id string) *Job {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if j := s.findLocked(id); j != nil {
+		return s.snapshotLocked(j)
+	}
+
+	return nil
+}
+
+func (s *Scheduler) findLocked(id string) *job {
+	for _, j := range s.jobs {
+		if j.id == id {
+			return j
+		}
+	}
+
+	return nil
+}
+
+func (s *Scheduler) snapshotLocked(j *job) *Job {
+	ret := &Job{
+		ID:             j.id,
+		Action:         j.action,
+		CronLine:       j.cronline,
+		Paused:         s.paused[j.id],
+		PreviousRun:    s.lastRuns[j.id].datetime,
+		LastTrackingID: s.lastRuns[j.id].trackingID,
+	}
+
+	if j.err != nil {
+		ret.Error = j.err.Error()
+	} else if !ret.Paused {
+		ret.NextRun = s.nextRunLocked(j)
+	}
+
+	return ret
+}
+
+// nextRunLocked uses the schedule of the entry, because cron only sets the
+// next run of an entry some time after it is added.
+func (s *Scheduler) nextRunLocked(j *job) time.Time {
+	entry := s.cron.Entry(j.entryID)
+
+	if entry.Schedule == nil {
+		return time.Time{}
+	}
+
+	return entry.Schedule.Next(time.Now())
+}
+
+// Pause stops the job from running until it is resumed.
+func (s *Scheduler) Pause(id string) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j := s.findLocked(id)
+
+	if j == nil {
+		return nil, ErrNotFound
+	}
+
+	if !s.paused[id] {
+		log.WithFields(log.Fields{
+			"action":   j.action.Title,
+			"cronline": j.cronline,
+		}).Infof("Pausing cron schedule")
+
+		s.paused[id] = true
+		s.cron.Remove(j.entryID)
+	}
+
+	return s.snapshotLocked(j), nil
+}
+
+// Resume runs a paused job on its cron line again.
+func (s *Scheduler) Resume(id string) (*Job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j := s.findLocked(id)
+
+	if j == nil {
+		return nil, ErrNotFound
+	}
+
+	if s.paused[id] {
+		delete(s.paused, id)
+		s.startJobLocked(j)
 	}
 
+	return s.snapshotLocked(j), nil
 }
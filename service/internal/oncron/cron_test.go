@@ -0,0 +1,94 @@
+package oncron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func newTestScheduler(t *testing.T, cronlines ...string) *Scheduler {
+	cfg := config.DefaultConfig()
+	cfg.CronSupportForSeconds = true
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:      "Tick",
+		Exec:       []string{"true"},
+		ExecOnCron: cronlines,
+	})
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	s := newScheduler(cfg, ex)
+	s.Rebuild()
+
+	t.Cleanup(func() {
+		s.cron.Stop()
+	})
+
+	return s
+}
+
+func TestJobsReportNextAndPreviousRuns(t *testing.T) {
+	s := newTestScheduler(t, "* * * * * *", "not a cron line")
+
+	jobs := s.List()
+	require.Len(t, jobs, 2)
+	assert.False(t, jobs[0].NextRun.IsZero())
+	assert.Empty(t, jobs[0].Error)
+	assert.NotEmpty(t, jobs[1].Error)
+	assert.True(t, jobs[1].NextRun.IsZero())
+
+	require.Eventually(t, func() bool {
+		return s.Find(jobs[0].ID).LastTrackingID != ""
+	}, 3*time.Second, 20*time.Millisecond)
+
+	assert.False(t, s.Find(jobs[0].ID).PreviousRun.IsZero())
+}
+
+func TestPausedJobsStayPausedAfterRebuild(t *testing.T) {
+	s := newTestScheduler(t, "0 0 0 1 1 *")
+	id := s.List()[0].ID
+
+	job, err := s.Pause(id)
+	require.NoError(t, err)
+	assert.True(t, job.Paused)
+	assert.True(t, job.NextRun.IsZero())
+
+	s.Rebuild()
+
+	job = s.Find(id)
+	require.NotNil(t, job)
+	assert.True(t, job.Paused)
+	assert.Empty(t, s.cron.Entries())
+
+	job, err = s.Resume(id)
+	require.NoError(t, err)
+	assert.False(t, job.Paused)
+	assert.False(t, job.NextRun.IsZero())
+
+	_, err = s.Pause("missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRebuildKeepsUnchangedJobs(t *testing.T) {
+	s := newTestScheduler(t, "0 0 0 1 1 *", "0 0 0 2 1 *")
+	jobs := s.List()
+	kept := s.jobs[0]
+
+	action := s.cfg.Actions[len(s.cfg.Actions)-1]
+	action.ExecOnCron = []string{"0 0 0 1 1 *", "0 0 0 3 1 *"}
+	s.Rebuild()
+
+	after := s.List()
+	require.Len(t, after, 2)
+	assert.Equal(t, jobs[0].ID, after[0].ID)
+	assert.Same(t, kept, s.jobs[0])
+	assert.NotEqual(t, jobs[1].ID, after[1].ID)
+	assert.Len(t, s.cron.Entries(), 2)
+}
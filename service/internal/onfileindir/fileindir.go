@@ -1,36 +1,139 @@
 package onfileindir
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/OliveTin/OliveTin/internal/auth"
 	"github.com/OliveTin/OliveTin/internal/config"
 	"github.com/OliveTin/OliveTin/internal/executor"
 	"github.com/OliveTin/OliveTin/internal/filehelper"
+	log "github.com/sirupsen/logrus"
 )
 
+const (
+	eventCreated = "created"
+	eventChanged = "changed"
+)
+
+type watchKey struct {
+	actionID string
+	event    string
+	dir      string
+}
+
+// dirWatch is a running watcher. The action is replaced when the config is
+// reloaded, so that executions use the current config of the action.
+type dirWatch struct {
+	cancel context.CancelFunc
+	action *config.Action
+}
+
+var (
+	watches      = make(map[watchKey]*dirWatch)
+	watchesMutex sync.Mutex
+)
+
+// WatchFilesInDirectory starts the watchers of the config, and reconciles
+// them with the config whenever it is reloaded.
 func WatchFilesInDirectory(cfg *config.Config, ex *executor.Executor) {
+	reconcile(cfg, ex)
+
+	config.AddListener(func() {
+		reconcile(cfg, ex)
+	})
+}
+
+func desiredWatches(cfg *config.Config) map[watchKey]*config.Action {
+	ret := make(map[watchKey]*config.Action)
+
 	for _, action := range cfg.Actions {
-		for _, dirname := range action.ExecOnFileCreatedInDir {
-			go func(act *config.Action, dir string) {
-				filehelper.WatchDirectoryCreate(dir, func(filename string) {
-					scheduleExec(act, cfg, ex, filename)
-				})
-			}(action, dirname)
+		for _, dir := range action.ExecOnFileCreatedInDir {
+			ret[watchKey{actionID: action.ID, event: eventCreated, dir: dir}] = action
 		}
-		for _, dirname := range action.ExecOnFileChangedInDir {
-			// Pass values into anonymous function because of this issue
-			// https://github.com/OliveTin/OliveTin/issues/503
-
-			go func(act *config.Action, dir string) {
-				filehelper.WatchDirectoryWrite(dir, func(filename string) {
-					scheduleExec(act, cfg, ex, filename)
-				})
-			}(action, dirname)
+
+		for _, dir := range action.ExecOnFileChangedInDir {
+			ret[watchKey{actionID: action.ID, event: eventChanged, dir: dir}] = action
 		}
 	}
+
+	return ret
+}
+
+func reconcile(cfg *config.Config, ex *executor.Executor) {
+	desired := desiredWatches(cfg)
+
+	watchesMutex.Lock()
+	defer watchesMutex.Unlock()
+
+	removed := 0
+
+	for key, w := range watches {
+		if _, ok := desired[key]; !ok {
+			stopWatch(key, w)
+			removed++
+		}
+	}
+
+	added := 0
+
+	for key, action := range desired {
+		if w, ok := watches[key]; ok {
+			w.action = action
+		} else {
+			startWatch(cfg, ex, key, action)
+			added++
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"added":   added,
+		"removed": removed,
+		"total":   len(watches),
+	}).Infof("Reconciled file in directory watchers with config")
+}
+
+func startWatch(cfg *config.Config, ex *executor.Executor, key watchKey, action *config.Action) {
+	log.WithFields(log.Fields{
+		"actionTitle": action.Title,
+		"event":       key.event,
+		"dir":         key.dir,
+	}).Infof("Watching directory for files")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &dirWatch{cancel: cancel, action: action}
+	watches[key] = w
+
+	callback := func(filename string) {
+		scheduleExec(w.currentAction(), cfg, ex, filename)
+	}
+
+	if key.event == eventCreated {
+		go filehelper.WatchDirectoryCreateContext(ctx, key.dir, callback)
+	} else {
+		go filehelper.WatchDirectoryWriteContext(ctx, key.dir, callback)
+	}
+}
+
+func stopWatch(key watchKey, w *dirWatch) {
+	log.WithFields(log.Fields{
+		"actionTitle": w.action.Title,
+		"event":       key.event,
+		"dir":         key.dir,
+	}).Infof("No longer watching directory for files")
+
+	w.cancel()
+	delete(watches, key)
+}
+
+func (w *dirWatch) currentAction() *config.Action {
+	watchesMutex.Lock()
+	defer watchesMutex.Unlock()
+
+	return w.action
 }
 
 func scheduleExec(action *config.Action, cfg *config.Config, ex *executor.Executor, path string) {
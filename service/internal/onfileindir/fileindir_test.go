@@ -0,0 +1,56 @@
+package onfileindir
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func watchCount() int {
+	watchesMutex.Lock()
+	defer watchesMutex.Unlock()
+
+	return len(watches)
+}
+
+func TestWatchersAreReconciledWithConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:                  "Import",
+		Exec:                   []string{"echo", "{{ filename }}"},
+		ExecOnFileCreatedInDir: []string{dir},
+		Arguments:              []config.ActionArgument{{Name: "filename", Type: "very_dangerous_raw_string"}},
+	})
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	reconcile(cfg, ex)
+	assert.Equal(t, 1, watchCount())
+
+	reconcile(cfg, ex)
+	assert.Equal(t, 1, watchCount())
+
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.csv"), []byte("a,b"), 0600))
+
+	binding := ex.FindBindingWithNoEntity(cfg.Actions[len(cfg.Actions)-1])
+
+	require.Eventually(t, func() bool {
+		return len(ex.GetLogsByBindingId(binding.ID)) == 1
+	}, 5*time.Second, 20*time.Millisecond)
+
+	cfg.Actions = cfg.Actions[:len(cfg.Actions)-1]
+	reconcile(cfg, ex)
+	assert.Equal(t, 0, watchCount())
+}
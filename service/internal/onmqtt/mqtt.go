@@ -0,0 +1,257 @@
+package onmqtt
+
+/*
+Actions can be started by MQTT messages with execOnMqtt, and can publish the
+result of each execution with publishToMqtt. There is one connection to each
+broker that is used by an action, which reconnects and subscribes again by
+itself when the broker goes away.
+*/
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/webhooks"
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	connectRetryInterval = 10 * time.Second
+	publishTimeout       = 30 * time.Second
+)
+
+type subscription struct {
+	action  *config.Action
+	trigger config.MqttTrigger
+}
+
+type service struct {
+	cfg     *config.Config
+	ex      *executor.Executor
+	clients map[string]paho.Client
+
+	// publishing is used by tests to wait for publishes to finish.
+	publishing sync.WaitGroup
+}
+
+// Start connects to the brokers that are used by actions. It does not wait
+// for the connections, as they are retried until they succeed.
+func Start(cfg *config.Config, ex *executor.Executor) {
+	s := newService(cfg, ex)
+	s.connect()
+
+	if len(s.clients) > 0 {
+		ex.AddListener(s)
+	}
+}
+
+func newService(cfg *config.Config, ex *executor.Executor) *service {
+	return &service{
+		cfg:     cfg,
+		ex:      ex,
+		clients: make(map[string]paho.Client),
+	}
+}
+
+func (s *service) connect() {
+	subscriptions, used := s.findBrokerUses()
+
+	for name := range used {
+		broker, found := s.cfg.Mqtt.Brokers[name]
+
+		if !found {
+			continue
+		}
+
+		client := paho.NewClient(s.clientOptions(name, broker, subscriptions[name]))
+		client.Connect()
+
+		s.clients[name] = client
+	}
+}
+
+// findBrokerUses returns the subscriptions of each broker, and the names of
+// every broker that is subscribed or published to.
+func (s *service) findBrokerUses() (map[string][]subscription, map[string]bool) {
+	subscriptions := make(map[string][]subscription)
+	used := make(map[string]bool)
+
+	for _, action := range s.cfg.Actions {
+		for _, trigger := range action.ExecOnMqtt {
+			subscriptions[trigger.Broker] = append(subscriptions[trigger.Broker], subscription{action, trigger})
+			used[trigger.Broker] = true
+		}
+
+		for _, publish := range action.PublishToMqtt {
+			used[publish.Broker] = true
+		}
+	}
+
+	return subscriptions, used
+}
+
+func (s *service) clientOptions(name string, broker *config.MqttBroker, subscriptions []subscription) *paho.ClientOptions {
+	clientId := broker.ClientId
+
+	if clientId == "" {
+		clientId = "olivetin-" + uuid.NewString()[:8]
+	}
+
+	opts := paho.NewClientOptions()
+	opts.AddBroker(broker.Url)
+	opts.SetClientID(clientId)
+	opts.SetUsername(broker.Username)
+	opts.SetPassword(broker.Password)
+	opts.SetAutoReconnect(true)
+	opts.SetConnectRetry(true)
+	opts.SetConnectRetryInterval(connectRetryInterval)
+
+	// Sessions are not kept by the broker, so subscribe again on every
+	// connection.
+	opts.SetOnConnectHandler(func(client paho.Client) {
+		log.WithFields(log.Fields{
+			"broker": name,
+		}).Infof("Connected to MQTT broker")
+
+		s.subscribe(name, client, subscriptions)
+	})
+
+	opts.SetConnectionLostHandler(func(_ paho.Client, err error) {
+		log.WithFields(log.Fields{
+			"broker": name,
+			"error":  err,
+		}).Warnf("Lost connection to MQTT broker, reconnecting")
+	})
+
+	return opts
+}
+
+func (s *service) subscribe(name string, client paho.Client, subscriptions []subscription) {
+	for _, sub := range subscriptions {
+		token := client.Subscribe(sub.trigger.Topic, sub.trigger.Qos, s.onMessage(sub))
+		token.Wait()
+
+		if err := token.Error(); err != nil {
+			log.WithFields(log.Fields{
+				"broker":      name,
+				"topic":       sub.trigger.Topic,
+				"actionTitle": sub.action.Title,
+				"error":       err,
+			}).Errorf("Failed to subscribe to MQTT topic")
+		}
+	}
+}
+
+func (s *service) onMessage(sub subscription) paho.MessageHandler {
+	return func(_ paho.Client, msg paho.Message) {
+		s.execTrigger(sub, msg.Topic(), msg.Payload())
+	}
+}
+
+func (s *service) execTrigger(sub subscription, topic string, payload []byte) {
+	args, matches := extractArguments(sub.trigger, topic, payload)
+
+	if !matches {
+		return
+	}
+
+	binding := s.ex.FindBindingWithNoEntity(sub.action)
+
+	if binding == nil {
+		log.WithFields(log.Fields{
+			"actionTitle": sub.action.Title,
+		}).Warnf("Action binding not found, skipping execution")
+		return
+	}
+
+	s.ex.ExecRequest(&executor.ExecutionRequest{
+		Binding:           binding,
+		Cfg:               s.cfg,
+		Tags:              []string{"mqtt"},
+		Arguments:         filterToDefinedArguments(args, sub.action),
+		AuthenticatedUser: auth.UserFromSystem(s.cfg, "mqtt"),
+	})
+}
+
+// extractArguments returns the topic, the payload, and the values extracted
+// from a JSON payload, and whether the payload matches the trigger.
+func extractArguments(trigger config.MqttTrigger, topic string, payload []byte) (map[string]string, bool) {
+	args := map[string]string{
+		"mqtt_topic":   topic,
+		"mqtt_payload": string(payload),
+	}
+
+	if trigger.MatchPath == "" && len(trigger.Extract) == 0 {
+		return args, true
+	}
+
+	matcher, err := webhooks.NewJSONMatcher(payload)
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"topic": topic,
+			"error": err,
+		}).Debugf("MQTT payload is not JSON")
+
+		return args, trigger.MatchPath == ""
+	}
+
+	if !matchesPath(matcher, trigger.MatchPath) {
+		return nil, false
+	}
+
+	extractJSONPathValues(matcher, trigger.Extract, args)
+
+	return args, true
+}
+
+func extractJSONPathValues(matcher *webhooks.JSONMatcher, extract map[string]string, args map[string]string) {
+	for argName, jsonPath := range extract {
+		if value, err := matcher.ExtractValue(jsonPath); err == nil {
+			args[argName] = value
+		}
+	}
+}
+
+// matchesPath works like matchPath for webhooks; "jsonpath=value" matches a
+// value, and just "jsonpath" matches when the path exists.
+func matchesPath(matcher *webhooks.JSONMatcher, matchPath string) bool {
+	if matchPath == "" {
+		return true
+	}
+
+	jsonPath, expectedValue, _ := strings.Cut(matchPath, "=")
+
+	if expectedValue == "" {
+		_, err := matcher.ExtractValue(jsonPath)
+		return err == nil
+	}
+
+	matches, err := matcher.MatchPath(jsonPath, expectedValue)
+
+	return err == nil && matches
+}
+
+func filterToDefinedArguments(args map[string]string, action *config.Action) map[string]string {
+	filtered := make(map[string]string)
+
+	for _, arg := range action.Arguments {
+		if value, ok := args[arg.Name]; ok {
+			filtered[arg.Name] = value
+		}
+	}
+
+	return filtered
+}
+
+func (s *service) disconnect() {
+	for _, client := range s.clients {
+		client.Disconnect(250)
+	}
+}
@@ -0,0 +1,195 @@
+package onmqtt
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	mochi "github.com/mochi-mqtt/server/v2"
+	"github.com/mochi-mqtt/server/v2/hooks/auth"
+	"github.com/mochi-mqtt/server/v2/listeners"
+	"github.com/mochi-mqtt/server/v2/packets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func startBroker(t *testing.T) (*mochi.Server, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := listener.Addr().String()
+	_ = listener.Close()
+
+	server := mochi.New(&mochi.Options{InlineClient: true})
+	require.NoError(t, server.AddHook(new(auth.AllowHook), nil))
+	require.NoError(t, server.AddListener(listeners.NewTCP(listeners.Config{ID: "test", Address: addr})))
+	require.NoError(t, server.Serve())
+
+	t.Cleanup(func() { _ = server.Close() })
+
+	return server, "tcp://" + addr
+}
+
+func startService(t *testing.T, cfg *config.Config) (*service, *executor.Executor) {
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	s := newService(cfg, ex)
+	s.connect()
+	ex.AddListener(s)
+
+	t.Cleanup(s.disconnect)
+
+	for _, client := range s.clients {
+		assert.Eventually(t, client.IsConnectionOpen, 5*time.Second, 10*time.Millisecond)
+	}
+
+	return s, ex
+}
+
+func waitForSubscription(t *testing.T, server *mochi.Server, topic string) {
+	assert.Eventually(t, func() bool {
+		return len(server.Topics.Subscribers(topic).Subscriptions) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+type finishedCollector struct {
+	finished chan *executor.InternalLogEntry
+}
+
+func (c *finishedCollector) OnExecutionFinished(entry *executor.InternalLogEntry) {
+	c.finished <- entry
+}
+
+func (c *finishedCollector) OnExecutionStarted(_ *executor.InternalLogEntry) {}
+
+func (c *finishedCollector) OnOutputChunk(_ executor.OutputChunk, _ string) {}
+
+func (c *finishedCollector) OnActionMapRebuilt() {}
+
+func (c *finishedCollector) OnApprovalRequested(_ *executor.PendingApproval) {}
+
+func (c *finishedCollector) OnApprovalResolved(_ *executor.InternalLogEntry, _ bool) {}
+
+func TestMessagesStartActions(t *testing.T) {
+	server, url := startBroker(t)
+
+	cfg := config.DefaultConfig()
+	cfg.Mqtt.Brokers = map[string]*config.MqttBroker{"home": {Url: url}}
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title: "Open door",
+		Exec:  []string{"echo", "opening", "{{ door }}", "from", "{{ mqtt_topic }}"},
+		Arguments: []config.ActionArgument{
+			{Name: "door", Type: "ascii_identifier"},
+			{Name: "mqtt_topic", Type: "very_dangerous_raw_string"},
+		},
+		ExecOnMqtt: []config.MqttTrigger{
+			{Topic: "home/+/button", MatchPath: "$.action=open", Extract: map[string]string{"door": "$.door"}},
+		},
+	})
+
+	_, ex := startService(t, cfg)
+
+	collector := &finishedCollector{finished: make(chan *executor.InternalLogEntry, 1)}
+	ex.AddListener(collector)
+
+	waitForSubscription(t, server, "home/hall/button")
+
+	require.NoError(t, server.Publish("home/hall/button", []byte(`{"action": "close", "door": "front"}`), false, 0))
+	require.NoError(t, server.Publish("home/hall/button", []byte(`{"action": "open", "door": "front"}`), false, 0))
+
+	select {
+	case entry := <-collector.finished:
+		assert.Equal(t, "opening front from home/hall/button\n", entry.Output)
+		assert.Equal(t, "mqtt", entry.Username)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The action was not started")
+	}
+
+	select {
+	case entry := <-collector.finished:
+		t.Fatalf("Only the matching message should start the action, got: %v", entry.Output)
+	case <-time.After(200 * time.Millisecond):
+	}
+}
+
+func TestExecutionResultsArePublished(t *testing.T) {
+	server, url := startBroker(t)
+
+	received := make(chan []byte, 1)
+	require.NoError(t, server.Subscribe("olivetin/results/#", 1, func(_ *mochi.Client, _ packets.Subscription, pk packets.Packet) {
+		received <- pk.Payload
+	}))
+
+	cfg := config.DefaultConfig()
+	cfg.Mqtt.Brokers = map[string]*config.MqttBroker{"home": {Url: url}}
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:         "Check disk",
+		Shell:         "echo 42% used",
+		PublishToMqtt: []config.MqttPublish{{Topic: "olivetin/results/disk", Qos: 1}},
+	})
+
+	s, ex := startService(t, cfg)
+
+	wg, trackingID := ex.ExecRequest(&executor.ExecutionRequest{
+		Binding: ex.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+	s.publishing.Wait()
+
+	select {
+	case payload := <-received:
+		result := executionResult{}
+		require.NoError(t, json.Unmarshal(payload, &result))
+
+		assert.Equal(t, trackingID, result.TrackingID)
+		assert.Equal(t, "Check disk", result.ActionTitle)
+		assert.Equal(t, "42% used\n", result.Output)
+		assert.Equal(t, int32(0), result.ExitCode)
+	case <-time.After(5 * time.Second):
+		t.Fatal("The execution result was not published")
+	}
+}
+
+func TestExtractArguments(t *testing.T) {
+	trigger := config.MqttTrigger{Extract: map[string]string{"temp": "$.temperature"}}
+
+	args, matches := extractArguments(trigger, "sensors/garage", []byte(`{"temperature": 21.5}`))
+	assert.True(t, matches)
+	assert.Equal(t, "21.5", args["temp"])
+	assert.Equal(t, "sensors/garage", args["mqtt_topic"])
+
+	args, matches = extractArguments(trigger, "sensors/garage", []byte("not json"))
+	assert.True(t, matches, "Without matchPath, payloads that are not JSON still start the action")
+	assert.Equal(t, "not json", args["mqtt_payload"])
+
+	trigger.MatchPath = "$.temperature"
+
+	_, matches = extractArguments(trigger, "sensors/garage", []byte("not json"))
+	assert.False(t, matches)
+
+	_, matches = extractArguments(trigger, "sensors/garage", []byte(`{"humidity": 40}`))
+	assert.False(t, matches, "The matchPath must exist")
+}
+
+func TestSanitizeDefaultsToTheOnlyBroker(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Mqtt.Brokers = map[string]*config.MqttBroker{"home": {Url: "tcp://localhost:1883"}}
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:         "Lights",
+		ExecOnMqtt:    []config.MqttTrigger{{Topic: "lights", Qos: 7}},
+		PublishToMqtt: []config.MqttPublish{{Broker: "other", Topic: "results"}},
+	})
+	cfg.Sanitize()
+
+	assert.Equal(t, "home", cfg.Actions[0].ExecOnMqtt[0].Broker)
+	assert.Equal(t, byte(2), cfg.Actions[0].ExecOnMqtt[0].Qos)
+	assert.Equal(t, "other", cfg.Actions[0].PublishToMqtt[0].Broker)
+}
@@ -0,0 +1,114 @@
+package onmqtt
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	paho "github.com/eclipse/paho.mqtt.golang"
+	log "github.com/sirupsen/logrus"
+)
+
+// executionResult is the JSON that is published for publishToMqtt.
+type executionResult struct {
+	TrackingID       string         `json:"trackingId"`
+	ActionId         string         `json:"actionId"`
+	ActionTitle      string         `json:"actionTitle"`
+	Username         string         `json:"username"`
+	ExitCode         int32          `json:"exitCode"`
+	TimedOut         bool           `json:"timedOut"`
+	Blocked          bool           `json:"blocked"`
+	Output           string         `json:"output"`
+	Result           map[string]any `json:"result,omitempty"`
+	DatetimeStarted  string         `json:"datetimeStarted"`
+	DatetimeFinished string         `json:"datetimeFinished"`
+}
+
+func (s *service) OnExecutionFinished(entry *executor.InternalLogEntry) {
+	if !publishesToMqtt(entry) {
+		return
+	}
+
+	payload, err := json.Marshal(newExecutionResult(entry))
+
+	if err != nil {
+		log.WithFields(log.Fields{
+			"actionTitle": entry.ActionTitle,
+			"error":       err,
+		}).Errorf("Failed to marshal execution result for MQTT")
+		return
+	}
+
+	for _, publish := range entry.Binding.Action.PublishToMqtt {
+		if client, found := s.clients[publish.Broker]; found {
+			s.publish(client, publish, payload)
+		}
+	}
+}
+
+func publishesToMqtt(entry *executor.InternalLogEntry) bool {
+	return entry.Binding != nil && entry.Binding.Action != nil && len(entry.Binding.Action.PublishToMqtt) > 0
+}
+
+func newExecutionResult(entry *executor.InternalLogEntry) *executionResult {
+	return &executionResult{
+		TrackingID:       entry.ExecutionTrackingID,
+		ActionId:         entry.Binding.Action.ID,
+		ActionTitle:      entry.ActionTitle,
+		Username:         entry.Username,
+		ExitCode:         entry.ExitCode,
+		TimedOut:         entry.TimedOut,
+		Blocked:          entry.Blocked,
+		Output:           entry.Output,
+		Result:           entry.Result,
+		DatetimeStarted:  formatTime(entry.DatetimeStarted),
+		DatetimeFinished: formatTime(entry.DatetimeFinished),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// publish does not wait for the broker, so that a slow or missing broker does
+// not hold up the executor.
+func (s *service) publish(client paho.Client, publish config.MqttPublish, payload []byte) {
+	token := client.Publish(publish.Topic, publish.Qos, publish.Retain, payload)
+
+	s.publishing.Add(1)
+
+	go func() {
+		defer s.publishing.Done()
+
+		if !token.WaitTimeout(publishTimeout) {
+			log.WithFields(log.Fields{
+				"broker": publish.Broker,
+				"topic":  publish.Topic,
+			}).Warnf("Timed out publishing execution result to MQTT")
+			return
+		}
+
+		if err := token.Error(); err != nil {
+			log.WithFields(log.Fields{
+				"broker": publish.Broker,
+				"topic":  publish.Topic,
+				"error":  err,
+			}).Errorf("Failed to publish execution result to MQTT")
+		}
+	}()
+}
+
+func (s *service) OnExecutionStarted(_ *executor.InternalLogEntry) {}
+
+func (s *service) OnOutputChunk(_ executor.OutputChunk, _ string) {}
+
+func (s *service) OnActionMapRebuilt() {}
+
+func (s *service) OnApprovalRequested(_ *executor.PendingApproval) {}
+
+func (s *service) OnApprovalResolved(_ *executor.InternalLogEntry, _ bool) {}
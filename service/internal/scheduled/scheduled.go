@@ -0,0 +1,360 @@
+package scheduled
+
+/*
+Scheduled executions are executions that a user asked to run once, at a
+later time, through the API. They are saved to file whenever they change, so
+that they are still run after a restart, and they are executed as the user
+that scheduled them, with the ACLs that user has when the time comes.
+*/
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNotFound is returned when cancelling an execution that is not scheduled.
+var ErrNotFound = errors.New("scheduled execution not found")
+
+// ErrInThePast is returned when scheduling an execution for a time that has
+// already passed.
+var ErrInThePast = errors.New("scheduled time is in the past")
+
+// ScheduledExecution is an execution of an action that will run at a later
+// time. The ID is used as the tracking ID of the execution.
+type ScheduledExecution struct {
+	ID                string            `yaml:"id"`
+	BindingID         string            `yaml:"bindingId"`
+	ActionTitle       string            `yaml:"actionTitle"`
+	Datetime          time.Time         `yaml:"datetime"`
+	DatetimeScheduled time.Time         `yaml:"datetimeScheduled"`
+	Arguments         map[string]string `yaml:"arguments"`
+	Justification     string            `yaml:"justification"`
+	Username          string            `yaml:"username"`
+	UsergroupLine     string            `yaml:"usergroupLine"`
+	Provider          string            `yaml:"provider"`
+}
+
+// Scheduler runs the scheduled executions of an executor.
+type Scheduler struct {
+	ex   *executor.Executor
+	file string
+
+	mu      sync.Mutex
+	pending []*ScheduledExecution
+	timers  map[string]*time.Timer
+}
+
+var (
+	schedulers   = make(map[*executor.Executor]*Scheduler)
+	schedulersMu sync.Mutex
+)
+
+// Register starts the scheduler of the executor, including the executions
+// that were scheduled before a restart.
+func Register(ex *executor.Executor) {
+	schedulersMu.Lock()
+	defer schedulersMu.Unlock()
+
+	if _, ok := schedulers[ex]; ok {
+		return
+	}
+
+	s := newScheduler(ex, scheduleFile(ex.Cfg))
+	s.load()
+
+	schedulers[ex] = s
+}
+
+// Get returns the scheduler of the executor, or nil if it was not registered.
+func Get(ex *executor.Executor) *Scheduler {
+	schedulersMu.Lock()
+	defer schedulersMu.Unlock()
+
+	return schedulers[ex]
+}
+
+func newScheduler(ex *executor.Executor, file string) *Scheduler {
+	return &Scheduler{
+		ex:     ex,
+		file:   file,
+		timers: make(map[string]*time.Timer),
+	}
+}
+
+func scheduleFile(cfg *config.Config) string {
+	if cfg.GetDir() == "" {
+		return ""
+	}
+
+	return filepath.Join(cfg.GetDir(), "scheduled-executions.yaml")
+}
+
+func (s *Scheduler) load() {
+	if s.file == "" {
+		return
+	}
+
+	loaded := readScheduleFile(s.file)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sched := range loaded {
+		s.restoreLocked(sched)
+	}
+
+	s.saveLocked()
+
+	log.WithFields(log.Fields{
+		"count": len(s.pending),
+	}).Infof("Loaded scheduled executions")
+}
+
+func readScheduleFile(file string) []*ScheduledExecution {
+	data, err := os.ReadFile(file)
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		log.WithError(err).Warnf("Failed to read scheduled executions")
+		return nil
+	}
+
+	var loaded []*ScheduledExecution
+
+	if err := yaml.Unmarshal(data, &loaded); err != nil {
+		log.WithError(err).Errorf("Failed to unmarshal scheduled executions")
+		return nil
+	}
+
+	return loaded
+}
+
+// restoreLocked skips executions whose time passed while OliveTin was not
+// running, like calendar files do.
+func (s *Scheduler) restoreLocked(sched *ScheduledExecution) {
+	if time.Now().After(sched.Datetime) {
+		log.WithFields(log.Fields{
+			"actionTitle": sched.ActionTitle,
+			"datetime":    sched.Datetime,
+		}).Warnf("Not running stale scheduled execution")
+
+		return
+	}
+
+	s.addLocked(sched)
+}
+
+// saveLocked writes the scheduled executions to file. Arguments can be
+// sensitive, so the file is only readable by the owner.
+func (s *Scheduler) saveLocked() {
+	if s.file == "" {
+		return
+	}
+
+	out, err := yaml.Marshal(s.pending)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to marshal scheduled executions")
+		return
+	}
+
+	if err := os.WriteFile(s.file, out, 0600); err != nil {
+		log.WithError(err).Errorf("Failed to write scheduled executions")
+	}
+}
+
+// Schedule runs the action of the binding at the given time, as the user. The
+// caller is expected to have checked that the user may execute the action.
+func (s *Scheduler) Schedule(binding *executor.ActionBinding, at time.Time, args map[string]string, justification string, user *authpublic.AuthenticatedUser) (*ScheduledExecution, error) {
+	if !at.After(time.Now()) {
+		return nil, ErrInThePast
+	}
+
+	sched := &ScheduledExecution{
+		ID:                uuid.NewString(),
+		BindingID:         binding.ID,
+		ActionTitle:       binding.Action.Title,
+		Datetime:          at,
+		DatetimeScheduled: time.Now(),
+		Arguments:         args,
+		Justification:     justification,
+		Username:          user.Username,
+		UsergroupLine:     user.UsergroupLine,
+		Provider:          user.Provider,
+	}
+
+	log.WithFields(log.Fields{
+		"actionTitle": sched.ActionTitle,
+		"datetime":    sched.Datetime,
+		"username":    sched.Username,
+	}).Infof("Scheduling execution")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addLocked(sched)
+	s.saveLocked()
+
+	return sched, nil
+}
+
+func (s *Scheduler) addLocked(sched *ScheduledExecution) {
+	s.pending = append(s.pending, sched)
+	s.timers[sched.ID] = time.AfterFunc(time.Until(sched.Datetime), func() {
+		s.run(sched.ID)
+	})
+}
+
+// List returns the scheduled executions, soonest first.
+func (s *Scheduler) List() []*ScheduledExecution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ret := slices.Clone(s.pending)
+
+	slices.SortFunc(ret, func(a, b *ScheduledExecution) int {
+		return a.Datetime.Compare(b.Datetime)
+	})
+
+	return ret
+}
+
+// Find returns the scheduled execution with the ID, or nil.
+func (s *Scheduler) Find(id string) *ScheduledExecution {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.findLocked(id)
+}
+
+func (s *Scheduler) findLocked(id string) *ScheduledExecution {
+	for _, sched := range s.pending {
+		if sched.ID == id {
+			return sched
+		}
+	}
+
+	return nil
+}
+
+// Cancel removes a scheduled execution before it runs.
+func (s *Scheduler) Cancel(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.removeLocked(id) == nil {
+		return ErrNotFound
+	}
+
+	s.saveLocked()
+
+	return nil
+}
+
+func (s *Scheduler) removeLocked(id string) *ScheduledExecution {
+	sched := s.findLocked(id)
+
+	if sched == nil {
+		return nil
+	}
+
+	s.timers[id].Stop()
+	delete(s.timers, id)
+
+	s.pending = slices.DeleteFunc(s.pending, func(p *ScheduledExecution) bool {
+		return p == sched
+	})
+
+	return sched
+}
+
+func (s *Scheduler) run(id string) {
+	s.mu.Lock()
+	sched := s.removeLocked(id)
+	s.saveLocked()
+	s.mu.Unlock()
+
+	if sched == nil {
+		return
+	}
+
+	s.exec(sched)
+}
+
+func (s *Scheduler) exec(sched *ScheduledExecution) {
+	binding := s.ex.FindBindingByID(sched.BindingID)
+
+	if binding == nil {
+		log.WithFields(log.Fields{
+			"actionTitle": sched.ActionTitle,
+			"bindingId":   sched.BindingID,
+		}).Warnf("Scheduled action no longer exists, skipping execution")
+
+		return
+	}
+
+	user := sched.user(s.ex.Cfg)
+
+	if user == nil {
+		log.WithFields(log.Fields{
+			"actionTitle": sched.ActionTitle,
+			"username":    sched.Username,
+		}).Warnf("Scheduled action user no longer exists, skipping execution")
+
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"actionTitle": sched.ActionTitle,
+		"username":    sched.Username,
+	}).Infof("Executing scheduled action")
+
+	s.ex.ExecRequest(&executor.ExecutionRequest{
+		Binding:           binding,
+		TrackingID:        sched.ID,
+		Cfg:               s.ex.Cfg,
+		Tags:              []string{"scheduled"},
+		Arguments:         sched.Arguments,
+		Justification:     sched.Justification,
+		AuthenticatedUser: user,
+	})
+}
+
+// user builds the user again, so that the ACLs are checked with the config
+// at the time of the execution. Local users are looked up in the config again,
+// rather than trusting the saved usergroups, and nil is returned when they
+// have been removed.
+func (sched *ScheduledExecution) user(cfg *config.Config) *authpublic.AuthenticatedUser {
+	user := &authpublic.AuthenticatedUser{
+		Username:      sched.Username,
+		UsergroupLine: sched.UsergroupLine,
+		Provider:      sched.Provider,
+	}
+
+	if sched.Provider == "local" {
+		localUser := cfg.FindUserByUsername(sched.Username)
+
+		if !cfg.AuthLocalUsers.Enabled || localUser == nil {
+			return nil
+		}
+
+		user.UsergroupLine = localUser.UsergroupLine(cfg.AuthHttpHeaderUserGroupSep)
+	}
+
+	user.BuildUserAcls(cfg)
+
+	return user
+}
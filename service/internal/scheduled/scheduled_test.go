@@ -0,0 +1,152 @@
+package scheduled
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func newTestExecutor(t *testing.T) *executor.Executor {
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+	cfg.Actions = append(cfg.Actions, &config.Action{
+		Title:     "Greet",
+		Exec:      []string{"echo", "hello {{ name }}"},
+		Arguments: []config.ActionArgument{{Name: "name", Type: "ascii_identifier"}},
+	})
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	return ex
+}
+
+func schedule(t *testing.T, s *Scheduler, at time.Time) *ScheduledExecution {
+	binding := s.ex.FindBindingByActionTitle("Greet")
+	require.NotNil(t, binding)
+
+	sched, err := s.Schedule(binding, at, map[string]string{"name": "olive"}, "because", auth.UserFromSystem(s.ex.Cfg, "alice"))
+	require.NoError(t, err)
+
+	return sched
+}
+
+func TestScheduledExecutionRuns(t *testing.T) {
+	ex := newTestExecutor(t)
+	s := newScheduler(ex, scheduleFile(ex.Cfg))
+
+	sched := schedule(t, s, time.Now().Add(50*time.Millisecond))
+	assert.Len(t, s.List(), 1)
+
+	require.Eventually(t, func() bool {
+		snapshot, ok := ex.SnapshotLog(sched.ID)
+		return ok && snapshot.ExecutionFinished
+	}, 5*time.Second, 10*time.Millisecond)
+
+	snapshot, _ := ex.SnapshotLog(sched.ID)
+	assert.Equal(t, "hello olive\n", snapshot.Output)
+
+	entry, _ := ex.GetLog(sched.ID)
+	assert.Equal(t, "because", entry.Justification)
+	assert.Equal(t, "alice", entry.Username)
+	assert.Contains(t, entry.Tags, "scheduled")
+	assert.Empty(t, s.List())
+}
+
+func TestCancelledExecutionDoesNotRun(t *testing.T) {
+	ex := newTestExecutor(t)
+	s := newScheduler(ex, scheduleFile(ex.Cfg))
+
+	sched := schedule(t, s, time.Now().Add(100*time.Millisecond))
+
+	require.NoError(t, s.Cancel(sched.ID))
+	assert.ErrorIs(t, s.Cancel(sched.ID), ErrNotFound)
+
+	time.Sleep(200 * time.Millisecond)
+
+	_, ok := ex.GetLog(sched.ID)
+	assert.False(t, ok)
+}
+
+func TestScheduledExecutionsAreRestored(t *testing.T) {
+	ex := newTestExecutor(t)
+	s := newScheduler(ex, scheduleFile(ex.Cfg))
+
+	sched := schedule(t, s, time.Now().Add(time.Hour))
+	stale := schedule(t, s, time.Now().Add(time.Hour))
+	stale.Datetime = time.Now().Add(-time.Minute)
+	s.saveLocked()
+
+	assert.FileExists(t, filepath.Join(ex.Cfg.GetDir(), "scheduled-executions.yaml"))
+
+	restored := newScheduler(ex, scheduleFile(ex.Cfg))
+	restored.load()
+
+	list := restored.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, sched.ID, list[0].ID)
+	assert.Equal(t, map[string]string{"name": "olive"}, list[0].Arguments)
+
+	require.NoError(t, s.Cancel(sched.ID))
+	require.NoError(t, s.Cancel(stale.ID))
+	require.NoError(t, restored.Cancel(sched.ID))
+}
+
+func TestSchedulingInThePastFails(t *testing.T) {
+	ex := newTestExecutor(t)
+	s := newScheduler(ex, "")
+
+	_, err := s.Schedule(ex.FindBindingByActionTitle("Greet"), time.Now().Add(-time.Second), nil, "", auth.UserFromSystem(ex.Cfg, "alice"))
+	assert.ErrorIs(t, err, ErrInThePast)
+}
+
+func scheduleAsLocalUser(t *testing.T, s *Scheduler) *ScheduledExecution {
+	s.ex.Cfg.AuthLocalUsers.Enabled = true
+	s.ex.Cfg.AuthLocalUsers.Users = []*config.LocalUser{{Username: "alice", Usergroup: "admins"}}
+
+	user := &authpublic.AuthenticatedUser{Username: "alice", UsergroupLine: "admins", Provider: "local"}
+	user.BuildUserAcls(s.ex.Cfg)
+
+	sched, err := s.Schedule(s.ex.FindBindingByActionTitle("Greet"), time.Now().Add(time.Hour), map[string]string{"name": "olive"}, "", user)
+	require.NoError(t, err)
+
+	return sched
+}
+
+func TestScheduledLocalUserUsesCurrentUsergroups(t *testing.T) {
+	ex := newTestExecutor(t)
+	s := newScheduler(ex, "")
+
+	sched := scheduleAsLocalUser(t, s)
+	ex.Cfg.AuthLocalUsers.Users[0].Usergroup = "guests"
+
+	user := sched.user(ex.Cfg)
+	require.NotNil(t, user)
+	assert.Equal(t, "guests", user.UsergroupLine)
+
+	require.NoError(t, s.Cancel(sched.ID))
+}
+
+func TestScheduledExecutionOfRemovedLocalUserDoesNotRun(t *testing.T) {
+	ex := newTestExecutor(t)
+	s := newScheduler(ex, "")
+
+	sched := scheduleAsLocalUser(t, s)
+	ex.Cfg.AuthLocalUsers.Users = nil
+
+	s.exec(sched)
+
+	_, ok := ex.GetLog(sched.ID)
+	assert.False(t, ok)
+
+	require.NoError(t, s.Cancel(sched.ID))
+}
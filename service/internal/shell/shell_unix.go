@@ -0,0 +1,28 @@
+//go:build !windows
+// +build !windows
+
+// Package shell runs commands in the shell of the OS, the same way for actions
+// and for anything else that runs commands from the config.
+package shell
+
+import (
+	"context"
+	"os/exec"
+	"syscall"
+)
+
+// Command runs the command with sh -c, in its own process group.
+func Command(ctx context.Context, command string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+
+	// This is to ensure that the process group is killed when the parent process is killed.
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	// When ctx is done, kill the whole process group too, rather than just
+	// the shell, so that its children do not keep the output pipes open.
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+
+	return cmd
+}
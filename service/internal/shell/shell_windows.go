@@ -0,0 +1,23 @@
+//go:build windows
+// +build windows
+
+// Package shell runs commands in the shell of the OS, the same way for actions
+// and for anything else that runs commands from the config.
+package shell
+
+import (
+	"context"
+	"os"
+	"os/exec"
+)
+
+// Command runs the command with cmd /C, and with /u unless OT_WIN_FLAG_U is 0.
+func Command(ctx context.Context, command string) *exec.Cmd {
+	winCodepage := os.Getenv("OT_WIN_FLAG_U")
+
+	if winCodepage == "0" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		return exec.CommandContext(ctx, "cmd", "/u", "/C", command)
+	}
+}
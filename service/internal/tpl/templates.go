@@ -46,6 +46,7 @@ type generalTemplateContext struct {
 type actionTemplateContext struct {
 	CurrentEntity interface{}
 	Arguments     map[string]string
+	Result        map[string]any
 
 	// These are deliberately repeated because embedding structs
 	// won't work in text/template.
@@ -134,6 +135,12 @@ func migrateLegacyArgumentNames(rawShellCommand string) string {
 }
 
 func ParseTemplateWithActionContext(source string, ent *entities.Entity, args map[string]string) (string, error) {
+	return ParseTemplateWithActionResult(source, ent, args, nil)
+}
+
+// ParseTemplateWithActionResult is ParseTemplateWithActionContext, with the
+// parsed output of an execution available as .Result.
+func ParseTemplateWithActionResult(source string, ent *entities.Entity, args map[string]string, result map[string]any) (string, error) {
 	source = migrateLegacyArgumentNames(source)
 	source = migrateLegacyEntityProperties(source)
 
@@ -149,9 +156,10 @@ func ParseTemplateWithActionContext(source string, ent *entities.Entity, args ma
 
 		Arguments:     args,
 		CurrentEntity: entdata,
+		Result:        result,
 	}
 
-	result, err := parseTemplate(source, templateVariables)
+	parsed, err := parseTemplate(source, templateVariables)
 
 	if isMissingArgumentError, argName := checkMissingArgumentError(err); isMissingArgumentError {
 		return "", fmt.Errorf("required arg not provided: %s", argName)
@@ -161,7 +169,13 @@ func ParseTemplateWithActionContext(source string, ent *entities.Entity, args ma
 		return "", err
 	}
 
-	return result, nil
+	return parsed, nil
+}
+
+// ParseTemplateWithData parses a template that is not part of an action, such
+// as a notification, with any data.
+func ParseTemplateWithData(source string, data any) (string, error) {
+	return parseTemplate(source, data)
 }
 
 func checkMissingArgumentError(err error) (bool, string) {
@@ -0,0 +1,387 @@
+package webhooks
+
+/*
+Outgoing webhooks post execution events to other services. Events are added to
+a delivery queue, which is saved to disk so that deliveries survive a restart,
+and a single goroutine delivers them, retrying failures with a backoff until
+maxAttempts is reached.
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	outgoingTimeout = 30 * time.Second
+
+	// outgoingIdleWait is how long the dispatcher sleeps when there is
+	// nothing to deliver, unless it is woken by a new delivery.
+	outgoingIdleWait = time.Hour
+
+	firstRetryDelay = 5 * time.Second
+	maxRetryDelay   = 10 * time.Minute
+)
+
+var (
+	outgoingDispatchers   = make(map[*executor.Executor]*OutgoingDispatcher)
+	outgoingDispatchersMu sync.Mutex
+)
+
+// outgoingPayload is the JSON body of outgoing webhooks.
+type outgoingPayload struct {
+	Event     string            `json:"event"`
+	Datetime  string            `json:"datetime"`
+	Execution outgoingExecution `json:"execution"`
+}
+
+type outgoingExecution struct {
+	TrackingID       string `json:"trackingId"`
+	ActionId         string `json:"actionId"`
+	ActionTitle      string `json:"actionTitle"`
+	Username         string `json:"username"`
+	ExitCode         int32  `json:"exitCode"`
+	TimedOut         bool   `json:"timedOut"`
+	Blocked          bool   `json:"blocked"`
+	Output           string `json:"output"`
+	DatetimeStarted  string `json:"datetimeStarted"`
+	DatetimeFinished string `json:"datetimeFinished"`
+}
+
+// OutgoingDispatcher is an executor listener that queues and delivers
+// outgoing webhooks.
+type OutgoingDispatcher struct {
+	cfg    *config.Config
+	client *http.Client
+	queue  *deliveryQueue
+	wake   chan struct{}
+
+	// lastEvent is the last event of each unfinished execution.
+	lastEvent map[string]string
+	sentMu    sync.Mutex
+
+	// retryDelay is the wait before the given retry. It is a field so that
+	// tests do not have to wait.
+	retryDelay func(attempt int) time.Duration
+}
+
+func NewOutgoingDispatcher(cfg *config.Config, queueFile string) *OutgoingDispatcher {
+	return &OutgoingDispatcher{
+		cfg:        cfg,
+		client:     &http.Client{Timeout: outgoingTimeout},
+		queue:      newDeliveryQueue(queueFile),
+		wake:       make(chan struct{}, 1),
+		lastEvent:  make(map[string]string),
+		retryDelay: exponentialRetryDelay,
+	}
+}
+
+// RegisterOutgoingDispatcher starts delivering outgoing webhooks for the
+// executor, including any that were queued before a restart.
+func RegisterOutgoingDispatcher(ex *executor.Executor) {
+	outgoingDispatchersMu.Lock()
+	defer outgoingDispatchersMu.Unlock()
+
+	if _, ok := outgoingDispatchers[ex]; ok {
+		return
+	}
+
+	d := NewOutgoingDispatcher(ex.Cfg, outgoingQueueFile(ex.Cfg))
+	d.queue.load()
+
+	outgoingDispatchers[ex] = d
+	ex.AddListener(d)
+
+	go d.run()
+}
+
+func outgoingQueueFile(cfg *config.Config) string {
+	if cfg.GetStateDir() == "" {
+		return ""
+	}
+
+	return filepath.Join(cfg.GetStateDir(), "outgoing-webhooks.yaml")
+}
+
+// GetDeliveryLog returns the most recent delivery attempts, newest first, and
+// how many deliveries are waiting to be sent.
+func GetDeliveryLog(ex *executor.Executor) ([]DeliveryLogEntry, int) {
+	outgoingDispatchersMu.Lock()
+	d, ok := outgoingDispatchers[ex]
+	outgoingDispatchersMu.Unlock()
+
+	if !ok {
+		return nil, 0
+	}
+
+	return d.queue.deliveryLog()
+}
+
+func exponentialRetryDelay(attempt int) time.Duration {
+	delay := firstRetryDelay << (attempt - 1)
+
+	if delay <= 0 || delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+
+	return delay
+}
+
+// OnExecutionStarted is called when an execution is requested, queued, and
+// started, which are told apart by the log entry.
+func (d *OutgoingDispatcher) OnExecutionStarted(entry *executor.InternalLogEntry) {
+	switch {
+	case entry.Queued:
+		d.enqueue(config.WebhookEventQueued, entry)
+	case entry.ExecutionStarted:
+		d.enqueue(config.WebhookEventStarted, entry)
+	}
+}
+
+func (d *OutgoingDispatcher) OnExecutionFinished(entry *executor.InternalLogEntry) {
+	if entry.Blocked {
+		d.enqueue(config.WebhookEventBlocked, entry)
+	} else {
+		d.enqueue(config.WebhookEventFinished, entry)
+	}
+
+	d.sentMu.Lock()
+	delete(d.lastEvent, entry.ExecutionTrackingID)
+	d.sentMu.Unlock()
+}
+
+func (d *OutgoingDispatcher) OnOutputChunk(_ executor.OutputChunk, _ string) {}
+
+func (d *OutgoingDispatcher) OnActionMapRebuilt() {}
+
+func (d *OutgoingDispatcher) OnApprovalRequested(_ *executor.PendingApproval) {}
+
+func (d *OutgoingDispatcher) OnApprovalResolved(_ *executor.InternalLogEntry, _ bool) {}
+
+func (d *OutgoingDispatcher) enqueue(event string, entry *executor.InternalLogEntry) {
+	if d.isRepeatedEvent(event, entry.ExecutionTrackingID) {
+		return
+	}
+
+	webhooks := d.webhooksFor(event)
+
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload := newOutgoingPayload(event, entry)
+
+	for _, webhook := range webhooks {
+		d.queue.add(&delivery{
+			ID:          uuid.NewString(),
+			Webhook:     webhook.Name,
+			Event:       event,
+			TrackingID:  entry.ExecutionTrackingID,
+			Payload:     string(payload),
+			NextAttempt: time.Now(),
+		})
+	}
+
+	d.wakeUp()
+}
+
+func (d *OutgoingDispatcher) webhooksFor(event string) []*config.OutgoingWebhookConfig {
+	var ret []*config.OutgoingWebhookConfig
+
+	for _, webhook := range d.cfg.OutgoingWebhooks {
+		if slices.Contains(webhook.Events, event) {
+			ret = append(ret, webhook)
+		}
+	}
+
+	return ret
+}
+
+// isRepeatedEvent is true when the event is the same as the last event of
+// the execution, as a queued execution that starts straight away can be
+// reported as started more than once.
+func (d *OutgoingDispatcher) isRepeatedEvent(event string, trackingID string) bool {
+	d.sentMu.Lock()
+	defer d.sentMu.Unlock()
+
+	if d.lastEvent[trackingID] == event {
+		return true
+	}
+
+	d.lastEvent[trackingID] = event
+
+	return false
+}
+
+func (d *OutgoingDispatcher) wakeUp() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func newOutgoingPayload(event string, entry *executor.InternalLogEntry) []byte {
+	execution := outgoingExecution{
+		TrackingID:       entry.ExecutionTrackingID,
+		ActionTitle:      entry.ActionTitle,
+		Username:         entry.Username,
+		ExitCode:         entry.ExitCode,
+		TimedOut:         entry.TimedOut,
+		Blocked:          entry.Blocked,
+		Output:           entry.Output,
+		DatetimeStarted:  formatOutgoingTime(entry.DatetimeStarted),
+		DatetimeFinished: formatOutgoingTime(entry.DatetimeFinished),
+	}
+
+	if entry.Binding != nil && entry.Binding.Action != nil {
+		execution.ActionId = entry.Binding.Action.ID
+	}
+
+	// The payload only has strings, numbers and bools, so it always
+	// marshals.
+	payload, _ := json.Marshal(&outgoingPayload{
+		Event:     event,
+		Datetime:  formatOutgoingTime(time.Now()),
+		Execution: execution,
+	})
+
+	return payload
+}
+
+func formatOutgoingTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func (d *OutgoingDispatcher) run() {
+	for {
+		d.deliverDue()
+		d.waitForNextDelivery()
+	}
+}
+
+func (d *OutgoingDispatcher) waitForNextDelivery() {
+	wait := outgoingIdleWait
+
+	if next, ok := d.queue.nextAttempt(); ok {
+		wait = time.Until(next)
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-d.wake:
+	}
+}
+
+// deliverDue makes one attempt at each delivery that is due. Failed
+// deliveries are rescheduled in the future, so this always returns.
+func (d *OutgoingDispatcher) deliverDue() {
+	for {
+		next := d.queue.nextDue(time.Now())
+
+		if next == nil {
+			return
+		}
+
+		d.attempt(next)
+	}
+}
+
+func (d *OutgoingDispatcher) attempt(del *delivery) {
+	webhook := d.findWebhook(del.Webhook)
+
+	if webhook == nil {
+		log.WithFields(log.Fields{
+			"webhook": del.Webhook,
+		}).Warnf("Dropping outgoing webhook delivery, as the webhook is no longer configured")
+
+		d.queue.remove(del)
+		return
+	}
+
+	status, err := d.send(webhook, del)
+
+	d.queue.recordAttempt(del, status, err, webhook.MaxAttempts, d.retryDelay)
+}
+
+func (d *OutgoingDispatcher) findWebhook(name string) *config.OutgoingWebhookConfig {
+	for _, webhook := range d.cfg.OutgoingWebhooks {
+		if webhook.Name == name {
+			return webhook
+		}
+	}
+
+	return nil
+}
+
+func (d *OutgoingDispatcher) send(webhook *config.OutgoingWebhookConfig, del *delivery) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), outgoingTimeout)
+	defer cancel()
+
+	req, err := newOutgoingRequest(ctx, webhook, del)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+
+	_ = res.Body.Close()
+
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return res.Status, fmt.Errorf("unexpected response status: %v", res.Status)
+	}
+
+	return res.Status, nil
+}
+
+func newOutgoingRequest(ctx context.Context, webhook *config.OutgoingWebhookConfig, del *delivery) (*http.Request, error) {
+	payload := []byte(del.Payload)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-OliveTin-Event", del.Event)
+	req.Header.Set("X-OliveTin-Delivery", del.ID)
+
+	if webhook.Secret != "" {
+		req.Header.Set(webhook.AuthHeader, signPayload(webhook.Secret, payload))
+	}
+
+	return req, nil
+}
+
+// signPayload signs the payload in the format that AuthVerifier accepts for
+// hmac-sha256.
+func signPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
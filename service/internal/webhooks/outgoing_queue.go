@@ -0,0 +1,266 @@
+package webhooks
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// maxDeliveryLogEntries is how many delivery attempts are kept for
+// diagnostics.
+const maxDeliveryLogEntries = 100
+
+// queueSaveDelay is how long changes to the queue are collected before it is
+// saved, so that a burst of events writes the file once.
+const queueSaveDelay = time.Second
+
+// delivery is a single event to be sent to a single webhook. The payload is
+// kept as it was when the event happened, so retries send the same body.
+type delivery struct {
+	ID          string    `yaml:"id"`
+	Webhook     string    `yaml:"webhook"`
+	Event       string    `yaml:"event"`
+	TrackingID  string    `yaml:"trackingId"`
+	Payload     string    `yaml:"payload"`
+	Attempts    int       `yaml:"attempts"`
+	NextAttempt time.Time `yaml:"nextAttempt"`
+}
+
+// DeliveryLogEntry is a single attempt to deliver an outgoing webhook.
+type DeliveryLogEntry struct {
+	Datetime   time.Time
+	Webhook    string
+	Event      string
+	TrackingID string
+	Attempt    int
+	Status     string
+	Delivered  bool
+	WillRetry  bool
+}
+
+// deliveryQueue is the deliveries that have not been sent yet, which are
+// saved to file shortly after they change, and the log of recent attempts,
+// which is only kept in memory.
+type deliveryQueue struct {
+	mu        sync.Mutex
+	file      string
+	pending   []*delivery
+	attempts  []DeliveryLogEntry
+	saveTimer *time.Timer
+
+	// saveMu is held while writing the file, so that writes are in order,
+	// without holding mu while waiting for the disk.
+	saveMu sync.Mutex
+}
+
+func newDeliveryQueue(file string) *deliveryQueue {
+	return &deliveryQueue{
+		file: file,
+	}
+}
+
+func (q *deliveryQueue) load() {
+	if q.file == "" {
+		return
+	}
+
+	data, err := os.ReadFile(q.file)
+
+	if os.IsNotExist(err) {
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Warnf("Failed to read outgoing webhook queue")
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if err := yaml.Unmarshal(data, &q.pending); err != nil {
+		log.WithError(err).Errorf("Failed to unmarshal outgoing webhook queue")
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"count": len(q.pending),
+	}).Infof("Loaded outgoing webhook queue")
+}
+
+// saveLocked saves the pending deliveries after queueSaveDelay, unless a save
+// is already waiting. The caller must hold mu.
+func (q *deliveryQueue) saveLocked() {
+	if q.file == "" || q.saveTimer != nil {
+		return
+	}
+
+	q.saveTimer = time.AfterFunc(queueSaveDelay, q.flush)
+}
+
+// flush writes the pending deliveries to file.
+func (q *deliveryQueue) flush() {
+	q.saveMu.Lock()
+	defer q.saveMu.Unlock()
+
+	q.mu.Lock()
+	q.saveTimer = nil
+	out, err := yaml.Marshal(q.pending)
+	q.mu.Unlock()
+
+	if err != nil {
+		log.WithError(err).Errorf("Failed to marshal outgoing webhook queue")
+		return
+	}
+
+	if err := writeFileAtomic(q.file, out); err != nil {
+		log.WithError(err).Errorf("Failed to write outgoing webhook queue")
+	}
+}
+
+// writeFileAtomic writes to a temporary file and renames it over the file,
+// so that the file is never left half written. Payloads can have action
+// output in them, so the file is only readable by the owner.
+func writeFileAtomic(file string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = tmp.Write(data)
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), file)
+}
+
+func (q *deliveryQueue) add(del *delivery) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.pending = append(q.pending, del)
+	q.saveLocked()
+}
+
+func (q *deliveryQueue) remove(del *delivery) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.removeLocked(del)
+	q.saveLocked()
+}
+
+func (q *deliveryQueue) removeLocked(del *delivery) {
+	q.pending = slices.DeleteFunc(q.pending, func(d *delivery) bool {
+		return d == del
+	})
+}
+
+// nextDue returns the oldest delivery that is due to be attempted.
+func (q *deliveryQueue) nextDue(now time.Time) *delivery {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, del := range q.pending {
+		if !del.NextAttempt.After(now) {
+			return del
+		}
+	}
+
+	return nil
+}
+
+func (q *deliveryQueue) nextAttempt() (time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) == 0 {
+		return time.Time{}, false
+	}
+
+	next := q.pending[0].NextAttempt
+
+	for _, del := range q.pending[1:] {
+		if del.NextAttempt.Before(next) {
+			next = del.NextAttempt
+		}
+	}
+
+	return next, true
+}
+
+// recordAttempt removes the delivery if it was sent or has run out of
+// attempts, and otherwise schedules the next attempt.
+func (q *deliveryQueue) recordAttempt(del *delivery, status string, err error, maxAttempts int, retryDelay func(int) time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	del.Attempts++
+
+	entry := DeliveryLogEntry{
+		Datetime:   time.Now(),
+		Webhook:    del.Webhook,
+		Event:      del.Event,
+		TrackingID: del.TrackingID,
+		Attempt:    del.Attempts,
+		Status:     status,
+		Delivered:  err == nil,
+		WillRetry:  err != nil && del.Attempts < maxAttempts,
+	}
+
+	if err != nil {
+		entry.Status = err.Error()
+		logFailedDelivery(del, err, entry.WillRetry)
+	}
+
+	if entry.WillRetry {
+		del.NextAttempt = time.Now().Add(retryDelay(del.Attempts))
+	} else {
+		q.removeLocked(del)
+	}
+
+	q.attempts = append([]DeliveryLogEntry{entry}, q.attempts...)
+	q.attempts = q.attempts[:min(len(q.attempts), maxDeliveryLogEntries)]
+
+	q.saveLocked()
+}
+
+func logFailedDelivery(del *delivery, err error, willRetry bool) {
+	fields := log.Fields{
+		"webhook":  del.Webhook,
+		"event":    del.Event,
+		"attempt":  del.Attempts,
+		"error":    err,
+		"retrying": willRetry,
+	}
+
+	if willRetry {
+		log.WithFields(fields).Warnf("Outgoing webhook delivery failed")
+	} else {
+		log.WithFields(fields).Errorf("Outgoing webhook delivery failed, giving up")
+	}
+}
+
+func (q *deliveryQueue) deliveryLog() ([]DeliveryLogEntry, int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return slices.Clone(q.attempts), len(q.pending)
+}
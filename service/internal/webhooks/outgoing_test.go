@@ -0,0 +1,232 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	config "github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+type outgoingReceiver struct {
+	mu       sync.Mutex
+	verifier *AuthVerifier
+	failures int
+	events   []string
+	payloads []outgoingPayload
+	verified []bool
+}
+
+func (r *outgoingReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	payload := outgoingPayload{}
+	_ = json.Unmarshal(body, &payload)
+
+	r.events = append(r.events, req.Header.Get("X-OliveTin-Event"))
+	r.payloads = append(r.payloads, payload)
+	r.verified = append(r.verified, r.verifier.Verify(req, body))
+}
+
+func newOutgoingTestConfig(url string) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.OutgoingWebhooks = []*config.OutgoingWebhookConfig{
+		{Name: "incidents", Url: url, Secret: "s3cret", AuthHeader: "X-Incident-Signature", MaxAttempts: 3},
+	}
+
+	return cfg
+}
+
+func noRetryDelay(_ int) time.Duration {
+	return 0
+}
+
+func TestOutgoingWebhooksAreSignedLikeIncomingWebhooks(t *testing.T) {
+	receiver := &outgoingReceiver{
+		verifier: NewAuthVerifier(config.WebhookConfig{AuthType: "hmac-sha256", Secret: "s3cret", AuthHeader: "X-Incident-Signature"}),
+	}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	cfg := newOutgoingTestConfig(srv.URL)
+	cfg.Actions = append(cfg.Actions, &config.Action{Title: "Restart", Shell: "echo restarted"})
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	d := NewOutgoingDispatcher(cfg, "")
+	ex.AddListener(d)
+
+	wg, trackingID := ex.ExecRequest(&executor.ExecutionRequest{
+		Binding: ex.FindBindingWithNoEntity(cfg.Actions[0]),
+		Cfg:     cfg,
+	})
+	wg.Wait()
+
+	d.deliverDue()
+
+	assert.Equal(t, []string{"started", "finished"}, receiver.events)
+	assert.Equal(t, []bool{true, true}, receiver.verified, "Signatures are accepted by the hmac-sha256 verifier")
+
+	finished := receiver.payloads[1]
+	assert.Equal(t, "finished", finished.Event)
+	assert.Equal(t, trackingID, finished.Execution.TrackingID)
+	assert.Equal(t, "Restart", finished.Execution.ActionTitle)
+	assert.Equal(t, cfg.Actions[0].ID, finished.Execution.ActionId)
+	assert.Equal(t, "restarted\n", finished.Execution.Output)
+}
+
+func TestOutgoingWebhooksOnlySendConfiguredEvents(t *testing.T) {
+	cfg := newOutgoingTestConfig("http://localhost")
+	cfg.OutgoingWebhooks[0].Events = []string{"Blocked"}
+	cfg.Sanitize()
+
+	d := NewOutgoingDispatcher(cfg, "")
+	d.OnExecutionFinished(&executor.InternalLogEntry{})
+	d.OnExecutionStarted(&executor.InternalLogEntry{ExecutionStarted: true})
+
+	_, pending := d.queue.deliveryLog()
+	assert.Equal(t, 0, pending)
+
+	d.OnExecutionFinished(&executor.InternalLogEntry{Blocked: true})
+
+	_, pending = d.queue.deliveryLog()
+	assert.Equal(t, 1, pending)
+}
+
+func TestOutgoingWebhooksRetryUntilDelivered(t *testing.T) {
+	receiver := &outgoingReceiver{verifier: NewAuthVerifier(config.WebhookConfig{}), failures: 2}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	cfg := newOutgoingTestConfig(srv.URL)
+	cfg.Sanitize()
+
+	d := NewOutgoingDispatcher(cfg, "")
+	d.retryDelay = noRetryDelay
+
+	d.OnExecutionStarted(&executor.InternalLogEntry{ExecutionTrackingID: "abc", Queued: true})
+	d.deliverDue()
+
+	assert.Equal(t, []string{"queued"}, receiver.events)
+
+	attempts, pending := d.queue.deliveryLog()
+	assert.Equal(t, 0, pending)
+	require.Len(t, attempts, 3)
+	assert.True(t, attempts[0].Delivered, "The log is newest first")
+	assert.Equal(t, "200 OK", attempts[0].Status)
+	assert.Equal(t, 3, attempts[0].Attempt)
+	assert.True(t, attempts[2].WillRetry)
+	assert.Equal(t, "abc", attempts[2].TrackingID)
+}
+
+func TestOutgoingWebhooksGiveUpAfterMaxAttempts(t *testing.T) {
+	receiver := &outgoingReceiver{failures: 100}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	cfg := newOutgoingTestConfig(srv.URL)
+	cfg.OutgoingWebhooks[0].MaxAttempts = 2
+	cfg.Sanitize()
+
+	d := NewOutgoingDispatcher(cfg, "")
+	d.retryDelay = noRetryDelay
+
+	d.OnExecutionStarted(&executor.InternalLogEntry{ExecutionStarted: true})
+	d.deliverDue()
+
+	attempts, pending := d.queue.deliveryLog()
+	assert.Equal(t, 0, pending)
+	require.Len(t, attempts, 2)
+	assert.False(t, attempts[0].Delivered)
+	assert.False(t, attempts[0].WillRetry)
+	assert.Contains(t, attempts[0].Status, "503")
+}
+
+func TestOutgoingWebhookQueueSurvivesRestart(t *testing.T) {
+	receiver := &outgoingReceiver{verifier: NewAuthVerifier(config.WebhookConfig{})}
+	srv := httptest.NewServer(receiver)
+	defer srv.Close()
+
+	cfg := newOutgoingTestConfig(srv.URL)
+	cfg.Sanitize()
+
+	queueFile := filepath.Join(t.TempDir(), "outgoing-webhooks.yaml")
+
+	before := NewOutgoingDispatcher(cfg, queueFile)
+	before.OnExecutionStarted(&executor.InternalLogEntry{ExecutionTrackingID: "before-restart", ExecutionStarted: true})
+	before.queue.flush()
+
+	after := NewOutgoingDispatcher(cfg, queueFile)
+	after.queue.load()
+	after.deliverDue()
+	after.queue.flush()
+
+	require.Len(t, receiver.payloads, 1)
+	assert.Equal(t, "before-restart", receiver.payloads[0].Execution.TrackingID)
+
+	reloaded := NewOutgoingDispatcher(cfg, queueFile)
+	reloaded.queue.load()
+
+	_, pending := reloaded.queue.deliveryLog()
+	assert.Equal(t, 0, pending, "Delivered webhooks are removed from the saved queue")
+}
+
+func TestOutgoingWebhookQueueIsSavedOnceAfterABurst(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SetDir(t.TempDir())
+
+	queueFile := outgoingQueueFile(cfg)
+	assert.Equal(t, filepath.Join(cfg.GetDir(), "state", "outgoing-webhooks.yaml"), queueFile, "The queue is not saved in the config directory")
+
+	q := newDeliveryQueue(queueFile)
+
+	for i := range 10 {
+		q.add(&delivery{ID: strconv.Itoa(i)})
+	}
+
+	assert.NoFileExists(t, queueFile, "Saves wait for more changes")
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(queueFile)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	reloaded := newDeliveryQueue(queueFile)
+	reloaded.load()
+
+	_, pending := reloaded.deliveryLog()
+	assert.Equal(t, 10, pending)
+
+	files, err := os.ReadDir(filepath.Dir(queueFile))
+	require.NoError(t, err)
+	assert.Len(t, files, 1, "No temporary files are left behind")
+}
+
+func TestExponentialRetryDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Second, exponentialRetryDelay(1))
+	assert.Equal(t, 20*time.Second, exponentialRetryDelay(3))
+	assert.Equal(t, maxRetryDelay, exponentialRetryDelay(20))
+	assert.Equal(t, maxRetryDelay, exponentialRetryDelay(100))
+}
@@ -0,0 +1,438 @@
+package workflows
+
+/*
+A workflow runs its steps as a graph. Every step waits for the steps that it
+needs, and then runs or is skipped depending on its condition. The arguments
+of a step are templates, so that the output of one step can be passed to the
+next. All the executions of a run share the tracking ID of the run.
+*/
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	authpublic "github.com/OliveTin/OliveTin/internal/auth/authpublic"
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+	"github.com/OliveTin/OliveTin/internal/tpl"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+// Statuses of runs and steps.
+const (
+	StatusPending   = "pending"
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusSkipped   = "skipped"
+)
+
+// maxRuns is how many runs are kept in memory for each executor. The
+// executions of older runs are still in the logs.
+const maxRuns = 100
+
+// Run is the state of a workflow that has been started.
+type Run struct {
+	TrackingID       string
+	WorkflowID       string
+	Title            string
+	Username         string
+	Status           string
+	DatetimeStarted  time.Time
+	DatetimeFinished time.Time
+	Steps            []StepRun
+}
+
+// StepRun is the state of one step of a run.
+type StepRun struct {
+	ID                  string
+	ActionTitle         string
+	Status              string
+	ExecutionTrackingID string
+	ExitCode            int32
+	Error               string
+}
+
+// stepOutput is what the templates of later steps can use, as
+// {{ .Steps.<id>.Stdout }}.
+type stepOutput struct {
+	Status     string
+	ExitCode   int32
+	Output     string
+	Stdout     string
+	Stderr     string
+	Result     map[string]any
+	TrackingID string
+}
+
+type templateData struct {
+	Arguments          map[string]string
+	Steps              map[string]*stepOutput
+	WorkflowTrackingId string
+}
+
+type runner struct {
+	ex       *executor.Executor
+	cfg      *config.Config
+	workflow *config.Workflow
+	user     *authpublic.AuthenticatedUser
+	args     map[string]string
+
+	done     map[string]chan struct{}
+	finished chan struct{}
+
+	mu      sync.Mutex
+	run     *Run
+	outputs map[string]*stepOutput
+}
+
+type runStore struct {
+	mu    sync.Mutex
+	runs  map[string]*runner
+	order []string
+}
+
+var (
+	stores   = make(map[*executor.Executor]*runStore)
+	storesMu sync.Mutex
+)
+
+func getStore(ex *executor.Executor) *runStore {
+	storesMu.Lock()
+	defer storesMu.Unlock()
+
+	store, ok := stores[ex]
+
+	if !ok {
+		store = &runStore{runs: make(map[string]*runner)}
+		stores[ex] = store
+	}
+
+	return store
+}
+
+func (store *runStore) add(r *runner) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.runs[r.run.TrackingID] = r
+	store.order = append(store.order, r.run.TrackingID)
+
+	if len(store.order) > maxRuns {
+		delete(store.runs, store.order[0])
+		store.order = store.order[1:]
+	}
+}
+
+// Start starts a run of the workflow, and returns its tracking ID without
+// waiting for it to finish.
+func Start(ex *executor.Executor, workflowID string, args map[string]string, user *authpublic.AuthenticatedUser) (string, error) {
+	r, err := newRunner(ex, workflowID, args, user)
+
+	if err != nil {
+		return "", err
+	}
+
+	getStore(ex).add(r)
+
+	log.WithFields(log.Fields{
+		"workflow":   workflowID,
+		"trackingId": r.run.TrackingID,
+		"username":   user.Username,
+	}).Infof("Starting workflow")
+
+	for idx := range r.workflow.Steps {
+		go r.runStep(idx)
+	}
+
+	go r.finish()
+
+	return r.run.TrackingID, nil
+}
+
+func newRunner(ex *executor.Executor, workflowID string, args map[string]string, user *authpublic.AuthenticatedUser) (*runner, error) {
+	workflow := ex.Cfg.FindWorkflow(workflowID)
+
+	if workflow == nil {
+		return nil, fmt.Errorf("workflow not found: %v", workflowID)
+	}
+
+	if err := ex.Cfg.ValidateWorkflow(workflow); err != nil {
+		return nil, fmt.Errorf("workflow %v is invalid: %w", workflowID, err)
+	}
+
+	r := &runner{
+		ex:       ex,
+		cfg:      ex.Cfg,
+		workflow: workflow,
+		user:     user,
+		args:     args,
+		done:     make(map[string]chan struct{}),
+		finished: make(chan struct{}),
+		outputs:  make(map[string]*stepOutput),
+		run: &Run{
+			TrackingID:      uuid.NewString(),
+			WorkflowID:      workflow.ID,
+			Title:           workflow.Title,
+			Username:        user.Username,
+			Status:          StatusRunning,
+			DatetimeStarted: time.Now(),
+		},
+	}
+
+	for _, step := range workflow.Steps {
+		r.done[step.ID] = make(chan struct{})
+		r.run.Steps = append(r.run.Steps, StepRun{
+			ID:          step.ID,
+			ActionTitle: step.Action,
+			Status:      StatusPending,
+		})
+	}
+
+	return r, nil
+}
+
+// GetRun returns a copy of the state of a run, or false when the run is not
+// known, such as after a restart.
+func GetRun(ex *executor.Executor, trackingID string) (*Run, bool) {
+	store := getStore(ex)
+
+	store.mu.Lock()
+	r, ok := store.runs[trackingID]
+	store.mu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run := *r.run
+	run.Steps = slices.Clone(r.run.Steps)
+
+	return &run, true
+}
+
+// Wait blocks until the run has finished.
+func Wait(ex *executor.Executor, trackingID string) {
+	store := getStore(ex)
+
+	store.mu.Lock()
+	r, ok := store.runs[trackingID]
+	store.mu.Unlock()
+
+	if !ok {
+		return
+	}
+
+	<-r.finished
+}
+
+func (r *runner) finish() {
+	defer close(r.finished)
+
+	for _, done := range r.done {
+		<-done
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.run.Status = StatusSucceeded
+	r.run.DatetimeFinished = time.Now()
+
+	for _, step := range r.run.Steps {
+		if step.Status == StatusFailed {
+			r.run.Status = StatusFailed
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"workflow":   r.run.WorkflowID,
+		"trackingId": r.run.TrackingID,
+		"status":     r.run.Status,
+	}).Infof("Workflow finished")
+}
+
+func (r *runner) runStep(idx int) {
+	step := &r.workflow.Steps[idx]
+
+	defer close(r.done[step.ID])
+
+	for _, need := range step.Needs {
+		<-r.done[need]
+	}
+
+	if !r.shouldRun(step) {
+		r.updateStep(idx, func(s *StepRun) { s.Status = StatusSkipped })
+		return
+	}
+
+	args, err := r.stepArguments(step)
+
+	if err != nil {
+		r.failStep(idx, err)
+		return
+	}
+
+	r.execStep(idx, step, args)
+}
+
+// shouldRun checks the condition of the step against the steps that it needs,
+// which have all finished. Steps that were skipped have neither succeeded nor
+// failed.
+func (r *runner) shouldRun(step *config.WorkflowStep) bool {
+	switch step.If {
+	case config.WorkflowStepIfAlways:
+		return true
+	case config.WorkflowStepIfFailure:
+		return r.countNeeds(step, StatusFailed) > 0
+	default:
+		return r.countNeeds(step, StatusSucceeded) == len(step.Needs)
+	}
+}
+
+func (r *runner) countNeeds(step *config.WorkflowStep, status string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count := 0
+
+	for _, need := range step.Needs {
+		if r.outputs[need] != nil && r.outputs[need].Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
+// stepArguments renders the arguments of the step over the arguments that
+// the workflow was started with.
+func (r *runner) stepArguments(step *config.WorkflowStep) (map[string]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := &templateData{
+		Arguments:          r.args,
+		Steps:              r.outputs,
+		WorkflowTrackingId: r.run.TrackingID,
+	}
+
+	args := make(map[string]string, len(r.args)+len(step.Arguments))
+
+	for name, value := range r.args {
+		args[name] = value
+	}
+
+	for name, source := range step.Arguments {
+		value, err := tpl.ParseTemplateWithData(source, data)
+
+		if err != nil {
+			return nil, fmt.Errorf("argument %v: %w", name, err)
+		}
+
+		args[name] = value
+	}
+
+	return args, nil
+}
+
+func (r *runner) execStep(idx int, step *config.WorkflowStep, args map[string]string) {
+	binding := r.ex.FindBindingByActionTitle(step.Action)
+
+	if binding == nil {
+		r.failStep(idx, fmt.Errorf("action not found: %v", step.Action))
+		return
+	}
+
+	wg, trackingID := r.ex.ExecRequest(&executor.ExecutionRequest{
+		Binding:            binding,
+		Cfg:                r.cfg,
+		Tags:               []string{"workflow"},
+		Arguments:          args,
+		AuthenticatedUser:  r.user,
+		Justification:      fmt.Sprintf("Step %v of workflow: %v", step.ID, r.workflow.Title),
+		WorkflowTrackingID: r.run.TrackingID,
+		WorkflowStep:       step.ID,
+	})
+
+	r.updateStep(idx, func(s *StepRun) {
+		s.Status = StatusRunning
+		s.ExecutionTrackingID = trackingID
+	})
+
+	wg.Wait()
+
+	entry, ok := r.ex.GetLog(trackingID)
+
+	if !ok {
+		r.failStep(idx, fmt.Errorf("execution log not found: %v", trackingID))
+		return
+	}
+
+	r.finishStep(idx, step, entry)
+}
+
+func (r *runner) finishStep(idx int, step *config.WorkflowStep, entry *executor.InternalLogEntry) {
+	status := StatusFailed
+
+	if stepSucceeded(step, entry) {
+		status = StatusSucceeded
+	}
+
+	r.mu.Lock()
+	r.outputs[step.ID] = &stepOutput{
+		Status:     status,
+		ExitCode:   entry.ExitCode,
+		Output:     entry.Output,
+		Stdout:     strings.TrimRight(entry.Stdout, "\n"),
+		Stderr:     entry.Stderr,
+		Result:     entry.Result,
+		TrackingID: entry.ExecutionTrackingID,
+	}
+	r.mu.Unlock()
+
+	r.updateStep(idx, func(s *StepRun) {
+		s.Status = status
+		s.ExitCode = entry.ExitCode
+	})
+}
+
+func stepSucceeded(step *config.WorkflowStep, entry *executor.InternalLogEntry) bool {
+	if entry.Blocked || entry.TimedOut || !entry.ExecutionFinished {
+		return false
+	}
+
+	return slices.Contains(step.ExitCodes, entry.ExitCode)
+}
+
+func (r *runner) failStep(idx int, err error) {
+	log.WithFields(log.Fields{
+		"workflow":   r.run.WorkflowID,
+		"trackingId": r.run.TrackingID,
+		"step":       r.workflow.Steps[idx].ID,
+		"error":      err,
+	}).Warnf("Workflow step failed")
+
+	r.mu.Lock()
+	r.outputs[r.workflow.Steps[idx].ID] = &stepOutput{Status: StatusFailed}
+	r.mu.Unlock()
+
+	r.updateStep(idx, func(s *StepRun) {
+		s.Status = StatusFailed
+		s.Error = err.Error()
+	})
+}
+
+func (r *runner) updateStep(idx int, mutator func(*StepRun)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	mutator(&r.run.Steps[idx])
+}
@@ -0,0 +1,153 @@
+package workflows
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/config"
+	"github.com/OliveTin/OliveTin/internal/executor"
+)
+
+func newTestExecutor(cfg *config.Config) *executor.Executor {
+	cfg.Sanitize()
+
+	ex := executor.DefaultExecutor(cfg)
+	ex.RebuildActionMap()
+
+	return ex
+}
+
+func startAndWait(t *testing.T, ex *executor.Executor, workflowID string, args map[string]string) *Run {
+	trackingID, err := Start(ex, workflowID, args, auth.UserFromSystem(ex.Cfg, "test"))
+	require.NoError(t, err)
+
+	Wait(ex, trackingID)
+
+	run, ok := GetRun(ex, trackingID)
+	require.True(t, ok)
+
+	return run
+}
+
+func stepStatuses(run *Run) map[string]string {
+	ret := make(map[string]string)
+
+	for _, step := range run.Steps {
+		ret[step.ID] = step.Status
+	}
+
+	return ret
+}
+
+func TestOutputIsPassedToTheNextStep(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions,
+		&config.Action{
+			Title:     "Build",
+			Exec:      []string{"echo", "build-{{ version }}"},
+			Arguments: []config.ActionArgument{{Name: "version", Type: "ascii_identifier"}},
+		},
+		&config.Action{
+			Title:     "Deploy",
+			Exec:      []string{"echo", "deploying", "{{ artifact }}"},
+			Arguments: []config.ActionArgument{{Name: "artifact", Type: "very_dangerous_raw_string"}},
+		},
+	)
+	cfg.Workflows = []*config.Workflow{{
+		ID: "release",
+		Steps: []config.WorkflowStep{
+			{Action: "Build"},
+			{Action: "Deploy", Needs: []string{"Build"}, Arguments: map[string]string{"artifact": `{{ (index .Steps "Build").Stdout }}.tar`}},
+		},
+	}}
+
+	ex := newTestExecutor(cfg)
+	run := startAndWait(t, ex, "release", map[string]string{"version": "7"})
+
+	assert.Equal(t, StatusSucceeded, run.Status)
+
+	entries := ex.GetLogsByWorkflow(run.TrackingID)
+	require.Len(t, entries, 2)
+
+	deploy, ok := ex.GetLog(run.Steps[1].ExecutionTrackingID)
+	require.True(t, ok)
+	assert.Equal(t, "deploying build-7.tar\n", deploy.Output)
+	assert.Equal(t, "Deploy", deploy.WorkflowStep)
+	assert.Equal(t, run.TrackingID, deploy.WorkflowTrackingID)
+}
+
+func TestStepConditions(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions,
+		&config.Action{Title: "Test", Exec: []string{"false"}},
+		&config.Action{Title: "Deploy", Exec: []string{"true"}},
+		&config.Action{Title: "Notify", Exec: []string{"true"}},
+		&config.Action{Title: "Clean up", Exec: []string{"true"}},
+	)
+	cfg.Workflows = []*config.Workflow{{
+		ID: "release",
+		Steps: []config.WorkflowStep{
+			{Action: "Test"},
+			{Action: "Deploy", Needs: []string{"Test"}},
+			{ID: "after-deploy", Action: "Notify", Needs: []string{"Deploy"}, If: "failure"},
+			{ID: "on-failure", Action: "Notify", Needs: []string{"Test"}, If: "failure"},
+			{ID: "cleanup", Action: "Clean up", Needs: []string{"Deploy", "Test"}, If: "always"},
+		},
+	}}
+
+	run := startAndWait(t, newTestExecutor(cfg), "release", nil)
+
+	assert.Equal(t, StatusFailed, run.Status)
+	assert.Equal(t, map[string]string{
+		"Test":         StatusFailed,
+		"Deploy":       StatusSkipped,
+		"after-deploy": StatusSkipped,
+		"on-failure":   StatusSucceeded,
+		"cleanup":      StatusSucceeded,
+	}, stepStatuses(run))
+}
+
+func TestExitCodesThatCountAsSuccess(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions,
+		&config.Action{Title: "Check", Exec: []string{"false"}},
+		&config.Action{Title: "Fix", Exec: []string{"true"}},
+	)
+	cfg.Workflows = []*config.Workflow{{
+		ID: "maintenance",
+		Steps: []config.WorkflowStep{
+			{Action: "Check", ExitCodes: []int32{0, 1}},
+			{Action: "Fix", Needs: []string{"Check"}},
+		},
+	}}
+
+	run := startAndWait(t, newTestExecutor(cfg), "maintenance", nil)
+
+	assert.Equal(t, StatusSucceeded, run.Status)
+	assert.Equal(t, int32(1), run.Steps[0].ExitCode)
+	assert.Equal(t, StatusSucceeded, run.Steps[1].Status)
+}
+
+func TestInvalidWorkflowsAreNotStarted(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Actions = append(cfg.Actions, &config.Action{Title: "Ping", Exec: []string{"true"}})
+	cfg.Workflows = []*config.Workflow{
+		{ID: "cycle", Steps: []config.WorkflowStep{
+			{ID: "a", Action: "Ping", Needs: []string{"b"}},
+			{ID: "b", Action: "Ping", Needs: []string{"a"}},
+		}},
+		{ID: "unknown-action", Steps: []config.WorkflowStep{{Action: "Pong"}}},
+		{ID: "unknown-need", Steps: []config.WorkflowStep{{Action: "Ping", Needs: []string{"Pong"}}}},
+	}
+
+	ex := newTestExecutor(cfg)
+	user := auth.UserFromSystem(cfg, "test")
+
+	for _, id := range []string{"cycle", "unknown-action", "unknown-need", "missing"} {
+		_, err := Start(ex, id, nil, user)
+		assert.Error(t, err, id)
+	}
+}
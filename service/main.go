@@ -2,23 +2,31 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"path/filepath"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/OliveTin/OliveTin/internal/agents"
 	"github.com/OliveTin/OliveTin/internal/api"
+	"github.com/OliveTin/OliveTin/internal/audit"
 	"github.com/OliveTin/OliveTin/internal/auth"
+	"github.com/OliveTin/OliveTin/internal/auth/ottotp"
 	"github.com/OliveTin/OliveTin/internal/entities"
 	"github.com/OliveTin/OliveTin/internal/executor"
 	"github.com/OliveTin/OliveTin/internal/httpservers"
 	"github.com/OliveTin/OliveTin/internal/installationinfo"
+	"github.com/OliveTin/OliveTin/internal/notifications"
 	"github.com/OliveTin/OliveTin/internal/oncalendarfile"
 	"github.com/OliveTin/OliveTin/internal/oncron"
 	"github.com/OliveTin/OliveTin/internal/onfileindir"
+	"github.com/OliveTin/OliveTin/internal/onmqtt"
 	"github.com/OliveTin/OliveTin/internal/onstartup"
+	"github.com/OliveTin/OliveTin/internal/scheduled"
 	"github.com/OliveTin/OliveTin/internal/servicehost"
 	updatecheck "github.com/OliveTin/OliveTin/internal/updatecheck"
+	"github.com/OliveTin/OliveTin/internal/webhooks"
 
 	"os"
 	"strconv"
@@ -250,6 +258,16 @@ func warnIfPuidGuid() {
 	}
 }
 
+// recordConfigReload is only added once the config has been loaded, so it is
+// only called on reloads.
+func recordConfigReload() {
+	audit.Record(audit.Event{
+		Type:    audit.TypeConfigReload,
+		Outcome: audit.OutcomeSuccess,
+		Detail:  fmt.Sprintf("actions: %d", len(cfg.Actions)),
+	})
+}
+
 func main() {
 	servicehost.Start(cfg.ServiceHostMode, cfg.ServiceLogs.Directory)
 
@@ -259,19 +277,39 @@ func main() {
 
 	log.Debugf("Config: %+v", cfg)
 
+	if err := audit.Open(cfg); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Fatalf("Error opening audit log")
+	}
+
+	config.AddListener(recordConfigReload)
+
 	executor := executor.DefaultExecutor(cfg)
 	executor.RebuildActionMap()
 	config.AddListener(executor.RebuildActionMap)
 
+	if err := executor.OpenHistoryStore(); err != nil {
+		log.WithFields(log.Fields{
+			"error": err,
+		}).Fatalf("Error opening history database")
+	}
+
 	executor.LoadLogsFromDisk()
+	go executor.StartRetentionSweeper()
 
 	api.RegisterExecutorListener(executor)
+	agents.RegisterExecutorDispatcher(executor)
+	notifications.RegisterExecutorListener(executor)
+	webhooks.RegisterOutgoingDispatcher(executor)
+	scheduled.Register(executor)
 	entities.AddListener(executor.RebuildActionMap)
 
 	go onstartup.Execute(cfg, executor)
-	go oncron.Schedule(cfg, executor)
-	go onfileindir.WatchFilesInDirectory(cfg, executor)
-	go oncalendarfile.Schedule(cfg, executor)
+	oncron.Schedule(cfg, executor)
+	onfileindir.WatchFilesInDirectory(cfg, executor)
+	oncalendarfile.Schedule(cfg, executor)
+	go onmqtt.Start(cfg, executor)
 
 	go entities.SetupEntityFileWatchers(cfg)
 
@@ -279,6 +317,7 @@ func main() {
 
 	// Load persistent sessions from disk
 	auth.LoadUserSessions(cfg)
+	ottotp.Load(cfg)
 
 	httpservers.StartFrontendMux(cfg, executor)
 }